@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.symSlash.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.symSlash.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.symSlash.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName        = "/cosmos.symSlash.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName   = "/cosmos.symSlash.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName  = "/cosmos.symSlash.v1beta1.Query/SigningInfos"
+	Query_SlashRequest_FullMethodName  = "/cosmos.symSlash.v1beta1.Query/SlashRequest"
+	Query_SlashRequests_FullMethodName = "/cosmos.symSlash.v1beta1.Query/SlashRequests"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,11 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// SlashRequest queries a slash request of the outbox by ID
+	SlashRequest(ctx context.Context, in *QuerySlashRequestRequest, opts ...grpc.CallOption) (*QuerySlashRequestResponse, error)
+	// SlashRequests queries the slash requests of the outbox, optionally with a
+	// given status
+	SlashRequests(ctx context.Context, in *QuerySlashRequestsRequest, opts ...grpc.CallOption) (*QuerySlashRequestsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +78,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) SlashRequest(ctx context.Context, in *QuerySlashRequestRequest, opts ...grpc.CallOption) (*QuerySlashRequestResponse, error) {
+	out := new(QuerySlashRequestResponse)
+	err := c.cc.Invoke(ctx, Query_SlashRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashRequests(ctx context.Context, in *QuerySlashRequestsRequest, opts ...grpc.CallOption) (*QuerySlashRequestsResponse, error) {
+	out := new(QuerySlashRequestsResponse)
+	err := c.cc.Invoke(ctx, Query_SlashRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +106,11 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// SlashRequest queries a slash request of the outbox by ID
+	SlashRequest(context.Context, *QuerySlashRequestRequest) (*QuerySlashRequestResponse, error)
+	// SlashRequests queries the slash requests of the outbox, optionally with a
+	// given status
+	SlashRequests(context.Context, *QuerySlashRequestsRequest) (*QuerySlashRequestsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +127,12 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) SlashRequest(context.Context, *QuerySlashRequestRequest) (*QuerySlashRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRequest not implemented")
+}
+func (UnimplementedQueryServer) SlashRequests(context.Context, *QuerySlashRequestsRequest) (*QuerySlashRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRequests not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +200,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRequest(ctx, req.(*QuerySlashRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRequests(ctx, req.(*QuerySlashRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +255,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "SlashRequest",
+			Handler:    _Query_SlashRequest_Handler,
+		},
+		{
+			MethodName: "SlashRequests",
+			Handler:    _Query_SlashRequests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symSlash/v1beta1/query.proto",
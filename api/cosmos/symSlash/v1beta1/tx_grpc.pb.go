@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.symSlash.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.symSlash.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName               = "/cosmos.symSlash.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName         = "/cosmos.symSlash.v1beta1.Msg/UpdateParams"
+	Msg_CompleteSlashRequest_FullMethodName = "/cosmos.symSlash.v1beta1.Msg/CompleteSlashRequest"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,6 +35,9 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/symSlash module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CompleteSlashRequest defines a method for a slash relayer to mark a slash
+	// request completed once its slash is observed on Ethereum.
+	CompleteSlashRequest(ctx context.Context, in *MsgCompleteSlashRequest, opts ...grpc.CallOption) (*MsgCompleteSlashRequestResponse, error)
 }
 
 type msgClient struct {
@@ -62,6 +66,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CompleteSlashRequest(ctx context.Context, in *MsgCompleteSlashRequest, opts ...grpc.CallOption) (*MsgCompleteSlashRequestResponse, error) {
+	out := new(MsgCompleteSlashRequestResponse)
+	err := c.cc.Invoke(ctx, Msg_CompleteSlashRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -73,6 +86,9 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/symSlash module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CompleteSlashRequest defines a method for a slash relayer to mark a slash
+	// request completed once its slash is observed on Ethereum.
+	CompleteSlashRequest(context.Context, *MsgCompleteSlashRequest) (*MsgCompleteSlashRequestResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -86,6 +102,9 @@ func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) CompleteSlashRequest(context.Context, *MsgCompleteSlashRequest) (*MsgCompleteSlashRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteSlashRequest not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -135,6 +154,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CompleteSlashRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteSlashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteSlashRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CompleteSlashRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteSlashRequest(ctx, req.(*MsgCompleteSlashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -150,6 +187,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CompleteSlashRequest",
+			Handler:    _Msg_CompleteSlashRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symSlash/v1beta1/tx.proto",
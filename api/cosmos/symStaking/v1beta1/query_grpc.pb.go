@@ -19,10 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Validators_FullMethodName     = "/cosmos.symStaking.v1beta1.Query/Validators"
-	Query_Validator_FullMethodName      = "/cosmos.symStaking.v1beta1.Query/Validator"
-	Query_HistoricalInfo_FullMethodName = "/cosmos.symStaking.v1beta1.Query/HistoricalInfo"
-	Query_Params_FullMethodName         = "/cosmos.symStaking.v1beta1.Query/Params"
+	Query_Validators_FullMethodName               = "/cosmos.symStaking.v1beta1.Query/Validators"
+	Query_Validator_FullMethodName                = "/cosmos.symStaking.v1beta1.Query/Validator"
+	Query_HistoricalInfo_FullMethodName           = "/cosmos.symStaking.v1beta1.Query/HistoricalInfo"
+	Query_Params_FullMethodName                   = "/cosmos.symStaking.v1beta1.Query/Params"
+	Query_InjectedSymbioticData_FullMethodName    = "/cosmos.symStaking.v1beta1.Query/InjectedSymbioticData"
+	Query_SymbioticSyncStatus_FullMethodName      = "/cosmos.symStaking.v1beta1.Query/SymbioticSyncStatus"
+	Query_SymbioticSyncHistory_FullMethodName     = "/cosmos.symStaking.v1beta1.Query/SymbioticSyncHistory"
+	Query_ValidatorSymbioticStakes_FullMethodName = "/cosmos.symStaking.v1beta1.Query/ValidatorSymbioticStakes"
+	Query_SymbioticCheckpoint_FullMethodName      = "/cosmos.symStaking.v1beta1.Query/SymbioticCheckpoint"
+	Query_SymbioticCheckpoints_FullMethodName     = "/cosmos.symStaking.v1beta1.Query/SymbioticCheckpoints"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +46,29 @@ type QueryClient interface {
 	HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// InjectedSymbioticData queries the data injected by the proposer at the given
+	// sync height, i.e. the Ethereum block the validator set update is derived from.
+	InjectedSymbioticData(ctx context.Context, in *QueryInjectedSymbioticDataRequest, opts ...grpc.CallOption) (*QueryInjectedSymbioticDataResponse, error)
+	// SymbioticSyncStatus queries the last sync points of the validator set with
+	// the Symbiotic middleware and the next sync height.
+	SymbioticSyncStatus(ctx context.Context, in *QuerySymbioticSyncStatusRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncStatusResponse, error)
+	// SymbioticSyncHistory queries the sync points of the validator set with the
+	// Symbiotic middleware.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	SymbioticSyncHistory(ctx context.Context, in *QuerySymbioticSyncHistoryRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncHistoryResponse, error)
+	// ValidatorSymbioticStakes queries the stake of a validator in every Symbiotic
+	// middleware listing it at the last sync.
+	ValidatorSymbioticStakes(ctx context.Context, in *QueryValidatorSymbioticStakesRequest, opts ...grpc.CallOption) (*QueryValidatorSymbioticStakesResponse, error)
+	// SymbioticCheckpoint queries the Symbiotic checkpoint of an epoch along with
+	// its payload, for submission to the middleware.
+	SymbioticCheckpoint(ctx context.Context, in *QuerySymbioticCheckpointRequest, opts ...grpc.CallOption) (*QuerySymbioticCheckpointResponse, error)
+	// SymbioticCheckpoints queries the Symbiotic checkpoints.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	SymbioticCheckpoints(ctx context.Context, in *QuerySymbioticCheckpointsRequest, opts ...grpc.CallOption) (*QuerySymbioticCheckpointsResponse, error)
 }
 
 type queryClient struct {
@@ -86,6 +115,60 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) InjectedSymbioticData(ctx context.Context, in *QueryInjectedSymbioticDataRequest, opts ...grpc.CallOption) (*QueryInjectedSymbioticDataResponse, error) {
+	out := new(QueryInjectedSymbioticDataResponse)
+	err := c.cc.Invoke(ctx, Query_InjectedSymbioticData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SymbioticSyncStatus(ctx context.Context, in *QuerySymbioticSyncStatusRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncStatusResponse, error) {
+	out := new(QuerySymbioticSyncStatusResponse)
+	err := c.cc.Invoke(ctx, Query_SymbioticSyncStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SymbioticSyncHistory(ctx context.Context, in *QuerySymbioticSyncHistoryRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncHistoryResponse, error) {
+	out := new(QuerySymbioticSyncHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_SymbioticSyncHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSymbioticStakes(ctx context.Context, in *QueryValidatorSymbioticStakesRequest, opts ...grpc.CallOption) (*QueryValidatorSymbioticStakesResponse, error) {
+	out := new(QueryValidatorSymbioticStakesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSymbioticStakes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SymbioticCheckpoint(ctx context.Context, in *QuerySymbioticCheckpointRequest, opts ...grpc.CallOption) (*QuerySymbioticCheckpointResponse, error) {
+	out := new(QuerySymbioticCheckpointResponse)
+	err := c.cc.Invoke(ctx, Query_SymbioticCheckpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SymbioticCheckpoints(ctx context.Context, in *QuerySymbioticCheckpointsRequest, opts ...grpc.CallOption) (*QuerySymbioticCheckpointsResponse, error) {
+	out := new(QuerySymbioticCheckpointsResponse)
+	err := c.cc.Invoke(ctx, Query_SymbioticCheckpoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -101,6 +184,29 @@ type QueryServer interface {
 	HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// InjectedSymbioticData queries the data injected by the proposer at the given
+	// sync height, i.e. the Ethereum block the validator set update is derived from.
+	InjectedSymbioticData(context.Context, *QueryInjectedSymbioticDataRequest) (*QueryInjectedSymbioticDataResponse, error)
+	// SymbioticSyncStatus queries the last sync points of the validator set with
+	// the Symbiotic middleware and the next sync height.
+	SymbioticSyncStatus(context.Context, *QuerySymbioticSyncStatusRequest) (*QuerySymbioticSyncStatusResponse, error)
+	// SymbioticSyncHistory queries the sync points of the validator set with the
+	// Symbiotic middleware.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	SymbioticSyncHistory(context.Context, *QuerySymbioticSyncHistoryRequest) (*QuerySymbioticSyncHistoryResponse, error)
+	// ValidatorSymbioticStakes queries the stake of a validator in every Symbiotic
+	// middleware listing it at the last sync.
+	ValidatorSymbioticStakes(context.Context, *QueryValidatorSymbioticStakesRequest) (*QueryValidatorSymbioticStakesResponse, error)
+	// SymbioticCheckpoint queries the Symbiotic checkpoint of an epoch along with
+	// its payload, for submission to the middleware.
+	SymbioticCheckpoint(context.Context, *QuerySymbioticCheckpointRequest) (*QuerySymbioticCheckpointResponse, error)
+	// SymbioticCheckpoints queries the Symbiotic checkpoints.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	SymbioticCheckpoints(context.Context, *QuerySymbioticCheckpointsRequest) (*QuerySymbioticCheckpointsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -120,6 +226,24 @@ func (UnimplementedQueryServer) HistoricalInfo(context.Context, *QueryHistorical
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) InjectedSymbioticData(context.Context, *QueryInjectedSymbioticDataRequest) (*QueryInjectedSymbioticDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InjectedSymbioticData not implemented")
+}
+func (UnimplementedQueryServer) SymbioticSyncStatus(context.Context, *QuerySymbioticSyncStatusRequest) (*QuerySymbioticSyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbioticSyncStatus not implemented")
+}
+func (UnimplementedQueryServer) SymbioticSyncHistory(context.Context, *QuerySymbioticSyncHistoryRequest) (*QuerySymbioticSyncHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbioticSyncHistory not implemented")
+}
+func (UnimplementedQueryServer) ValidatorSymbioticStakes(context.Context, *QueryValidatorSymbioticStakesRequest) (*QueryValidatorSymbioticStakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSymbioticStakes not implemented")
+}
+func (UnimplementedQueryServer) SymbioticCheckpoint(context.Context, *QuerySymbioticCheckpointRequest) (*QuerySymbioticCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbioticCheckpoint not implemented")
+}
+func (UnimplementedQueryServer) SymbioticCheckpoints(context.Context, *QuerySymbioticCheckpointsRequest) (*QuerySymbioticCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbioticCheckpoints not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -205,6 +329,114 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InjectedSymbioticData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInjectedSymbioticDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InjectedSymbioticData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InjectedSymbioticData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InjectedSymbioticData(ctx, req.(*QueryInjectedSymbioticDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SymbioticSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbioticSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbioticSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SymbioticSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbioticSyncStatus(ctx, req.(*QuerySymbioticSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SymbioticSyncHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbioticSyncHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbioticSyncHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SymbioticSyncHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbioticSyncHistory(ctx, req.(*QuerySymbioticSyncHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSymbioticStakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSymbioticStakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSymbioticStakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSymbioticStakes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSymbioticStakes(ctx, req.(*QueryValidatorSymbioticStakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SymbioticCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbioticCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbioticCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SymbioticCheckpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbioticCheckpoint(ctx, req.(*QuerySymbioticCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SymbioticCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbioticCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbioticCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SymbioticCheckpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbioticCheckpoints(ctx, req.(*QuerySymbioticCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -228,6 +460,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "InjectedSymbioticData",
+			Handler:    _Query_InjectedSymbioticData_Handler,
+		},
+		{
+			MethodName: "SymbioticSyncStatus",
+			Handler:    _Query_SymbioticSyncStatus_Handler,
+		},
+		{
+			MethodName: "SymbioticSyncHistory",
+			Handler:    _Query_SymbioticSyncHistory_Handler,
+		},
+		{
+			MethodName: "ValidatorSymbioticStakes",
+			Handler:    _Query_ValidatorSymbioticStakes_Handler,
+		},
+		{
+			MethodName: "SymbioticCheckpoint",
+			Handler:    _Query_SymbioticCheckpoint_Handler,
+		},
+		{
+			MethodName: "SymbioticCheckpoints",
+			Handler:    _Query_SymbioticCheckpoints_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symStaking/v1beta1/query.proto",
@@ -599,6 +599,7 @@ var (
 	fd_HistoricalRecord_apphash         protoreflect.FieldDescriptor
 	fd_HistoricalRecord_time            protoreflect.FieldDescriptor
 	fd_HistoricalRecord_validators_hash protoreflect.FieldDescriptor
+	fd_HistoricalRecord_symbiotic_epoch protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -607,6 +608,7 @@ func init() {
 	fd_HistoricalRecord_apphash = md_HistoricalRecord.Fields().ByName("apphash")
 	fd_HistoricalRecord_time = md_HistoricalRecord.Fields().ByName("time")
 	fd_HistoricalRecord_validators_hash = md_HistoricalRecord.Fields().ByName("validators_hash")
+	fd_HistoricalRecord_symbiotic_epoch = md_HistoricalRecord.Fields().ByName("symbiotic_epoch")
 }
 
 var _ protoreflect.Message = (*fastReflection_HistoricalRecord)(nil)
@@ -692,6 +694,12 @@ func (x *fastReflection_HistoricalRecord) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if x.SymbioticEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SymbioticEpoch)
+		if !f(fd_HistoricalRecord_symbiotic_epoch, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -713,6 +721,8 @@ func (x *fastReflection_HistoricalRecord) Has(fd protoreflect.FieldDescriptor) b
 		return x.Time != nil
 	case "cosmos.symStaking.v1beta1.HistoricalRecord.validators_hash":
 		return len(x.ValidatorsHash) != 0
+	case "cosmos.symStaking.v1beta1.HistoricalRecord.symbiotic_epoch":
+		return x.SymbioticEpoch != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.HistoricalRecord"))
@@ -735,6 +745,8 @@ func (x *fastReflection_HistoricalRecord) Clear(fd protoreflect.FieldDescriptor)
 		x.Time = nil
 	case "cosmos.symStaking.v1beta1.HistoricalRecord.validators_hash":
 		x.ValidatorsHash = nil
+	case "cosmos.symStaking.v1beta1.HistoricalRecord.symbiotic_epoch":
+		x.SymbioticEpoch = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.HistoricalRecord"))
@@ -760,6 +772,9 @@ func (x *fastReflection_HistoricalRecord) Get(descriptor protoreflect.FieldDescr
 	case "cosmos.symStaking.v1beta1.HistoricalRecord.validators_hash":
 		value := x.ValidatorsHash
 		return protoreflect.ValueOfBytes(value)
+	case "cosmos.symStaking.v1beta1.HistoricalRecord.symbiotic_epoch":
+		value := x.SymbioticEpoch
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.HistoricalRecord"))
@@ -786,6 +801,8 @@ func (x *fastReflection_HistoricalRecord) Set(fd protoreflect.FieldDescriptor, v
 		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
 	case "cosmos.symStaking.v1beta1.HistoricalRecord.validators_hash":
 		x.ValidatorsHash = value.Bytes()
+	case "cosmos.symStaking.v1beta1.HistoricalRecord.symbiotic_epoch":
+		x.SymbioticEpoch = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.HistoricalRecord"))
@@ -815,6 +832,8 @@ func (x *fastReflection_HistoricalRecord) Mutable(fd protoreflect.FieldDescripto
 		panic(fmt.Errorf("field apphash of message cosmos.symStaking.v1beta1.HistoricalRecord is not mutable"))
 	case "cosmos.symStaking.v1beta1.HistoricalRecord.validators_hash":
 		panic(fmt.Errorf("field validators_hash of message cosmos.symStaking.v1beta1.HistoricalRecord is not mutable"))
+	case "cosmos.symStaking.v1beta1.HistoricalRecord.symbiotic_epoch":
+		panic(fmt.Errorf("field symbiotic_epoch of message cosmos.symStaking.v1beta1.HistoricalRecord is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.HistoricalRecord"))
@@ -835,6 +854,8 @@ func (x *fastReflection_HistoricalRecord) NewField(fd protoreflect.FieldDescript
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "cosmos.symStaking.v1beta1.HistoricalRecord.validators_hash":
 		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.symStaking.v1beta1.HistoricalRecord.symbiotic_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.HistoricalRecord"))
@@ -916,6 +937,9 @@ func (x *fastReflection_HistoricalRecord) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.SymbioticEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.SymbioticEpoch))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -945,6 +969,11 @@ func (x *fastReflection_HistoricalRecord) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SymbioticEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SymbioticEpoch))
+			i--
+			dAtA[i] = 0x20
+		}
 		if len(x.ValidatorsHash) > 0 {
 			i -= len(x.ValidatorsHash)
 			copy(dAtA[i:], x.ValidatorsHash)
@@ -1126,6 +1155,25 @@ func (x *fastReflection_HistoricalRecord) ProtoMethods() *protoiface.Methods {
 					x.ValidatorsHash = []byte{}
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticEpoch", wireType)
+				}
+				x.SymbioticEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SymbioticEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2946,17 +2994,18 @@ func (x *_Validator_10_list) IsValid() bool {
 }
 
 var (
-	md_Validator                  protoreflect.MessageDescriptor
-	fd_Validator_operator_address protoreflect.FieldDescriptor
-	fd_Validator_consensus_pubkey protoreflect.FieldDescriptor
-	fd_Validator_jailed           protoreflect.FieldDescriptor
-	fd_Validator_status           protoreflect.FieldDescriptor
-	fd_Validator_tokens           protoreflect.FieldDescriptor
-	fd_Validator_description      protoreflect.FieldDescriptor
-	fd_Validator_unbonding_height protoreflect.FieldDescriptor
-	fd_Validator_unbonding_time   protoreflect.FieldDescriptor
-	fd_Validator_commission       protoreflect.FieldDescriptor
-	fd_Validator_unbonding_ids    protoreflect.FieldDescriptor
+	md_Validator                    protoreflect.MessageDescriptor
+	fd_Validator_operator_address   protoreflect.FieldDescriptor
+	fd_Validator_consensus_pubkey   protoreflect.FieldDescriptor
+	fd_Validator_jailed             protoreflect.FieldDescriptor
+	fd_Validator_status             protoreflect.FieldDescriptor
+	fd_Validator_tokens             protoreflect.FieldDescriptor
+	fd_Validator_description        protoreflect.FieldDescriptor
+	fd_Validator_unbonding_height   protoreflect.FieldDescriptor
+	fd_Validator_unbonding_time     protoreflect.FieldDescriptor
+	fd_Validator_commission         protoreflect.FieldDescriptor
+	fd_Validator_unbonding_ids      protoreflect.FieldDescriptor
+	fd_Validator_symbiotic_operator protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2972,6 +3021,7 @@ func init() {
 	fd_Validator_unbonding_time = md_Validator.Fields().ByName("unbonding_time")
 	fd_Validator_commission = md_Validator.Fields().ByName("commission")
 	fd_Validator_unbonding_ids = md_Validator.Fields().ByName("unbonding_ids")
+	fd_Validator_symbiotic_operator = md_Validator.Fields().ByName("symbiotic_operator")
 }
 
 var _ protoreflect.Message = (*fastReflection_Validator)(nil)
@@ -3099,6 +3149,12 @@ func (x *fastReflection_Validator) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.SymbioticOperator != "" {
+		value := protoreflect.ValueOfString(x.SymbioticOperator)
+		if !f(fd_Validator_symbiotic_operator, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3134,6 +3190,8 @@ func (x *fastReflection_Validator) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Commission != nil
 	case "cosmos.symStaking.v1beta1.Validator.unbonding_ids":
 		return len(x.UnbondingIds) != 0
+	case "cosmos.symStaking.v1beta1.Validator.symbiotic_operator":
+		return x.SymbioticOperator != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Validator"))
@@ -3170,6 +3228,8 @@ func (x *fastReflection_Validator) Clear(fd protoreflect.FieldDescriptor) {
 		x.Commission = nil
 	case "cosmos.symStaking.v1beta1.Validator.unbonding_ids":
 		x.UnbondingIds = nil
+	case "cosmos.symStaking.v1beta1.Validator.symbiotic_operator":
+		x.SymbioticOperator = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Validator"))
@@ -3219,6 +3279,9 @@ func (x *fastReflection_Validator) Get(descriptor protoreflect.FieldDescriptor)
 		}
 		listValue := &_Validator_10_list{list: &x.UnbondingIds}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.symStaking.v1beta1.Validator.symbiotic_operator":
+		value := x.SymbioticOperator
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Validator"))
@@ -3261,6 +3324,8 @@ func (x *fastReflection_Validator) Set(fd protoreflect.FieldDescriptor, value pr
 		lv := value.List()
 		clv := lv.(*_Validator_10_list)
 		x.UnbondingIds = *clv.list
+	case "cosmos.symStaking.v1beta1.Validator.symbiotic_operator":
+		x.SymbioticOperator = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Validator"))
@@ -3317,6 +3382,8 @@ func (x *fastReflection_Validator) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field tokens of message cosmos.symStaking.v1beta1.Validator is not mutable"))
 	case "cosmos.symStaking.v1beta1.Validator.unbonding_height":
 		panic(fmt.Errorf("field unbonding_height of message cosmos.symStaking.v1beta1.Validator is not mutable"))
+	case "cosmos.symStaking.v1beta1.Validator.symbiotic_operator":
+		panic(fmt.Errorf("field symbiotic_operator of message cosmos.symStaking.v1beta1.Validator is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Validator"))
@@ -3355,6 +3422,8 @@ func (x *fastReflection_Validator) NewField(fd protoreflect.FieldDescriptor) pro
 	case "cosmos.symStaking.v1beta1.Validator.unbonding_ids":
 		list := []uint64{}
 		return protoreflect.ValueOfList(&_Validator_10_list{list: &list})
+	case "cosmos.symStaking.v1beta1.Validator.symbiotic_operator":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Validator"))
@@ -3464,6 +3533,10 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 			}
 			n += 1 + runtime.Sov(uint64(l)) + l
 		}
+		l = len(x.SymbioticOperator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3493,6 +3566,13 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.SymbioticOperator) > 0 {
+			i -= len(x.SymbioticOperator)
+			copy(dAtA[i:], x.SymbioticOperator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbioticOperator)))
+			i--
+			dAtA[i] = 0x5a
+		}
 		if len(x.UnbondingIds) > 0 {
 			var pksize2 int
 			for _, num := range x.UnbondingIds {
@@ -3994,6 +4074,38 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 				} else {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
 				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticOperator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SymbioticOperator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4509,14 +4621,129 @@ func (x *fastReflection_ValAddresses) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_16_list)(nil)
+
+type _Params_16_list struct {
+	list *[]*SymbioticMiddleware
+}
+
+func (x *_Params_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SymbioticMiddleware)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SymbioticMiddleware)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_16_list) AppendMutable() protoreflect.Value {
+	v := new(SymbioticMiddleware)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_16_list) NewElement() protoreflect.Value {
+	v := new(SymbioticMiddleware)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_23_list)(nil)
+
+type _Params_23_list struct {
+	list *[]string
+}
+
+func (x *_Params_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_23_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field SymbioticHaltMsgTypeUrls as it is not of Message kind"))
+}
+
+func (x *_Params_23_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_23_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_23_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                     protoreflect.MessageDescriptor
-	fd_Params_unbonding_time      protoreflect.FieldDescriptor
-	fd_Params_max_validators      protoreflect.FieldDescriptor
-	fd_Params_max_entries         protoreflect.FieldDescriptor
-	fd_Params_historical_entries  protoreflect.FieldDescriptor
-	fd_Params_bond_denom          protoreflect.FieldDescriptor
-	fd_Params_min_commission_rate protoreflect.FieldDescriptor
+	md_Params                                  protoreflect.MessageDescriptor
+	fd_Params_unbonding_time                   protoreflect.FieldDescriptor
+	fd_Params_max_validators                   protoreflect.FieldDescriptor
+	fd_Params_max_entries                      protoreflect.FieldDescriptor
+	fd_Params_historical_entries               protoreflect.FieldDescriptor
+	fd_Params_bond_denom                       protoreflect.FieldDescriptor
+	fd_Params_min_commission_rate              protoreflect.FieldDescriptor
+	fd_Params_symbiotic_middleware_address     protoreflect.FieldDescriptor
+	fd_Params_beacon_genesis_timestamp         protoreflect.FieldDescriptor
+	fd_Params_slot_duration                    protoreflect.FieldDescriptor
+	fd_Params_symbiotic_sync_period            protoreflect.FieldDescriptor
+	fd_Params_ethereum_network                 protoreflect.FieldDescriptor
+	fd_Params_slots_per_epoch                  protoreflect.FieldDescriptor
+	fd_Params_finality_depth                   protoreflect.FieldDescriptor
+	fd_Params_symbiotic_auto_create_validators protoreflect.FieldDescriptor
+	fd_Params_symbiotic_key_type               protoreflect.FieldDescriptor
+	fd_Params_symbiotic_middlewares            protoreflect.FieldDescriptor
+	fd_Params_symbiotic_max_power_share        protoreflect.FieldDescriptor
+	fd_Params_symbiotic_max_power_change       protoreflect.FieldDescriptor
+	fd_Params_symbiotic_min_stake              protoreflect.FieldDescriptor
+	fd_Params_symbiotic_max_stale_syncs        protoreflect.FieldDescriptor
+	fd_Params_symbiotic_stale_policy           protoreflect.FieldDescriptor
+	fd_Params_symbiotic_stale_decay            protoreflect.FieldDescriptor
+	fd_Params_symbiotic_halt_msg_type_urls     protoreflect.FieldDescriptor
+	fd_Params_symbiotic_epoch_identifier       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4528,6 +4755,24 @@ func init() {
 	fd_Params_historical_entries = md_Params.Fields().ByName("historical_entries")
 	fd_Params_bond_denom = md_Params.Fields().ByName("bond_denom")
 	fd_Params_min_commission_rate = md_Params.Fields().ByName("min_commission_rate")
+	fd_Params_symbiotic_middleware_address = md_Params.Fields().ByName("symbiotic_middleware_address")
+	fd_Params_beacon_genesis_timestamp = md_Params.Fields().ByName("beacon_genesis_timestamp")
+	fd_Params_slot_duration = md_Params.Fields().ByName("slot_duration")
+	fd_Params_symbiotic_sync_period = md_Params.Fields().ByName("symbiotic_sync_period")
+	fd_Params_ethereum_network = md_Params.Fields().ByName("ethereum_network")
+	fd_Params_slots_per_epoch = md_Params.Fields().ByName("slots_per_epoch")
+	fd_Params_finality_depth = md_Params.Fields().ByName("finality_depth")
+	fd_Params_symbiotic_auto_create_validators = md_Params.Fields().ByName("symbiotic_auto_create_validators")
+	fd_Params_symbiotic_key_type = md_Params.Fields().ByName("symbiotic_key_type")
+	fd_Params_symbiotic_middlewares = md_Params.Fields().ByName("symbiotic_middlewares")
+	fd_Params_symbiotic_max_power_share = md_Params.Fields().ByName("symbiotic_max_power_share")
+	fd_Params_symbiotic_max_power_change = md_Params.Fields().ByName("symbiotic_max_power_change")
+	fd_Params_symbiotic_min_stake = md_Params.Fields().ByName("symbiotic_min_stake")
+	fd_Params_symbiotic_max_stale_syncs = md_Params.Fields().ByName("symbiotic_max_stale_syncs")
+	fd_Params_symbiotic_stale_policy = md_Params.Fields().ByName("symbiotic_stale_policy")
+	fd_Params_symbiotic_stale_decay = md_Params.Fields().ByName("symbiotic_stale_decay")
+	fd_Params_symbiotic_halt_msg_type_urls = md_Params.Fields().ByName("symbiotic_halt_msg_type_urls")
+	fd_Params_symbiotic_epoch_identifier = md_Params.Fields().ByName("symbiotic_epoch_identifier")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -4631,6 +4876,114 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.SymbioticMiddlewareAddress != "" {
+		value := protoreflect.ValueOfString(x.SymbioticMiddlewareAddress)
+		if !f(fd_Params_symbiotic_middleware_address, value) {
+			return
+		}
+	}
+	if x.BeaconGenesisTimestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BeaconGenesisTimestamp)
+		if !f(fd_Params_beacon_genesis_timestamp, value) {
+			return
+		}
+	}
+	if x.SlotDuration != nil {
+		value := protoreflect.ValueOfMessage(x.SlotDuration.ProtoReflect())
+		if !f(fd_Params_slot_duration, value) {
+			return
+		}
+	}
+	if x.SymbioticSyncPeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SymbioticSyncPeriod)
+		if !f(fd_Params_symbiotic_sync_period, value) {
+			return
+		}
+	}
+	if x.EthereumNetwork != "" {
+		value := protoreflect.ValueOfString(x.EthereumNetwork)
+		if !f(fd_Params_ethereum_network, value) {
+			return
+		}
+	}
+	if x.SlotsPerEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlotsPerEpoch)
+		if !f(fd_Params_slots_per_epoch, value) {
+			return
+		}
+	}
+	if x.FinalityDepth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FinalityDepth)
+		if !f(fd_Params_finality_depth, value) {
+			return
+		}
+	}
+	if x.SymbioticAutoCreateValidators != false {
+		value := protoreflect.ValueOfBool(x.SymbioticAutoCreateValidators)
+		if !f(fd_Params_symbiotic_auto_create_validators, value) {
+			return
+		}
+	}
+	if x.SymbioticKeyType != "" {
+		value := protoreflect.ValueOfString(x.SymbioticKeyType)
+		if !f(fd_Params_symbiotic_key_type, value) {
+			return
+		}
+	}
+	if len(x.SymbioticMiddlewares) != 0 {
+		value := protoreflect.ValueOfList(&_Params_16_list{list: &x.SymbioticMiddlewares})
+		if !f(fd_Params_symbiotic_middlewares, value) {
+			return
+		}
+	}
+	if x.SymbioticMaxPowerShare != "" {
+		value := protoreflect.ValueOfString(x.SymbioticMaxPowerShare)
+		if !f(fd_Params_symbiotic_max_power_share, value) {
+			return
+		}
+	}
+	if x.SymbioticMaxPowerChange != "" {
+		value := protoreflect.ValueOfString(x.SymbioticMaxPowerChange)
+		if !f(fd_Params_symbiotic_max_power_change, value) {
+			return
+		}
+	}
+	if x.SymbioticMinStake != "" {
+		value := protoreflect.ValueOfString(x.SymbioticMinStake)
+		if !f(fd_Params_symbiotic_min_stake, value) {
+			return
+		}
+	}
+	if x.SymbioticMaxStaleSyncs != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SymbioticMaxStaleSyncs)
+		if !f(fd_Params_symbiotic_max_stale_syncs, value) {
+			return
+		}
+	}
+	if x.SymbioticStalePolicy != "" {
+		value := protoreflect.ValueOfString(x.SymbioticStalePolicy)
+		if !f(fd_Params_symbiotic_stale_policy, value) {
+			return
+		}
+	}
+	if x.SymbioticStaleDecay != "" {
+		value := protoreflect.ValueOfString(x.SymbioticStaleDecay)
+		if !f(fd_Params_symbiotic_stale_decay, value) {
+			return
+		}
+	}
+	if len(x.SymbioticHaltMsgTypeUrls) != 0 {
+		value := protoreflect.ValueOfList(&_Params_23_list{list: &x.SymbioticHaltMsgTypeUrls})
+		if !f(fd_Params_symbiotic_halt_msg_type_urls, value) {
+			return
+		}
+	}
+	if x.SymbioticEpochIdentifier != "" {
+		value := protoreflect.ValueOfString(x.SymbioticEpochIdentifier)
+		if !f(fd_Params_symbiotic_epoch_identifier, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4658,6 +5011,42 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.BondDenom != ""
 	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
 		return x.MinCommissionRate != ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middleware_address":
+		return x.SymbioticMiddlewareAddress != ""
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		return x.BeaconGenesisTimestamp != uint64(0)
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		return x.SlotDuration != nil
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		return x.SymbioticSyncPeriod != int64(0)
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		return x.EthereumNetwork != ""
+	case "cosmos.symStaking.v1beta1.Params.slots_per_epoch":
+		return x.SlotsPerEpoch != uint64(0)
+	case "cosmos.symStaking.v1beta1.Params.finality_depth":
+		return x.FinalityDepth != uint64(0)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_auto_create_validators":
+		return x.SymbioticAutoCreateValidators != false
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_key_type":
+		return x.SymbioticKeyType != ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middlewares":
+		return len(x.SymbioticMiddlewares) != 0
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_share":
+		return x.SymbioticMaxPowerShare != ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_change":
+		return x.SymbioticMaxPowerChange != ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_min_stake":
+		return x.SymbioticMinStake != ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_stale_syncs":
+		return x.SymbioticMaxStaleSyncs != uint32(0)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_policy":
+		return x.SymbioticStalePolicy != ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_decay":
+		return x.SymbioticStaleDecay != ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_halt_msg_type_urls":
+		return len(x.SymbioticHaltMsgTypeUrls) != 0
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_epoch_identifier":
+		return x.SymbioticEpochIdentifier != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
@@ -4686,6 +5075,42 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.BondDenom = ""
 	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
 		x.MinCommissionRate = ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middleware_address":
+		x.SymbioticMiddlewareAddress = ""
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		x.BeaconGenesisTimestamp = uint64(0)
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		x.SlotDuration = nil
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		x.SymbioticSyncPeriod = int64(0)
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		x.EthereumNetwork = ""
+	case "cosmos.symStaking.v1beta1.Params.slots_per_epoch":
+		x.SlotsPerEpoch = uint64(0)
+	case "cosmos.symStaking.v1beta1.Params.finality_depth":
+		x.FinalityDepth = uint64(0)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_auto_create_validators":
+		x.SymbioticAutoCreateValidators = false
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_key_type":
+		x.SymbioticKeyType = ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middlewares":
+		x.SymbioticMiddlewares = nil
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_share":
+		x.SymbioticMaxPowerShare = ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_change":
+		x.SymbioticMaxPowerChange = ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_min_stake":
+		x.SymbioticMinStake = ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_stale_syncs":
+		x.SymbioticMaxStaleSyncs = uint32(0)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_policy":
+		x.SymbioticStalePolicy = ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_decay":
+		x.SymbioticStaleDecay = ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_halt_msg_type_urls":
+		x.SymbioticHaltMsgTypeUrls = nil
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_epoch_identifier":
+		x.SymbioticEpochIdentifier = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
@@ -4720,6 +5145,66 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
 		value := x.MinCommissionRate
 		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middleware_address":
+		value := x.SymbioticMiddlewareAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		value := x.BeaconGenesisTimestamp
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		value := x.SlotDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		value := x.SymbioticSyncPeriod
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		value := x.EthereumNetwork
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.slots_per_epoch":
+		value := x.SlotsPerEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.Params.finality_depth":
+		value := x.FinalityDepth
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_auto_create_validators":
+		value := x.SymbioticAutoCreateValidators
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_key_type":
+		value := x.SymbioticKeyType
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middlewares":
+		if len(x.SymbioticMiddlewares) == 0 {
+			return protoreflect.ValueOfList(&_Params_16_list{})
+		}
+		listValue := &_Params_16_list{list: &x.SymbioticMiddlewares}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_share":
+		value := x.SymbioticMaxPowerShare
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_change":
+		value := x.SymbioticMaxPowerChange
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_min_stake":
+		value := x.SymbioticMinStake
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_stale_syncs":
+		value := x.SymbioticMaxStaleSyncs
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_policy":
+		value := x.SymbioticStalePolicy
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_decay":
+		value := x.SymbioticStaleDecay
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_halt_msg_type_urls":
+		if len(x.SymbioticHaltMsgTypeUrls) == 0 {
+			return protoreflect.ValueOfList(&_Params_23_list{})
+		}
+		listValue := &_Params_23_list{list: &x.SymbioticHaltMsgTypeUrls}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_epoch_identifier":
+		value := x.SymbioticEpochIdentifier
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
@@ -4752,6 +5237,46 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.BondDenom = value.Interface().(string)
 	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
 		x.MinCommissionRate = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middleware_address":
+		x.SymbioticMiddlewareAddress = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		x.BeaconGenesisTimestamp = value.Uint()
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		x.SlotDuration = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		x.SymbioticSyncPeriod = value.Int()
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		x.EthereumNetwork = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.slots_per_epoch":
+		x.SlotsPerEpoch = value.Uint()
+	case "cosmos.symStaking.v1beta1.Params.finality_depth":
+		x.FinalityDepth = value.Uint()
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_auto_create_validators":
+		x.SymbioticAutoCreateValidators = value.Bool()
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_key_type":
+		x.SymbioticKeyType = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middlewares":
+		lv := value.List()
+		clv := lv.(*_Params_16_list)
+		x.SymbioticMiddlewares = *clv.list
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_share":
+		x.SymbioticMaxPowerShare = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_change":
+		x.SymbioticMaxPowerChange = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_min_stake":
+		x.SymbioticMinStake = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_stale_syncs":
+		x.SymbioticMaxStaleSyncs = uint32(value.Uint())
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_policy":
+		x.SymbioticStalePolicy = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_decay":
+		x.SymbioticStaleDecay = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_halt_msg_type_urls":
+		lv := value.List()
+		clv := lv.(*_Params_23_list)
+		x.SymbioticHaltMsgTypeUrls = *clv.list
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_epoch_identifier":
+		x.SymbioticEpochIdentifier = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
@@ -4777,6 +5302,23 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.UnbondingTime = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		if x.SlotDuration == nil {
+			x.SlotDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.SlotDuration.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middlewares":
+		if x.SymbioticMiddlewares == nil {
+			x.SymbioticMiddlewares = []*SymbioticMiddleware{}
+		}
+		value := &_Params_16_list{list: &x.SymbioticMiddlewares}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_halt_msg_type_urls":
+		if x.SymbioticHaltMsgTypeUrls == nil {
+			x.SymbioticHaltMsgTypeUrls = []string{}
+		}
+		value := &_Params_23_list{list: &x.SymbioticHaltMsgTypeUrls}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.symStaking.v1beta1.Params.max_validators":
 		panic(fmt.Errorf("field max_validators of message cosmos.symStaking.v1beta1.Params is not mutable"))
 	case "cosmos.symStaking.v1beta1.Params.max_entries":
@@ -4787,6 +5329,36 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field bond_denom of message cosmos.symStaking.v1beta1.Params is not mutable"))
 	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
 		panic(fmt.Errorf("field min_commission_rate of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middleware_address":
+		panic(fmt.Errorf("field symbiotic_middleware_address of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		panic(fmt.Errorf("field beacon_genesis_timestamp of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		panic(fmt.Errorf("field symbiotic_sync_period of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		panic(fmt.Errorf("field ethereum_network of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.slots_per_epoch":
+		panic(fmt.Errorf("field slots_per_epoch of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.finality_depth":
+		panic(fmt.Errorf("field finality_depth of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_auto_create_validators":
+		panic(fmt.Errorf("field symbiotic_auto_create_validators of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_key_type":
+		panic(fmt.Errorf("field symbiotic_key_type of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_share":
+		panic(fmt.Errorf("field symbiotic_max_power_share of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_change":
+		panic(fmt.Errorf("field symbiotic_max_power_change of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_min_stake":
+		panic(fmt.Errorf("field symbiotic_min_stake of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_stale_syncs":
+		panic(fmt.Errorf("field symbiotic_max_stale_syncs of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_policy":
+		panic(fmt.Errorf("field symbiotic_stale_policy of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_decay":
+		panic(fmt.Errorf("field symbiotic_stale_decay of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_epoch_identifier":
+		panic(fmt.Errorf("field symbiotic_epoch_identifier of message cosmos.symStaking.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
@@ -4813,6 +5385,45 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
 		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middleware_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.slots_per_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.Params.finality_depth":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_auto_create_validators":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_key_type":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_middlewares":
+		list := []*SymbioticMiddleware{}
+		return protoreflect.ValueOfList(&_Params_16_list{list: &list})
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_share":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_power_change":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_min_stake":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_max_stale_syncs":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_policy":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_stale_decay":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_halt_msg_type_urls":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_23_list{list: &list})
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_epoch_identifier":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
@@ -4903,37 +5514,262 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		l = len(x.SymbioticMiddlewareAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if x.BeaconGenesisTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.BeaconGenesisTimestamp))
 		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
+		if x.SlotDuration != nil {
+			l = options.Size(x.SlotDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
+		if x.SymbioticSyncPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.SymbioticSyncPeriod))
 		}
-		if len(x.MinCommissionRate) > 0 {
-			i -= len(x.MinCommissionRate)
+		l = len(x.EthereumNetwork)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SlotsPerEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlotsPerEpoch))
+		}
+		if x.FinalityDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.FinalityDepth))
+		}
+		if x.SymbioticAutoCreateValidators {
+			n += 2
+		}
+		l = len(x.SymbioticKeyType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.SymbioticMiddlewares) > 0 {
+			for _, e := range x.SymbioticMiddlewares {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.SymbioticMaxPowerShare)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SymbioticMaxPowerChange)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SymbioticMinStake)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.SymbioticMaxStaleSyncs != 0 {
+			n += 2 + runtime.Sov(uint64(x.SymbioticMaxStaleSyncs))
+		}
+		l = len(x.SymbioticStalePolicy)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SymbioticStaleDecay)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.SymbioticHaltMsgTypeUrls) > 0 {
+			for _, s := range x.SymbioticHaltMsgTypeUrls {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.SymbioticEpochIdentifier)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SymbioticEpochIdentifier) > 0 {
+			i -= len(x.SymbioticEpochIdentifier)
+			copy(dAtA[i:], x.SymbioticEpochIdentifier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbioticEpochIdentifier)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+		if len(x.SymbioticHaltMsgTypeUrls) > 0 {
+			for iNdEx := len(x.SymbioticHaltMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SymbioticHaltMsgTypeUrls[iNdEx])
+				copy(dAtA[i:], x.SymbioticHaltMsgTypeUrls[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbioticHaltMsgTypeUrls[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.SymbioticStaleDecay) > 0 {
+			i -= len(x.SymbioticStaleDecay)
+			copy(dAtA[i:], x.SymbioticStaleDecay)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbioticStaleDecay)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if len(x.SymbioticStalePolicy) > 0 {
+			i -= len(x.SymbioticStalePolicy)
+			copy(dAtA[i:], x.SymbioticStalePolicy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbioticStalePolicy)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.SymbioticMaxStaleSyncs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SymbioticMaxStaleSyncs))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.SymbioticMinStake) > 0 {
+			i -= len(x.SymbioticMinStake)
+			copy(dAtA[i:], x.SymbioticMinStake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbioticMinStake)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.SymbioticMaxPowerChange) > 0 {
+			i -= len(x.SymbioticMaxPowerChange)
+			copy(dAtA[i:], x.SymbioticMaxPowerChange)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbioticMaxPowerChange)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.SymbioticMaxPowerShare) > 0 {
+			i -= len(x.SymbioticMaxPowerShare)
+			copy(dAtA[i:], x.SymbioticMaxPowerShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbioticMaxPowerShare)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.SymbioticMiddlewares) > 0 {
+			for iNdEx := len(x.SymbioticMiddlewares) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SymbioticMiddlewares[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.SymbioticKeyType) > 0 {
+			i -= len(x.SymbioticKeyType)
+			copy(dAtA[i:], x.SymbioticKeyType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbioticKeyType)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.SymbioticAutoCreateValidators {
+			i--
+			if x.SymbioticAutoCreateValidators {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.FinalityDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FinalityDepth))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.SlotsPerEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlotsPerEpoch))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.EthereumNetwork) > 0 {
+			i -= len(x.EthereumNetwork)
+			copy(dAtA[i:], x.EthereumNetwork)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EthereumNetwork)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.SymbioticSyncPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SymbioticSyncPeriod))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.SlotDuration != nil {
+			encoded, err := options.Marshal(x.SlotDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.BeaconGenesisTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BeaconGenesisTimestamp))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.SymbioticMiddlewareAddress) > 0 {
+			i -= len(x.SymbioticMiddlewareAddress)
+			copy(dAtA[i:], x.SymbioticMiddlewareAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbioticMiddlewareAddress)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.MinCommissionRate) > 0 {
+			i -= len(x.MinCommissionRate)
 			copy(dAtA[i:], x.MinCommissionRate)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinCommissionRate)))
 			i--
@@ -5181,1178 +6017,10462 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.MinCommissionRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticMiddlewareAddress", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.SymbioticMiddlewareAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeaconGenesisTimestamp", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_ValidatorUpdates_1_list)(nil)
-
-type _ValidatorUpdates_1_list struct {
-	list *[]*v11.ValidatorUpdate
-}
-
-func (x *_ValidatorUpdates_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_ValidatorUpdates_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_ValidatorUpdates_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v11.ValidatorUpdate)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_ValidatorUpdates_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v11.ValidatorUpdate)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_ValidatorUpdates_1_list) AppendMutable() protoreflect.Value {
-	v := new(v11.ValidatorUpdate)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ValidatorUpdates_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ValidatorUpdates_1_list) NewElement() protoreflect.Value {
-	v := new(v11.ValidatorUpdate)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ValidatorUpdates_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_ValidatorUpdates         protoreflect.MessageDescriptor
-	fd_ValidatorUpdates_updates protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_cosmos_symStaking_v1beta1_staking_proto_init()
-	md_ValidatorUpdates = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("ValidatorUpdates")
-	fd_ValidatorUpdates_updates = md_ValidatorUpdates.Fields().ByName("updates")
-}
-
-var _ protoreflect.Message = (*fastReflection_ValidatorUpdates)(nil)
-
-type fastReflection_ValidatorUpdates ValidatorUpdates
-
-func (x *ValidatorUpdates) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorUpdates)(x)
-}
+				x.BeaconGenesisTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BeaconGenesisTimestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlotDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SlotDuration == nil {
+					x.SlotDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlotDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticSyncPeriod", wireType)
+				}
+				x.SymbioticSyncPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SymbioticSyncPeriod |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EthereumNetwork", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EthereumNetwork = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlotsPerEpoch", wireType)
+				}
+				x.SlotsPerEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlotsPerEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalityDepth", wireType)
+				}
+				x.FinalityDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FinalityDepth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticAutoCreateValidators", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SymbioticAutoCreateValidators = bool(v != 0)
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticKeyType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SymbioticKeyType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticMiddlewares", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SymbioticMiddlewares = append(x.SymbioticMiddlewares, &SymbioticMiddleware{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SymbioticMiddlewares[len(x.SymbioticMiddlewares)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticMaxPowerShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SymbioticMaxPowerShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticMaxPowerChange", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SymbioticMaxPowerChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticMinStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SymbioticMinStake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticMaxStaleSyncs", wireType)
+				}
+				x.SymbioticMaxStaleSyncs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SymbioticMaxStaleSyncs |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticStalePolicy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SymbioticStalePolicy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticStaleDecay", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SymbioticStaleDecay = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticHaltMsgTypeUrls", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SymbioticHaltMsgTypeUrls = append(x.SymbioticHaltMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticEpochIdentifier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SymbioticEpochIdentifier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SymbioticMiddleware         protoreflect.MessageDescriptor
+	fd_SymbioticMiddleware_address protoreflect.FieldDescriptor
+	fd_SymbioticMiddleware_weight  protoreflect.FieldDescriptor
+	fd_SymbioticMiddleware_cap     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_SymbioticMiddleware = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("SymbioticMiddleware")
+	fd_SymbioticMiddleware_address = md_SymbioticMiddleware.Fields().ByName("address")
+	fd_SymbioticMiddleware_weight = md_SymbioticMiddleware.Fields().ByName("weight")
+	fd_SymbioticMiddleware_cap = md_SymbioticMiddleware.Fields().ByName("cap")
+}
+
+var _ protoreflect.Message = (*fastReflection_SymbioticMiddleware)(nil)
+
+type fastReflection_SymbioticMiddleware SymbioticMiddleware
+
+func (x *SymbioticMiddleware) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SymbioticMiddleware)(x)
+}
+
+func (x *SymbioticMiddleware) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SymbioticMiddleware_messageType fastReflection_SymbioticMiddleware_messageType
+var _ protoreflect.MessageType = fastReflection_SymbioticMiddleware_messageType{}
+
+type fastReflection_SymbioticMiddleware_messageType struct{}
+
+func (x fastReflection_SymbioticMiddleware_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SymbioticMiddleware)(nil)
+}
+func (x fastReflection_SymbioticMiddleware_messageType) New() protoreflect.Message {
+	return new(fastReflection_SymbioticMiddleware)
+}
+func (x fastReflection_SymbioticMiddleware_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticMiddleware
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SymbioticMiddleware) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticMiddleware
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SymbioticMiddleware) Type() protoreflect.MessageType {
+	return _fastReflection_SymbioticMiddleware_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SymbioticMiddleware) New() protoreflect.Message {
+	return new(fastReflection_SymbioticMiddleware)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SymbioticMiddleware) Interface() protoreflect.ProtoMessage {
+	return (*SymbioticMiddleware)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SymbioticMiddleware) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_SymbioticMiddleware_address, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_SymbioticMiddleware_weight, value) {
+			return
+		}
+	}
+	if x.Cap != "" {
+		value := protoreflect.ValueOfString(x.Cap)
+		if !f(fd_SymbioticMiddleware_cap, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SymbioticMiddleware) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.address":
+		return x.Address != ""
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.weight":
+		return x.Weight != ""
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.cap":
+		return x.Cap != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddleware"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddleware does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticMiddleware) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.address":
+		x.Address = ""
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.weight":
+		x.Weight = ""
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.cap":
+		x.Cap = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddleware"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddleware does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SymbioticMiddleware) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.cap":
+		value := x.Cap
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddleware"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddleware does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticMiddleware) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.weight":
+		x.Weight = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.cap":
+		x.Cap = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddleware"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddleware does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticMiddleware) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.address":
+		panic(fmt.Errorf("field address of message cosmos.symStaking.v1beta1.SymbioticMiddleware is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.weight":
+		panic(fmt.Errorf("field weight of message cosmos.symStaking.v1beta1.SymbioticMiddleware is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.cap":
+		panic(fmt.Errorf("field cap of message cosmos.symStaking.v1beta1.SymbioticMiddleware is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddleware"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddleware does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SymbioticMiddleware) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.weight":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticMiddleware.cap":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddleware"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddleware does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SymbioticMiddleware) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.SymbioticMiddleware", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SymbioticMiddleware) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticMiddleware) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SymbioticMiddleware) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SymbioticMiddleware) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SymbioticMiddleware)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Cap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticMiddleware)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Cap) > 0 {
+			i -= len(x.Cap)
+			copy(dAtA[i:], x.Cap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cap)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticMiddleware)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticMiddleware: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticMiddleware: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cap = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SymbioticMiddlewareStake                    protoreflect.MessageDescriptor
+	fd_SymbioticMiddlewareStake_middleware_address protoreflect.FieldDescriptor
+	fd_SymbioticMiddlewareStake_stake              protoreflect.FieldDescriptor
+	fd_SymbioticMiddlewareStake_weighted_stake     protoreflect.FieldDescriptor
+	fd_SymbioticMiddlewareStake_epoch              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_SymbioticMiddlewareStake = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("SymbioticMiddlewareStake")
+	fd_SymbioticMiddlewareStake_middleware_address = md_SymbioticMiddlewareStake.Fields().ByName("middleware_address")
+	fd_SymbioticMiddlewareStake_stake = md_SymbioticMiddlewareStake.Fields().ByName("stake")
+	fd_SymbioticMiddlewareStake_weighted_stake = md_SymbioticMiddlewareStake.Fields().ByName("weighted_stake")
+	fd_SymbioticMiddlewareStake_epoch = md_SymbioticMiddlewareStake.Fields().ByName("epoch")
+}
+
+var _ protoreflect.Message = (*fastReflection_SymbioticMiddlewareStake)(nil)
+
+type fastReflection_SymbioticMiddlewareStake SymbioticMiddlewareStake
+
+func (x *SymbioticMiddlewareStake) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SymbioticMiddlewareStake)(x)
+}
+
+func (x *SymbioticMiddlewareStake) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SymbioticMiddlewareStake_messageType fastReflection_SymbioticMiddlewareStake_messageType
+var _ protoreflect.MessageType = fastReflection_SymbioticMiddlewareStake_messageType{}
+
+type fastReflection_SymbioticMiddlewareStake_messageType struct{}
+
+func (x fastReflection_SymbioticMiddlewareStake_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SymbioticMiddlewareStake)(nil)
+}
+func (x fastReflection_SymbioticMiddlewareStake_messageType) New() protoreflect.Message {
+	return new(fastReflection_SymbioticMiddlewareStake)
+}
+func (x fastReflection_SymbioticMiddlewareStake_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticMiddlewareStake
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SymbioticMiddlewareStake) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticMiddlewareStake
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SymbioticMiddlewareStake) Type() protoreflect.MessageType {
+	return _fastReflection_SymbioticMiddlewareStake_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SymbioticMiddlewareStake) New() protoreflect.Message {
+	return new(fastReflection_SymbioticMiddlewareStake)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SymbioticMiddlewareStake) Interface() protoreflect.ProtoMessage {
+	return (*SymbioticMiddlewareStake)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SymbioticMiddlewareStake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MiddlewareAddress != "" {
+		value := protoreflect.ValueOfString(x.MiddlewareAddress)
+		if !f(fd_SymbioticMiddlewareStake_middleware_address, value) {
+			return
+		}
+	}
+	if x.Stake != "" {
+		value := protoreflect.ValueOfString(x.Stake)
+		if !f(fd_SymbioticMiddlewareStake_stake, value) {
+			return
+		}
+	}
+	if x.WeightedStake != "" {
+		value := protoreflect.ValueOfString(x.WeightedStake)
+		if !f(fd_SymbioticMiddlewareStake_weighted_stake, value) {
+			return
+		}
+	}
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_SymbioticMiddlewareStake_epoch, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SymbioticMiddlewareStake) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.middleware_address":
+		return x.MiddlewareAddress != ""
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.stake":
+		return x.Stake != ""
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.weighted_stake":
+		return x.WeightedStake != ""
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.epoch":
+		return x.Epoch != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddlewareStake"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddlewareStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticMiddlewareStake) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.middleware_address":
+		x.MiddlewareAddress = ""
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.stake":
+		x.Stake = ""
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.weighted_stake":
+		x.WeightedStake = ""
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.epoch":
+		x.Epoch = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddlewareStake"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddlewareStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SymbioticMiddlewareStake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.middleware_address":
+		value := x.MiddlewareAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.stake":
+		value := x.Stake
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.weighted_stake":
+		value := x.WeightedStake
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddlewareStake"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddlewareStake does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticMiddlewareStake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.middleware_address":
+		x.MiddlewareAddress = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.stake":
+		x.Stake = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.weighted_stake":
+		x.WeightedStake = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.epoch":
+		x.Epoch = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddlewareStake"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddlewareStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticMiddlewareStake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.middleware_address":
+		panic(fmt.Errorf("field middleware_address of message cosmos.symStaking.v1beta1.SymbioticMiddlewareStake is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.stake":
+		panic(fmt.Errorf("field stake of message cosmos.symStaking.v1beta1.SymbioticMiddlewareStake is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.weighted_stake":
+		panic(fmt.Errorf("field weighted_stake of message cosmos.symStaking.v1beta1.SymbioticMiddlewareStake is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.epoch":
+		panic(fmt.Errorf("field epoch of message cosmos.symStaking.v1beta1.SymbioticMiddlewareStake is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddlewareStake"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddlewareStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SymbioticMiddlewareStake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.middleware_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.stake":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.weighted_stake":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticMiddlewareStake"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticMiddlewareStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SymbioticMiddlewareStake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.SymbioticMiddlewareStake", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SymbioticMiddlewareStake) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticMiddlewareStake) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SymbioticMiddlewareStake) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SymbioticMiddlewareStake) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SymbioticMiddlewareStake)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MiddlewareAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Stake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WeightedStake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticMiddlewareStake)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.WeightedStake) > 0 {
+			i -= len(x.WeightedStake)
+			copy(dAtA[i:], x.WeightedStake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WeightedStake)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Stake) > 0 {
+			i -= len(x.Stake)
+			copy(dAtA[i:], x.Stake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Stake)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MiddlewareAddress) > 0 {
+			i -= len(x.MiddlewareAddress)
+			copy(dAtA[i:], x.MiddlewareAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MiddlewareAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticMiddlewareStake)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticMiddlewareStake: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticMiddlewareStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MiddlewareAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MiddlewareAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WeightedStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WeightedStake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SymbioticStakes_1_list)(nil)
+
+type _SymbioticStakes_1_list struct {
+	list *[]*SymbioticMiddlewareStake
+}
+
+func (x *_SymbioticStakes_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SymbioticStakes_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SymbioticStakes_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SymbioticMiddlewareStake)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SymbioticStakes_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SymbioticMiddlewareStake)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SymbioticStakes_1_list) AppendMutable() protoreflect.Value {
+	v := new(SymbioticMiddlewareStake)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SymbioticStakes_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SymbioticStakes_1_list) NewElement() protoreflect.Value {
+	v := new(SymbioticMiddlewareStake)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SymbioticStakes_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SymbioticStakes        protoreflect.MessageDescriptor
+	fd_SymbioticStakes_stakes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_SymbioticStakes = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("SymbioticStakes")
+	fd_SymbioticStakes_stakes = md_SymbioticStakes.Fields().ByName("stakes")
+}
+
+var _ protoreflect.Message = (*fastReflection_SymbioticStakes)(nil)
+
+type fastReflection_SymbioticStakes SymbioticStakes
+
+func (x *SymbioticStakes) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SymbioticStakes)(x)
+}
+
+func (x *SymbioticStakes) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SymbioticStakes_messageType fastReflection_SymbioticStakes_messageType
+var _ protoreflect.MessageType = fastReflection_SymbioticStakes_messageType{}
+
+type fastReflection_SymbioticStakes_messageType struct{}
+
+func (x fastReflection_SymbioticStakes_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SymbioticStakes)(nil)
+}
+func (x fastReflection_SymbioticStakes_messageType) New() protoreflect.Message {
+	return new(fastReflection_SymbioticStakes)
+}
+func (x fastReflection_SymbioticStakes_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticStakes
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SymbioticStakes) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticStakes
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SymbioticStakes) Type() protoreflect.MessageType {
+	return _fastReflection_SymbioticStakes_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SymbioticStakes) New() protoreflect.Message {
+	return new(fastReflection_SymbioticStakes)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SymbioticStakes) Interface() protoreflect.ProtoMessage {
+	return (*SymbioticStakes)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SymbioticStakes) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Stakes) != 0 {
+		value := protoreflect.ValueOfList(&_SymbioticStakes_1_list{list: &x.Stakes})
+		if !f(fd_SymbioticStakes_stakes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SymbioticStakes) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStakes.stakes":
+		return len(x.Stakes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStakes"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStakes does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticStakes) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStakes.stakes":
+		x.Stakes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStakes"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStakes does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SymbioticStakes) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStakes.stakes":
+		if len(x.Stakes) == 0 {
+			return protoreflect.ValueOfList(&_SymbioticStakes_1_list{})
+		}
+		listValue := &_SymbioticStakes_1_list{list: &x.Stakes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStakes"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStakes does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticStakes) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStakes.stakes":
+		lv := value.List()
+		clv := lv.(*_SymbioticStakes_1_list)
+		x.Stakes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStakes"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStakes does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticStakes) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStakes.stakes":
+		if x.Stakes == nil {
+			x.Stakes = []*SymbioticMiddlewareStake{}
+		}
+		value := &_SymbioticStakes_1_list{list: &x.Stakes}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStakes"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStakes does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SymbioticStakes) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStakes.stakes":
+		list := []*SymbioticMiddlewareStake{}
+		return protoreflect.ValueOfList(&_SymbioticStakes_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStakes"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStakes does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SymbioticStakes) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.SymbioticStakes", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SymbioticStakes) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticStakes) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SymbioticStakes) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SymbioticStakes) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SymbioticStakes)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Stakes) > 0 {
+			for _, e := range x.Stakes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticStakes)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Stakes) > 0 {
+			for iNdEx := len(x.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Stakes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticStakes)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticStakes: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticStakes: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stakes = append(x.Stakes, &SymbioticMiddlewareStake{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stakes[len(x.Stakes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InjectedSymbioticData                      protoreflect.MessageDescriptor
+	fd_InjectedSymbioticData_version              protoreflect.FieldDescriptor
+	fd_InjectedSymbioticData_block_hash           protoreflect.FieldDescriptor
+	fd_InjectedSymbioticData_block_number         protoreflect.FieldDescriptor
+	fd_InjectedSymbioticData_block_timestamp      protoreflect.FieldDescriptor
+	fd_InjectedSymbioticData_slot                 protoreflect.FieldDescriptor
+	fd_InjectedSymbioticData_validator_set_digest protoreflect.FieldDescriptor
+	fd_InjectedSymbioticData_extended_commit_info protoreflect.FieldDescriptor
+	fd_InjectedSymbioticData_validator_set        protoreflect.FieldDescriptor
+	fd_InjectedSymbioticData_epoch                protoreflect.FieldDescriptor
+	fd_InjectedSymbioticData_skip_reason          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_InjectedSymbioticData = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("InjectedSymbioticData")
+	fd_InjectedSymbioticData_version = md_InjectedSymbioticData.Fields().ByName("version")
+	fd_InjectedSymbioticData_block_hash = md_InjectedSymbioticData.Fields().ByName("block_hash")
+	fd_InjectedSymbioticData_block_number = md_InjectedSymbioticData.Fields().ByName("block_number")
+	fd_InjectedSymbioticData_block_timestamp = md_InjectedSymbioticData.Fields().ByName("block_timestamp")
+	fd_InjectedSymbioticData_slot = md_InjectedSymbioticData.Fields().ByName("slot")
+	fd_InjectedSymbioticData_validator_set_digest = md_InjectedSymbioticData.Fields().ByName("validator_set_digest")
+	fd_InjectedSymbioticData_extended_commit_info = md_InjectedSymbioticData.Fields().ByName("extended_commit_info")
+	fd_InjectedSymbioticData_validator_set = md_InjectedSymbioticData.Fields().ByName("validator_set")
+	fd_InjectedSymbioticData_epoch = md_InjectedSymbioticData.Fields().ByName("epoch")
+	fd_InjectedSymbioticData_skip_reason = md_InjectedSymbioticData.Fields().ByName("skip_reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_InjectedSymbioticData)(nil)
+
+type fastReflection_InjectedSymbioticData InjectedSymbioticData
+
+func (x *InjectedSymbioticData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InjectedSymbioticData)(x)
+}
+
+func (x *InjectedSymbioticData) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InjectedSymbioticData_messageType fastReflection_InjectedSymbioticData_messageType
+var _ protoreflect.MessageType = fastReflection_InjectedSymbioticData_messageType{}
+
+type fastReflection_InjectedSymbioticData_messageType struct{}
+
+func (x fastReflection_InjectedSymbioticData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InjectedSymbioticData)(nil)
+}
+func (x fastReflection_InjectedSymbioticData_messageType) New() protoreflect.Message {
+	return new(fastReflection_InjectedSymbioticData)
+}
+func (x fastReflection_InjectedSymbioticData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InjectedSymbioticData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InjectedSymbioticData) Descriptor() protoreflect.MessageDescriptor {
+	return md_InjectedSymbioticData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InjectedSymbioticData) Type() protoreflect.MessageType {
+	return _fastReflection_InjectedSymbioticData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InjectedSymbioticData) New() protoreflect.Message {
+	return new(fastReflection_InjectedSymbioticData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InjectedSymbioticData) Interface() protoreflect.ProtoMessage {
+	return (*InjectedSymbioticData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InjectedSymbioticData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Version != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Version)
+		if !f(fd_InjectedSymbioticData_version, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_InjectedSymbioticData_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_InjectedSymbioticData_block_number, value) {
+			return
+		}
+	}
+	if x.BlockTimestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockTimestamp)
+		if !f(fd_InjectedSymbioticData_block_timestamp, value) {
+			return
+		}
+	}
+	if x.Slot != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Slot)
+		if !f(fd_InjectedSymbioticData_slot, value) {
+			return
+		}
+	}
+	if len(x.ValidatorSetDigest) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValidatorSetDigest)
+		if !f(fd_InjectedSymbioticData_validator_set_digest, value) {
+			return
+		}
+	}
+	if len(x.ExtendedCommitInfo) != 0 {
+		value := protoreflect.ValueOfBytes(x.ExtendedCommitInfo)
+		if !f(fd_InjectedSymbioticData_extended_commit_info, value) {
+			return
+		}
+	}
+	if len(x.ValidatorSet) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValidatorSet)
+		if !f(fd_InjectedSymbioticData_validator_set, value) {
+			return
+		}
+	}
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_InjectedSymbioticData_epoch, value) {
+			return
+		}
+	}
+	if x.SkipReason != "" {
+		value := protoreflect.ValueOfString(x.SkipReason)
+		if !f(fd_InjectedSymbioticData_skip_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InjectedSymbioticData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.version":
+		return x.Version != uint32(0)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_number":
+		return x.BlockNumber != uint64(0)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_timestamp":
+		return x.BlockTimestamp != uint64(0)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.slot":
+		return x.Slot != int64(0)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set_digest":
+		return len(x.ValidatorSetDigest) != 0
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.extended_commit_info":
+		return len(x.ExtendedCommitInfo) != 0
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set":
+		return len(x.ValidatorSet) != 0
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.epoch":
+		return x.Epoch != uint64(0)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.skip_reason":
+		return x.SkipReason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.InjectedSymbioticData"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.InjectedSymbioticData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InjectedSymbioticData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.version":
+		x.Version = uint32(0)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_hash":
+		x.BlockHash = ""
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_number":
+		x.BlockNumber = uint64(0)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_timestamp":
+		x.BlockTimestamp = uint64(0)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.slot":
+		x.Slot = int64(0)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set_digest":
+		x.ValidatorSetDigest = nil
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.extended_commit_info":
+		x.ExtendedCommitInfo = nil
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set":
+		x.ValidatorSet = nil
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.epoch":
+		x.Epoch = uint64(0)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.skip_reason":
+		x.SkipReason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.InjectedSymbioticData"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.InjectedSymbioticData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InjectedSymbioticData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.version":
+		value := x.Version
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_timestamp":
+		value := x.BlockTimestamp
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.slot":
+		value := x.Slot
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set_digest":
+		value := x.ValidatorSetDigest
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.extended_commit_info":
+		value := x.ExtendedCommitInfo
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set":
+		value := x.ValidatorSet
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.skip_reason":
+		value := x.SkipReason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.InjectedSymbioticData"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.InjectedSymbioticData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InjectedSymbioticData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.version":
+		x.Version = uint32(value.Uint())
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_number":
+		x.BlockNumber = value.Uint()
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_timestamp":
+		x.BlockTimestamp = value.Uint()
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.slot":
+		x.Slot = value.Int()
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set_digest":
+		x.ValidatorSetDigest = value.Bytes()
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.extended_commit_info":
+		x.ExtendedCommitInfo = value.Bytes()
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set":
+		x.ValidatorSet = value.Bytes()
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.epoch":
+		x.Epoch = value.Uint()
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.skip_reason":
+		x.SkipReason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.InjectedSymbioticData"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.InjectedSymbioticData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InjectedSymbioticData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.version":
+		panic(fmt.Errorf("field version of message cosmos.symStaking.v1beta1.InjectedSymbioticData is not mutable"))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.symStaking.v1beta1.InjectedSymbioticData is not mutable"))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.symStaking.v1beta1.InjectedSymbioticData is not mutable"))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_timestamp":
+		panic(fmt.Errorf("field block_timestamp of message cosmos.symStaking.v1beta1.InjectedSymbioticData is not mutable"))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.slot":
+		panic(fmt.Errorf("field slot of message cosmos.symStaking.v1beta1.InjectedSymbioticData is not mutable"))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set_digest":
+		panic(fmt.Errorf("field validator_set_digest of message cosmos.symStaking.v1beta1.InjectedSymbioticData is not mutable"))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.extended_commit_info":
+		panic(fmt.Errorf("field extended_commit_info of message cosmos.symStaking.v1beta1.InjectedSymbioticData is not mutable"))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set":
+		panic(fmt.Errorf("field validator_set of message cosmos.symStaking.v1beta1.InjectedSymbioticData is not mutable"))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.epoch":
+		panic(fmt.Errorf("field epoch of message cosmos.symStaking.v1beta1.InjectedSymbioticData is not mutable"))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.skip_reason":
+		panic(fmt.Errorf("field skip_reason of message cosmos.symStaking.v1beta1.InjectedSymbioticData is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.InjectedSymbioticData"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.InjectedSymbioticData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InjectedSymbioticData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.block_timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.slot":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set_digest":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.extended_commit_info":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.validator_set":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.InjectedSymbioticData.skip_reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.InjectedSymbioticData"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.InjectedSymbioticData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InjectedSymbioticData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.InjectedSymbioticData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InjectedSymbioticData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InjectedSymbioticData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InjectedSymbioticData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InjectedSymbioticData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InjectedSymbioticData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		if x.BlockTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockTimestamp))
+		}
+		if x.Slot != 0 {
+			n += 1 + runtime.Sov(uint64(x.Slot))
+		}
+		l = len(x.ValidatorSetDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExtendedCommitInfo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorSet)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		l = len(x.SkipReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InjectedSymbioticData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SkipReason) > 0 {
+			i -= len(x.SkipReason)
+			copy(dAtA[i:], x.SkipReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SkipReason)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ValidatorSet) > 0 {
+			i -= len(x.ValidatorSet)
+			copy(dAtA[i:], x.ValidatorSet)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSet)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.ExtendedCommitInfo) > 0 {
+			i -= len(x.ExtendedCommitInfo)
+			copy(dAtA[i:], x.ExtendedCommitInfo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtendedCommitInfo)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.ValidatorSetDigest) > 0 {
+			i -= len(x.ValidatorSetDigest)
+			copy(dAtA[i:], x.ValidatorSetDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSetDigest)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Slot != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Slot))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.BlockTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockTimestamp))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InjectedSymbioticData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InjectedSymbioticData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InjectedSymbioticData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+				}
+				x.BlockTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockTimestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				}
+				x.Slot = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Slot |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetDigest", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorSetDigest = append(x.ValidatorSetDigest[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValidatorSetDigest == nil {
+					x.ValidatorSetDigest = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommitInfo", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExtendedCommitInfo = append(x.ExtendedCommitInfo[:0], dAtA[iNdEx:postIndex]...)
+				if x.ExtendedCommitInfo == nil {
+					x.ExtendedCommitInfo = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorSet = append(x.ValidatorSet[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValidatorSet == nil {
+					x.ValidatorSet = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkipReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SkipReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SymbioticVoteExtension                      protoreflect.MessageDescriptor
+	fd_SymbioticVoteExtension_block_hash           protoreflect.FieldDescriptor
+	fd_SymbioticVoteExtension_block_number         protoreflect.FieldDescriptor
+	fd_SymbioticVoteExtension_block_timestamp      protoreflect.FieldDescriptor
+	fd_SymbioticVoteExtension_slot                 protoreflect.FieldDescriptor
+	fd_SymbioticVoteExtension_validator_set_digest protoreflect.FieldDescriptor
+	fd_SymbioticVoteExtension_epoch                protoreflect.FieldDescriptor
+	fd_SymbioticVoteExtension_checkpoint_epoch     protoreflect.FieldDescriptor
+	fd_SymbioticVoteExtension_checkpoint_signature protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_SymbioticVoteExtension = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("SymbioticVoteExtension")
+	fd_SymbioticVoteExtension_block_hash = md_SymbioticVoteExtension.Fields().ByName("block_hash")
+	fd_SymbioticVoteExtension_block_number = md_SymbioticVoteExtension.Fields().ByName("block_number")
+	fd_SymbioticVoteExtension_block_timestamp = md_SymbioticVoteExtension.Fields().ByName("block_timestamp")
+	fd_SymbioticVoteExtension_slot = md_SymbioticVoteExtension.Fields().ByName("slot")
+	fd_SymbioticVoteExtension_validator_set_digest = md_SymbioticVoteExtension.Fields().ByName("validator_set_digest")
+	fd_SymbioticVoteExtension_epoch = md_SymbioticVoteExtension.Fields().ByName("epoch")
+	fd_SymbioticVoteExtension_checkpoint_epoch = md_SymbioticVoteExtension.Fields().ByName("checkpoint_epoch")
+	fd_SymbioticVoteExtension_checkpoint_signature = md_SymbioticVoteExtension.Fields().ByName("checkpoint_signature")
+}
+
+var _ protoreflect.Message = (*fastReflection_SymbioticVoteExtension)(nil)
+
+type fastReflection_SymbioticVoteExtension SymbioticVoteExtension
+
+func (x *SymbioticVoteExtension) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SymbioticVoteExtension)(x)
+}
+
+func (x *SymbioticVoteExtension) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SymbioticVoteExtension_messageType fastReflection_SymbioticVoteExtension_messageType
+var _ protoreflect.MessageType = fastReflection_SymbioticVoteExtension_messageType{}
+
+type fastReflection_SymbioticVoteExtension_messageType struct{}
+
+func (x fastReflection_SymbioticVoteExtension_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SymbioticVoteExtension)(nil)
+}
+func (x fastReflection_SymbioticVoteExtension_messageType) New() protoreflect.Message {
+	return new(fastReflection_SymbioticVoteExtension)
+}
+func (x fastReflection_SymbioticVoteExtension_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticVoteExtension
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SymbioticVoteExtension) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticVoteExtension
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SymbioticVoteExtension) Type() protoreflect.MessageType {
+	return _fastReflection_SymbioticVoteExtension_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SymbioticVoteExtension) New() protoreflect.Message {
+	return new(fastReflection_SymbioticVoteExtension)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SymbioticVoteExtension) Interface() protoreflect.ProtoMessage {
+	return (*SymbioticVoteExtension)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SymbioticVoteExtension) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_SymbioticVoteExtension_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_SymbioticVoteExtension_block_number, value) {
+			return
+		}
+	}
+	if x.BlockTimestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockTimestamp)
+		if !f(fd_SymbioticVoteExtension_block_timestamp, value) {
+			return
+		}
+	}
+	if x.Slot != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Slot)
+		if !f(fd_SymbioticVoteExtension_slot, value) {
+			return
+		}
+	}
+	if len(x.ValidatorSetDigest) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValidatorSetDigest)
+		if !f(fd_SymbioticVoteExtension_validator_set_digest, value) {
+			return
+		}
+	}
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_SymbioticVoteExtension_epoch, value) {
+			return
+		}
+	}
+	if x.CheckpointEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CheckpointEpoch)
+		if !f(fd_SymbioticVoteExtension_checkpoint_epoch, value) {
+			return
+		}
+	}
+	if len(x.CheckpointSignature) != 0 {
+		value := protoreflect.ValueOfBytes(x.CheckpointSignature)
+		if !f(fd_SymbioticVoteExtension_checkpoint_signature, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SymbioticVoteExtension) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_number":
+		return x.BlockNumber != uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_timestamp":
+		return x.BlockTimestamp != uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.slot":
+		return x.Slot != int64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.validator_set_digest":
+		return len(x.ValidatorSetDigest) != 0
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.epoch":
+		return x.Epoch != uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_epoch":
+		return x.CheckpointEpoch != uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_signature":
+		return len(x.CheckpointSignature) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticVoteExtension"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticVoteExtension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticVoteExtension) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_hash":
+		x.BlockHash = ""
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_number":
+		x.BlockNumber = uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_timestamp":
+		x.BlockTimestamp = uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.slot":
+		x.Slot = int64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.validator_set_digest":
+		x.ValidatorSetDigest = nil
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.epoch":
+		x.Epoch = uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_epoch":
+		x.CheckpointEpoch = uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_signature":
+		x.CheckpointSignature = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticVoteExtension"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticVoteExtension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SymbioticVoteExtension) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_timestamp":
+		value := x.BlockTimestamp
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.slot":
+		value := x.Slot
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.validator_set_digest":
+		value := x.ValidatorSetDigest
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_epoch":
+		value := x.CheckpointEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_signature":
+		value := x.CheckpointSignature
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticVoteExtension"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticVoteExtension does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticVoteExtension) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_number":
+		x.BlockNumber = value.Uint()
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_timestamp":
+		x.BlockTimestamp = value.Uint()
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.slot":
+		x.Slot = value.Int()
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.validator_set_digest":
+		x.ValidatorSetDigest = value.Bytes()
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.epoch":
+		x.Epoch = value.Uint()
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_epoch":
+		x.CheckpointEpoch = value.Uint()
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_signature":
+		x.CheckpointSignature = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticVoteExtension"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticVoteExtension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticVoteExtension) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.symStaking.v1beta1.SymbioticVoteExtension is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.symStaking.v1beta1.SymbioticVoteExtension is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_timestamp":
+		panic(fmt.Errorf("field block_timestamp of message cosmos.symStaking.v1beta1.SymbioticVoteExtension is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.slot":
+		panic(fmt.Errorf("field slot of message cosmos.symStaking.v1beta1.SymbioticVoteExtension is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.validator_set_digest":
+		panic(fmt.Errorf("field validator_set_digest of message cosmos.symStaking.v1beta1.SymbioticVoteExtension is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.epoch":
+		panic(fmt.Errorf("field epoch of message cosmos.symStaking.v1beta1.SymbioticVoteExtension is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_epoch":
+		panic(fmt.Errorf("field checkpoint_epoch of message cosmos.symStaking.v1beta1.SymbioticVoteExtension is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_signature":
+		panic(fmt.Errorf("field checkpoint_signature of message cosmos.symStaking.v1beta1.SymbioticVoteExtension is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticVoteExtension"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticVoteExtension does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SymbioticVoteExtension) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.block_timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.slot":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.validator_set_digest":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticVoteExtension.checkpoint_signature":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticVoteExtension"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticVoteExtension does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SymbioticVoteExtension) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.SymbioticVoteExtension", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SymbioticVoteExtension) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticVoteExtension) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SymbioticVoteExtension) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SymbioticVoteExtension) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SymbioticVoteExtension)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		if x.BlockTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockTimestamp))
+		}
+		if x.Slot != 0 {
+			n += 1 + runtime.Sov(uint64(x.Slot))
+		}
+		l = len(x.ValidatorSetDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if x.CheckpointEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.CheckpointEpoch))
+		}
+		l = len(x.CheckpointSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticVoteExtension)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CheckpointSignature) > 0 {
+			i -= len(x.CheckpointSignature)
+			copy(dAtA[i:], x.CheckpointSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CheckpointSignature)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.CheckpointEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CheckpointEpoch))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.ValidatorSetDigest) > 0 {
+			i -= len(x.ValidatorSetDigest)
+			copy(dAtA[i:], x.ValidatorSetDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSetDigest)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Slot != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Slot))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.BlockTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockTimestamp))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticVoteExtension)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticVoteExtension: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+				}
+				x.BlockTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockTimestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				}
+				x.Slot = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Slot |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetDigest", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorSetDigest = append(x.ValidatorSetDigest[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValidatorSetDigest == nil {
+					x.ValidatorSetDigest = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CheckpointEpoch", wireType)
+				}
+				x.CheckpointEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CheckpointEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CheckpointSignature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CheckpointSignature = append(x.CheckpointSignature[:0], dAtA[iNdEx:postIndex]...)
+				if x.CheckpointSignature == nil {
+					x.CheckpointSignature = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SymbioticSyncPoint                    protoreflect.MessageDescriptor
+	fd_SymbioticSyncPoint_height             protoreflect.FieldDescriptor
+	fd_SymbioticSyncPoint_block_hash         protoreflect.FieldDescriptor
+	fd_SymbioticSyncPoint_block_number       protoreflect.FieldDescriptor
+	fd_SymbioticSyncPoint_block_timestamp    protoreflect.FieldDescriptor
+	fd_SymbioticSyncPoint_epoch              protoreflect.FieldDescriptor
+	fd_SymbioticSyncPoint_validators_updated protoreflect.FieldDescriptor
+	fd_SymbioticSyncPoint_skip_reason        protoreflect.FieldDescriptor
+	fd_SymbioticSyncPoint_validators_removed protoreflect.FieldDescriptor
+	fd_SymbioticSyncPoint_validators_rotated protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_SymbioticSyncPoint = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("SymbioticSyncPoint")
+	fd_SymbioticSyncPoint_height = md_SymbioticSyncPoint.Fields().ByName("height")
+	fd_SymbioticSyncPoint_block_hash = md_SymbioticSyncPoint.Fields().ByName("block_hash")
+	fd_SymbioticSyncPoint_block_number = md_SymbioticSyncPoint.Fields().ByName("block_number")
+	fd_SymbioticSyncPoint_block_timestamp = md_SymbioticSyncPoint.Fields().ByName("block_timestamp")
+	fd_SymbioticSyncPoint_epoch = md_SymbioticSyncPoint.Fields().ByName("epoch")
+	fd_SymbioticSyncPoint_validators_updated = md_SymbioticSyncPoint.Fields().ByName("validators_updated")
+	fd_SymbioticSyncPoint_skip_reason = md_SymbioticSyncPoint.Fields().ByName("skip_reason")
+	fd_SymbioticSyncPoint_validators_removed = md_SymbioticSyncPoint.Fields().ByName("validators_removed")
+	fd_SymbioticSyncPoint_validators_rotated = md_SymbioticSyncPoint.Fields().ByName("validators_rotated")
+}
+
+var _ protoreflect.Message = (*fastReflection_SymbioticSyncPoint)(nil)
+
+type fastReflection_SymbioticSyncPoint SymbioticSyncPoint
+
+func (x *SymbioticSyncPoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SymbioticSyncPoint)(x)
+}
+
+func (x *SymbioticSyncPoint) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SymbioticSyncPoint_messageType fastReflection_SymbioticSyncPoint_messageType
+var _ protoreflect.MessageType = fastReflection_SymbioticSyncPoint_messageType{}
+
+type fastReflection_SymbioticSyncPoint_messageType struct{}
+
+func (x fastReflection_SymbioticSyncPoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SymbioticSyncPoint)(nil)
+}
+func (x fastReflection_SymbioticSyncPoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_SymbioticSyncPoint)
+}
+func (x fastReflection_SymbioticSyncPoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticSyncPoint
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SymbioticSyncPoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticSyncPoint
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SymbioticSyncPoint) Type() protoreflect.MessageType {
+	return _fastReflection_SymbioticSyncPoint_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SymbioticSyncPoint) New() protoreflect.Message {
+	return new(fastReflection_SymbioticSyncPoint)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SymbioticSyncPoint) Interface() protoreflect.ProtoMessage {
+	return (*SymbioticSyncPoint)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SymbioticSyncPoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_SymbioticSyncPoint_height, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_SymbioticSyncPoint_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_SymbioticSyncPoint_block_number, value) {
+			return
+		}
+	}
+	if x.BlockTimestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockTimestamp)
+		if !f(fd_SymbioticSyncPoint_block_timestamp, value) {
+			return
+		}
+	}
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_SymbioticSyncPoint_epoch, value) {
+			return
+		}
+	}
+	if x.ValidatorsUpdated != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ValidatorsUpdated)
+		if !f(fd_SymbioticSyncPoint_validators_updated, value) {
+			return
+		}
+	}
+	if x.SkipReason != "" {
+		value := protoreflect.ValueOfString(x.SkipReason)
+		if !f(fd_SymbioticSyncPoint_skip_reason, value) {
+			return
+		}
+	}
+	if x.ValidatorsRemoved != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ValidatorsRemoved)
+		if !f(fd_SymbioticSyncPoint_validators_removed, value) {
+			return
+		}
+	}
+	if x.ValidatorsRotated != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ValidatorsRotated)
+		if !f(fd_SymbioticSyncPoint_validators_rotated, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SymbioticSyncPoint) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.height":
+		return x.Height != int64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_number":
+		return x.BlockNumber != uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_timestamp":
+		return x.BlockTimestamp != uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.epoch":
+		return x.Epoch != uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_updated":
+		return x.ValidatorsUpdated != uint32(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.skip_reason":
+		return x.SkipReason != ""
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_removed":
+		return x.ValidatorsRemoved != uint32(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_rotated":
+		return x.ValidatorsRotated != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticSyncPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticSyncPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticSyncPoint) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.height":
+		x.Height = int64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_hash":
+		x.BlockHash = ""
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_number":
+		x.BlockNumber = uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_timestamp":
+		x.BlockTimestamp = uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.epoch":
+		x.Epoch = uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_updated":
+		x.ValidatorsUpdated = uint32(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.skip_reason":
+		x.SkipReason = ""
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_removed":
+		x.ValidatorsRemoved = uint32(0)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_rotated":
+		x.ValidatorsRotated = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticSyncPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticSyncPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SymbioticSyncPoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_timestamp":
+		value := x.BlockTimestamp
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_updated":
+		value := x.ValidatorsUpdated
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.skip_reason":
+		value := x.SkipReason
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_removed":
+		value := x.ValidatorsRemoved
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_rotated":
+		value := x.ValidatorsRotated
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticSyncPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticSyncPoint does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticSyncPoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.height":
+		x.Height = value.Int()
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_number":
+		x.BlockNumber = value.Uint()
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_timestamp":
+		x.BlockTimestamp = value.Uint()
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.epoch":
+		x.Epoch = value.Uint()
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_updated":
+		x.ValidatorsUpdated = uint32(value.Uint())
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.skip_reason":
+		x.SkipReason = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_removed":
+		x.ValidatorsRemoved = uint32(value.Uint())
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_rotated":
+		x.ValidatorsRotated = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticSyncPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticSyncPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticSyncPoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.height":
+		panic(fmt.Errorf("field height of message cosmos.symStaking.v1beta1.SymbioticSyncPoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.symStaking.v1beta1.SymbioticSyncPoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.symStaking.v1beta1.SymbioticSyncPoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_timestamp":
+		panic(fmt.Errorf("field block_timestamp of message cosmos.symStaking.v1beta1.SymbioticSyncPoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.epoch":
+		panic(fmt.Errorf("field epoch of message cosmos.symStaking.v1beta1.SymbioticSyncPoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_updated":
+		panic(fmt.Errorf("field validators_updated of message cosmos.symStaking.v1beta1.SymbioticSyncPoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.skip_reason":
+		panic(fmt.Errorf("field skip_reason of message cosmos.symStaking.v1beta1.SymbioticSyncPoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_removed":
+		panic(fmt.Errorf("field validators_removed of message cosmos.symStaking.v1beta1.SymbioticSyncPoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_rotated":
+		panic(fmt.Errorf("field validators_rotated of message cosmos.symStaking.v1beta1.SymbioticSyncPoint is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticSyncPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticSyncPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SymbioticSyncPoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.block_timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_updated":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.skip_reason":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_removed":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.symStaking.v1beta1.SymbioticSyncPoint.validators_rotated":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticSyncPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticSyncPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SymbioticSyncPoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.SymbioticSyncPoint", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SymbioticSyncPoint) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticSyncPoint) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SymbioticSyncPoint) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SymbioticSyncPoint) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SymbioticSyncPoint)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		if x.BlockTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockTimestamp))
+		}
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if x.ValidatorsUpdated != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorsUpdated))
+		}
+		l = len(x.SkipReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValidatorsRemoved != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorsRemoved))
+		}
+		if x.ValidatorsRotated != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorsRotated))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticSyncPoint)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ValidatorsRotated != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorsRotated))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ValidatorsRemoved != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorsRemoved))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.SkipReason) > 0 {
+			i -= len(x.SkipReason)
+			copy(dAtA[i:], x.SkipReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SkipReason)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.ValidatorsUpdated != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorsUpdated))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.BlockTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockTimestamp))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticSyncPoint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticSyncPoint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticSyncPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+				}
+				x.BlockTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockTimestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsUpdated", wireType)
+				}
+				x.ValidatorsUpdated = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorsUpdated |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkipReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SkipReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsRemoved", wireType)
+				}
+				x.ValidatorsRemoved = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorsRemoved |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsRotated", wireType)
+				}
+				x.ValidatorsRotated = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorsRotated |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SymbioticStaleness               protoreflect.MessageDescriptor
+	fd_SymbioticStaleness_since_height  protoreflect.FieldDescriptor
+	fd_SymbioticStaleness_skipped_syncs protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_SymbioticStaleness = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("SymbioticStaleness")
+	fd_SymbioticStaleness_since_height = md_SymbioticStaleness.Fields().ByName("since_height")
+	fd_SymbioticStaleness_skipped_syncs = md_SymbioticStaleness.Fields().ByName("skipped_syncs")
+}
+
+var _ protoreflect.Message = (*fastReflection_SymbioticStaleness)(nil)
+
+type fastReflection_SymbioticStaleness SymbioticStaleness
+
+func (x *SymbioticStaleness) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SymbioticStaleness)(x)
+}
+
+func (x *SymbioticStaleness) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SymbioticStaleness_messageType fastReflection_SymbioticStaleness_messageType
+var _ protoreflect.MessageType = fastReflection_SymbioticStaleness_messageType{}
+
+type fastReflection_SymbioticStaleness_messageType struct{}
+
+func (x fastReflection_SymbioticStaleness_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SymbioticStaleness)(nil)
+}
+func (x fastReflection_SymbioticStaleness_messageType) New() protoreflect.Message {
+	return new(fastReflection_SymbioticStaleness)
+}
+func (x fastReflection_SymbioticStaleness_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticStaleness
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SymbioticStaleness) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticStaleness
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SymbioticStaleness) Type() protoreflect.MessageType {
+	return _fastReflection_SymbioticStaleness_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SymbioticStaleness) New() protoreflect.Message {
+	return new(fastReflection_SymbioticStaleness)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SymbioticStaleness) Interface() protoreflect.ProtoMessage {
+	return (*SymbioticStaleness)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SymbioticStaleness) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SinceHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SinceHeight)
+		if !f(fd_SymbioticStaleness_since_height, value) {
+			return
+		}
+	}
+	if x.SkippedSyncs != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SkippedSyncs)
+		if !f(fd_SymbioticStaleness_skipped_syncs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SymbioticStaleness) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.since_height":
+		return x.SinceHeight != int64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.skipped_syncs":
+		return x.SkippedSyncs != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStaleness"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStaleness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticStaleness) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.since_height":
+		x.SinceHeight = int64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.skipped_syncs":
+		x.SkippedSyncs = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStaleness"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStaleness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SymbioticStaleness) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.since_height":
+		value := x.SinceHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.skipped_syncs":
+		value := x.SkippedSyncs
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStaleness"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStaleness does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticStaleness) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.since_height":
+		x.SinceHeight = value.Int()
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.skipped_syncs":
+		x.SkippedSyncs = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStaleness"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStaleness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticStaleness) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.since_height":
+		panic(fmt.Errorf("field since_height of message cosmos.symStaking.v1beta1.SymbioticStaleness is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.skipped_syncs":
+		panic(fmt.Errorf("field skipped_syncs of message cosmos.symStaking.v1beta1.SymbioticStaleness is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStaleness"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStaleness does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SymbioticStaleness) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.since_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticStaleness.skipped_syncs":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticStaleness"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticStaleness does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SymbioticStaleness) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.SymbioticStaleness", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SymbioticStaleness) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticStaleness) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SymbioticStaleness) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SymbioticStaleness) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SymbioticStaleness)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SinceHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SinceHeight))
+		}
+		if x.SkippedSyncs != 0 {
+			n += 1 + runtime.Sov(uint64(x.SkippedSyncs))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticStaleness)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SkippedSyncs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SkippedSyncs))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SinceHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SinceHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticStaleness)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticStaleness: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticStaleness: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
+				}
+				x.SinceHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SinceHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkippedSyncs", wireType)
+				}
+				x.SkippedSyncs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SkippedSyncs |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SymbioticValidatorTokens                   protoreflect.MessageDescriptor
+	fd_SymbioticValidatorTokens_validator_address protoreflect.FieldDescriptor
+	fd_SymbioticValidatorTokens_tokens            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_SymbioticValidatorTokens = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("SymbioticValidatorTokens")
+	fd_SymbioticValidatorTokens_validator_address = md_SymbioticValidatorTokens.Fields().ByName("validator_address")
+	fd_SymbioticValidatorTokens_tokens = md_SymbioticValidatorTokens.Fields().ByName("tokens")
+}
+
+var _ protoreflect.Message = (*fastReflection_SymbioticValidatorTokens)(nil)
+
+type fastReflection_SymbioticValidatorTokens SymbioticValidatorTokens
+
+func (x *SymbioticValidatorTokens) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SymbioticValidatorTokens)(x)
+}
+
+func (x *SymbioticValidatorTokens) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SymbioticValidatorTokens_messageType fastReflection_SymbioticValidatorTokens_messageType
+var _ protoreflect.MessageType = fastReflection_SymbioticValidatorTokens_messageType{}
+
+type fastReflection_SymbioticValidatorTokens_messageType struct{}
+
+func (x fastReflection_SymbioticValidatorTokens_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SymbioticValidatorTokens)(nil)
+}
+func (x fastReflection_SymbioticValidatorTokens_messageType) New() protoreflect.Message {
+	return new(fastReflection_SymbioticValidatorTokens)
+}
+func (x fastReflection_SymbioticValidatorTokens_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticValidatorTokens
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SymbioticValidatorTokens) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticValidatorTokens
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SymbioticValidatorTokens) Type() protoreflect.MessageType {
+	return _fastReflection_SymbioticValidatorTokens_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SymbioticValidatorTokens) New() protoreflect.Message {
+	return new(fastReflection_SymbioticValidatorTokens)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SymbioticValidatorTokens) Interface() protoreflect.ProtoMessage {
+	return (*SymbioticValidatorTokens)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SymbioticValidatorTokens) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_SymbioticValidatorTokens_validator_address, value) {
+			return
+		}
+	}
+	if x.Tokens != "" {
+		value := protoreflect.ValueOfString(x.Tokens)
+		if !f(fd_SymbioticValidatorTokens_tokens, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SymbioticValidatorTokens) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.validator_address":
+		return x.ValidatorAddress != ""
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.tokens":
+		return x.Tokens != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticValidatorTokens"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticValidatorTokens does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticValidatorTokens) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.validator_address":
+		x.ValidatorAddress = ""
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.tokens":
+		x.Tokens = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticValidatorTokens"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticValidatorTokens does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SymbioticValidatorTokens) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.tokens":
+		value := x.Tokens
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticValidatorTokens"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticValidatorTokens does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticValidatorTokens) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.tokens":
+		x.Tokens = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticValidatorTokens"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticValidatorTokens does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticValidatorTokens) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.symStaking.v1beta1.SymbioticValidatorTokens is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.tokens":
+		panic(fmt.Errorf("field tokens of message cosmos.symStaking.v1beta1.SymbioticValidatorTokens is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticValidatorTokens"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticValidatorTokens does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SymbioticValidatorTokens) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.validator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticValidatorTokens.tokens":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticValidatorTokens"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticValidatorTokens does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SymbioticValidatorTokens) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.SymbioticValidatorTokens", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SymbioticValidatorTokens) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticValidatorTokens) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SymbioticValidatorTokens) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SymbioticValidatorTokens) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SymbioticValidatorTokens)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Tokens)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticValidatorTokens)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Tokens) > 0 {
+			i -= len(x.Tokens)
+			copy(dAtA[i:], x.Tokens)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tokens)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticValidatorTokens)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticValidatorTokens: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticValidatorTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tokens = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ConsPubKeyRotation                  protoreflect.MessageDescriptor
+	fd_ConsPubKeyRotation_operator_address protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotation_old_cons_pubkey  protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotation_new_cons_pubkey  protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotation_height           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_ConsPubKeyRotation = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("ConsPubKeyRotation")
+	fd_ConsPubKeyRotation_operator_address = md_ConsPubKeyRotation.Fields().ByName("operator_address")
+	fd_ConsPubKeyRotation_old_cons_pubkey = md_ConsPubKeyRotation.Fields().ByName("old_cons_pubkey")
+	fd_ConsPubKeyRotation_new_cons_pubkey = md_ConsPubKeyRotation.Fields().ByName("new_cons_pubkey")
+	fd_ConsPubKeyRotation_height = md_ConsPubKeyRotation.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ConsPubKeyRotation)(nil)
+
+type fastReflection_ConsPubKeyRotation ConsPubKeyRotation
+
+func (x *ConsPubKeyRotation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ConsPubKeyRotation)(x)
+}
+
+func (x *ConsPubKeyRotation) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ConsPubKeyRotation_messageType fastReflection_ConsPubKeyRotation_messageType
+var _ protoreflect.MessageType = fastReflection_ConsPubKeyRotation_messageType{}
+
+type fastReflection_ConsPubKeyRotation_messageType struct{}
+
+func (x fastReflection_ConsPubKeyRotation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ConsPubKeyRotation)(nil)
+}
+func (x fastReflection_ConsPubKeyRotation_messageType) New() protoreflect.Message {
+	return new(fastReflection_ConsPubKeyRotation)
+}
+func (x fastReflection_ConsPubKeyRotation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConsPubKeyRotation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ConsPubKeyRotation) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConsPubKeyRotation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ConsPubKeyRotation) Type() protoreflect.MessageType {
+	return _fastReflection_ConsPubKeyRotation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ConsPubKeyRotation) New() protoreflect.Message {
+	return new(fastReflection_ConsPubKeyRotation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ConsPubKeyRotation) Interface() protoreflect.ProtoMessage {
+	return (*ConsPubKeyRotation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ConsPubKeyRotation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_ConsPubKeyRotation_operator_address, value) {
+			return
+		}
+	}
+	if x.OldConsPubkey != nil {
+		value := protoreflect.ValueOfMessage(x.OldConsPubkey.ProtoReflect())
+		if !f(fd_ConsPubKeyRotation_old_cons_pubkey, value) {
+			return
+		}
+	}
+	if x.NewConsPubkey != nil {
+		value := protoreflect.ValueOfMessage(x.NewConsPubkey.ProtoReflect())
+		if !f(fd_ConsPubKeyRotation_new_cons_pubkey, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ConsPubKeyRotation_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ConsPubKeyRotation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.operator_address":
+		return x.OperatorAddress != ""
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		return x.OldConsPubkey != nil
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		return x.NewConsPubkey != nil
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		return x.Height != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConsPubKeyRotation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.operator_address":
+		x.OperatorAddress = ""
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		x.OldConsPubkey = nil
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		x.NewConsPubkey = nil
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		x.Height = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ConsPubKeyRotation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.operator_address":
+		value := x.OperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		value := x.OldConsPubkey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		value := x.NewConsPubkey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConsPubKeyRotation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		x.OldConsPubkey = value.Message().Interface().(*anypb.Any)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		x.NewConsPubkey = value.Message().Interface().(*anypb.Any)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		x.Height = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConsPubKeyRotation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		if x.OldConsPubkey == nil {
+			x.OldConsPubkey = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.OldConsPubkey.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		if x.NewConsPubkey == nil {
+			x.NewConsPubkey = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.NewConsPubkey.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.operator_address":
+		panic(fmt.Errorf("field operator_address of message cosmos.symStaking.v1beta1.ConsPubKeyRotation is not mutable"))
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		panic(fmt.Errorf("field height of message cosmos.symStaking.v1beta1.ConsPubKeyRotation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ConsPubKeyRotation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.operator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ConsPubKeyRotation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.ConsPubKeyRotation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ConsPubKeyRotation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConsPubKeyRotation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ConsPubKeyRotation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ConsPubKeyRotation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ConsPubKeyRotation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OperatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OldConsPubkey != nil {
+			l = options.Size(x.OldConsPubkey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NewConsPubkey != nil {
+			l = options.Size(x.NewConsPubkey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ConsPubKeyRotation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.NewConsPubkey != nil {
+			encoded, err := options.Marshal(x.NewConsPubkey)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OldConsPubkey != nil {
+			encoded, err := options.Marshal(x.OldConsPubkey)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ConsPubKeyRotation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConsPubKeyRotation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConsPubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OldConsPubkey == nil {
+					x.OldConsPubkey = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OldConsPubkey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NewConsPubkey == nil {
+					x.NewConsPubkey = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NewConsPubkey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SymbioticCheckpoint_5_list)(nil)
+
+type _SymbioticCheckpoint_5_list struct {
+	list *[]*SymbioticCheckpointValidator
+}
+
+func (x *_SymbioticCheckpoint_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SymbioticCheckpoint_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SymbioticCheckpoint_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SymbioticCheckpointValidator)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SymbioticCheckpoint_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SymbioticCheckpointValidator)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SymbioticCheckpoint_5_list) AppendMutable() protoreflect.Value {
+	v := new(SymbioticCheckpointValidator)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SymbioticCheckpoint_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SymbioticCheckpoint_5_list) NewElement() protoreflect.Value {
+	v := new(SymbioticCheckpointValidator)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SymbioticCheckpoint_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SymbioticCheckpoint_7_list)(nil)
+
+type _SymbioticCheckpoint_7_list struct {
+	list *[]*SymbioticCheckpointSignature
+}
+
+func (x *_SymbioticCheckpoint_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SymbioticCheckpoint_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SymbioticCheckpoint_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SymbioticCheckpointSignature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SymbioticCheckpoint_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SymbioticCheckpointSignature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SymbioticCheckpoint_7_list) AppendMutable() protoreflect.Value {
+	v := new(SymbioticCheckpointSignature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SymbioticCheckpoint_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SymbioticCheckpoint_7_list) NewElement() protoreflect.Value {
+	v := new(SymbioticCheckpointSignature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SymbioticCheckpoint_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SymbioticCheckpoint                    protoreflect.MessageDescriptor
+	fd_SymbioticCheckpoint_epoch              protoreflect.FieldDescriptor
+	fd_SymbioticCheckpoint_height             protoreflect.FieldDescriptor
+	fd_SymbioticCheckpoint_validator_set_hash protoreflect.FieldDescriptor
+	fd_SymbioticCheckpoint_app_hash           protoreflect.FieldDescriptor
+	fd_SymbioticCheckpoint_validators         protoreflect.FieldDescriptor
+	fd_SymbioticCheckpoint_total_power        protoreflect.FieldDescriptor
+	fd_SymbioticCheckpoint_signatures         protoreflect.FieldDescriptor
+	fd_SymbioticCheckpoint_signed_power       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_SymbioticCheckpoint = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("SymbioticCheckpoint")
+	fd_SymbioticCheckpoint_epoch = md_SymbioticCheckpoint.Fields().ByName("epoch")
+	fd_SymbioticCheckpoint_height = md_SymbioticCheckpoint.Fields().ByName("height")
+	fd_SymbioticCheckpoint_validator_set_hash = md_SymbioticCheckpoint.Fields().ByName("validator_set_hash")
+	fd_SymbioticCheckpoint_app_hash = md_SymbioticCheckpoint.Fields().ByName("app_hash")
+	fd_SymbioticCheckpoint_validators = md_SymbioticCheckpoint.Fields().ByName("validators")
+	fd_SymbioticCheckpoint_total_power = md_SymbioticCheckpoint.Fields().ByName("total_power")
+	fd_SymbioticCheckpoint_signatures = md_SymbioticCheckpoint.Fields().ByName("signatures")
+	fd_SymbioticCheckpoint_signed_power = md_SymbioticCheckpoint.Fields().ByName("signed_power")
+}
+
+var _ protoreflect.Message = (*fastReflection_SymbioticCheckpoint)(nil)
+
+type fastReflection_SymbioticCheckpoint SymbioticCheckpoint
+
+func (x *SymbioticCheckpoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SymbioticCheckpoint)(x)
+}
+
+func (x *SymbioticCheckpoint) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SymbioticCheckpoint_messageType fastReflection_SymbioticCheckpoint_messageType
+var _ protoreflect.MessageType = fastReflection_SymbioticCheckpoint_messageType{}
+
+type fastReflection_SymbioticCheckpoint_messageType struct{}
+
+func (x fastReflection_SymbioticCheckpoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SymbioticCheckpoint)(nil)
+}
+func (x fastReflection_SymbioticCheckpoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_SymbioticCheckpoint)
+}
+func (x fastReflection_SymbioticCheckpoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticCheckpoint
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SymbioticCheckpoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticCheckpoint
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SymbioticCheckpoint) Type() protoreflect.MessageType {
+	return _fastReflection_SymbioticCheckpoint_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SymbioticCheckpoint) New() protoreflect.Message {
+	return new(fastReflection_SymbioticCheckpoint)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SymbioticCheckpoint) Interface() protoreflect.ProtoMessage {
+	return (*SymbioticCheckpoint)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SymbioticCheckpoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_SymbioticCheckpoint_epoch, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_SymbioticCheckpoint_height, value) {
+			return
+		}
+	}
+	if len(x.ValidatorSetHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValidatorSetHash)
+		if !f(fd_SymbioticCheckpoint_validator_set_hash, value) {
+			return
+		}
+	}
+	if len(x.AppHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.AppHash)
+		if !f(fd_SymbioticCheckpoint_app_hash, value) {
+			return
+		}
+	}
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_SymbioticCheckpoint_5_list{list: &x.Validators})
+		if !f(fd_SymbioticCheckpoint_validators, value) {
+			return
+		}
+	}
+	if x.TotalPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalPower)
+		if !f(fd_SymbioticCheckpoint_total_power, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_SymbioticCheckpoint_7_list{list: &x.Signatures})
+		if !f(fd_SymbioticCheckpoint_signatures, value) {
+			return
+		}
+	}
+	if x.SignedPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SignedPower)
+		if !f(fd_SymbioticCheckpoint_signed_power, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SymbioticCheckpoint) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.epoch":
+		return x.Epoch != uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.height":
+		return x.Height != int64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validator_set_hash":
+		return len(x.ValidatorSetHash) != 0
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.app_hash":
+		return len(x.AppHash) != 0
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validators":
+		return len(x.Validators) != 0
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.total_power":
+		return x.TotalPower != int64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signatures":
+		return len(x.Signatures) != 0
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signed_power":
+		return x.SignedPower != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpoint) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.epoch":
+		x.Epoch = uint64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.height":
+		x.Height = int64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validator_set_hash":
+		x.ValidatorSetHash = nil
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.app_hash":
+		x.AppHash = nil
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validators":
+		x.Validators = nil
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.total_power":
+		x.TotalPower = int64(0)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signatures":
+		x.Signatures = nil
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signed_power":
+		x.SignedPower = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SymbioticCheckpoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validator_set_hash":
+		value := x.ValidatorSetHash
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.app_hash":
+		value := x.AppHash
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_SymbioticCheckpoint_5_list{})
+		}
+		listValue := &_SymbioticCheckpoint_5_list{list: &x.Validators}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.total_power":
+		value := x.TotalPower
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_SymbioticCheckpoint_7_list{})
+		}
+		listValue := &_SymbioticCheckpoint_7_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signed_power":
+		value := x.SignedPower
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpoint does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.epoch":
+		x.Epoch = value.Uint()
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.height":
+		x.Height = value.Int()
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validator_set_hash":
+		x.ValidatorSetHash = value.Bytes()
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.app_hash":
+		x.AppHash = value.Bytes()
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validators":
+		lv := value.List()
+		clv := lv.(*_SymbioticCheckpoint_5_list)
+		x.Validators = *clv.list
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.total_power":
+		x.TotalPower = value.Int()
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signatures":
+		lv := value.List()
+		clv := lv.(*_SymbioticCheckpoint_7_list)
+		x.Signatures = *clv.list
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signed_power":
+		x.SignedPower = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validators":
+		if x.Validators == nil {
+			x.Validators = []*SymbioticCheckpointValidator{}
+		}
+		value := &_SymbioticCheckpoint_5_list{list: &x.Validators}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*SymbioticCheckpointSignature{}
+		}
+		value := &_SymbioticCheckpoint_7_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.epoch":
+		panic(fmt.Errorf("field epoch of message cosmos.symStaking.v1beta1.SymbioticCheckpoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.height":
+		panic(fmt.Errorf("field height of message cosmos.symStaking.v1beta1.SymbioticCheckpoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validator_set_hash":
+		panic(fmt.Errorf("field validator_set_hash of message cosmos.symStaking.v1beta1.SymbioticCheckpoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.app_hash":
+		panic(fmt.Errorf("field app_hash of message cosmos.symStaking.v1beta1.SymbioticCheckpoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.total_power":
+		panic(fmt.Errorf("field total_power of message cosmos.symStaking.v1beta1.SymbioticCheckpoint is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signed_power":
+		panic(fmt.Errorf("field signed_power of message cosmos.symStaking.v1beta1.SymbioticCheckpoint is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SymbioticCheckpoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validator_set_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.app_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.validators":
+		list := []*SymbioticCheckpointValidator{}
+		return protoreflect.ValueOfList(&_SymbioticCheckpoint_5_list{list: &list})
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.total_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signatures":
+		list := []*SymbioticCheckpointSignature{}
+		return protoreflect.ValueOfList(&_SymbioticCheckpoint_7_list{list: &list})
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpoint.signed_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpoint"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SymbioticCheckpoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.SymbioticCheckpoint", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SymbioticCheckpoint) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpoint) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SymbioticCheckpoint) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SymbioticCheckpoint) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SymbioticCheckpoint)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.ValidatorSetHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AppHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Validators) > 0 {
+			for _, e := range x.Validators {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TotalPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalPower))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SignedPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.SignedPower))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticCheckpoint)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SignedPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignedPower))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.TotalPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalPower))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Validators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AppHash) > 0 {
+			i -= len(x.AppHash)
+			copy(dAtA[i:], x.AppHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ValidatorSetHash) > 0 {
+			i -= len(x.ValidatorSetHash)
+			copy(dAtA[i:], x.ValidatorSetHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSetHash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticCheckpoint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticCheckpoint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorSetHash = append(x.ValidatorSetHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValidatorSetHash == nil {
+					x.ValidatorSetHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppHash = append(x.AppHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.AppHash == nil {
+					x.AppHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validators = append(x.Validators, &SymbioticCheckpointValidator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+				}
+				x.TotalPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &SymbioticCheckpointSignature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
+				}
+				x.SignedPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SignedPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SymbioticCheckpointValidator          protoreflect.MessageDescriptor
+	fd_SymbioticCheckpointValidator_operator protoreflect.FieldDescriptor
+	fd_SymbioticCheckpointValidator_power    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_SymbioticCheckpointValidator = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("SymbioticCheckpointValidator")
+	fd_SymbioticCheckpointValidator_operator = md_SymbioticCheckpointValidator.Fields().ByName("operator")
+	fd_SymbioticCheckpointValidator_power = md_SymbioticCheckpointValidator.Fields().ByName("power")
+}
+
+var _ protoreflect.Message = (*fastReflection_SymbioticCheckpointValidator)(nil)
+
+type fastReflection_SymbioticCheckpointValidator SymbioticCheckpointValidator
+
+func (x *SymbioticCheckpointValidator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SymbioticCheckpointValidator)(x)
+}
+
+func (x *SymbioticCheckpointValidator) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SymbioticCheckpointValidator_messageType fastReflection_SymbioticCheckpointValidator_messageType
+var _ protoreflect.MessageType = fastReflection_SymbioticCheckpointValidator_messageType{}
+
+type fastReflection_SymbioticCheckpointValidator_messageType struct{}
+
+func (x fastReflection_SymbioticCheckpointValidator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SymbioticCheckpointValidator)(nil)
+}
+func (x fastReflection_SymbioticCheckpointValidator_messageType) New() protoreflect.Message {
+	return new(fastReflection_SymbioticCheckpointValidator)
+}
+func (x fastReflection_SymbioticCheckpointValidator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticCheckpointValidator
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SymbioticCheckpointValidator) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticCheckpointValidator
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SymbioticCheckpointValidator) Type() protoreflect.MessageType {
+	return _fastReflection_SymbioticCheckpointValidator_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SymbioticCheckpointValidator) New() protoreflect.Message {
+	return new(fastReflection_SymbioticCheckpointValidator)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SymbioticCheckpointValidator) Interface() protoreflect.ProtoMessage {
+	return (*SymbioticCheckpointValidator)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SymbioticCheckpointValidator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_SymbioticCheckpointValidator_operator, value) {
+			return
+		}
+	}
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_SymbioticCheckpointValidator_power, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SymbioticCheckpointValidator) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.operator":
+		return x.Operator != ""
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.power":
+		return x.Power != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointValidator"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointValidator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpointValidator) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.operator":
+		x.Operator = ""
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.power":
+		x.Power = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointValidator"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointValidator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SymbioticCheckpointValidator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.power":
+		value := x.Power
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointValidator"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointValidator does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpointValidator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.operator":
+		x.Operator = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.power":
+		x.Power = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointValidator"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointValidator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpointValidator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.operator":
+		panic(fmt.Errorf("field operator of message cosmos.symStaking.v1beta1.SymbioticCheckpointValidator is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.power":
+		panic(fmt.Errorf("field power of message cosmos.symStaking.v1beta1.SymbioticCheckpointValidator is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointValidator"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointValidator does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SymbioticCheckpointValidator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.operator":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator.power":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointValidator"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointValidator does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SymbioticCheckpointValidator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.SymbioticCheckpointValidator", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SymbioticCheckpointValidator) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpointValidator) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SymbioticCheckpointValidator) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SymbioticCheckpointValidator) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SymbioticCheckpointValidator)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticCheckpointValidator)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticCheckpointValidator)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticCheckpointValidator: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticCheckpointValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				}
+				x.Power = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Power |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SymbioticCheckpointSignature                   protoreflect.MessageDescriptor
+	fd_SymbioticCheckpointSignature_validator_address protoreflect.FieldDescriptor
+	fd_SymbioticCheckpointSignature_operator          protoreflect.FieldDescriptor
+	fd_SymbioticCheckpointSignature_signature         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_SymbioticCheckpointSignature = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("SymbioticCheckpointSignature")
+	fd_SymbioticCheckpointSignature_validator_address = md_SymbioticCheckpointSignature.Fields().ByName("validator_address")
+	fd_SymbioticCheckpointSignature_operator = md_SymbioticCheckpointSignature.Fields().ByName("operator")
+	fd_SymbioticCheckpointSignature_signature = md_SymbioticCheckpointSignature.Fields().ByName("signature")
+}
+
+var _ protoreflect.Message = (*fastReflection_SymbioticCheckpointSignature)(nil)
+
+type fastReflection_SymbioticCheckpointSignature SymbioticCheckpointSignature
+
+func (x *SymbioticCheckpointSignature) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SymbioticCheckpointSignature)(x)
+}
+
+func (x *SymbioticCheckpointSignature) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SymbioticCheckpointSignature_messageType fastReflection_SymbioticCheckpointSignature_messageType
+var _ protoreflect.MessageType = fastReflection_SymbioticCheckpointSignature_messageType{}
+
+type fastReflection_SymbioticCheckpointSignature_messageType struct{}
+
+func (x fastReflection_SymbioticCheckpointSignature_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SymbioticCheckpointSignature)(nil)
+}
+func (x fastReflection_SymbioticCheckpointSignature_messageType) New() protoreflect.Message {
+	return new(fastReflection_SymbioticCheckpointSignature)
+}
+func (x fastReflection_SymbioticCheckpointSignature_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticCheckpointSignature
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SymbioticCheckpointSignature) Descriptor() protoreflect.MessageDescriptor {
+	return md_SymbioticCheckpointSignature
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SymbioticCheckpointSignature) Type() protoreflect.MessageType {
+	return _fastReflection_SymbioticCheckpointSignature_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SymbioticCheckpointSignature) New() protoreflect.Message {
+	return new(fastReflection_SymbioticCheckpointSignature)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SymbioticCheckpointSignature) Interface() protoreflect.ProtoMessage {
+	return (*SymbioticCheckpointSignature)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SymbioticCheckpointSignature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_SymbioticCheckpointSignature_validator_address, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_SymbioticCheckpointSignature_operator, value) {
+			return
+		}
+	}
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_SymbioticCheckpointSignature_signature, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SymbioticCheckpointSignature) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.validator_address":
+		return x.ValidatorAddress != ""
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.operator":
+		return x.Operator != ""
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.signature":
+		return len(x.Signature) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpointSignature) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.validator_address":
+		x.ValidatorAddress = ""
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.operator":
+		x.Operator = ""
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.signature":
+		x.Signature = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SymbioticCheckpointSignature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointSignature does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpointSignature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.operator":
+		x.Operator = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.signature":
+		x.Signature = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpointSignature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.symStaking.v1beta1.SymbioticCheckpointSignature is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.operator":
+		panic(fmt.Errorf("field operator of message cosmos.symStaking.v1beta1.SymbioticCheckpointSignature is not mutable"))
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.signature":
+		panic(fmt.Errorf("field signature of message cosmos.symStaking.v1beta1.SymbioticCheckpointSignature is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SymbioticCheckpointSignature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.validator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.operator":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature.signature":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.SymbioticCheckpointSignature"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.SymbioticCheckpointSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SymbioticCheckpointSignature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.SymbioticCheckpointSignature", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SymbioticCheckpointSignature) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SymbioticCheckpointSignature) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SymbioticCheckpointSignature) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SymbioticCheckpointSignature) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SymbioticCheckpointSignature)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticCheckpointSignature)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SymbioticCheckpointSignature)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticCheckpointSignature: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SymbioticCheckpointSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ValidatorUpdates_1_list)(nil)
+
+type _ValidatorUpdates_1_list struct {
+	list *[]*v11.ValidatorUpdate
+}
+
+func (x *_ValidatorUpdates_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorUpdates_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorUpdates_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v11.ValidatorUpdate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorUpdates_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v11.ValidatorUpdate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorUpdates_1_list) AppendMutable() protoreflect.Value {
+	v := new(v11.ValidatorUpdate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorUpdates_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorUpdates_1_list) NewElement() protoreflect.Value {
+	v := new(v11.ValidatorUpdate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorUpdates_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValidatorUpdates         protoreflect.MessageDescriptor
+	fd_ValidatorUpdates_updates protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_ValidatorUpdates = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("ValidatorUpdates")
+	fd_ValidatorUpdates_updates = md_ValidatorUpdates.Fields().ByName("updates")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorUpdates)(nil)
+
+type fastReflection_ValidatorUpdates ValidatorUpdates
+
+func (x *ValidatorUpdates) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorUpdates)(x)
+}
+
+func (x *ValidatorUpdates) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorUpdates_messageType fastReflection_ValidatorUpdates_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorUpdates_messageType{}
+
+type fastReflection_ValidatorUpdates_messageType struct{}
+
+func (x fastReflection_ValidatorUpdates_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorUpdates)(nil)
+}
+func (x fastReflection_ValidatorUpdates_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorUpdates)
+}
+func (x fastReflection_ValidatorUpdates_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorUpdates
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorUpdates) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorUpdates
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorUpdates) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorUpdates_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorUpdates) New() protoreflect.Message {
+	return new(fastReflection_ValidatorUpdates)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorUpdates) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorUpdates)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorUpdates) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Updates) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorUpdates_1_list{list: &x.Updates})
+		if !f(fd_ValidatorUpdates_updates, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorUpdates) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
+		return len(x.Updates) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorUpdates) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
+		x.Updates = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorUpdates) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
+		if len(x.Updates) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorUpdates_1_list{})
+		}
+		listValue := &_ValidatorUpdates_1_list{list: &x.Updates}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorUpdates) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
+		lv := value.List()
+		clv := lv.(*_ValidatorUpdates_1_list)
+		x.Updates = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorUpdates) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
+		if x.Updates == nil {
+			x.Updates = []*v11.ValidatorUpdate{}
+		}
+		value := &_ValidatorUpdates_1_list{list: &x.Updates}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorUpdates) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
+		list := []*v11.ValidatorUpdate{}
+		return protoreflect.ValueOfList(&_ValidatorUpdates_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorUpdates) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.ValidatorUpdates", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorUpdates) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorUpdates) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorUpdates) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorUpdates)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Updates) > 0 {
+			for _, e := range x.Updates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorUpdates)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Updates) > 0 {
+			for iNdEx := len(x.Updates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Updates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorUpdates)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Updates = append(x.Updates, &v11.ValidatorUpdate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Updates[len(x.Updates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/symStaking/v1beta1/staking.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BondStatus is the status of a validator.
+type BondStatus int32
+
+const (
+	// UNSPECIFIED defines an invalid validator status.
+	BondStatus_BOND_STATUS_UNSPECIFIED BondStatus = 0
+	// UNBONDED defines a validator that is not bonded.
+	BondStatus_BOND_STATUS_UNBONDED BondStatus = 1
+	// UNBONDING defines a validator that is unbonding.
+	BondStatus_BOND_STATUS_UNBONDING BondStatus = 2
+	// BONDED defines a validator that is bonded.
+	BondStatus_BOND_STATUS_BONDED BondStatus = 3
+)
+
+// Enum value maps for BondStatus.
+var (
+	BondStatus_name = map[int32]string{
+		0: "BOND_STATUS_UNSPECIFIED",
+		1: "BOND_STATUS_UNBONDED",
+		2: "BOND_STATUS_UNBONDING",
+		3: "BOND_STATUS_BONDED",
+	}
+	BondStatus_value = map[string]int32{
+		"BOND_STATUS_UNSPECIFIED": 0,
+		"BOND_STATUS_UNBONDED":    1,
+		"BOND_STATUS_UNBONDING":   2,
+		"BOND_STATUS_BONDED":      3,
+	}
+)
+
+func (x BondStatus) Enum() *BondStatus {
+	p := new(BondStatus)
+	*p = x
+	return p
+}
+
+func (x BondStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BondStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[0].Descriptor()
+}
+
+func (BondStatus) Type() protoreflect.EnumType {
+	return &file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[0]
+}
+
+func (x BondStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BondStatus.Descriptor instead.
+func (BondStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{0}
+}
+
+// Infraction indicates the infraction a validator committed.
+type Infraction int32
+
+const (
+	// UNSPECIFIED defines an empty infraction.
+	Infraction_INFRACTION_UNSPECIFIED Infraction = 0
+	// DOUBLE_SIGN defines a validator that double-signs a block.
+	Infraction_INFRACTION_DOUBLE_SIGN Infraction = 1
+	// DOWNTIME defines a validator that missed signing too many blocks.
+	Infraction_INFRACTION_DOWNTIME Infraction = 2
+)
+
+// Enum value maps for Infraction.
+var (
+	Infraction_name = map[int32]string{
+		0: "INFRACTION_UNSPECIFIED",
+		1: "INFRACTION_DOUBLE_SIGN",
+		2: "INFRACTION_DOWNTIME",
+	}
+	Infraction_value = map[string]int32{
+		"INFRACTION_UNSPECIFIED": 0,
+		"INFRACTION_DOUBLE_SIGN": 1,
+		"INFRACTION_DOWNTIME":    2,
+	}
+)
+
+func (x Infraction) Enum() *Infraction {
+	p := new(Infraction)
+	*p = x
+	return p
+}
+
+func (x Infraction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Infraction) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[1].Descriptor()
+}
+
+func (Infraction) Type() protoreflect.EnumType {
+	return &file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[1]
+}
+
+func (x Infraction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Infraction.Descriptor instead.
+func (Infraction) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{1}
+}
+
+// HistoricalInfo contains header and validator information for a given block.
+// It is stored as part of staking module's state, which persists the `n` most
+// recent HistoricalInfo
+// (`n` is set by the staking module's `historical_entries` parameter).
+//
+// Deprecated: Do not use.
+type HistoricalInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *v1.Header   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Valset []*Validator `protobuf:"bytes,2,rep,name=valset,proto3" json:"valset,omitempty"`
+}
+
+func (x *HistoricalInfo) Reset() {
+	*x = HistoricalInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoricalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricalInfo) ProtoMessage() {}
+
+// Deprecated: Use HistoricalInfo.ProtoReflect.Descriptor instead.
+func (*HistoricalInfo) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HistoricalInfo) GetHeader() *v1.Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *HistoricalInfo) GetValset() []*Validator {
+	if x != nil {
+		return x.Valset
+	}
+	return nil
+}
+
+// HistoricalRecord contains a set of minimum values needed for evaluating historical validator sets and blocks.
+// It is stored as part of staking module's state, which persists the `n` most
+// recent HistoricalInfo
+// (`n` is set by the staking module's `historical_entries` parameter).
+type HistoricalRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Apphash        []byte                 `protobuf:"bytes,1,opt,name=apphash,proto3" json:"apphash,omitempty"`
+	Time           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	ValidatorsHash []byte                 `protobuf:"bytes,3,opt,name=validators_hash,json=validatorsHash,proto3" json:"validators_hash,omitempty"`
+	// symbiotic_epoch is the middleware epoch of the validator set at the height.
+	SymbioticEpoch uint64 `protobuf:"varint,4,opt,name=symbiotic_epoch,json=symbioticEpoch,proto3" json:"symbiotic_epoch,omitempty"`
+}
+
+func (x *HistoricalRecord) Reset() {
+	*x = HistoricalRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoricalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricalRecord) ProtoMessage() {}
+
+// Deprecated: Use HistoricalRecord.ProtoReflect.Descriptor instead.
+func (*HistoricalRecord) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HistoricalRecord) GetApphash() []byte {
+	if x != nil {
+		return x.Apphash
+	}
+	return nil
+}
+
+func (x *HistoricalRecord) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *HistoricalRecord) GetValidatorsHash() []byte {
+	if x != nil {
+		return x.ValidatorsHash
+	}
+	return nil
+}
+
+func (x *HistoricalRecord) GetSymbioticEpoch() uint64 {
+	if x != nil {
+		return x.SymbioticEpoch
+	}
+	return 0
+}
+
+// CommissionRates defines the initial commission rates to be used for creating
+// a validator.
+type CommissionRates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rate is the commission rate charged to delegators, as a fraction.
+	Rate string `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	// max_rate defines the maximum commission rate which validator can ever charge, as a fraction.
+	MaxRate string `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3" json:"max_rate,omitempty"`
+	// max_change_rate defines the maximum daily increase of the validator commission, as a fraction.
+	MaxChangeRate string `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3" json:"max_change_rate,omitempty"`
+}
+
+func (x *CommissionRates) Reset() {
+	*x = CommissionRates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommissionRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionRates) ProtoMessage() {}
+
+// Deprecated: Use CommissionRates.ProtoReflect.Descriptor instead.
+func (*CommissionRates) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CommissionRates) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *CommissionRates) GetMaxRate() string {
+	if x != nil {
+		return x.MaxRate
+	}
+	return ""
+}
+
+func (x *CommissionRates) GetMaxChangeRate() string {
+	if x != nil {
+		return x.MaxChangeRate
+	}
+	return ""
+}
+
+// Commission defines commission parameters for a given validator.
+type Commission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commission_rates defines the initial commission rates to be used for creating a validator.
+	CommissionRates *CommissionRates `protobuf:"bytes,1,opt,name=commission_rates,json=commissionRates,proto3" json:"commission_rates,omitempty"`
+	// update_time is the last time the commission rate was changed.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *Commission) Reset() {
+	*x = Commission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Commission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Commission) ProtoMessage() {}
+
+// Deprecated: Use Commission.ProtoReflect.Descriptor instead.
+func (*Commission) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Commission) GetCommissionRates() *CommissionRates {
+	if x != nil {
+		return x.CommissionRates
+	}
+	return nil
+}
+
+func (x *Commission) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Description defines a validator description.
+type Description struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// moniker defines a human-readable name for the validator.
+	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// identity defines an optional identity signature (ex. UPort or Keybase).
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	// website defines an optional website link.
+	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	// security_contact defines an optional email for security contact.
+	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
+	// details define other optional details.
+	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *Description) Reset() {
+	*x = Description{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Description) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Description) ProtoMessage() {}
+
+// Deprecated: Use Description.ProtoReflect.Descriptor instead.
+func (*Description) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Description) GetMoniker() string {
+	if x != nil {
+		return x.Moniker
+	}
+	return ""
+}
+
+func (x *Description) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *Description) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *Description) GetSecurityContact() string {
+	if x != nil {
+		return x.SecurityContact
+	}
+	return ""
+}
+
+func (x *Description) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+// Validator defines a validator, together with the total amount of the
+// Validator's bond shares and their exchange rate to coins. Slashing results in
+// a decrease in the exchange rate, allowing correct calculation of future
+// undelegations without iterating over delegators. When coins are delegated to
+// this validator, the validator is credited with a delegation whose number of
+// bond shares is based on the amount of coins delegated divided by the current
+// exchange rate. Voting power can be calculated as total bonded shares
+// multiplied by exchange rate.
+type Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// consensus_pubkey is the consensus public key of the validator, as a Protobuf Any.
+	ConsensusPubkey *anypb.Any `protobuf:"bytes,2,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// jailed defined whether the validator has been jailed from bonded status or not.
+	Jailed bool `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// status is the validator status (bonded/unbonding/unbonded).
+	Status BondStatus `protobuf:"varint,4,opt,name=status,proto3,enum=cosmos.symStaking.v1beta1.BondStatus" json:"status,omitempty"`
+	// tokens define the delegated tokens (incl. self-delegation).
+	Tokens string `protobuf:"bytes,5,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	// description defines the description terms for the validator.
+	Description *Description `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// unbonding_height defines, if unbonding, the height at which this validator has begun unbonding.
+	UnbondingHeight int64 `protobuf:"varint,7,opt,name=unbonding_height,json=unbondingHeight,proto3" json:"unbonding_height,omitempty"`
+	// unbonding_time defines, if unbonding, the min time for the validator to complete unbonding.
+	UnbondingTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// commission defines the commission parameters.
+	Commission *Commission `protobuf:"bytes,9,opt,name=commission,proto3" json:"commission,omitempty"`
+	// list of unbonding ids, each uniquely identifying an unbonding of this validator
+	UnbondingIds []uint64 `protobuf:"varint,10,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
+	// symbiotic_operator is the Ethereum address of the operator that registered the
+	// validator key in the Symbiotic middleware, empty if unknown.
+	SymbioticOperator string `protobuf:"bytes,11,opt,name=symbiotic_operator,json=symbioticOperator,proto3" json:"symbiotic_operator,omitempty"`
+}
+
+func (x *Validator) Reset() {
+	*x = Validator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validator) ProtoMessage() {}
+
+// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
+func (*Validator) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Validator) GetOperatorAddress() string {
+	if x != nil {
+		return x.OperatorAddress
+	}
+	return ""
+}
+
+func (x *Validator) GetConsensusPubkey() *anypb.Any {
+	if x != nil {
+		return x.ConsensusPubkey
+	}
+	return nil
+}
+
+func (x *Validator) GetJailed() bool {
+	if x != nil {
+		return x.Jailed
+	}
+	return false
+}
+
+func (x *Validator) GetStatus() BondStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BondStatus_BOND_STATUS_UNSPECIFIED
+}
 
-func (x *ValidatorUpdates) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Validator) GetTokens() string {
+	if x != nil {
+		return x.Tokens
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-var _fastReflection_ValidatorUpdates_messageType fastReflection_ValidatorUpdates_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorUpdates_messageType{}
+func (x *Validator) GetDescription() *Description {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
 
-type fastReflection_ValidatorUpdates_messageType struct{}
+func (x *Validator) GetUnbondingHeight() int64 {
+	if x != nil {
+		return x.UnbondingHeight
+	}
+	return 0
+}
 
-func (x fastReflection_ValidatorUpdates_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorUpdates)(nil)
+func (x *Validator) GetUnbondingTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnbondingTime
+	}
+	return nil
 }
-func (x fastReflection_ValidatorUpdates_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorUpdates)
+
+func (x *Validator) GetCommission() *Commission {
+	if x != nil {
+		return x.Commission
+	}
+	return nil
 }
-func (x fastReflection_ValidatorUpdates_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorUpdates
+
+func (x *Validator) GetUnbondingIds() []uint64 {
+	if x != nil {
+		return x.UnbondingIds
+	}
+	return nil
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_ValidatorUpdates) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorUpdates
+func (x *Validator) GetSymbioticOperator() string {
+	if x != nil {
+		return x.SymbioticOperator
+	}
+	return ""
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorUpdates) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorUpdates_messageType
+// ValAddresses defines a repeated set of validator addresses.
+type ValAddresses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorUpdates) New() protoreflect.Message {
-	return new(fastReflection_ValidatorUpdates)
+func (x *ValAddresses) Reset() {
+	*x = ValAddresses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorUpdates) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorUpdates)(x)
+func (x *ValAddresses) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_ValidatorUpdates) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Updates) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorUpdates_1_list{list: &x.Updates})
-		if !f(fd_ValidatorUpdates_updates, value) {
-			return
-		}
-	}
+func (*ValAddresses) ProtoMessage() {}
+
+// Deprecated: Use ValAddresses.ProtoReflect.Descriptor instead.
+func (*ValAddresses) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{6}
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorUpdates) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
-		return len(x.Updates) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
-		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", fd.FullName()))
+func (x *ValAddresses) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
 	}
+	return nil
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorUpdates) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
-		x.Updates = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
-		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", fd.FullName()))
+// Params defines the parameters for the x/symStaking module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unbonding_time is the time duration of unbonding.
+	UnbondingTime *durationpb.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// max_validators is the maximum number of validators.
+	MaxValidators uint32 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+	// max_entries is the max entries for either unbonding delegation or redelegation (per pair/trio).
+	MaxEntries uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	// historical_entries is the number of historical entries to persist.
+	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
+	// bond_denom defines the bondable coin denomination.
+	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
+	MinCommissionRate string `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3" json:"min_commission_rate,omitempty"`
+	// symbiotic_middleware_address is the hex address of the Symbiotic network middleware contract on Ethereum.
+	// The validator set sync is disabled while it and symbiotic_middlewares are empty.
+	SymbioticMiddlewareAddress string `protobuf:"bytes,7,opt,name=symbiotic_middleware_address,json=symbioticMiddlewareAddress,proto3" json:"symbiotic_middleware_address,omitempty"`
+	// beacon_genesis_timestamp is the unix timestamp of the beacon chain genesis.
+	// Only used by the custom ethereum network.
+	BeaconGenesisTimestamp uint64 `protobuf:"varint,8,opt,name=beacon_genesis_timestamp,json=beaconGenesisTimestamp,proto3" json:"beacon_genesis_timestamp,omitempty"`
+	// slot_duration is the duration of a beacon chain slot.
+	// Only used by the custom ethereum network.
+	SlotDuration *durationpb.Duration `protobuf:"bytes,9,opt,name=slot_duration,json=slotDuration,proto3" json:"slot_duration,omitempty"`
+	// symbiotic_sync_period is the number of blocks between two validator set syncs, or between two
+	// attempts of a sync under symbiotic_epoch_identifier.
+	SymbioticSyncPeriod int64 `protobuf:"varint,10,opt,name=symbiotic_sync_period,json=symbioticSyncPeriod,proto3" json:"symbiotic_sync_period,omitempty"`
+	// ethereum_network is the name of the Ethereum network the Symbiotic middleware is deployed on:
+	// mainnet, holesky, sepolia or custom.
+	EthereumNetwork string `protobuf:"bytes,11,opt,name=ethereum_network,json=ethereumNetwork,proto3" json:"ethereum_network,omitempty"`
+	// slots_per_epoch is the number of slots in a beacon chain epoch.
+	// Only used by the custom ethereum network.
+	SlotsPerEpoch uint64 `protobuf:"varint,12,opt,name=slots_per_epoch,json=slotsPerEpoch,proto3" json:"slots_per_epoch,omitempty"`
+	// finality_depth is the number of epochs behind the current one that are considered finalized.
+	// Only used by the custom ethereum network.
+	FinalityDepth uint64 `protobuf:"varint,13,opt,name=finality_depth,json=finalityDepth,proto3" json:"finality_depth,omitempty"`
+	// symbiotic_auto_create_validators makes the validator set sync create the validators missing
+	// from the store. It requires the ed25519 symbiotic_key_type.
+	SymbioticAutoCreateValidators bool `protobuf:"varint,14,opt,name=symbiotic_auto_create_validators,json=symbioticAutoCreateValidators,proto3" json:"symbiotic_auto_create_validators,omitempty"`
+	// symbiotic_key_type is the type of the 32-byte keys of the middleware validator set: address
+	// (zero padded consensus address), ed25519 (consensus pubkey) or secp256k1 (sha256 hash of the
+	// compressed consensus pubkey).
+	SymbioticKeyType string `protobuf:"bytes,15,opt,name=symbiotic_key_type,json=symbioticKeyType,proto3" json:"symbiotic_key_type,omitempty"`
+	// symbiotic_middlewares are the Symbiotic middleware contracts the stake of the validators is
+	// combined from, in place of symbiotic_middleware_address.
+	SymbioticMiddlewares []*SymbioticMiddleware `protobuf:"bytes,16,rep,name=symbiotic_middlewares,json=symbioticMiddlewares,proto3" json:"symbiotic_middlewares,omitempty"`
+	// symbiotic_max_power_share is the maximum share of the tokens of the synced validators a single
+	// validator can get, or zero if unlimited.
+	SymbioticMaxPowerShare string `protobuf:"bytes,17,opt,name=symbiotic_max_power_share,json=symbioticMaxPowerShare,proto3" json:"symbiotic_max_power_share,omitempty"`
+	// symbiotic_max_power_change is the maximum change of the tokens of a validator at a sync, relative
+	// to its tokens, or zero if unlimited. The rest of the change is applied over the next syncs.
+	SymbioticMaxPowerChange string `protobuf:"bytes,18,opt,name=symbiotic_max_power_change,json=symbioticMaxPowerChange,proto3" json:"symbiotic_max_power_change,omitempty"`
+	// symbiotic_min_stake is the minimum combined stake of a validator to get tokens.
+	SymbioticMinStake string `protobuf:"bytes,19,opt,name=symbiotic_min_stake,json=symbioticMinStake,proto3" json:"symbiotic_min_stake,omitempty"`
+	// symbiotic_max_stale_syncs is the number of consecutive skipped syncs the last synced validator
+	// set is kept for before the symbiotic_stale_policy applies, or zero to keep it indefinitely.
+	SymbioticMaxStaleSyncs uint32 `protobuf:"varint,20,opt,name=symbiotic_max_stale_syncs,json=symbioticMaxStaleSyncs,proto3" json:"symbiotic_max_stale_syncs,omitempty"`
+	// symbiotic_stale_policy is the policy applied at each skipped sync past symbiotic_max_stale_syncs:
+	// keep (the validator set is kept), decay (the tokens of the validators decay), halt (the
+	// symbiotic_halt_msg_type_urls are disabled through x/circuit) or manual (the syncs are suspended
+	// until a MsgUpdateSymbioticValidatorSet).
+	SymbioticStalePolicy string `protobuf:"bytes,21,opt,name=symbiotic_stale_policy,json=symbioticStalePolicy,proto3" json:"symbiotic_stale_policy,omitempty"`
+	// symbiotic_stale_decay is the share of their tokens the validators lose at each skipped sync
+	// under the decay stale policy.
+	SymbioticStaleDecay string `protobuf:"bytes,22,opt,name=symbiotic_stale_decay,json=symbioticStaleDecay,proto3" json:"symbiotic_stale_decay,omitempty"`
+	// symbiotic_halt_msg_type_urls are the messages disabled under the halt stale policy.
+	SymbioticHaltMsgTypeUrls []string `protobuf:"bytes,23,rep,name=symbiotic_halt_msg_type_urls,json=symbioticHaltMsgTypeUrls,proto3" json:"symbiotic_halt_msg_type_urls,omitempty"`
+	// symbiotic_epoch_identifier is the x/epochs identifier whose epochs match the middleware epochs: the
+	// validator set is synced at the start of each of its epochs, and retried every symbiotic_sync_period
+	// blocks until synced, backing off while the middleware epoch doesn't advance. If empty, the validator
+	// set is synced every symbiotic_sync_period blocks.
+	SymbioticEpochIdentifier string `protobuf:"bytes,24,opt,name=symbiotic_epoch_identifier,json=symbioticEpochIdentifier,proto3" json:"symbiotic_epoch_identifier,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorUpdates) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
-		if len(x.Updates) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorUpdates_1_list{})
-		}
-		listValue := &_ValidatorUpdates_1_list{list: &x.Updates}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
-		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", descriptor.FullName()))
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Params) GetUnbondingTime() *durationpb.Duration {
+	if x != nil {
+		return x.UnbondingTime
 	}
+	return nil
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorUpdates) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
-		lv := value.List()
-		clv := lv.(*_ValidatorUpdates_1_list)
-		x.Updates = *clv.list
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
-		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", fd.FullName()))
+func (x *Params) GetMaxValidators() uint32 {
+	if x != nil {
+		return x.MaxValidators
 	}
+	return 0
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorUpdates) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
-		if x.Updates == nil {
-			x.Updates = []*v11.ValidatorUpdate{}
-		}
-		value := &_ValidatorUpdates_1_list{list: &x.Updates}
-		return protoreflect.ValueOfList(value)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
-		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", fd.FullName()))
+func (x *Params) GetMaxEntries() uint32 {
+	if x != nil {
+		return x.MaxEntries
+	}
+	return 0
+}
+
+func (x *Params) GetHistoricalEntries() uint32 {
+	if x != nil {
+		return x.HistoricalEntries
 	}
+	return 0
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorUpdates) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cosmos.symStaking.v1beta1.ValidatorUpdates.updates":
-		list := []*v11.ValidatorUpdate{}
-		return protoreflect.ValueOfList(&_ValidatorUpdates_1_list{list: &list})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ValidatorUpdates"))
-		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ValidatorUpdates does not contain field %s", fd.FullName()))
+func (x *Params) GetBondDenom() string {
+	if x != nil {
+		return x.BondDenom
 	}
+	return ""
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorUpdates) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.ValidatorUpdates", d.FullName()))
+func (x *Params) GetMinCommissionRate() string {
+	if x != nil {
+		return x.MinCommissionRate
 	}
-	panic("unreachable")
+	return ""
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorUpdates) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *Params) GetSymbioticMiddlewareAddress() string {
+	if x != nil {
+		return x.SymbioticMiddlewareAddress
+	}
+	return ""
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorUpdates) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *Params) GetBeaconGenesisTimestamp() uint64 {
+	if x != nil {
+		return x.BeaconGenesisTimestamp
+	}
+	return 0
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorUpdates) IsValid() bool {
-	return x != nil
+func (x *Params) GetSlotDuration() *durationpb.Duration {
+	if x != nil {
+		return x.SlotDuration
+	}
+	return nil
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorUpdates)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if len(x.Updates) > 0 {
-			for _, e := range x.Updates {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *Params) GetSymbioticSyncPeriod() int64 {
+	if x != nil {
+		return x.SymbioticSyncPeriod
 	}
+	return 0
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorUpdates)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Updates) > 0 {
-			for iNdEx := len(x.Updates) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Updates[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *Params) GetEthereumNetwork() string {
+	if x != nil {
+		return x.EthereumNetwork
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorUpdates)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Updates = append(x.Updates, &v11.ValidatorUpdate{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Updates[len(x.Updates)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return ""
+}
+
+func (x *Params) GetSlotsPerEpoch() uint64 {
+	if x != nil {
+		return x.SlotsPerEpoch
+	}
+	return 0
+}
+
+func (x *Params) GetFinalityDepth() uint64 {
+	if x != nil {
+		return x.FinalityDepth
+	}
+	return 0
+}
+
+func (x *Params) GetSymbioticAutoCreateValidators() bool {
+	if x != nil {
+		return x.SymbioticAutoCreateValidators
+	}
+	return false
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *Params) GetSymbioticKeyType() string {
+	if x != nil {
+		return x.SymbioticKeyType
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return ""
+}
+
+func (x *Params) GetSymbioticMiddlewares() []*SymbioticMiddleware {
+	if x != nil {
+		return x.SymbioticMiddlewares
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/symStaking/v1beta1/staking.proto
+func (x *Params) GetSymbioticMaxPowerShare() string {
+	if x != nil {
+		return x.SymbioticMaxPowerShare
+	}
+	return ""
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *Params) GetSymbioticMaxPowerChange() string {
+	if x != nil {
+		return x.SymbioticMaxPowerChange
+	}
+	return ""
+}
 
-// BondStatus is the status of a validator.
-type BondStatus int32
+func (x *Params) GetSymbioticMinStake() string {
+	if x != nil {
+		return x.SymbioticMinStake
+	}
+	return ""
+}
 
-const (
-	// UNSPECIFIED defines an invalid validator status.
-	BondStatus_BOND_STATUS_UNSPECIFIED BondStatus = 0
-	// UNBONDED defines a validator that is not bonded.
-	BondStatus_BOND_STATUS_UNBONDED BondStatus = 1
-	// UNBONDING defines a validator that is unbonding.
-	BondStatus_BOND_STATUS_UNBONDING BondStatus = 2
-	// BONDED defines a validator that is bonded.
-	BondStatus_BOND_STATUS_BONDED BondStatus = 3
-)
+func (x *Params) GetSymbioticMaxStaleSyncs() uint32 {
+	if x != nil {
+		return x.SymbioticMaxStaleSyncs
+	}
+	return 0
+}
 
-// Enum value maps for BondStatus.
-var (
-	BondStatus_name = map[int32]string{
-		0: "BOND_STATUS_UNSPECIFIED",
-		1: "BOND_STATUS_UNBONDED",
-		2: "BOND_STATUS_UNBONDING",
-		3: "BOND_STATUS_BONDED",
+func (x *Params) GetSymbioticStalePolicy() string {
+	if x != nil {
+		return x.SymbioticStalePolicy
 	}
-	BondStatus_value = map[string]int32{
-		"BOND_STATUS_UNSPECIFIED": 0,
-		"BOND_STATUS_UNBONDED":    1,
-		"BOND_STATUS_UNBONDING":   2,
-		"BOND_STATUS_BONDED":      3,
+	return ""
+}
+
+func (x *Params) GetSymbioticStaleDecay() string {
+	if x != nil {
+		return x.SymbioticStaleDecay
 	}
-)
+	return ""
+}
 
-func (x BondStatus) Enum() *BondStatus {
-	p := new(BondStatus)
-	*p = x
-	return p
+func (x *Params) GetSymbioticHaltMsgTypeUrls() []string {
+	if x != nil {
+		return x.SymbioticHaltMsgTypeUrls
+	}
+	return nil
 }
 
-func (x BondStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *Params) GetSymbioticEpochIdentifier() string {
+	if x != nil {
+		return x.SymbioticEpochIdentifier
+	}
+	return ""
 }
 
-func (BondStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[0].Descriptor()
+// SymbioticMiddleware is a Symbiotic middleware contract the stake of the
+// validators is read from.
+type SymbioticMiddleware struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the hex address of the middleware contract on Ethereum.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the factor applied to the stake of the validators in the middleware.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// cap is the maximum weighted stake of a validator in the middleware, or zero
+	// if the stake isn't capped.
+	Cap string `protobuf:"bytes,3,opt,name=cap,proto3" json:"cap,omitempty"`
 }
 
-func (BondStatus) Type() protoreflect.EnumType {
-	return &file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[0]
+func (x *SymbioticMiddleware) Reset() {
+	*x = SymbioticMiddleware{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x BondStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *SymbioticMiddleware) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Deprecated: Use BondStatus.Descriptor instead.
-func (BondStatus) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{0}
+func (*SymbioticMiddleware) ProtoMessage() {}
+
+// Deprecated: Use SymbioticMiddleware.ProtoReflect.Descriptor instead.
+func (*SymbioticMiddleware) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{8}
 }
 
-// Infraction indicates the infraction a validator committed.
-type Infraction int32
+func (x *SymbioticMiddleware) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
 
-const (
-	// UNSPECIFIED defines an empty infraction.
-	Infraction_INFRACTION_UNSPECIFIED Infraction = 0
-	// DOUBLE_SIGN defines a validator that double-signs a block.
-	Infraction_INFRACTION_DOUBLE_SIGN Infraction = 1
-	// DOWNTIME defines a validator that missed signing too many blocks.
-	Infraction_INFRACTION_DOWNTIME Infraction = 2
-)
+func (x *SymbioticMiddleware) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
 
-// Enum value maps for Infraction.
-var (
-	Infraction_name = map[int32]string{
-		0: "INFRACTION_UNSPECIFIED",
-		1: "INFRACTION_DOUBLE_SIGN",
-		2: "INFRACTION_DOWNTIME",
+func (x *SymbioticMiddleware) GetCap() string {
+	if x != nil {
+		return x.Cap
 	}
-	Infraction_value = map[string]int32{
-		"INFRACTION_UNSPECIFIED": 0,
-		"INFRACTION_DOUBLE_SIGN": 1,
-		"INFRACTION_DOWNTIME":    2,
+	return ""
+}
+
+// SymbioticMiddlewareStake is the stake of a validator in a Symbiotic middleware.
+type SymbioticMiddlewareStake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// middleware_address is the hex address of the middleware contract.
+	MiddlewareAddress string `protobuf:"bytes,1,opt,name=middleware_address,json=middlewareAddress,proto3" json:"middleware_address,omitempty"`
+	// stake is the stake of the validator in the middleware.
+	Stake string `protobuf:"bytes,2,opt,name=stake,proto3" json:"stake,omitempty"`
+	// weighted_stake is the stake after the weight and cap of the middleware,
+	// added to the tokens of the validator.
+	WeightedStake string `protobuf:"bytes,3,opt,name=weighted_stake,json=weightedStake,proto3" json:"weighted_stake,omitempty"`
+	// epoch is the epoch of the middleware validator set the stake was synced
+	// from.
+	Epoch uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *SymbioticMiddlewareStake) Reset() {
+	*x = SymbioticMiddlewareStake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-)
+}
 
-func (x Infraction) Enum() *Infraction {
-	p := new(Infraction)
-	*p = x
-	return p
+func (x *SymbioticMiddlewareStake) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x Infraction) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (*SymbioticMiddlewareStake) ProtoMessage() {}
+
+// Deprecated: Use SymbioticMiddlewareStake.ProtoReflect.Descriptor instead.
+func (*SymbioticMiddlewareStake) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{9}
 }
 
-func (Infraction) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[1].Descriptor()
+func (x *SymbioticMiddlewareStake) GetMiddlewareAddress() string {
+	if x != nil {
+		return x.MiddlewareAddress
+	}
+	return ""
+}
+
+func (x *SymbioticMiddlewareStake) GetStake() string {
+	if x != nil {
+		return x.Stake
+	}
+	return ""
+}
+
+func (x *SymbioticMiddlewareStake) GetWeightedStake() string {
+	if x != nil {
+		return x.WeightedStake
+	}
+	return ""
+}
+
+func (x *SymbioticMiddlewareStake) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+// SymbioticStakes holds the stake of a validator in every middleware listing it
+// at the last sync.
+type SymbioticStakes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stakes []*SymbioticMiddlewareStake `protobuf:"bytes,1,rep,name=stakes,proto3" json:"stakes,omitempty"`
+}
+
+func (x *SymbioticStakes) Reset() {
+	*x = SymbioticStakes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymbioticStakes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbioticStakes) ProtoMessage() {}
+
+// Deprecated: Use SymbioticStakes.ProtoReflect.Descriptor instead.
+func (*SymbioticStakes) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SymbioticStakes) GetStakes() []*SymbioticMiddlewareStake {
+	if x != nil {
+		return x.Stakes
+	}
+	return nil
+}
+
+// InjectedSymbioticData is the data injected by the proposer as the first tx of a
+// block at a Symbiotic sync height. It identifies the Ethereum block the validator
+// set update is derived from.
+type InjectedSymbioticData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version is the version of the injected data encoding.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// block_hash is the hash of the finalized execution block, or "invalid" if the
+	// sync is skipped.
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_number is the number of the execution block.
+	BlockNumber uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the unix timestamp of the execution block.
+	BlockTimestamp uint64 `protobuf:"varint,4,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// slot is the beacon chain slot the execution block was included in.
+	Slot int64 `protobuf:"varint,5,opt,name=slot,proto3" json:"slot,omitempty"`
+	// validator_set_digest is the sha256 digest of the validator set at the
+	// execution block.
+	ValidatorSetDigest []byte `protobuf:"bytes,6,opt,name=validator_set_digest,json=validatorSetDigest,proto3" json:"validator_set_digest,omitempty"`
+	// extended_commit_info is the encoded ExtendedCommitInfo holding the vote
+	// extensions the proposer derived the data from.
+	ExtendedCommitInfo []byte `protobuf:"bytes,7,opt,name=extended_commit_info,json=extendedCommitInfo,proto3" json:"extended_commit_info,omitempty"`
+	// validator_set is the validator set at the execution block, i.e. the
+	// getValidatorSet entries of every middleware along with the operator of their
+	// key, fetched by the proposer and checked against validator_set_digest.
+	ValidatorSet []byte `protobuf:"bytes,8,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+	// epoch is the epoch of the validator set of the first middleware.
+	Epoch uint64 `protobuf:"varint,9,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// skip_reason is the reason the block hash is "invalid", if so, or
+	// "epoch_not_advanced" if the block carries no validator set.
+	SkipReason string `protobuf:"bytes,10,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+}
+
+func (x *InjectedSymbioticData) Reset() {
+	*x = InjectedSymbioticData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InjectedSymbioticData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectedSymbioticData) ProtoMessage() {}
+
+// Deprecated: Use InjectedSymbioticData.ProtoReflect.Descriptor instead.
+func (*InjectedSymbioticData) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InjectedSymbioticData) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *InjectedSymbioticData) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *InjectedSymbioticData) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *InjectedSymbioticData) GetBlockTimestamp() uint64 {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return 0
+}
+
+func (x *InjectedSymbioticData) GetSlot() int64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *InjectedSymbioticData) GetValidatorSetDigest() []byte {
+	if x != nil {
+		return x.ValidatorSetDigest
+	}
+	return nil
+}
+
+func (x *InjectedSymbioticData) GetExtendedCommitInfo() []byte {
+	if x != nil {
+		return x.ExtendedCommitInfo
+	}
+	return nil
 }
 
-func (Infraction) Type() protoreflect.EnumType {
-	return &file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[1]
+func (x *InjectedSymbioticData) GetValidatorSet() []byte {
+	if x != nil {
+		return x.ValidatorSet
+	}
+	return nil
 }
 
-func (x Infraction) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *InjectedSymbioticData) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
 }
 
-// Deprecated: Use Infraction.Descriptor instead.
-func (Infraction) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{1}
+func (x *InjectedSymbioticData) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
 }
 
-// HistoricalInfo contains header and validator information for a given block.
-// It is stored as part of staking module's state, which persists the `n` most
-// recent HistoricalInfo
-// (`n` is set by the staking module's `historical_entries` parameter).
-//
-// Deprecated: Do not use.
-type HistoricalInfo struct {
+// SymbioticVoteExtension is the vote extension of a validator at the height
+// before a Symbiotic sync height. It holds the finalized execution block the
+// validator observed locally, if any, and its signature of the last Symbiotic
+// checkpoint.
+type SymbioticVoteExtension struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *v1.Header   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Valset []*Validator `protobuf:"bytes,2,rep,name=valset,proto3" json:"valset,omitempty"`
-}
-
-func (x *HistoricalInfo) Reset() {
-	*x = HistoricalInfo{}
+	// block_hash is the hash of the finalized execution block.
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_number is the number of the execution block.
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the unix timestamp of the execution block.
+	BlockTimestamp uint64 `protobuf:"varint,3,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// slot is the beacon chain slot the execution block was included in.
+	Slot int64 `protobuf:"varint,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	// validator_set_digest is the sha256 digest of the validator set at the
+	// execution block, empty if its epoch didn't advance past the last synced one.
+	ValidatorSetDigest []byte `protobuf:"bytes,5,opt,name=validator_set_digest,json=validatorSetDigest,proto3" json:"validator_set_digest,omitempty"`
+	// epoch is the epoch of the validator set of the first middleware.
+	Epoch uint64 `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// checkpoint_epoch is the epoch of the checkpoint signed, if any.
+	CheckpointEpoch uint64 `protobuf:"varint,7,opt,name=checkpoint_epoch,json=checkpointEpoch,proto3" json:"checkpoint_epoch,omitempty"`
+	// checkpoint_signature is the signature of the checkpoint digest by the
+	// Symbiotic operator key of the validator, if any.
+	CheckpointSignature []byte `protobuf:"bytes,8,opt,name=checkpoint_signature,json=checkpointSignature,proto3" json:"checkpoint_signature,omitempty"`
+}
+
+func (x *SymbioticVoteExtension) Reset() {
+	*x = SymbioticVoteExtension{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[0]
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HistoricalInfo) String() string {
+func (x *SymbioticVoteExtension) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistoricalInfo) ProtoMessage() {}
+func (*SymbioticVoteExtension) ProtoMessage() {}
 
-// Deprecated: Use HistoricalInfo.ProtoReflect.Descriptor instead.
-func (*HistoricalInfo) Descriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use SymbioticVoteExtension.ProtoReflect.Descriptor instead.
+func (*SymbioticVoteExtension) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *HistoricalInfo) GetHeader() *v1.Header {
+func (x *SymbioticVoteExtension) GetBlockHash() string {
 	if x != nil {
-		return x.Header
+		return x.BlockHash
 	}
-	return nil
+	return ""
 }
 
-func (x *HistoricalInfo) GetValset() []*Validator {
+func (x *SymbioticVoteExtension) GetBlockNumber() uint64 {
 	if x != nil {
-		return x.Valset
+		return x.BlockNumber
 	}
-	return nil
-}
-
-// HistoricalRecord contains a set of minimum values needed for evaluating historical validator sets and blocks.
-// It is stored as part of staking module's state, which persists the `n` most
-// recent HistoricalInfo
-// (`n` is set by the staking module's `historical_entries` parameter).
-type HistoricalRecord struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Apphash        []byte                 `protobuf:"bytes,1,opt,name=apphash,proto3" json:"apphash,omitempty"`
-	Time           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
-	ValidatorsHash []byte                 `protobuf:"bytes,3,opt,name=validators_hash,json=validatorsHash,proto3" json:"validators_hash,omitempty"`
+	return 0
 }
 
-func (x *HistoricalRecord) Reset() {
-	*x = HistoricalRecord{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SymbioticVoteExtension) GetBlockTimestamp() uint64 {
+	if x != nil {
+		return x.BlockTimestamp
 	}
+	return 0
 }
 
-func (x *HistoricalRecord) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SymbioticVoteExtension) GetSlot() int64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
 }
 
-func (*HistoricalRecord) ProtoMessage() {}
-
-// Deprecated: Use HistoricalRecord.ProtoReflect.Descriptor instead.
-func (*HistoricalRecord) Descriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{1}
+func (x *SymbioticVoteExtension) GetValidatorSetDigest() []byte {
+	if x != nil {
+		return x.ValidatorSetDigest
+	}
+	return nil
 }
 
-func (x *HistoricalRecord) GetApphash() []byte {
+func (x *SymbioticVoteExtension) GetEpoch() uint64 {
 	if x != nil {
-		return x.Apphash
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (x *HistoricalRecord) GetTime() *timestamppb.Timestamp {
+func (x *SymbioticVoteExtension) GetCheckpointEpoch() uint64 {
 	if x != nil {
-		return x.Time
+		return x.CheckpointEpoch
 	}
-	return nil
+	return 0
 }
 
-func (x *HistoricalRecord) GetValidatorsHash() []byte {
+func (x *SymbioticVoteExtension) GetCheckpointSignature() []byte {
 	if x != nil {
-		return x.ValidatorsHash
+		return x.CheckpointSignature
 	}
 	return nil
 }
 
-// CommissionRates defines the initial commission rates to be used for creating
-// a validator.
-type CommissionRates struct {
+// SymbioticSyncPoint records the outcome of a Symbiotic sync height.
+type SymbioticSyncPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// rate is the commission rate charged to delegators, as a fraction.
-	Rate string `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
-	// max_rate defines the maximum commission rate which validator can ever charge, as a fraction.
-	MaxRate string `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3" json:"max_rate,omitempty"`
-	// max_change_rate defines the maximum daily increase of the validator commission, as a fraction.
-	MaxChangeRate string `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3" json:"max_change_rate,omitempty"`
-}
-
-func (x *CommissionRates) Reset() {
-	*x = CommissionRates{}
+	// height is the sync height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// block_hash is the hash of the execution block the validator set was synced
+	// with, empty if the sync was skipped.
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_number is the number of the execution block.
+	BlockNumber uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the unix timestamp of the execution block.
+	BlockTimestamp uint64 `protobuf:"varint,4,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// epoch is the epoch of the validator set of the first middleware.
+	Epoch uint64 `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// validators_updated is the number of validators whose tokens were updated.
+	ValidatorsUpdated uint32 `protobuf:"varint,6,opt,name=validators_updated,json=validatorsUpdated,proto3" json:"validators_updated,omitempty"`
+	// skip_reason is the reason the sync was skipped, empty if it was applied.
+	SkipReason string `protobuf:"bytes,7,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+	// validators_removed is the number of validators missing from the validator
+	// set whose tokens were zeroed.
+	ValidatorsRemoved uint32 `protobuf:"varint,8,opt,name=validators_removed,json=validatorsRemoved,proto3" json:"validators_removed,omitempty"`
+	// validators_rotated is the number of validators whose consensus pubkey was
+	// rotated following an operator key update.
+	ValidatorsRotated uint32 `protobuf:"varint,9,opt,name=validators_rotated,json=validatorsRotated,proto3" json:"validators_rotated,omitempty"`
+}
+
+func (x *SymbioticSyncPoint) Reset() {
+	*x = SymbioticSyncPoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[2]
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommissionRates) String() string {
+func (x *SymbioticSyncPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommissionRates) ProtoMessage() {}
+func (*SymbioticSyncPoint) ProtoMessage() {}
 
-// Deprecated: Use CommissionRates.ProtoReflect.Descriptor instead.
-func (*CommissionRates) Descriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SymbioticSyncPoint.ProtoReflect.Descriptor instead.
+func (*SymbioticSyncPoint) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CommissionRates) GetRate() string {
+func (x *SymbioticSyncPoint) GetHeight() int64 {
 	if x != nil {
-		return x.Rate
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *CommissionRates) GetMaxRate() string {
+func (x *SymbioticSyncPoint) GetBlockHash() string {
 	if x != nil {
-		return x.MaxRate
+		return x.BlockHash
 	}
 	return ""
 }
 
-func (x *CommissionRates) GetMaxChangeRate() string {
+func (x *SymbioticSyncPoint) GetBlockNumber() uint64 {
 	if x != nil {
-		return x.MaxChangeRate
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *SymbioticSyncPoint) GetBlockTimestamp() uint64 {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return 0
+}
+
+func (x *SymbioticSyncPoint) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SymbioticSyncPoint) GetValidatorsUpdated() uint32 {
+	if x != nil {
+		return x.ValidatorsUpdated
+	}
+	return 0
+}
+
+func (x *SymbioticSyncPoint) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
 	}
 	return ""
 }
 
-// Commission defines commission parameters for a given validator.
-type Commission struct {
+func (x *SymbioticSyncPoint) GetValidatorsRemoved() uint32 {
+	if x != nil {
+		return x.ValidatorsRemoved
+	}
+	return 0
+}
+
+func (x *SymbioticSyncPoint) GetValidatorsRotated() uint32 {
+	if x != nil {
+		return x.ValidatorsRotated
+	}
+	return 0
+}
+
+// SymbioticStaleness tracks the sync heights skipped since the last update of
+// the validator set.
+type SymbioticStaleness struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// commission_rates defines the initial commission rates to be used for creating a validator.
-	CommissionRates *CommissionRates `protobuf:"bytes,1,opt,name=commission_rates,json=commissionRates,proto3" json:"commission_rates,omitempty"`
-	// update_time is the last time the commission rate was changed.
-	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// since_height is the first sync height skipped since the last update.
+	SinceHeight int64 `protobuf:"varint,1,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
+	// skipped_syncs is the number of sync heights skipped since the last update.
+	SkippedSyncs uint32 `protobuf:"varint,2,opt,name=skipped_syncs,json=skippedSyncs,proto3" json:"skipped_syncs,omitempty"`
 }
 
-func (x *Commission) Reset() {
-	*x = Commission{}
+func (x *SymbioticStaleness) Reset() {
+	*x = SymbioticStaleness{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[3]
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Commission) String() string {
+func (x *SymbioticStaleness) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Commission) ProtoMessage() {}
+func (*SymbioticStaleness) ProtoMessage() {}
 
-// Deprecated: Use Commission.ProtoReflect.Descriptor instead.
-func (*Commission) Descriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SymbioticStaleness.ProtoReflect.Descriptor instead.
+func (*SymbioticStaleness) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Commission) GetCommissionRates() *CommissionRates {
+func (x *SymbioticStaleness) GetSinceHeight() int64 {
 	if x != nil {
-		return x.CommissionRates
+		return x.SinceHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *Commission) GetUpdateTime() *timestamppb.Timestamp {
+func (x *SymbioticStaleness) GetSkippedSyncs() uint32 {
 	if x != nil {
-		return x.UpdateTime
+		return x.SkippedSyncs
 	}
-	return nil
+	return 0
 }
 
-// Description defines a validator description.
-type Description struct {
+// SymbioticValidatorTokens are the tokens of a validator in a validator set
+// update submitted by governance.
+type SymbioticValidatorTokens struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// moniker defines a human-readable name for the validator.
-	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	// identity defines an optional identity signature (ex. UPort or Keybase).
-	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
-	// website defines an optional website link.
-	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
-	// security_contact defines an optional email for security contact.
-	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
-	// details define other optional details.
-	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// tokens are the tokens of the validator.
+	Tokens string `protobuf:"bytes,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
 }
 
-func (x *Description) Reset() {
-	*x = Description{}
+func (x *SymbioticValidatorTokens) Reset() {
+	*x = SymbioticValidatorTokens{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[4]
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Description) String() string {
+func (x *SymbioticValidatorTokens) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Description) ProtoMessage() {}
+func (*SymbioticValidatorTokens) ProtoMessage() {}
 
-// Deprecated: Use Description.ProtoReflect.Descriptor instead.
-func (*Description) Descriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SymbioticValidatorTokens.ProtoReflect.Descriptor instead.
+func (*SymbioticValidatorTokens) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Description) GetMoniker() string {
+func (x *SymbioticValidatorTokens) GetValidatorAddress() string {
 	if x != nil {
-		return x.Moniker
+		return x.ValidatorAddress
 	}
 	return ""
 }
 
-func (x *Description) GetIdentity() string {
+func (x *SymbioticValidatorTokens) GetTokens() string {
 	if x != nil {
-		return x.Identity
+		return x.Tokens
 	}
 	return ""
 }
 
-func (x *Description) GetWebsite() string {
-	if x != nil {
-		return x.Website
+// ConsPubKeyRotation records the rotation of the consensus pubkey of a validator
+// following an operator key update in the Symbiotic middleware.
+type ConsPubKeyRotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// operator_address is the address of the validator's operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus pubkey replaced.
+	OldConsPubkey *anypb.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the new consensus pubkey.
+	NewConsPubkey *anypb.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the height the rotation occurred at.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *ConsPubKeyRotation) Reset() {
+	*x = ConsPubKeyRotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Description) GetSecurityContact() string {
+func (x *ConsPubKeyRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsPubKeyRotation) ProtoMessage() {}
+
+// Deprecated: Use ConsPubKeyRotation.ProtoReflect.Descriptor instead.
+func (*ConsPubKeyRotation) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConsPubKeyRotation) GetOperatorAddress() string {
 	if x != nil {
-		return x.SecurityContact
+		return x.OperatorAddress
 	}
 	return ""
 }
 
-func (x *Description) GetDetails() string {
+func (x *ConsPubKeyRotation) GetOldConsPubkey() *anypb.Any {
 	if x != nil {
-		return x.Details
+		return x.OldConsPubkey
 	}
-	return ""
+	return nil
 }
 
-// Validator defines a validator, together with the total amount of the
-// Validator's bond shares and their exchange rate to coins. Slashing results in
-// a decrease in the exchange rate, allowing correct calculation of future
-// undelegations without iterating over delegators. When coins are delegated to
-// this validator, the validator is credited with a delegation whose number of
-// bond shares is based on the amount of coins delegated divided by the current
-// exchange rate. Voting power can be calculated as total bonded shares
-// multiplied by exchange rate.
-type Validator struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// operator_address defines the address of the validator's operator; bech encoded in JSON.
-	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
-	// consensus_pubkey is the consensus public key of the validator, as a Protobuf Any.
-	ConsensusPubkey *anypb.Any `protobuf:"bytes,2,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
-	// jailed defined whether the validator has been jailed from bonded status or not.
-	Jailed bool `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
-	// status is the validator status (bonded/unbonding/unbonded).
-	Status BondStatus `protobuf:"varint,4,opt,name=status,proto3,enum=cosmos.symStaking.v1beta1.BondStatus" json:"status,omitempty"`
-	// tokens define the delegated tokens (incl. self-delegation).
-	Tokens string `protobuf:"bytes,5,opt,name=tokens,proto3" json:"tokens,omitempty"`
-	// description defines the description terms for the validator.
-	Description *Description `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	// unbonding_height defines, if unbonding, the height at which this validator has begun unbonding.
-	UnbondingHeight int64 `protobuf:"varint,7,opt,name=unbonding_height,json=unbondingHeight,proto3" json:"unbonding_height,omitempty"`
-	// unbonding_time defines, if unbonding, the min time for the validator to complete unbonding.
-	UnbondingTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
-	// commission defines the commission parameters.
-	Commission *Commission `protobuf:"bytes,9,opt,name=commission,proto3" json:"commission,omitempty"`
-	// list of unbonding ids, each uniquely identifying an unbonding of this validator
-	UnbondingIds []uint64 `protobuf:"varint,10,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
+func (x *ConsPubKeyRotation) GetNewConsPubkey() *anypb.Any {
+	if x != nil {
+		return x.NewConsPubkey
+	}
+	return nil
+}
+
+func (x *ConsPubKeyRotation) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
 }
 
-func (x *Validator) Reset() {
-	*x = Validator{}
+// SymbioticCheckpoint is a checkpoint of the validator set at a Symbiotic
+// middleware epoch, co-signed by the Symbiotic operators of the validators
+// through vote extensions for submission to the middleware.
+type SymbioticCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch is the epoch of the validator set of the first middleware.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// height is the height the checkpoint was created at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// validator_set_hash is the keccak256 hash of the ABI encoded operators and
+	// powers of the validators.
+	ValidatorSetHash []byte `protobuf:"bytes,3,opt,name=validator_set_hash,json=validatorSetHash,proto3" json:"validator_set_hash,omitempty"`
+	// app_hash is the app hash of the block the checkpoint was created at.
+	AppHash []byte `protobuf:"bytes,4,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	// validators are the bonded validators with a Symbiotic operator, ordered by
+	// power.
+	Validators []*SymbioticCheckpointValidator `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators,omitempty"`
+	// total_power is the sum of the powers of the validators.
+	TotalPower int64 `protobuf:"varint,6,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// signatures are the signatures of the checkpoint digest collected so far.
+	Signatures []*SymbioticCheckpointSignature `protobuf:"bytes,7,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// signed_power is the sum of the powers of the validators that signed.
+	SignedPower int64 `protobuf:"varint,8,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+}
+
+func (x *SymbioticCheckpoint) Reset() {
+	*x = SymbioticCheckpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[5]
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Validator) String() string {
+func (x *SymbioticCheckpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Validator) ProtoMessage() {}
-
-// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
-func (*Validator) Descriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{5}
-}
+func (*SymbioticCheckpoint) ProtoMessage() {}
 
-func (x *Validator) GetOperatorAddress() string {
-	if x != nil {
-		return x.OperatorAddress
-	}
-	return ""
+// Deprecated: Use SymbioticCheckpoint.ProtoReflect.Descriptor instead.
+func (*SymbioticCheckpoint) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Validator) GetConsensusPubkey() *anypb.Any {
+func (x *SymbioticCheckpoint) GetEpoch() uint64 {
 	if x != nil {
-		return x.ConsensusPubkey
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (x *Validator) GetJailed() bool {
+func (x *SymbioticCheckpoint) GetHeight() int64 {
 	if x != nil {
-		return x.Jailed
+		return x.Height
 	}
-	return false
+	return 0
 }
 
-func (x *Validator) GetStatus() BondStatus {
+func (x *SymbioticCheckpoint) GetValidatorSetHash() []byte {
 	if x != nil {
-		return x.Status
+		return x.ValidatorSetHash
 	}
-	return BondStatus_BOND_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *Validator) GetTokens() string {
+func (x *SymbioticCheckpoint) GetAppHash() []byte {
 	if x != nil {
-		return x.Tokens
+		return x.AppHash
 	}
-	return ""
+	return nil
 }
 
-func (x *Validator) GetDescription() *Description {
+func (x *SymbioticCheckpoint) GetValidators() []*SymbioticCheckpointValidator {
 	if x != nil {
-		return x.Description
+		return x.Validators
 	}
 	return nil
 }
 
-func (x *Validator) GetUnbondingHeight() int64 {
+func (x *SymbioticCheckpoint) GetTotalPower() int64 {
 	if x != nil {
-		return x.UnbondingHeight
+		return x.TotalPower
 	}
 	return 0
 }
 
-func (x *Validator) GetUnbondingTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UnbondingTime
-	}
-	return nil
-}
-
-func (x *Validator) GetCommission() *Commission {
+func (x *SymbioticCheckpoint) GetSignatures() []*SymbioticCheckpointSignature {
 	if x != nil {
-		return x.Commission
+		return x.Signatures
 	}
 	return nil
 }
 
-func (x *Validator) GetUnbondingIds() []uint64 {
+func (x *SymbioticCheckpoint) GetSignedPower() int64 {
 	if x != nil {
-		return x.UnbondingIds
+		return x.SignedPower
 	}
-	return nil
+	return 0
 }
 
-// ValAddresses defines a repeated set of validator addresses.
-type ValAddresses struct {
+// SymbioticCheckpointValidator is a validator of a Symbiotic checkpoint.
+type SymbioticCheckpointValidator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// operator is the Symbiotic operator of the validator.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// power is the consensus power of the validator.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (x *ValAddresses) Reset() {
-	*x = ValAddresses{}
+func (x *SymbioticCheckpointValidator) Reset() {
+	*x = SymbioticCheckpointValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[6]
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValAddresses) String() string {
+func (x *SymbioticCheckpointValidator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValAddresses) ProtoMessage() {}
+func (*SymbioticCheckpointValidator) ProtoMessage() {}
 
-// Deprecated: Use ValAddresses.ProtoReflect.Descriptor instead.
-func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SymbioticCheckpointValidator.ProtoReflect.Descriptor instead.
+func (*SymbioticCheckpointValidator) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ValAddresses) GetAddresses() []string {
+func (x *SymbioticCheckpointValidator) GetOperator() string {
 	if x != nil {
-		return x.Addresses
+		return x.Operator
 	}
-	return nil
+	return ""
 }
 
-// Params defines the parameters for the x/symStaking module.
-type Params struct {
+func (x *SymbioticCheckpointValidator) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+// SymbioticCheckpointSignature is the signature of a Symbiotic checkpoint by
+// the operator of a validator.
+type SymbioticCheckpointSignature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// unbonding_time is the time duration of unbonding.
-	UnbondingTime *durationpb.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
-	// max_validators is the maximum number of validators.
-	MaxValidators uint32 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
-	// max_entries is the max entries for either unbonding delegation or redelegation (per pair/trio).
-	MaxEntries uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
-	// historical_entries is the number of historical entries to persist.
-	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
-	// bond_denom defines the bondable coin denomination.
-	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
-	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
-	MinCommissionRate string `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3" json:"min_commission_rate,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// operator is the Symbiotic operator of the validator.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// signature is the 65 bytes [R || S || V] secp256k1 signature of the
+	// checkpoint digest, with V 0 or 1.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *SymbioticCheckpointSignature) Reset() {
+	*x = SymbioticCheckpointSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[7]
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *SymbioticCheckpointSignature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
-
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *Params) GetUnbondingTime() *durationpb.Duration {
-	if x != nil {
-		return x.UnbondingTime
-	}
-	return nil
-}
-
-func (x *Params) GetMaxValidators() uint32 {
-	if x != nil {
-		return x.MaxValidators
-	}
-	return 0
-}
+func (*SymbioticCheckpointSignature) ProtoMessage() {}
 
-func (x *Params) GetMaxEntries() uint32 {
-	if x != nil {
-		return x.MaxEntries
-	}
-	return 0
+// Deprecated: Use SymbioticCheckpointSignature.ProtoReflect.Descriptor instead.
+func (*SymbioticCheckpointSignature) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Params) GetHistoricalEntries() uint32 {
+func (x *SymbioticCheckpointSignature) GetValidatorAddress() string {
 	if x != nil {
-		return x.HistoricalEntries
+		return x.ValidatorAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *Params) GetBondDenom() string {
+func (x *SymbioticCheckpointSignature) GetOperator() string {
 	if x != nil {
-		return x.BondDenom
+		return x.Operator
 	}
 	return ""
 }
 
-func (x *Params) GetMinCommissionRate() string {
+func (x *SymbioticCheckpointSignature) GetSignature() []byte {
 	if x != nil {
-		return x.MinCommissionRate
+		return x.Signature
 	}
-	return ""
+	return nil
 }
 
 // ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
@@ -6370,7 +16490,7 @@ type ValidatorUpdates struct {
 func (x *ValidatorUpdates) Reset() {
 	*x = ValidatorUpdates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[8]
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6384,7 +16504,7 @@ func (*ValidatorUpdates) ProtoMessage() {}
 
 // Deprecated: Use ValidatorUpdates.ProtoReflect.Descriptor instead.
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ValidatorUpdates) GetUpdates() []*v11.ValidatorUpdate {
@@ -6427,7 +16547,7 @@ var file_cosmos_symStaking_v1beta1_staking_proto_rawDesc = []byte{
 	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56,
 	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
 	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x73, 0x65, 0x74, 0x3a, 0x02, 0x18, 0x01, 0x22,
-	0x8b, 0x01, 0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
+	0xb4, 0x01, 0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
 	0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x68, 0x61, 0x73, 0x68, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x70, 0x70, 0x68, 0x61, 0x73, 0x68, 0x12, 0x34,
 	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
@@ -6435,160 +16555,423 @@ var file_cosmos_symStaking_v1beta1_staking_proto_rawDesc = []byte{
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04,
 	0x74, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
 	0x72, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x48, 0x61, 0x73, 0x68, 0x22, 0x96, 0x02,
-	0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65,
-	0x73, 0x12, 0x4a, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a,
-	0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65,
-	0x12, 0x5e, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
-	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc4, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x64, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b,
-	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x42, 0x0d, 0xc8, 0xde, 0x1f,
-	0x00, 0xd0, 0xde, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde,
-	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa8, 0x01,
-	0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x29, 0x0a,
-	0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9a, 0x05, 0x0a, 0x09, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x59, 0x0a, 0x10, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x18, 0xca, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3d,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69,
-	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a,
-	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x12, 0x53, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x50, 0x0a, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x63, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x96, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0xc4, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x64,
+	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42,
-	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x75,
-	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f,
-	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x46, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x9f, 0x03,
-	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x75, 0x6e, 0x62, 0x6f,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f,
-	0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x75, 0x6e, 0x62, 0x6f,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
-	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x68,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6e, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
-	0x84, 0x01, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x54, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
-	0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x5f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22,
-	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x3a, 0x27, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x73, 0x79,
-	0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0x5e, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e,
-	0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x02, 0x18, 0x01, 0x2a,
-	0xb6, 0x01, 0x0a, 0x0a, 0x42, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c,
-	0x0a, 0x17, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x0f, 0x8a, 0x9d, 0x20,
-	0x0b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x14,
-	0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f,
-	0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x0c, 0x8a, 0x9d, 0x20, 0x08, 0x55, 0x6e, 0x62, 0x6f,
-	0x6e, 0x64, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x15, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a,
-	0x0d, 0x8a, 0x9d, 0x20, 0x09, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22,
-	0x0a, 0x12, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f,
-	0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x0a, 0x8a, 0x9d, 0x20, 0x06, 0x42, 0x6f, 0x6e, 0x64,
-	0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x5d, 0x0a, 0x0a, 0x49, 0x6e, 0x66, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x01, 0x12, 0x17,
-	0x0a, 0x13, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57,
-	0x4e, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x42, 0xf1, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0xd0, 0xde, 0x1f, 0x01, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa8, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0xc9, 0x05, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x43, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x59, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x42, 0x18, 0xca, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x50, 0x0a, 0x0e, 0x75,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d,
+	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x63, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x46, 0x0a,
+	0x0c, 0x56, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x36, 0x0a,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xa4, 0x0d, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x4f, 0x0a, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0d, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d,
+	0x61, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61,
+	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6e, 0x64,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f,
+	0x6e, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x54, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x6d, 0x69, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40,
+	0x0a, 0x1c, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x5f, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x38, 0x0a, 0x18, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x16, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x4d, 0x0a, 0x0d, 0x73, 0x6c,
+	0x6f, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde,
+	0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x73, 0x6c, 0x6f,
+	0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x79, 0x6d,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f,
+	0x74, 0x69, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x6c, 0x6f, 0x74,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x47, 0x0a, 0x20, 0x73, 0x79, 0x6d, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x1d, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x41, 0x75, 0x74, 0x6f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x79,
+	0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x6e,
+	0x0a, 0x15, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x5f, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x69,
-	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x19,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x5c, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53,
-	0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69,
-	0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f,
+	0x74, 0x69, 0x63, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f,
+	0x74, 0x69, 0x63, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x73, 0x12, 0x71,
+	0x0a, 0x19, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x73, 0x79, 0x6d, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x63, 0x4d, 0x61, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x73, 0x0a, 0x1a, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x73,
+	0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x4d, 0x61, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f,
+	0x74, 0x69, 0x63, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63,
+	0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x73, 0x79, 0x6d, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f,
+	0x73, 0x79, 0x6e, 0x63, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x73, 0x79, 0x6d,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x4d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x53, 0x79,
+	0x6e, 0x63, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63,
+	0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x53, 0x74,
+	0x61, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x6a, 0x0a, 0x15, 0x73, 0x79, 0x6d,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x63,
+	0x61, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x13, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x53, 0x74, 0x61, 0x6c, 0x65,
+	0x44, 0x65, 0x63, 0x61, 0x79, 0x12, 0x3e, 0x0a, 0x1c, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x63, 0x5f, 0x68, 0x61, 0x6c, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x73, 0x79, 0x6d,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x48, 0x61, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x63, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x73, 0x79, 0x6d, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x63, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x3a, 0x27, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x73, 0x79, 0x6d, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xc9, 0x01, 0x0a,
+	0x13, 0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4e,
+	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x42,
+	0x0a, 0x03, 0x63, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03, 0x63,
+	0x61, 0x70, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xf6, 0x01, 0x0a, 0x18, 0x53, 0x79, 0x6d,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x52, 0x0a, 0x0e, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0d, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x22, 0x69, 0x0a, 0x0f, 0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x79,
+	0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x22, 0xf0, 0x02, 0x0a,
+	0x15, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x30, 0x0a, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74,
+	0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x12, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0xbd, 0x02, 0x0a, 0x16, 0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x56, 0x6f, 0x74,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x31, 0x0a, 0x14,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0xdb, 0x02, 0x0a, 0x12, 0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x53, 0x79, 0x6e,
+	0x63, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x2d, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x2d, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x2d,
+	0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5c, 0x0a,
+	0x12, 0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x18,
+	0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x22, 0xab, 0x02, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x10, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56,
+	0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x18, 0xca,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x73,
+	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x56, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f,
+	0x6e, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x18, 0xca, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52,
+	0x0d, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
+	0x22, 0x98, 0x03, 0x0a, 0x13, 0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x70, 0x70, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x62, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x79, 0x6d,
+	0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x1c, 0x53,
+	0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xa8, 0x01,
+	0x0a, 0x1c, 0x53, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x4e,
+	0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x3a, 0x02, 0x18, 0x01, 0x2a, 0xb6, 0x01, 0x0a, 0x0a, 0x42, 0x6f, 0x6e,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x17, 0x42, 0x4f, 0x4e, 0x44, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x1a, 0x0f, 0x8a, 0x9d, 0x20, 0x0b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x14, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x1a,
+	0x0c, 0x8a, 0x9d, 0x20, 0x08, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x28, 0x0a,
+	0x15, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42,
+	0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a, 0x0d, 0x8a, 0x9d, 0x20, 0x09, 0x55, 0x6e,
+	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x12, 0x42, 0x4f, 0x4e, 0x44, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x1a,
+	0x0a, 0x8a, 0x9d, 0x20, 0x06, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
+	0x00, 0x2a, 0x5d, 0x0a, 0x0a, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49,
+	0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45,
+	0x5f, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x46, 0x52, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02,
+	0x42, 0xf1, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x42, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x3c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x79, 0x6d, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73,
+	0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x79, 0x6d, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
+	0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a,
+	0x3a, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6604,43 +16987,62 @@ func file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_symStaking_v1beta1_staking_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cosmos_symStaking_v1beta1_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cosmos_symStaking_v1beta1_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_cosmos_symStaking_v1beta1_staking_proto_goTypes = []interface{}{
-	(BondStatus)(0),               // 0: cosmos.symStaking.v1beta1.BondStatus
-	(Infraction)(0),               // 1: cosmos.symStaking.v1beta1.Infraction
-	(*HistoricalInfo)(nil),        // 2: cosmos.symStaking.v1beta1.HistoricalInfo
-	(*HistoricalRecord)(nil),      // 3: cosmos.symStaking.v1beta1.HistoricalRecord
-	(*CommissionRates)(nil),       // 4: cosmos.symStaking.v1beta1.CommissionRates
-	(*Commission)(nil),            // 5: cosmos.symStaking.v1beta1.Commission
-	(*Description)(nil),           // 6: cosmos.symStaking.v1beta1.Description
-	(*Validator)(nil),             // 7: cosmos.symStaking.v1beta1.Validator
-	(*ValAddresses)(nil),          // 8: cosmos.symStaking.v1beta1.ValAddresses
-	(*Params)(nil),                // 9: cosmos.symStaking.v1beta1.Params
-	(*ValidatorUpdates)(nil),      // 10: cosmos.symStaking.v1beta1.ValidatorUpdates
-	(*v1.Header)(nil),             // 11: cometbft.types.v1.Header
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
-	(*anypb.Any)(nil),             // 13: google.protobuf.Any
-	(*durationpb.Duration)(nil),   // 14: google.protobuf.Duration
-	(*v11.ValidatorUpdate)(nil),   // 15: cometbft.abci.v1.ValidatorUpdate
+	(BondStatus)(0),                      // 0: cosmos.symStaking.v1beta1.BondStatus
+	(Infraction)(0),                      // 1: cosmos.symStaking.v1beta1.Infraction
+	(*HistoricalInfo)(nil),               // 2: cosmos.symStaking.v1beta1.HistoricalInfo
+	(*HistoricalRecord)(nil),             // 3: cosmos.symStaking.v1beta1.HistoricalRecord
+	(*CommissionRates)(nil),              // 4: cosmos.symStaking.v1beta1.CommissionRates
+	(*Commission)(nil),                   // 5: cosmos.symStaking.v1beta1.Commission
+	(*Description)(nil),                  // 6: cosmos.symStaking.v1beta1.Description
+	(*Validator)(nil),                    // 7: cosmos.symStaking.v1beta1.Validator
+	(*ValAddresses)(nil),                 // 8: cosmos.symStaking.v1beta1.ValAddresses
+	(*Params)(nil),                       // 9: cosmos.symStaking.v1beta1.Params
+	(*SymbioticMiddleware)(nil),          // 10: cosmos.symStaking.v1beta1.SymbioticMiddleware
+	(*SymbioticMiddlewareStake)(nil),     // 11: cosmos.symStaking.v1beta1.SymbioticMiddlewareStake
+	(*SymbioticStakes)(nil),              // 12: cosmos.symStaking.v1beta1.SymbioticStakes
+	(*InjectedSymbioticData)(nil),        // 13: cosmos.symStaking.v1beta1.InjectedSymbioticData
+	(*SymbioticVoteExtension)(nil),       // 14: cosmos.symStaking.v1beta1.SymbioticVoteExtension
+	(*SymbioticSyncPoint)(nil),           // 15: cosmos.symStaking.v1beta1.SymbioticSyncPoint
+	(*SymbioticStaleness)(nil),           // 16: cosmos.symStaking.v1beta1.SymbioticStaleness
+	(*SymbioticValidatorTokens)(nil),     // 17: cosmos.symStaking.v1beta1.SymbioticValidatorTokens
+	(*ConsPubKeyRotation)(nil),           // 18: cosmos.symStaking.v1beta1.ConsPubKeyRotation
+	(*SymbioticCheckpoint)(nil),          // 19: cosmos.symStaking.v1beta1.SymbioticCheckpoint
+	(*SymbioticCheckpointValidator)(nil), // 20: cosmos.symStaking.v1beta1.SymbioticCheckpointValidator
+	(*SymbioticCheckpointSignature)(nil), // 21: cosmos.symStaking.v1beta1.SymbioticCheckpointSignature
+	(*ValidatorUpdates)(nil),             // 22: cosmos.symStaking.v1beta1.ValidatorUpdates
+	(*v1.Header)(nil),                    // 23: cometbft.types.v1.Header
+	(*timestamppb.Timestamp)(nil),        // 24: google.protobuf.Timestamp
+	(*anypb.Any)(nil),                    // 25: google.protobuf.Any
+	(*durationpb.Duration)(nil),          // 26: google.protobuf.Duration
+	(*v11.ValidatorUpdate)(nil),          // 27: cometbft.abci.v1.ValidatorUpdate
 }
 var file_cosmos_symStaking_v1beta1_staking_proto_depIdxs = []int32{
-	11, // 0: cosmos.symStaking.v1beta1.HistoricalInfo.header:type_name -> cometbft.types.v1.Header
+	23, // 0: cosmos.symStaking.v1beta1.HistoricalInfo.header:type_name -> cometbft.types.v1.Header
 	7,  // 1: cosmos.symStaking.v1beta1.HistoricalInfo.valset:type_name -> cosmos.symStaking.v1beta1.Validator
-	12, // 2: cosmos.symStaking.v1beta1.HistoricalRecord.time:type_name -> google.protobuf.Timestamp
+	24, // 2: cosmos.symStaking.v1beta1.HistoricalRecord.time:type_name -> google.protobuf.Timestamp
 	4,  // 3: cosmos.symStaking.v1beta1.Commission.commission_rates:type_name -> cosmos.symStaking.v1beta1.CommissionRates
-	12, // 4: cosmos.symStaking.v1beta1.Commission.update_time:type_name -> google.protobuf.Timestamp
-	13, // 5: cosmos.symStaking.v1beta1.Validator.consensus_pubkey:type_name -> google.protobuf.Any
+	24, // 4: cosmos.symStaking.v1beta1.Commission.update_time:type_name -> google.protobuf.Timestamp
+	25, // 5: cosmos.symStaking.v1beta1.Validator.consensus_pubkey:type_name -> google.protobuf.Any
 	0,  // 6: cosmos.symStaking.v1beta1.Validator.status:type_name -> cosmos.symStaking.v1beta1.BondStatus
 	6,  // 7: cosmos.symStaking.v1beta1.Validator.description:type_name -> cosmos.symStaking.v1beta1.Description
-	12, // 8: cosmos.symStaking.v1beta1.Validator.unbonding_time:type_name -> google.protobuf.Timestamp
+	24, // 8: cosmos.symStaking.v1beta1.Validator.unbonding_time:type_name -> google.protobuf.Timestamp
 	5,  // 9: cosmos.symStaking.v1beta1.Validator.commission:type_name -> cosmos.symStaking.v1beta1.Commission
-	14, // 10: cosmos.symStaking.v1beta1.Params.unbonding_time:type_name -> google.protobuf.Duration
-	15, // 11: cosmos.symStaking.v1beta1.ValidatorUpdates.updates:type_name -> cometbft.abci.v1.ValidatorUpdate
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	26, // 10: cosmos.symStaking.v1beta1.Params.unbonding_time:type_name -> google.protobuf.Duration
+	26, // 11: cosmos.symStaking.v1beta1.Params.slot_duration:type_name -> google.protobuf.Duration
+	10, // 12: cosmos.symStaking.v1beta1.Params.symbiotic_middlewares:type_name -> cosmos.symStaking.v1beta1.SymbioticMiddleware
+	11, // 13: cosmos.symStaking.v1beta1.SymbioticStakes.stakes:type_name -> cosmos.symStaking.v1beta1.SymbioticMiddlewareStake
+	25, // 14: cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey:type_name -> google.protobuf.Any
+	25, // 15: cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey:type_name -> google.protobuf.Any
+	20, // 16: cosmos.symStaking.v1beta1.SymbioticCheckpoint.validators:type_name -> cosmos.symStaking.v1beta1.SymbioticCheckpointValidator
+	21, // 17: cosmos.symStaking.v1beta1.SymbioticCheckpoint.signatures:type_name -> cosmos.symStaking.v1beta1.SymbioticCheckpointSignature
+	27, // 18: cosmos.symStaking.v1beta1.ValidatorUpdates.updates:type_name -> cometbft.abci.v1.ValidatorUpdate
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_symStaking_v1beta1_staking_proto_init() }
@@ -6746,6 +17148,150 @@ func file_cosmos_symStaking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbioticMiddleware); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbioticMiddlewareStake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbioticStakes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InjectedSymbioticData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbioticVoteExtension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbioticSyncPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbioticStaleness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbioticValidatorTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsPubKeyRotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbioticCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbioticCheckpointValidator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbioticCheckpointSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValidatorUpdates); i {
 			case 0:
 				return &v.state
@@ -6764,7 +17310,7 @@ func file_cosmos_symStaking_v1beta1_staking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_symStaking_v1beta1_staking_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   9,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
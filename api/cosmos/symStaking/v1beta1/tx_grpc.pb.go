@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateValidator_FullMethodName = "/cosmos.symStaking.v1beta1.Msg/CreateValidator"
-	Msg_EditValidator_FullMethodName   = "/cosmos.symStaking.v1beta1.Msg/EditValidator"
-	Msg_UpdateParams_FullMethodName    = "/cosmos.symStaking.v1beta1.Msg/UpdateParams"
+	Msg_CreateValidator_FullMethodName             = "/cosmos.symStaking.v1beta1.Msg/CreateValidator"
+	Msg_EditValidator_FullMethodName               = "/cosmos.symStaking.v1beta1.Msg/EditValidator"
+	Msg_UpdateParams_FullMethodName                = "/cosmos.symStaking.v1beta1.Msg/UpdateParams"
+	Msg_InjectSymbioticData_FullMethodName         = "/cosmos.symStaking.v1beta1.Msg/InjectSymbioticData"
+	Msg_UpdateSymbioticValidatorSet_FullMethodName = "/cosmos.symStaking.v1beta1.Msg/UpdateSymbioticValidatorSet"
+	Msg_LinkOperator_FullMethodName                = "/cosmos.symStaking.v1beta1.Msg/LinkOperator"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +38,15 @@ type MsgClient interface {
 	// UpdateParams defines an operation for updating the x/symStaking module
 	// parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// InjectSymbioticData defines the message carried by the tx injected by the
+	// proposer at a Symbiotic sync height. It can't be submitted by users.
+	InjectSymbioticData(ctx context.Context, in *MsgInjectSymbioticData, opts ...grpc.CallOption) (*MsgInjectSymbioticDataResponse, error)
+	// UpdateSymbioticValidatorSet defines a governance operation for setting the
+	// tokens of the validators while the Symbiotic validator set is stale.
+	UpdateSymbioticValidatorSet(ctx context.Context, in *MsgUpdateSymbioticValidatorSet, opts ...grpc.CallOption) (*MsgUpdateSymbioticValidatorSetResponse, error)
+	// LinkOperator defines a method for linking the Ethereum address of a
+	// Symbiotic operator to a validator, with a signature of the operator key.
+	LinkOperator(ctx context.Context, in *MsgLinkOperator, opts ...grpc.CallOption) (*MsgLinkOperatorResponse, error)
 }
 
 type msgClient struct {
@@ -72,6 +84,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) InjectSymbioticData(ctx context.Context, in *MsgInjectSymbioticData, opts ...grpc.CallOption) (*MsgInjectSymbioticDataResponse, error) {
+	out := new(MsgInjectSymbioticDataResponse)
+	err := c.cc.Invoke(ctx, Msg_InjectSymbioticData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSymbioticValidatorSet(ctx context.Context, in *MsgUpdateSymbioticValidatorSet, opts ...grpc.CallOption) (*MsgUpdateSymbioticValidatorSetResponse, error) {
+	out := new(MsgUpdateSymbioticValidatorSetResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateSymbioticValidatorSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LinkOperator(ctx context.Context, in *MsgLinkOperator, opts ...grpc.CallOption) (*MsgLinkOperatorResponse, error) {
+	out := new(MsgLinkOperatorResponse)
+	err := c.cc.Invoke(ctx, Msg_LinkOperator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -83,6 +122,15 @@ type MsgServer interface {
 	// UpdateParams defines an operation for updating the x/symStaking module
 	// parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// InjectSymbioticData defines the message carried by the tx injected by the
+	// proposer at a Symbiotic sync height. It can't be submitted by users.
+	InjectSymbioticData(context.Context, *MsgInjectSymbioticData) (*MsgInjectSymbioticDataResponse, error)
+	// UpdateSymbioticValidatorSet defines a governance operation for setting the
+	// tokens of the validators while the Symbiotic validator set is stale.
+	UpdateSymbioticValidatorSet(context.Context, *MsgUpdateSymbioticValidatorSet) (*MsgUpdateSymbioticValidatorSetResponse, error)
+	// LinkOperator defines a method for linking the Ethereum address of a
+	// Symbiotic operator to a validator, with a signature of the operator key.
+	LinkOperator(context.Context, *MsgLinkOperator) (*MsgLinkOperatorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -99,6 +147,15 @@ func (UnimplementedMsgServer) EditValidator(context.Context, *MsgEditValidator)
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) InjectSymbioticData(context.Context, *MsgInjectSymbioticData) (*MsgInjectSymbioticDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InjectSymbioticData not implemented")
+}
+func (UnimplementedMsgServer) UpdateSymbioticValidatorSet(context.Context, *MsgUpdateSymbioticValidatorSet) (*MsgUpdateSymbioticValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSymbioticValidatorSet not implemented")
+}
+func (UnimplementedMsgServer) LinkOperator(context.Context, *MsgLinkOperator) (*MsgLinkOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkOperator not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +223,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InjectSymbioticData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInjectSymbioticData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InjectSymbioticData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InjectSymbioticData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InjectSymbioticData(ctx, req.(*MsgInjectSymbioticData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSymbioticValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSymbioticValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSymbioticValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateSymbioticValidatorSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSymbioticValidatorSet(ctx, req.(*MsgUpdateSymbioticValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LinkOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLinkOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LinkOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LinkOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LinkOperator(ctx, req.(*MsgLinkOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,6 +296,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "InjectSymbioticData",
+			Handler:    _Msg_InjectSymbioticData_Handler,
+		},
+		{
+			MethodName: "UpdateSymbioticValidatorSet",
+			Handler:    _Msg_UpdateSymbioticValidatorSet_Handler,
+		},
+		{
+			MethodName: "LinkOperator",
+			Handler:    _Msg_LinkOperator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symStaking/v1beta1/tx.proto",
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/x/auth/ante"
 	"cosmossdk.io/x/auth/ante/unorderedtx"
 	circuitante "cosmossdk.io/x/circuit/ante"
+	symstakingante "cosmossdk.io/x/symStaking/ante"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -34,7 +35,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	}
 
 	anteDecorators := []sdk.AnteDecorator{
-		ante.NewSetUpContextDecorator(options.Environment), // outermost AnteDecorator. SetUpContext must be called first
+		symstakingante.NewInjectedTxDecorator(),            // skips the tx injected by the proposer, before SetUpContext
+		ante.NewSetUpContextDecorator(options.Environment), // SetUpContext must be called before the other decorators
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(options.Environment),
@@ -35,7 +35,10 @@ import (
 	poolkeeper "cosmossdk.io/x/protocolpool/keeper"
 	govkeeper "cosmossdk.io/x/symGov/keeper"
 	slashingkeeper "cosmossdk.io/x/symSlash/keeper"
+	symstakingante "cosmossdk.io/x/symStaking/ante"
 	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	"cosmossdk.io/x/symStaking/symbiotic"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -217,13 +220,20 @@ func NewSymApp(
 	//
 	// Example:
 	//
-	// create and set dummy vote extension handler
+	// create and set the Symbiotic vote extension and proposal handlers
 	abciPropHandler := abci.NewProposalHandler(logger, app.StakingKeeper)
-	voteExtensionHandler := abci.NewVoteExtensionHandler()
+	voteExtensionHandler := abci.NewVoteExtensionHandler(logger, app.StakingKeeper)
+	checkpointKey, err := symbiotic.Config{CheckpointKeyFile: cast.ToString(appOpts.Get(symbiotic.FlagCheckpointKeyFile))}.LoadCheckpointKey()
+	if err != nil {
+		panic(err)
+	}
+	voteExtensionHandler.SetCheckpointKey(checkpointKey)
 	baseAppOptions = append(baseAppOptions, func(ba *baseapp.BaseApp) {
 		ba.SetExtendVoteHandler(voteExtensionHandler.ExtendVote())
 		ba.SetVerifyVoteExtensionHandler(voteExtensionHandler.VerifyVoteExtension())
+		ba.SetTxDecoder(stakingtypes.InjectedTxDecoder(app.StakingKeeper.GetAuthority(), app.txConfig.TxDecoder()))
 		ba.SetPrepareProposal(abciPropHandler.PrepareProposal())
+		ba.SetProcessProposal(abciPropHandler.ProcessProposal())
 		ba.SetPreBlocker(abciPropHandler.PreBlocker())
 	})
 
@@ -315,7 +325,7 @@ func (app *SymApp) setCustomAnteHandler() {
 				BankKeeper:      app.BankKeeper,
 				SignModeHandler: app.txConfig.SignModeHandler(),
 				FeegrantKeeper:  app.FeeGrantKeeper,
-				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+				SigGasConsumer:  symstakingante.SigVerificationGasConsumer,
 				Environment:     app.AuthKeeper.Environment,
 			},
 			&app.CircuitBreakerKeeper,
@@ -333,6 +343,7 @@ func (app *SymApp) setCustomAnteHandler() {
 // Close implements the Application interface and closes all necessary application
 // resources.
 func (app *SymApp) Close() error {
+	app.StakingKeeper.StopLightClient()
 	return app.UnorderedTxManager.Close()
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	"cosmossdk.io/x/accounts"
 	"cosmossdk.io/x/auth"
@@ -30,6 +31,8 @@ import (
 	"cosmossdk.io/x/slashing"
 	"cosmossdk.io/x/symGov"
 	staking "cosmossdk.io/x/symStaking"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 	"cosmossdk.io/x/upgrade"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -296,3 +299,39 @@ func TestProtoAnnotations(t *testing.T) {
 	err = msgservice.ValidateProtoAnnotations(r)
 	require.NoError(t, err)
 }
+
+func TestSymbioticStaleHaltDisablesMsgs(t *testing.T) {
+	app := NewSymApp(log.NewTestLogger(t), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+
+	msgSendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	params := stakingtypes.DefaultParams()
+	require.NoError(t, app.StakingKeeper.Params.Set(ctx, params))
+	params.SymbioticMiddlewareAddress = "0x0000000000000000000000000000000000000001"
+	params.SymbioticEpochIdentifier = ""
+	params.SymbioticMaxStaleSyncs = 1
+	params.SymbioticStalePolicy = stakingtypes.SymbioticStalePolicyHalt
+	params.SymbioticHaltMsgTypeUrls = []string{msgSendURL}
+
+	// the circuit keeper is injected, so the halt policy can be set
+	_, err := stakingkeeper.NewMsgServerImpl(app.StakingKeeper).UpdateParams(ctx, &stakingtypes.MsgUpdateParams{
+		Authority: app.StakingKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	// no data is injected at the sync heights, so they are skipped
+	ctx = ctx.WithHeaderInfo(header.Info{Height: params.SymbioticSyncPeriod, Time: ctx.HeaderInfo().Time})
+	require.NoError(t, app.StakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+
+	allowed, err := app.CircuitBreakerKeeper.IsAllowed(ctx, msgSendURL)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2 * params.SymbioticSyncPeriod, Time: ctx.HeaderInfo().Time})
+	require.NoError(t, app.StakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+
+	allowed, err = app.CircuitBreakerKeeper.IsAllowed(ctx, msgSendURL)
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
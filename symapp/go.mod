@@ -29,6 +29,7 @@ require (
 	cosmossdk.io/x/protocolpool v0.0.0-20230925135524-a1bc045b3190
 	cosmossdk.io/x/staking v0.0.0-00010101000000-000000000000 // indirect
 	cosmossdk.io/x/symGov v0.0.0-00010101000000-000000000000
+	cosmossdk.io/x/symRewards v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/symSlash v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/symStaking v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/tx v0.13.3
@@ -39,12 +40,14 @@ require (
 	// this version is not used as it is always replaced by the latest Cosmos SDK version
 	github.com/cosmos/cosmos-sdk v0.53.0
 	github.com/cosmos/gogoproto v1.5.0
+	github.com/ethereum/go-ethereum v1.11.1
 	github.com/golang/mock v1.6.0
 	github.com/spf13/cast v1.6.0
 	github.com/spf13/cobra v1.8.1
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.19.0
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/sync v0.7.0
 	google.golang.org/protobuf v1.34.2
 )
 
@@ -105,7 +108,6 @@ require (
 	github.com/dustin/go-humanize v1.0.1 // indirect
 	github.com/dvsekhvalnov/jose2go v1.6.0 // indirect
 	github.com/emicklei/dot v1.6.2 // indirect
-	github.com/ethereum/go-ethereum v1.11.1 // indirect
 	github.com/fatih/color v1.17.0 // indirect
 	github.com/felixge/httpsnoop v1.0.4 // indirect
 	github.com/fsnotify/fsnotify v1.7.0 // indirect
@@ -223,7 +225,6 @@ require (
 	golang.org/x/mod v0.17.0 // indirect
 	golang.org/x/net v0.27.0 // indirect
 	golang.org/x/oauth2 v0.21.0 // indirect
-	golang.org/x/sync v0.7.0 // indirect
 	golang.org/x/sys v0.22.0 // indirect
 	golang.org/x/term v0.22.0 // indirect
 	golang.org/x/text v0.16.0 // indirect
@@ -278,6 +279,7 @@ replace (
 	cosmossdk.io/x/protocolpool => ../x/protocolpool
 	cosmossdk.io/x/staking => ../x/staking
 	cosmossdk.io/x/symGov => ../x/symGov
+	cosmossdk.io/x/symRewards => ../x/symRewards
 	cosmossdk.io/x/symSlash => ../x/symSlash
 	cosmossdk.io/x/symStaking => ../x/symStaking
 	cosmossdk.io/x/tx => ../x/tx
@@ -1,12 +1,14 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"io"
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/sync/errgroup"
 
 	"cosmossdk.io/client/v2/offchain"
 	"cosmossdk.io/log"
@@ -45,14 +47,18 @@ func initRootCmd(
 		snapshot.Cmd(newApp),
 	)
 
-	server.AddCommands(rootCmd, newApp, server.StartCmdOptions[servertypes.Application]{})
+	server.AddCommands(rootCmd, newApp, server.StartCmdOptions[servertypes.Application]{
+		PostSetup:           startLightClient,
+		PostSetupStandalone: startLightClient,
+	})
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(moduleManager, appExport),
+		genesisCommand(moduleManager, appExport, importSymbioticGenesisCmd()),
 		queryCommand(),
 		txCommand(),
+		symbioticCommand(),
 		keys.Commands(),
 		offchain.OffChain(),
 	)
@@ -128,6 +134,18 @@ func newApp(
 	)
 }
 
+// startLightClient starts the light client of the Symbiotic source, if any,
+// along with the node. It is stopped when the node shuts down.
+func startLightClient(app servertypes.Application, _ *server.Context, _ client.Context, ctx context.Context, _ *errgroup.Group) error {
+	symApp, ok := app.(*symapp.SymApp)
+	if !ok {
+		return errors.New("the app is not a symapp")
+	}
+
+	symApp.StakingKeeper.StartLightClient(ctx)
+	return nil
+}
+
 // appExport creates a new symapp (optionally at a given height) and exports state.
 func appExport(
 	logger log.Logger,
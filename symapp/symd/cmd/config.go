@@ -5,6 +5,8 @@ import (
 
 	cmtcfg "github.com/cometbft/cometbft/config"
 
+	"cosmossdk.io/x/symStaking/symbiotic"
+
 	clientconfig "github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -85,6 +87,8 @@ func initAppConfig() (string, interface{}) {
 		serverconfig.Config `mapstructure:",squash"`
 
 		Custom CustomConfig `mapstructure:"custom"`
+
+		Symbiotic symbiotic.Config `mapstructure:"symbiotic"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -111,6 +115,7 @@ func initAppConfig() (string, interface{}) {
 		Custom: CustomConfig{
 			CustomField: "anything",
 		},
+		Symbiotic: symbiotic.DefaultConfig(),
 	}
 
 	// The default SDK app template is defined in serverconfig.DefaultConfigTemplate.
@@ -120,7 +125,8 @@ func initAppConfig() (string, interface{}) {
 [custom]
 # That field will be parsed by server.InterceptConfigsPreRunHandler and held by viper.
 # Do not forget to add quotes around the value if it is a string.
-custom-field = "{{ .Custom.CustomField }}"`
+custom-field = "{{ .Custom.CustomField }}"
+` + symbiotic.DefaultConfigTemplate
 
 	return customAppTemplate, customAppConfig
 }
@@ -0,0 +1,525 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	"cosmossdk.io/x/symStaking/symbiotic"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagSymbioticBeaconAPIURLs = "beacon-api-urls"
+	flagSymbioticEthAPIURLs    = "eth-api-urls"
+	flagSymbioticEpoch         = "epoch"
+)
+
+// symbioticCommand builds the `symd symbiotic` commands, previewing the syncs
+// of the validator set with the Symbiotic middlewares. The middlewares are read
+// through the endpoints of the [symbiotic] section of app.toml, and the params
+// and validators are queried from the node.
+func symbioticCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "symbiotic",
+		Short:                      "Inspect and dry-run the Symbiotic middleware validator sets",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		symbioticFinalizedBlockCmd(),
+		symbioticValidatorSetCmd(),
+		symbioticDiffCmd(),
+		symbioticValidatorUpdatesCmd(),
+	)
+
+	return cmd
+}
+
+func symbioticFinalizedBlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalized-block",
+		Short: "Fetch the finalized Ethereum block the validator set would be synced with now",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, params, source, err := symbioticContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			block, err := fetchSymbioticFinalizedBlock(cmd.Context(), source, params)
+			if err != nil {
+				return err
+			}
+
+			return printSymbioticJSON(clientCtx, block)
+		},
+	}
+
+	addSymbioticFlags(cmd)
+	return cmd
+}
+
+func symbioticValidatorSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set [block-hash]",
+		Short: "Call getValidatorSet on the middlewares at an execution block",
+		Long: `Call getValidatorSet on the middlewares at an execution block, the current finalized
+block if omitted, and print the validator set of each middleware along with the stakes
+weighted by the params. The current epoch of each middleware is read unless --epoch is set.
+The output can be imported in genesis with the --fixture flag of genesis import-symbiotic.`,
+		Example: fmt.Sprintf("%s symbiotic validator-set 0x5c2b...d1e0 --epoch 42", version.AppName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			blockHash, epoch, err := parseSymbioticValidatorSetArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, params, source, err := symbioticContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			out, err := fetchSymbioticValidatorSetsOutput(cmd.Context(), source, params, blockHash, epoch)
+			if err != nil {
+				return err
+			}
+
+			return printSymbioticJSON(clientCtx, out)
+		},
+	}
+
+	addSymbioticValidatorSetFlags(cmd)
+	return cmd
+}
+
+func symbioticDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [block-hash]",
+		Short: "Diff the middleware validator sets against the validators on chain",
+		Long: `Diff the middleware validator sets at an execution block, the current finalized block
+if omitted, against the validators on chain, matched by consensus address. Every validator
+is printed with its tokens before and after the sync, as applied by the staking module
+under the current params, along with the keys that wouldn't sync any validator.`,
+		Example: fmt.Sprintf("%s symbiotic diff 0x5c2b...d1e0", version.AppName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, _, blockHash, previews, skipped, err := previewSymbioticSync(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			out := symbioticDiffOutput{BlockHash: blockHash.Hex()}
+			for _, preview := range previews {
+				consAddr, err := preview.Validator.GetConsAddr()
+				if err != nil {
+					return err
+				}
+				consAddrStr, err := clientCtx.ConsensusAddressCodec.BytesToString(consAddr)
+				if err != nil {
+					return err
+				}
+
+				validatorOut := symbioticValidatorDiffOutput{
+					Validator:    preview.Validator.GetOperator(),
+					ConsAddress:  consAddrStr,
+					Status:       symbioticDiffStatus(preview),
+					TokensBefore: preview.PreviousTokens.String(),
+					TokensAfter:  preview.Validator.Tokens.String(),
+					PowerBefore:  preview.PreviousPower,
+					PowerAfter:   preview.Validator.PotentialConsensusPower(sdk.DefaultPowerReduction),
+				}
+				if preview.Stake != nil {
+					validatorOut.Key = hexutil.Encode(preview.Stake.ConsAddr[:])
+					validatorOut.Operator = preview.Stake.Operator.Hex()
+					validatorOut.Stake = preview.Stake.Stake.String()
+				}
+				out.Validators = append(out.Validators, validatorOut)
+			}
+
+			for _, key := range skipped {
+				out.Skipped = append(out.Skipped, symbioticSkippedKeyOutput{
+					Key:      hexutil.Encode(key.ConsAddr[:]),
+					Operator: key.Operator.Hex(),
+					Stake:    key.Stake.String(),
+					Reason:   key.Reason,
+				})
+			}
+
+			return printSymbioticJSON(clientCtx, out)
+		},
+	}
+
+	addSymbioticValidatorSetFlags(cmd)
+	return cmd
+}
+
+func symbioticValidatorUpdatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-updates [block-hash]",
+		Short: "Print the CometBFT validator updates of a sync with the middleware validator sets",
+		Long: `Print the CometBFT validator updates the staking module would return at the end of a sync
+with the middleware validator sets at an execution block, the current finalized block if omitted.`,
+		Example: fmt.Sprintf("%s symbiotic validator-updates 0x5c2b...d1e0", version.AppName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, params, blockHash, previews, _, err := previewSymbioticSync(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			updates, err := stakingkeeper.PreviewSymbioticValidatorUpdates(previews, params.MaxValidators, sdk.DefaultPowerReduction)
+			if err != nil {
+				return err
+			}
+
+			out := symbioticValidatorUpdatesOutput{BlockHash: blockHash.Hex(), ValidatorUpdates: []symbioticValidatorUpdateOutput{}}
+			for _, update := range updates {
+				out.ValidatorUpdates = append(out.ValidatorUpdates, symbioticValidatorUpdateOutput{
+					PubKeyType: update.PubKeyType,
+					PubKey:     update.PubKey,
+					Power:      update.Power,
+				})
+			}
+
+			return printSymbioticJSON(clientCtx, out)
+		},
+	}
+
+	addSymbioticValidatorSetFlags(cmd)
+	return cmd
+}
+
+// addSymbioticFlags adds the query flags and the endpoint overrides.
+func addSymbioticFlags(cmd *cobra.Command) {
+	flags.AddQueryFlagsToCmd(cmd)
+	addSymbioticEndpointFlags(cmd)
+}
+
+// addSymbioticEndpointFlags adds the endpoint overrides.
+func addSymbioticEndpointFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagSymbioticBeaconAPIURLs, nil, "Beacon API endpoints, instead of the ones of app.toml")
+	cmd.Flags().StringSlice(flagSymbioticEthAPIURLs, nil, "Execution layer JSON-RPC endpoints, instead of the ones of app.toml")
+}
+
+// addSymbioticValidatorSetFlags adds the flags of the commands reading the
+// middleware validator sets.
+func addSymbioticValidatorSetFlags(cmd *cobra.Command) {
+	addSymbioticFlags(cmd)
+	addSymbioticEpochFlag(cmd)
+}
+
+// addSymbioticEpochFlag adds the epoch of the middleware validator sets.
+func addSymbioticEpochFlag(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagSymbioticEpoch, 0, "Middleware epoch to read the validator sets of, the current epoch of each middleware if not set")
+}
+
+// symbioticContext returns the client context, the params queried from the
+// node, and the source reading Ethereum as configured in app.toml, or through
+// the endpoints of the flags.
+func symbioticContext(cmd *cobra.Command) (client.Context, stakingtypes.Params, stakingtypes.SymbioticSource, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return clientCtx, stakingtypes.Params{}, nil, err
+	}
+
+	res, err := stakingtypes.NewQueryClient(clientCtx).Params(cmd.Context(), &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return clientCtx, stakingtypes.Params{}, nil, err
+	}
+
+	return clientCtx, res.Params, newSymbioticSource(cmd), nil
+}
+
+// newSymbioticSource returns the source reading Ethereum as configured in
+// app.toml, or through the endpoints of the flags.
+func newSymbioticSource(cmd *cobra.Command) stakingtypes.SymbioticSource {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	cfg := symbiotic.DefaultConfig()
+	cfg.BeaconAPIURLs = serverCtx.Viper.GetStringSlice(symbiotic.FlagBeaconAPIURLs)
+	cfg.EthAPIURLs = serverCtx.Viper.GetStringSlice(symbiotic.FlagEthAPIURLs)
+	cfg.VerifyStorageProofs = serverCtx.Viper.GetBool(symbiotic.FlagVerifyStorageProofs)
+	cfg.QuorumSize = serverCtx.Viper.GetInt(symbiotic.FlagQuorumSize)
+	cfg.QuorumThreshold = serverCtx.Viper.GetInt(symbiotic.FlagQuorumThreshold)
+	if cmd.Flags().Changed(flagSymbioticBeaconAPIURLs) {
+		cfg.BeaconAPIURLs, _ = cmd.Flags().GetStringSlice(flagSymbioticBeaconAPIURLs)
+	}
+	if cmd.Flags().Changed(flagSymbioticEthAPIURLs) {
+		cfg.EthAPIURLs, _ = cmd.Flags().GetStringSlice(flagSymbioticEthAPIURLs)
+	}
+
+	rpcSource := symbiotic.NewRPCSource(serverCtx.Logger, cfg.ApiUrls())
+	source := cfg.NewSource(serverCtx.Logger, rpcSource)
+	if cfg.VerifyStorageProofs {
+		source = symbiotic.NewVerifyingSource(source, rpcSource)
+	}
+
+	return source
+}
+
+// fetchSymbioticFinalizedBlock returns the finalized block the validator set
+// would be synced with now.
+func fetchSymbioticFinalizedBlock(ctx context.Context, source stakingtypes.SymbioticSource, params stakingtypes.Params) (symbioticBlockOutput, error) {
+	profile, err := params.NetworkProfile()
+	if err != nil {
+		return symbioticBlockOutput{}, err
+	}
+
+	slot, blockHash, err := stakingkeeper.FetchFinalizedBeaconBlock(ctx, source, profile, time.Now())
+	if err != nil {
+		return symbioticBlockOutput{}, err
+	}
+
+	block := symbioticBlockOutput{Slot: slot, BlockHash: blockHash}
+	if blockHash == stakingkeeper.INVALID_BLOCKHASH {
+		return block, nil
+	}
+
+	header, err := source.HeaderByHash(ctx, common.HexToHash(blockHash))
+	if err != nil {
+		return block, err
+	}
+
+	block.Finalized = true
+	block.BlockNumber = header.Number.Uint64()
+	block.BlockTimestamp = header.Time
+	return block, nil
+}
+
+// fetchSymbioticValidatorSets returns the middleware validator sets at the
+// block hash of args, or at the current finalized block.
+func fetchSymbioticValidatorSets(cmd *cobra.Command, args []string) (client.Context, stakingtypes.Params, common.Hash, []stakingtypes.SymbioticValidatorSet, error) {
+	blockHash, epoch, err := parseSymbioticValidatorSetArgs(cmd, args)
+	if err != nil {
+		return client.Context{}, stakingtypes.Params{}, blockHash, nil, err
+	}
+
+	clientCtx, params, source, err := symbioticContext(cmd)
+	if err != nil {
+		return clientCtx, params, blockHash, nil, err
+	}
+
+	blockHash, sets, err := fetchSymbioticValidatorSetsAt(cmd.Context(), source, params, blockHash, epoch)
+	return clientCtx, params, blockHash, sets, err
+}
+
+// parseSymbioticValidatorSetArgs returns the block hash of args, zero if
+// omitted, and the epoch of the flags, nil if not set.
+func parseSymbioticValidatorSetArgs(cmd *cobra.Command, args []string) (common.Hash, *uint64, error) {
+	var blockHash common.Hash
+	if len(args) > 0 {
+		hash, err := hexutil.Decode(args[0])
+		if err != nil || len(hash) != common.HashLength {
+			return blockHash, nil, fmt.Errorf("invalid block hash: %q", args[0])
+		}
+		blockHash = common.BytesToHash(hash)
+	}
+
+	if !cmd.Flags().Changed(flagSymbioticEpoch) {
+		return blockHash, nil, nil
+	}
+
+	epoch, err := cmd.Flags().GetUint64(flagSymbioticEpoch)
+	if err != nil {
+		return blockHash, nil, err
+	}
+	return blockHash, &epoch, nil
+}
+
+// fetchSymbioticValidatorSetsAt returns the middleware validator sets at the
+// block hash, or at the current finalized block if zero, along with the hash.
+func fetchSymbioticValidatorSetsAt(
+	ctx context.Context, source stakingtypes.SymbioticSource, params stakingtypes.Params, blockHash common.Hash, epoch *uint64,
+) (common.Hash, []stakingtypes.SymbioticValidatorSet, error) {
+	if blockHash == (common.Hash{}) {
+		block, err := fetchSymbioticFinalizedBlock(ctx, source, params)
+		if err != nil {
+			return blockHash, nil, err
+		}
+		if !block.Finalized {
+			return blockHash, nil, fmt.Errorf("the beacon block of slot %d isn't finalized", block.Slot)
+		}
+		blockHash = common.HexToHash(block.BlockHash)
+	}
+
+	sets, err := stakingkeeper.FetchMiddlewareValidatorSets(ctx, source, params.Middlewares(), blockHash, epoch)
+	return blockHash, sets, err
+}
+
+// fetchSymbioticValidatorSetsOutput returns the middleware validator sets at
+// the block hash, or at the current finalized block if zero, along with the
+// block and the stakes weighted by the params.
+func fetchSymbioticValidatorSetsOutput(
+	ctx context.Context, source stakingtypes.SymbioticSource, params stakingtypes.Params, blockHash common.Hash, epoch *uint64,
+) (symbioticValidatorSetsOutput, error) {
+	blockHash, sets, err := fetchSymbioticValidatorSetsAt(ctx, source, params, blockHash, epoch)
+	if err != nil {
+		return symbioticValidatorSetsOutput{}, err
+	}
+
+	header, err := source.HeaderByHash(ctx, blockHash)
+	if err != nil {
+		return symbioticValidatorSetsOutput{}, err
+	}
+
+	middlewares := params.Middlewares()
+	out := symbioticValidatorSetsOutput{
+		BlockHash:      blockHash.Hex(),
+		BlockNumber:    header.Number.Uint64(),
+		BlockTimestamp: header.Time,
+	}
+	for i, set := range sets {
+		setOut := symbioticValidatorSetOutput{Middleware: set.Middleware.Hex(), Epoch: set.Epoch}
+		for _, v := range set.Validators {
+			setOut.Validators = append(setOut.Validators, symbioticKeyOutput{
+				Key:           hexutil.Encode(v.ConsAddr[:]),
+				Operator:      v.Operator.Hex(),
+				Stake:         v.Stake.String(),
+				WeightedStake: middlewares[i].WeightedStake(v.Stake).String(),
+			})
+		}
+		out.ValidatorSets = append(out.ValidatorSets, setOut)
+	}
+
+	return out, nil
+}
+
+// previewSymbioticSync returns the validators on chain as they would be after
+// a sync with the middleware validator sets at the block hash of args, or at
+// the current finalized block.
+func previewSymbioticSync(cmd *cobra.Command, args []string) (
+	client.Context, stakingtypes.Params, common.Hash, []*stakingkeeper.SymbioticValidatorPreview, []stakingkeeper.SymbioticSkippedKey, error,
+) {
+	clientCtx, params, blockHash, sets, err := fetchSymbioticValidatorSets(cmd, args)
+	if err != nil {
+		return clientCtx, params, blockHash, nil, nil, err
+	}
+
+	var (
+		validators []stakingtypes.Validator
+		pageReq    = &query.PageRequest{}
+	)
+	queryClient := stakingtypes.NewQueryClient(clientCtx)
+	for {
+		res, err := queryClient.Validators(cmd.Context(), &stakingtypes.QueryValidatorsRequest{Pagination: pageReq})
+		if err != nil {
+			return clientCtx, params, blockHash, nil, nil, err
+		}
+
+		validators = append(validators, res.Validators...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	logger := server.GetServerContextFromCmd(cmd).Logger
+	previews, skipped, err := stakingkeeper.PreviewSymbioticSync(logger, clientCtx.ValidatorAddressCodec, params, sdk.DefaultPowerReduction, validators, sets)
+	return clientCtx, params, blockHash, previews, skipped, err
+}
+
+// symbioticDiffStatus returns the change of a validator at a sync.
+func symbioticDiffStatus(preview *stakingkeeper.SymbioticValidatorPreview) string {
+	switch {
+	case preview.Created:
+		return "created"
+	case preview.PreviousConsPubKey != nil:
+		return "rotated"
+	case preview.Stake == nil && preview.PreviousTokens.IsPositive():
+		return "removed"
+	case preview.Validator.Tokens.Equal(preview.PreviousTokens):
+		return "unchanged"
+	default:
+		return "updated"
+	}
+}
+
+func printSymbioticJSON(clientCtx client.Context, out any) error {
+	bz, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintBytes(bz)
+}
+
+type symbioticBlockOutput struct {
+	Slot           int64  `json:"slot"`
+	BlockHash      string `json:"block_hash"`
+	Finalized      bool   `json:"finalized"`
+	BlockNumber    uint64 `json:"block_number,omitempty"`
+	BlockTimestamp uint64 `json:"block_timestamp,omitempty"`
+}
+
+type symbioticValidatorSetsOutput struct {
+	BlockHash      string                        `json:"block_hash"`
+	BlockNumber    uint64                        `json:"block_number,omitempty"`
+	BlockTimestamp uint64                        `json:"block_timestamp,omitempty"`
+	ValidatorSets  []symbioticValidatorSetOutput `json:"validator_sets"`
+}
+
+type symbioticValidatorSetOutput struct {
+	Middleware string               `json:"middleware"`
+	Epoch      uint64               `json:"epoch"`
+	Validators []symbioticKeyOutput `json:"validators"`
+}
+
+type symbioticKeyOutput struct {
+	Key           string `json:"key"`
+	Operator      string `json:"operator"`
+	Stake         string `json:"stake"`
+	WeightedStake string `json:"weighted_stake"`
+}
+
+type symbioticDiffOutput struct {
+	BlockHash  string                         `json:"block_hash"`
+	Validators []symbioticValidatorDiffOutput `json:"validators"`
+	Skipped    []symbioticSkippedKeyOutput    `json:"skipped"`
+}
+
+type symbioticValidatorDiffOutput struct {
+	Validator    string `json:"validator"`
+	ConsAddress  string `json:"cons_address"`
+	Status       string `json:"status"`
+	Key          string `json:"key,omitempty"`
+	Operator     string `json:"operator,omitempty"`
+	Stake        string `json:"stake,omitempty"`
+	TokensBefore string `json:"tokens_before"`
+	TokensAfter  string `json:"tokens_after"`
+	PowerBefore  int64  `json:"power_before"`
+	PowerAfter   int64  `json:"power_after"`
+}
+
+type symbioticSkippedKeyOutput struct {
+	Key      string `json:"key"`
+	Operator string `json:"operator"`
+	Stake    string `json:"stake"`
+	Reason   string `json:"reason"`
+}
+
+type symbioticValidatorUpdatesOutput struct {
+	BlockHash        string                           `json:"block_hash"`
+	ValidatorUpdates []symbioticValidatorUpdateOutput `json:"validator_updates"`
+}
+
+type symbioticValidatorUpdateOutput struct {
+	PubKeyType string `json:"pub_key_type"`
+	PubKey     []byte `json:"pub_key"`
+	Power      int64  `json:"power"`
+}
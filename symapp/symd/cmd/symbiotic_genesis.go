@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutil "github.com/cosmos/cosmos-sdk/x/symGenutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/symGenutil/types"
+)
+
+const flagSymbioticFixture = "fixture"
+
+// importSymbioticGenesisCmd builds the `symd genesis import-symbiotic` command,
+// bootstrapping the genesis validators from the middleware validator sets
+// instead of gentxs.
+func importSymbioticGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-symbiotic [block-hash]",
+		Short: "Import the genesis validators from the Symbiotic middleware validator sets",
+		Long: `Import the genesis validators from the validator sets of the middlewares of the staking
+params of genesis at an execution block, the current finalized block if omitted, or from
+a fixture holding the output of symbiotic validator-set. A validator is created for every
+key of the validator sets with the tokens a sync would give it, and the block is recorded
+as the symbiotic anchor of the staking genesis, the first sync point of the chain.`,
+		Example: fmt.Sprintf(`%[1]s genesis import-symbiotic 0x5c2b...d1e0
+%[1]s genesis import-symbiotic --fixture validator-set.json`, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config := client.GetConfigFromCmd(cmd)
+
+			fixture, _ := cmd.Flags().GetString(flagSymbioticFixture)
+			if fixture != "" && len(args) > 0 {
+				return errors.New("the block hash can't be set along with a fixture")
+			}
+
+			appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(config.GenesisFile())
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			stakingGenState := stakingtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+			params := stakingGenState.Params
+			if len(params.Middlewares()) == 0 {
+				return errors.New("no symbiotic middleware in the staking params of genesis")
+			}
+			// the validators are created with the consensus pubkey of their key
+			if params.SymbioticKeyType != stakingtypes.SymbioticKeyTypeEd25519 {
+				return fmt.Errorf("the validators can only be imported under the %s symbiotic key type", stakingtypes.SymbioticKeyTypeEd25519)
+			}
+
+			var validatorSets symbioticValidatorSetsOutput
+			if fixture != "" {
+				bz, err := os.ReadFile(fixture)
+				if err != nil {
+					return err
+				}
+				if err := json.Unmarshal(bz, &validatorSets); err != nil {
+					return fmt.Errorf("failed to unmarshal fixture %s: %w", fixture, err)
+				}
+			} else {
+				blockHash, epoch, err := parseSymbioticValidatorSetArgs(cmd, args)
+				if err != nil {
+					return err
+				}
+
+				validatorSets, err = fetchSymbioticValidatorSetsOutput(cmd.Context(), newSymbioticSource(cmd), params, blockHash, epoch)
+				if err != nil {
+					return err
+				}
+			}
+
+			sets, err := validatorSets.validatorSets()
+			if err != nil {
+				return err
+			}
+
+			anchor, err := symbioticGenesisAnchor(validatorSets, sets, params)
+			if err != nil {
+				return err
+			}
+
+			// the validators of the unknown keys are created whatever the params
+			importParams := params
+			importParams.SymbioticAutoCreateValidators = true
+
+			logger := server.GetServerContextFromCmd(cmd).Logger
+			previews, skipped, err := stakingkeeper.PreviewSymbioticSync(
+				logger, clientCtx.ValidatorAddressCodec, importParams, sdk.DefaultPowerReduction, stakingGenState.Validators, sets,
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, key := range skipped {
+				cmd.PrintErrf("skipped key %s of operator %s: %s\n", hexutil.Encode(key.ConsAddr[:]), key.Operator.Hex(), key.Reason)
+			}
+
+			commission := stakingtypes.NewCommissionWithTime(params.MinCommissionRate, math.LegacyOneDec(), math.LegacyOneDec(), appGenesis.GenesisTime)
+			validators := make([]stakingtypes.Validator, 0, len(previews))
+			for _, preview := range previews {
+				validator := preview.Validator
+				if preview.Created {
+					if validator, err = validator.SetInitialCommission(commission); err != nil {
+						return err
+					}
+				}
+				if preview.Stake != nil {
+					anchor.ValidatorsUpdated++
+				}
+				validators = append(validators, validator)
+			}
+			if anchor.ValidatorsUpdated == 0 {
+				return errors.New("no validator imported from the symbiotic middleware validator sets")
+			}
+
+			stakingGenState.Validators = validators
+			stakingGenState.SymbioticAnchor = &anchor
+
+			stakingGenStateBz, err := clientCtx.Codec.MarshalJSON(stakingGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal staking genesis state: %w", err)
+			}
+			appState[stakingtypes.ModuleName] = stakingGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			appGenesis.AppState = appStateJSON
+			if err := genutil.ExportGenesisFile(appGenesis, config.GenesisFile()); err != nil {
+				return err
+			}
+
+			cmd.Printf("Imported %d validators from block %s\n", anchor.ValidatorsUpdated, anchor.BlockHash)
+			return nil
+		},
+	}
+
+	addSymbioticEndpointFlags(cmd)
+	addSymbioticEpochFlag(cmd)
+	cmd.Flags().String(flagSymbioticFixture, "", "File holding the output of symbiotic validator-set to import, instead of reading the middlewares")
+	return cmd
+}
+
+// symbioticGenesisAnchor returns the sync point of the genesis validator set,
+// requiring the validator set of every middleware of the params.
+func symbioticGenesisAnchor(out symbioticValidatorSetsOutput, sets []stakingtypes.SymbioticValidatorSet, params stakingtypes.Params) (stakingtypes.SymbioticSyncPoint, error) {
+	hash, err := hexutil.Decode(out.BlockHash)
+	if err != nil || len(hash) != common.HashLength {
+		return stakingtypes.SymbioticSyncPoint{}, fmt.Errorf("invalid block hash: %q", out.BlockHash)
+	}
+
+	epochs := make(map[common.Address]uint64, len(sets))
+	for _, set := range sets {
+		epochs[set.Middleware] = set.Epoch
+	}
+
+	for _, m := range params.Middlewares() {
+		if _, ok := epochs[common.HexToAddress(m.Address)]; !ok {
+			return stakingtypes.SymbioticSyncPoint{}, fmt.Errorf("missing validator set of middleware %s", m.Address)
+		}
+	}
+
+	return stakingtypes.SymbioticSyncPoint{
+		BlockHash:      common.BytesToHash(hash).Hex(),
+		BlockNumber:    out.BlockNumber,
+		BlockTimestamp: out.BlockTimestamp,
+		Epoch:          epochs[common.HexToAddress(params.Middlewares()[0].Address)],
+	}, nil
+}
+
+// validatorSets decodes the middleware validator sets of the output.
+func (out symbioticValidatorSetsOutput) validatorSets() ([]stakingtypes.SymbioticValidatorSet, error) {
+	sets := make([]stakingtypes.SymbioticValidatorSet, 0, len(out.ValidatorSets))
+	for _, setOut := range out.ValidatorSets {
+		if !common.IsHexAddress(setOut.Middleware) {
+			return nil, fmt.Errorf("invalid middleware address: %q", setOut.Middleware)
+		}
+
+		set := stakingtypes.SymbioticValidatorSet{Middleware: common.HexToAddress(setOut.Middleware), Epoch: setOut.Epoch}
+		for _, keyOut := range setOut.Validators {
+			key, err := hexutil.Decode(keyOut.Key)
+			if err != nil || len(key) != 32 {
+				return nil, fmt.Errorf("invalid key of middleware %s: %q", setOut.Middleware, keyOut.Key)
+			}
+
+			if keyOut.Operator != "" && !common.IsHexAddress(keyOut.Operator) {
+				return nil, fmt.Errorf("invalid operator of key %s: %q", keyOut.Key, keyOut.Operator)
+			}
+
+			stake, ok := new(big.Int).SetString(keyOut.Stake, 10)
+			if !ok {
+				return nil, fmt.Errorf("invalid stake of key %s: %q", keyOut.Key, keyOut.Stake)
+			}
+
+			v := stakingtypes.SymbioticValidator{Stake: stake, Operator: common.HexToAddress(keyOut.Operator)}
+			copy(v.ConsAddr[:], key)
+			set.Validators = append(set.Validators, v)
+		}
+		sets = append(sets, set)
+	}
+
+	return sets, nil
+}
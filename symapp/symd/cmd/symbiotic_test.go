@@ -0,0 +1,106 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/symapp"
+	"cosmossdk.io/symapp/symd/cmd"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/symGenutil/types"
+)
+
+func TestSymbioticCmdInvalidBlockHash(t *testing.T) {
+	for _, subCmd := range []string{"validator-set", "diff", "validator-updates"} {
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs([]string{"symbiotic", subCmd, "0x1234", "--home", t.TempDir()})
+
+		err := svrcmd.Execute(rootCmd, "", symapp.DefaultNodeHome)
+		require.ErrorContains(t, err, "invalid block hash", subCmd)
+	}
+}
+
+func TestImportSymbioticGenesis(t *testing.T) {
+	home := t.TempDir()
+	execute := func(args ...string) error {
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs(append(args, "--home", home))
+		return svrcmd.Execute(rootCmd, "", symapp.DefaultNodeHome)
+	}
+
+	require.NoError(t, execute("init", "symapp-test", "--chain-id", "symapp-test"))
+
+	// the middleware of the validator sets
+	genFile := filepath.Join(home, "config", "genesis.json")
+	middleware := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	setGenesisMiddleware(t, genFile, middleware)
+
+	fixture := filepath.Join(home, "validator-set.json")
+	blockHash := "0x5c2b0a51b2dd4f3d5e1e0d3e4ebd0c1f93e4f1c5f7c6b3ee0d4a1f0b0c9cd1e0"
+	keys := []string{
+		hexutil.Encode(ed25519.GenPrivKey().PubKey().Bytes()),
+		hexutil.Encode(ed25519.GenPrivKey().PubKey().Bytes()),
+	}
+	require.NoError(t, os.WriteFile(fixture, []byte(fmt.Sprintf(`{
+		"block_hash": %q,
+		"block_number": 21000000,
+		"block_timestamp": 1730000000,
+		"validator_sets": [{
+			"middleware": %q,
+			"epoch": 7,
+			"validators": [
+				{"key": %q, "operator": "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", "stake": "2000000"},
+				{"key": %q, "operator": "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC", "stake": "1000000"}
+			]
+		}]
+	}`, blockHash, middleware, keys[0], keys[1])), 0o600))
+
+	require.ErrorContains(t, execute("genesis", "import-symbiotic", blockHash, "--fixture", fixture), "along with a fixture")
+	require.NoError(t, execute("genesis", "import-symbiotic", "--fixture", fixture))
+	require.NoError(t, execute("genesis", "validate"))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	require.NoError(t, err)
+
+	var stakingGenState struct {
+		Validators      []json.RawMessage `json:"validators"`
+		SymbioticAnchor struct {
+			BlockHash         string `json:"block_hash"`
+			Epoch             string `json:"epoch"`
+			ValidatorsUpdated int    `json:"validators_updated"`
+		} `json:"symbiotic_anchor"`
+	}
+	require.NoError(t, json.Unmarshal(appState["symStaking"], &stakingGenState))
+	require.Len(t, stakingGenState.Validators, 2)
+	require.Equal(t, blockHash, stakingGenState.SymbioticAnchor.BlockHash)
+	require.Equal(t, "7", stakingGenState.SymbioticAnchor.Epoch)
+	require.Equal(t, 2, stakingGenState.SymbioticAnchor.ValidatorsUpdated)
+}
+
+// setGenesisMiddleware sets the symbiotic middleware of the staking params of
+// the genesis file, with ed25519 keys.
+func setGenesisMiddleware(t *testing.T, genFile, middleware string) {
+	t.Helper()
+
+	bz, err := os.ReadFile(genFile)
+	require.NoError(t, err)
+
+	var genesis map[string]any
+	require.NoError(t, json.Unmarshal(bz, &genesis))
+
+	params := genesis["app_state"].(map[string]any)["symStaking"].(map[string]any)["params"].(map[string]any)
+	params["symbiotic_middleware_address"] = middleware
+	params["symbiotic_key_type"] = "ed25519"
+
+	bz, err = json.Marshal(genesis)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(genFile, bz, 0o600))
+}
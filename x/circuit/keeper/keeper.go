@@ -73,3 +73,17 @@ func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
 	return !has, err
 }
+
+// DisableMsgs adds the msg URLs to the DisableList, so that they aren't
+// processed until the circuit breaker is reset for them. It has a value
+// receiver so that the keeper provided by depinject can be injected into the
+// modules disabling messages.
+func (k Keeper) DisableMsgs(ctx context.Context, msgURLs ...string) error {
+	for _, msgURL := range msgURLs {
+		if err := k.DisableList.Set(ctx, msgURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
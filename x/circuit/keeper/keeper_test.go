@@ -166,3 +166,23 @@ func TestIterateDisabledList(t *testing.T) {
 	require.Equal(t, mockMsgs[1], returnedDisabled[0])
 	require.Equal(t, mockMsgs[2], returnedDisabled[1])
 }
+
+func TestDisableMsgs(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	require.NoError(t, f.keeper.DisableList.Set(f.ctx, "mockUrl1"))
+
+	// disabling an already disabled msg is a no-op
+	require.NoError(t, f.keeper.DisableMsgs(f.ctx, "mockUrl1", "mockUrl2"))
+
+	for _, url := range []string{"mockUrl1", "mockUrl2"} {
+		allowed, err := f.keeper.IsAllowed(f.ctx, url)
+		require.NoError(t, err)
+		require.False(t, allowed)
+	}
+
+	allowed, err := f.keeper.IsAllowed(f.ctx, "mockUrl3")
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
@@ -171,6 +171,9 @@ func InitCmd(mm genesisMM) *cobra.Command {
 			}
 
 			appGenesis.Consensus.Params.Validator.PubKeyTypes = []string{consensusKey}
+			// symStaking agrees on the Ethereum block to sync the validator set with
+			// in vote extensions
+			appGenesis.Consensus.Params.Feature.VoteExtensionsEnableHeight = initHeight
 
 			if err = symGenutil.ExportGenesisFile(appGenesis, genFile); err != nil {
 				return errorsmod.Wrap(err, "Failed to export genesis file")
@@ -111,8 +111,8 @@ func DeliverGenTxs(
 		}
 	}
 
-	if err := stakingKeeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: initBlockHash, Height: 0}); err != nil {
-		return nil, fmt.Errorf("failed to cache block hash %w", err)
+	if err := stakingKeeper.SetGenesisSymbioticData(ctx, initBlockHash); err != nil {
+		return nil, fmt.Errorf("failed to set genesis symbiotic data: %w", err)
 	}
 
 	return stakingKeeper.BlockValidatorUpdates(ctx)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"cosmossdk.io/core/appmodule"
 	bankexported "cosmossdk.io/x/bank/exported"
-	stakingtypes "cosmossdk.io/x/symStaking/types"
 	"encoding/json"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,7 +12,7 @@ import (
 
 // StakingKeeper defines the expected staking keeper (noalias)
 type StakingKeeper interface {
-	CacheBlockHash(ctx context.Context, blockHash stakingtypes.CachedBlockHash) error
+	SetGenesisSymbioticData(ctx context.Context, blockHash string) error
 	BlockValidatorUpdates(ctx context.Context) ([]appmodule.ValidatorUpdate, error)
 }
 
@@ -0,0 +1,23 @@
+package symRewards
+
+import (
+	"context"
+
+	"cosmossdk.io/x/symRewards/keeper"
+	"cosmossdk.io/x/symRewards/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// BeginBlocker accrues the fees of the previous block and the block provision
+// to the Symbiotic operators, and publishes the distribution of the epoch once
+// a validator set sync is applied.
+func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
+
+	if err := k.AllocateRewards(ctx); err != nil {
+		return err
+	}
+
+	return k.DistributeAfterSync(ctx)
+}
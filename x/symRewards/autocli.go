@@ -0,0 +1,62 @@
+package symRewards
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	rewardsv1beta "cosmossdk.io/api/cosmos/symRewards/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: rewardsv1beta.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current rewards parameters",
+				},
+				{
+					RpcMethod:      "OperatorRewards",
+					Use:            "operator-rewards [operator]",
+					Short:          "Query the rewards accrued by a Symbiotic operator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "operator"}},
+				},
+				{
+					RpcMethod:      "Distribution",
+					Use:            "distribution [epoch]",
+					Short:          "Query the rewards distribution published for a middleware epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch"}},
+				},
+				{
+					RpcMethod: "Distributions",
+					Use:       "distributions",
+					Short:     "Query the published rewards distributions",
+				},
+				{
+					RpcMethod:      "Claim",
+					Use:            "claim [epoch] [operator]",
+					Short:          "Query the claim of a Symbiotic operator in a distribution along with its Merkle proof",
+					Example:        fmt.Sprintf("%s query rewards claim 3 0x5b38da6a701c568545dcfcb03fcb875f56beddc4", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch"}, {ProtoField: "operator"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: rewardsv1beta.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update rewards module params. Note: the entire params must be provided.",
+					Example:        fmt.Sprintf(`%s tx rewards update-params-proposal '{ "inflation_rate": "0.05", "blocks_per_year": "6311520" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
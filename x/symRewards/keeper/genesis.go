@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/symRewards/types"
+)
+
+// InitGenesis initializes the parameters, the rewards of the operators and the
+// published distributions.
+func (keeper Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, rewards := range data.OperatorRewards {
+		key, err := operatorKey(rewards.Operator)
+		if err != nil {
+			return err
+		}
+		rewards.Operator = key
+		if err := keeper.OperatorRewards.Set(ctx, key, rewards); err != nil {
+			return err
+		}
+	}
+
+	for _, distribution := range data.Distributions {
+		if err := keeper.Distributions.Set(ctx, distribution.Epoch, distribution); err != nil {
+			return err
+		}
+	}
+
+	for _, claim := range data.Claims {
+		key, err := operatorKey(claim.Operator)
+		if err != nil {
+			return err
+		}
+		claim.Operator = key
+		if err := keeper.Claims.Set(ctx, collections.Join(claim.Epoch, key), claim); err != nil {
+			return err
+		}
+	}
+
+	return keeper.Params.Set(ctx, data.Params)
+}
+
+// ExportGenesis writes the current store values
+// to a genesis file, which can be imported again
+// with InitGenesis
+func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorRewards := make([]types.OperatorRewards, 0)
+	err = keeper.OperatorRewards.Walk(ctx, nil, func(_ string, rewards types.OperatorRewards) (bool, error) {
+		operatorRewards = append(operatorRewards, rewards)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	distributions := make([]types.RewardsDistribution, 0)
+	err = keeper.Distributions.Walk(ctx, nil, func(_ uint64, distribution types.RewardsDistribution) (bool, error) {
+		distributions = append(distributions, distribution)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims := make([]types.RewardsClaim, 0)
+	err = keeper.Claims.Walk(ctx, nil, func(_ collections.Pair[uint64, string], claim types.RewardsClaim) (bool, error) {
+		claims = append(claims, claim)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, operatorRewards, distributions, claims), nil
+}
@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	rewardstypes "cosmossdk.io/x/symRewards/types"
+)
+
+func (s *KeeperTestSuite) TestExportAndInitGenesis() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	root := make([]byte, 32)
+	root[0] = 1
+	genesis := rewardstypes.NewGenesisState(
+		rewardstypes.NewParams(math.LegacyNewDecWithPrec(2, 2), 1000),
+		[]rewardstypes.OperatorRewards{
+			{Operator: operatorA.Hex(), Pending: math.LegacyNewDecWithPrec(5, 1), Distributed: math.NewInt(10)},
+		},
+		[]rewardstypes.RewardsDistribution{
+			{Epoch: 2, Height: 5, MerkleRoot: root, TotalAmount: math.NewInt(10), Claims: 1},
+		},
+		[]rewardstypes.RewardsClaim{
+			{Epoch: 2, Operator: operatorA.Hex(), Amount: math.NewInt(10)},
+		},
+	)
+	require.NoError(rewardstypes.ValidateGenesis(*genesis))
+	require.NoError(keeper.InitGenesis(ctx, genesis))
+
+	exported, err := keeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Equal(genesis, exported)
+
+	genesis.Claims[0].Epoch = 3
+	require.ErrorContains(rewardstypes.ValidateGenesis(*genesis), "claim of unknown distribution")
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/symRewards/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params returns parameters of x/symRewards module
+func (k Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// OperatorRewards returns the rewards accrued by a Symbiotic operator.
+func (k Querier) OperatorRewards(ctx context.Context, req *types.QueryOperatorRewardsRequest) (*types.QueryOperatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	key, err := operatorKey(req.Operator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rewards, err := k.Keeper.OperatorRewards.Get(ctx, key)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no rewards for operator %s", key)
+	}
+
+	return &types.QueryOperatorRewardsResponse{Rewards: rewards}, nil
+}
+
+// Distribution returns the distribution published for an epoch.
+func (k Querier) Distribution(ctx context.Context, req *types.QueryDistributionRequest) (*types.QueryDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	distribution, err := k.Keeper.Distributions.Get(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no distribution at epoch %d", req.Epoch)
+	}
+
+	return &types.QueryDistributionResponse{Distribution: distribution}, nil
+}
+
+// Distributions returns the published distributions.
+func (k Querier) Distributions(ctx context.Context, req *types.QueryDistributionsRequest) (*types.QueryDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	distributions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.Distributions,
+		req.Pagination,
+		func(_ uint64, distribution types.RewardsDistribution) (types.RewardsDistribution, error) {
+			return distribution, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDistributionsResponse{Distributions: distributions, Pagination: pageRes}, nil
+}
+
+// Claim returns the claim of a Symbiotic operator in the distribution of an
+// epoch along with its Merkle proof.
+func (k Querier) Claim(ctx context.Context, req *types.QueryClaimRequest) (*types.QueryClaimResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	distribution, err := k.Keeper.Distributions.Get(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no distribution at epoch %d", req.Epoch)
+	}
+
+	claim, leaf, proof, err := k.GetClaimProof(ctx, req.Epoch, req.Operator)
+	if errors.Is(err, types.ErrInvalidOperator) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no claim of operator %s at epoch %d", req.Operator, req.Epoch)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClaimResponse{Claim: claim, Leaf: leaf, Proof: proof, MerkleRoot: distribution.MerkleRoot}, nil
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/x/symRewards/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Keeper of the rewards store
+type Keeper struct {
+	appmodule.Environment
+
+	cdc              codec.BinaryCodec
+	ak               types.AccountKeeper
+	bk               types.BankKeeper
+	sk               types.StakingKeeper
+	feeCollectorName string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+	Schema    collections.Schema
+	Params    collections.Item[types.Params]
+	// OperatorRewards key: operator hex address | value: OperatorRewards
+	OperatorRewards collections.Map[string, types.OperatorRewards]
+	// Distributions key: epoch | value: RewardsDistribution
+	Distributions collections.Map[uint64, types.RewardsDistribution]
+	// Claims key: epoch+operator hex address | value: RewardsClaim
+	Claims collections.Map[collections.Pair[uint64, string], types.RewardsClaim]
+}
+
+// NewKeeper creates a rewards keeper
+func NewKeeper(
+	environment appmodule.Environment,
+	cdc codec.BinaryCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	feeCollectorName string,
+	authority string,
+) Keeper {
+	// ensure the rewards module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(environment.KVStoreService)
+	k := Keeper{
+		Environment:      environment,
+		cdc:              cdc,
+		ak:               ak,
+		bk:               bk,
+		sk:               sk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		OperatorRewards: collections.NewMap(
+			sb,
+			types.OperatorRewardsKeyPrefix,
+			"operator_rewards",
+			collections.StringKey,
+			codec.CollValue[types.OperatorRewards](cdc),
+		),
+		Distributions: collections.NewMap(
+			sb,
+			types.DistributionsKeyPrefix,
+			"distributions",
+			collections.Uint64Key,
+			codec.CollValue[types.RewardsDistribution](cdc),
+		),
+		Claims: collections.NewMap(
+			sb,
+			types.ClaimsKeyPrefix,
+			"claims",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			codec.CollValue[types.RewardsClaim](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/symRewards module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// operatorKey returns the checksummed hex address the rewards of operator are
+// stored by.
+func operatorKey(operator string) (string, error) {
+	if !common.IsHexAddress(operator) {
+		return "", types.ErrInvalidOperator.Wrapf("%q", operator)
+	}
+	return common.HexToAddress(operator).Hex(), nil
+}
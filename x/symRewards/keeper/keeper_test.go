@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	rewardskeeper "cosmossdk.io/x/symRewards/keeper"
+	rewardstestutil "cosmossdk.io/x/symRewards/testutil"
+	rewardstypes "cosmossdk.io/x/symRewards/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	accountKeeper *rewardstestutil.MockAccountKeeper
+	bankKeeper    *rewardstestutil.MockBankKeeper
+	stakingKeeper *rewardstestutil.MockStakingKeeper
+	rewardsKeeper rewardskeeper.Keeper
+	queryClient   rewardstypes.QueryClient
+	msgServer     rewardstypes.MsgServer
+	feeCollector  sdk.AccAddress
+	authorityAddr string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(rewardstypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger())
+	testCtx := sdktestutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Now().Round(0).UTC()})
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = rewardstestutil.NewMockAccountKeeper(ctrl)
+	s.bankKeeper = rewardstestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = rewardstestutil.NewMockStakingKeeper(ctrl)
+
+	s.feeCollector = authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	s.accountKeeper.EXPECT().GetModuleAddress(rewardstypes.ModuleName).Return(authtypes.NewModuleAddress(rewardstypes.ModuleName)).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(s.feeCollector).AnyTimes()
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+
+	authStr, err := address.NewBech32Codec("cosmos").BytesToString(authtypes.NewModuleAddress(rewardstypes.GovModuleName))
+	s.Require().NoError(err)
+	s.authorityAddr = authStr
+
+	s.ctx = ctx
+	s.rewardsKeeper = rewardskeeper.NewKeeper(
+		env,
+		encCfg.Codec,
+		s.accountKeeper,
+		s.bankKeeper,
+		s.stakingKeeper,
+		authtypes.FeeCollectorName,
+		authStr,
+	)
+	s.Require().NoError(s.rewardsKeeper.Params.Set(ctx, rewardstypes.DefaultParams()))
+
+	rewardstypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
+	rewardstypes.RegisterQueryServer(queryHelper, rewardskeeper.NewQuerier(s.rewardsKeeper))
+
+	s.queryClient = rewardstypes.NewQueryClient(queryHelper)
+	s.msgServer = rewardskeeper.NewMsgServerImpl(s.rewardsKeeper)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	require := s.Require()
+
+	params := rewardstypes.DefaultParams()
+	params.BlocksPerYear = 0
+	_, err := s.msgServer.UpdateParams(s.ctx, &rewardstypes.MsgUpdateParams{Authority: s.authorityAddr, Params: params})
+	require.ErrorContains(err, "blocks per year must be positive")
+
+	params = rewardstypes.NewParams(rewardstypes.DefaultInflationRate.QuoInt64(2), 1000)
+	_, err = s.msgServer.UpdateParams(s.ctx, &rewardstypes.MsgUpdateParams{Authority: "invalid", Params: params})
+	require.ErrorIs(err, rewardstypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &rewardstypes.MsgUpdateParams{Authority: s.authorityAddr, Params: params})
+	require.NoError(err)
+
+	res, err := s.queryClient.Params(s.ctx, &rewardstypes.QueryParamsRequest{})
+	require.NoError(err)
+	require.Equal(params, res.Params)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/symRewards/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllocateRewards collects the fees of the previous block and mints the block
+// provision, and accrues them to the Symbiotic operators of the bonded
+// validators in proportion to their tokens, i.e. their weighted Symbiotic
+// stake. Nothing is collected nor minted if no bonded validator has an
+// operator. Only the bond denom is collected.
+//
+// The rewards are not split with the stakers of the vaults of the operators,
+// whose shares aren't synced: the operators must redistribute them.
+func (k Keeper) AllocateRewards(ctx context.Context) error {
+	validators, err := k.sk.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return err
+	}
+
+	// only the validators with an operator can claim on Ethereum
+	eligible := make([]stakingtypes.Validator, 0, len(validators))
+	totalTokens := math.ZeroInt()
+	for _, val := range validators {
+		if val.SymbioticOperator == "" || !val.Tokens.IsPositive() {
+			continue
+		}
+		eligible = append(eligible, val)
+		totalTokens = totalTokens.Add(val.Tokens)
+	}
+
+	if len(eligible) == 0 {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	bondDenom, err := k.sk.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	provision := params.BlockProvision(k.bk.GetSupply(ctx, bondDenom).Amount)
+	if provision.IsPositive() {
+		if err := k.bk.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(bondDenom, provision))); err != nil {
+			return err
+		}
+	}
+
+	fees := k.bk.GetBalance(ctx, k.ak.GetModuleAddress(k.feeCollectorName), bondDenom)
+	if fees.IsPositive() {
+		if err := k.bk.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, sdk.NewCoins(fees)); err != nil {
+			return err
+		}
+	}
+
+	total := provision.Add(fees.Amount)
+	if !total.IsPositive() {
+		return nil
+	}
+
+	for _, val := range eligible {
+		share := math.LegacyNewDecFromInt(total).MulInt(val.Tokens).QuoInt(totalTokens)
+		if err := k.accrueRewards(ctx, val.SymbioticOperator, share); err != nil {
+			return err
+		}
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRewards,
+		event.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(bondDenom, total).String()),
+		event.NewAttribute(types.AttributeKeyFees, fees.String()),
+	)
+}
+
+// accrueRewards adds amount to the pending rewards of operator.
+func (k Keeper) accrueRewards(ctx context.Context, operator string, amount math.LegacyDec) error {
+	key, err := operatorKey(operator)
+	if err != nil {
+		return err
+	}
+
+	rewards, err := k.OperatorRewards.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		rewards = types.OperatorRewards{Operator: key, Pending: math.LegacyZeroDec(), Distributed: math.ZeroInt()}
+	} else if err != nil {
+		return err
+	}
+
+	rewards.Pending = rewards.Pending.Add(amount)
+	return k.OperatorRewards.Set(ctx, key, rewards)
+}
+
+// DistributeRewards moves the whole pending rewards of every operator to its
+// distributed rewards and publishes the distribution of the epoch, the Merkle
+// root of the total rewards distributed to each operator so far. The claims
+// are cumulative, so the distributor contract pays out the difference with the
+// amount already claimed, and a later distribution of the same epoch replaces
+// the previous one.
+func (k Keeper) DistributeRewards(ctx context.Context, epoch uint64) error {
+	var operators []types.OperatorRewards
+	err := k.OperatorRewards.Walk(ctx, nil, func(_ string, rewards types.OperatorRewards) (bool, error) {
+		operators = append(operators, rewards)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var claims []types.RewardsClaim
+	for _, rewards := range operators {
+		amount := rewards.Pending.TruncateInt()
+		if amount.IsPositive() {
+			rewards.Pending = rewards.Pending.Sub(math.LegacyNewDecFromInt(amount))
+			rewards.Distributed = rewards.Distributed.Add(amount)
+			if err := k.OperatorRewards.Set(ctx, rewards.Operator, rewards); err != nil {
+				return err
+			}
+		}
+
+		if rewards.Distributed.IsPositive() {
+			claims = append(claims, types.RewardsClaim{Epoch: epoch, Operator: rewards.Operator, Amount: rewards.Distributed})
+		}
+	}
+
+	leaves := make([][]byte, 0, len(claims))
+	total := math.ZeroInt()
+	for _, claim := range claims {
+		leaf, err := types.ClaimLeaf(claim.Operator, claim.Amount)
+		if err != nil {
+			return err
+		}
+		leaves = append(leaves, leaf)
+		total = total.Add(claim.Amount)
+
+		if err := k.Claims.Set(ctx, collections.Join(epoch, claim.Operator), claim); err != nil {
+			return err
+		}
+	}
+
+	distribution := types.RewardsDistribution{
+		Epoch:       epoch,
+		Height:      k.HeaderService.HeaderInfo(ctx).Height,
+		MerkleRoot:  types.NewMerkleTree(leaves).Root(),
+		TotalAmount: total,
+		Claims:      uint64(len(claims)),
+	}
+	if err := k.Distributions.Set(ctx, epoch, distribution); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeDistribution,
+		event.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+		event.NewAttribute(types.AttributeKeyMerkleRoot, common.Bytes2Hex(distribution.MerkleRoot)),
+		event.NewAttribute(types.AttributeKeyTotalAmount, total.String()),
+		event.NewAttribute(types.AttributeKeyClaims, strconv.FormatUint(distribution.Claims, 10)),
+	)
+}
+
+// GetClaimProof returns the claim of operator in the distribution of epoch
+// along with its Merkle leaf and proof.
+func (k Keeper) GetClaimProof(ctx context.Context, epoch uint64, operator string) (types.RewardsClaim, []byte, [][]byte, error) {
+	key, err := operatorKey(operator)
+	if err != nil {
+		return types.RewardsClaim{}, nil, nil, err
+	}
+
+	claim, err := k.Claims.Get(ctx, collections.Join(epoch, key))
+	if err != nil {
+		return types.RewardsClaim{}, nil, nil, err
+	}
+
+	var leaves [][]byte
+	err = k.Claims.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](epoch), func(_ collections.Pair[uint64, string], c types.RewardsClaim) (bool, error) {
+		leaf, err := types.ClaimLeaf(c.Operator, c.Amount)
+		if err != nil {
+			return true, err
+		}
+		leaves = append(leaves, leaf)
+		return false, nil
+	})
+	if err != nil {
+		return types.RewardsClaim{}, nil, nil, err
+	}
+
+	leaf, err := types.ClaimLeaf(claim.Operator, claim.Amount)
+	if err != nil {
+		return types.RewardsClaim{}, nil, nil, err
+	}
+
+	proof, ok := types.NewMerkleTree(leaves).Proof(leaf)
+	if !ok {
+		return types.RewardsClaim{}, nil, nil, types.ErrClaimNotInTree.Wrapf("operator %s at epoch %d", operator, epoch)
+	}
+
+	return claim, leaf, proof, nil
+}
+
+// DistributeAfterSync publishes the distribution of the epoch synced at the
+// previous height, if its validator set was applied.
+func (k Keeper) DistributeAfterSync(ctx context.Context) error {
+	height := k.HeaderService.HeaderInfo(ctx).Height - 1
+	if height <= 0 {
+		return nil
+	}
+
+	syncPoint, err := k.sk.GetSymbioticSyncPoint(ctx, height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if syncPoint.SkipReason != "" {
+		return nil
+	}
+
+	return k.DistributeRewards(ctx, syncPoint.Epoch)
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	rewardstypes "cosmossdk.io/x/symRewards/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	operatorA = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	operatorB = common.HexToAddress("0x00000000000000000000000000000000000000b1")
+)
+
+// expectAllocation sets the expectations of an allocation of the given fees
+// and a provision of 1 to the bonded validators.
+func (s *KeeperTestSuite) expectAllocation(fees int64) {
+	s.stakingKeeper.EXPECT().GetBondedValidatorsByPower(s.ctx).Return([]stakingtypes.Validator{
+		{SymbioticOperator: operatorA.Hex(), Tokens: math.NewInt(300)},
+		{Tokens: math.NewInt(600)},
+		{SymbioticOperator: operatorB.Hex(), Tokens: math.NewInt(100)},
+	}, nil)
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, rewardstypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))).Return(nil)
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, s.feeCollector, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, fees))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, authtypes.FeeCollectorName, rewardstypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, fees))).Return(nil)
+}
+
+func (s *KeeperTestSuite) TestAllocateAndDistributeRewards() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	// a provision of 1 per block
+	require.NoError(keeper.Params.Set(ctx, rewardstypes.NewParams(math.LegacyNewDecWithPrec(1, 1), 100)))
+
+	// the validator without operator gets nothing
+	s.expectAllocation(399)
+	require.NoError(keeper.AllocateRewards(ctx))
+
+	rewards, err := keeper.OperatorRewards.Get(ctx, operatorA.Hex())
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(300), rewards.Pending)
+	require.True(rewards.Distributed.IsZero())
+
+	// nothing is collected without validators with an operator
+	s.stakingKeeper.EXPECT().GetBondedValidatorsByPower(ctx).Return([]stakingtypes.Validator{{Tokens: math.NewInt(600)}}, nil)
+	require.NoError(keeper.AllocateRewards(ctx))
+
+	require.NoError(keeper.DistributeRewards(ctx, 3))
+
+	res, err := s.queryClient.OperatorRewards(ctx, &rewardstypes.QueryOperatorRewardsRequest{Operator: "0x00000000000000000000000000000000000000B1"})
+	require.NoError(err)
+	require.True(res.Rewards.Pending.IsZero())
+	require.Equal(math.NewInt(100), res.Rewards.Distributed)
+
+	distribution, err := s.queryClient.Distribution(ctx, &rewardstypes.QueryDistributionRequest{Epoch: 3})
+	require.NoError(err)
+	require.Equal(math.NewInt(400), distribution.Distribution.TotalAmount)
+	require.Equal(uint64(2), distribution.Distribution.Claims)
+	require.Equal(int64(10), distribution.Distribution.Height)
+
+	claim, err := s.queryClient.Claim(ctx, &rewardstypes.QueryClaimRequest{Epoch: 3, Operator: operatorA.Hex()})
+	require.NoError(err)
+	require.Equal(math.NewInt(300), claim.Claim.Amount)
+	require.Equal(distribution.Distribution.MerkleRoot, claim.MerkleRoot)
+	require.True(rewardstypes.VerifyMerkleProof(claim.Proof, claim.MerkleRoot, claim.Leaf))
+
+	// the claims are cumulative and a later distribution of the same epoch
+	// replaces the previous one, the remainders stay pending
+	s.expectAllocation(4)
+	require.NoError(keeper.AllocateRewards(ctx))
+	require.NoError(keeper.DistributeRewards(ctx, 3))
+
+	claim, err = s.queryClient.Claim(ctx, &rewardstypes.QueryClaimRequest{Epoch: 3, Operator: operatorA.Hex()})
+	require.NoError(err)
+	require.Equal(math.NewInt(303), claim.Claim.Amount)
+	require.True(rewardstypes.VerifyMerkleProof(claim.Proof, claim.MerkleRoot, claim.Leaf))
+
+	rewards, err = keeper.OperatorRewards.Get(ctx, operatorB.Hex())
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(25, 2), rewards.Pending)
+	require.Equal(math.NewInt(101), rewards.Distributed)
+
+	distributions, err := s.queryClient.Distributions(ctx, &rewardstypes.QueryDistributionsRequest{})
+	require.NoError(err)
+	require.Len(distributions.Distributions, 1)
+	require.Equal(math.NewInt(404), distributions.Distributions[0].TotalAmount)
+
+	_, err = s.queryClient.Claim(ctx, &rewardstypes.QueryClaimRequest{Epoch: 4, Operator: operatorA.Hex()})
+	require.ErrorContains(err, "no distribution at epoch 4")
+
+	_, err = s.queryClient.Claim(ctx, &rewardstypes.QueryClaimRequest{Epoch: 3, Operator: common.HexToAddress("0x01").Hex()})
+	require.ErrorContains(err, "no claim of operator")
+
+	_, err = s.queryClient.Claim(ctx, &rewardstypes.QueryClaimRequest{Epoch: 3, Operator: "operator"})
+	require.ErrorContains(err, "invalid symbiotic operator address")
+}
+
+func (s *KeeperTestSuite) TestDistributeAfterSync() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	s.stakingKeeper.EXPECT().GetSymbioticSyncPoint(ctx, int64(9)).Return(stakingtypes.SymbioticSyncPoint{}, collections.ErrNotFound)
+	require.NoError(keeper.DistributeAfterSync(ctx))
+
+	s.stakingKeeper.EXPECT().GetSymbioticSyncPoint(ctx, int64(9)).Return(stakingtypes.SymbioticSyncPoint{
+		Height:     9,
+		SkipReason: stakingtypes.SyncSkipReasonNoAgreement,
+	}, nil)
+	require.NoError(keeper.DistributeAfterSync(ctx))
+
+	has, err := keeper.Distributions.Has(ctx, 0)
+	require.NoError(err)
+	require.False(has)
+
+	s.stakingKeeper.EXPECT().GetSymbioticSyncPoint(ctx, int64(9)).Return(stakingtypes.SymbioticSyncPoint{Height: 9, Epoch: 5}, nil)
+	require.NoError(keeper.DistributeAfterSync(ctx))
+
+	distribution, err := keeper.Distributions.Get(ctx, 5)
+	require.NoError(err)
+	require.Zero(distribution.Claims)
+	require.Equal(make([]byte, 32), distribution.MerkleRoot)
+}
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/symRewards module sentinel errors
+var (
+	ErrInvalidSigner   = errors.Register(ModuleName, 2, "expected authority account as only signer for proposal message")
+	ErrInvalidOperator = errors.Register(ModuleName, 3, "invalid symbiotic operator address")
+	ErrClaimNotInTree  = errors.Register(ModuleName, 4, "claim not in the distribution merkle tree")
+)
@@ -0,0 +1,14 @@
+package types
+
+// Rewards module event types
+const (
+	EventTypeRewards      = "rewards"
+	EventTypeDistribution = "rewards_distribution"
+
+	AttributeKeyAmount      = "amount"
+	AttributeKeyFees        = "fees"
+	AttributeKeyEpoch       = "epoch"
+	AttributeKeyMerkleRoot  = "merkle_root"
+	AttributeKeyTotalAmount = "total_amount"
+	AttributeKeyClaims      = "claims"
+)
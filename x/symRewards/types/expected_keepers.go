@@ -0,0 +1,39 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper expected account keeper
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
+}
+
+// BankKeeper defines the expected interface needed to collect fees and mint
+// rewards.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// StakingKeeper expected staking keeper
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+
+	// GetBondedValidatorsByPower returns the bonded validators sorted by
+	// decreasing power.
+	GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error)
+
+	// GetSymbioticSyncPoint returns the sync point stored at the given sync
+	// height.
+	GetSymbioticSyncPoint(ctx context.Context, height int64) (stakingtypes.SymbioticSyncPoint, error)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(
+	params Params, operatorRewards []OperatorRewards, distributions []RewardsDistribution, claims []RewardsClaim,
+) *GenesisState {
+	return &GenesisState{
+		Params:          params,
+		OperatorRewards: operatorRewards,
+		Distributions:   distributions,
+		Claims:          claims,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, without rewards.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []OperatorRewards{}, []RewardsDistribution{}, []RewardsClaim{})
+}
+
+// ValidateGenesis validates the rewards genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	operators := make(map[common.Address]bool, len(data.OperatorRewards))
+	for _, rewards := range data.OperatorRewards {
+		if !common.IsHexAddress(rewards.Operator) {
+			return ErrInvalidOperator.Wrapf("%q", rewards.Operator)
+		}
+		operator := common.HexToAddress(rewards.Operator)
+		if operators[operator] {
+			return fmt.Errorf("duplicate operator rewards: %s", rewards.Operator)
+		}
+		operators[operator] = true
+
+		if rewards.Pending.IsNil() || rewards.Pending.IsNegative() {
+			return fmt.Errorf("invalid pending rewards of %s: %s", rewards.Operator, rewards.Pending)
+		}
+		if rewards.Distributed.IsNil() || rewards.Distributed.IsNegative() {
+			return fmt.Errorf("invalid distributed rewards of %s: %s", rewards.Operator, rewards.Distributed)
+		}
+	}
+
+	epochs := make(map[uint64]bool, len(data.Distributions))
+	for _, distribution := range data.Distributions {
+		if epochs[distribution.Epoch] {
+			return fmt.Errorf("duplicate distribution: %d", distribution.Epoch)
+		}
+		epochs[distribution.Epoch] = true
+
+		if len(distribution.MerkleRoot) != common.HashLength {
+			return fmt.Errorf("invalid merkle root of distribution %d", distribution.Epoch)
+		}
+	}
+
+	for _, claim := range data.Claims {
+		if !epochs[claim.Epoch] {
+			return fmt.Errorf("claim of unknown distribution: %d", claim.Epoch)
+		}
+		if !common.IsHexAddress(claim.Operator) {
+			return ErrInvalidOperator.Wrapf("%q", claim.Operator)
+		}
+		if claim.Amount.IsNil() || claim.Amount.IsNegative() {
+			return fmt.Errorf("invalid claim of %s: %s", claim.Operator, claim.Amount)
+		}
+	}
+
+	return nil
+}
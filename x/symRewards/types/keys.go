@@ -0,0 +1,36 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "symRewards"
+
+	// StoreKey is the store key string for rewards
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for rewards
+	RouterKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	GovModuleName = "symGov"
+)
+
+// Keys for rewards store
+// Items are stored with the following key: values
+//
+// - 0x00: Params
+//
+// - 0x01<operator>: OperatorRewards
+//
+// - 0x02<epoch (8 Bytes)>: RewardsDistribution
+//
+// - 0x03<epoch (8 Bytes)><operator>: RewardsClaim
+
+var (
+	ParamsKey                = collections.NewPrefix(0) // Prefix for params key
+	OperatorRewardsKeyPrefix = collections.NewPrefix(1) // Prefix for the rewards accrued by each operator
+	DistributionsKeyPrefix   = collections.NewPrefix(2) // Prefix for the distributions, by epoch
+	ClaimsKeyPrefix          = collections.NewPrefix(3) // Prefix for the claims of each distribution, by epoch and operator
+)
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/math"
+)
+
+// claimArguments returns the encoding of a claim hashed into its leaf.
+func claimArguments() (abi.Arguments, error) {
+	addressType, err := abi.NewType("address", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	amountType, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Arguments{{Name: "operator", Type: addressType}, {Name: "amount", Type: amountType}}, nil
+}
+
+// ClaimLeaf returns the Merkle leaf of a claim,
+// keccak256(bytes.concat(keccak256(abi.encode(address operator, uint256 amount)))),
+// as computed by the OpenZeppelin standard Merkle tree.
+func ClaimLeaf(operator string, amount math.Int) ([]byte, error) {
+	if !common.IsHexAddress(operator) {
+		return nil, ErrInvalidOperator.Wrapf("%q", operator)
+	}
+
+	if amount.IsNegative() {
+		return nil, fmt.Errorf("negative claim amount: %s", amount)
+	}
+
+	arguments, err := claimArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := arguments.Pack(common.HexToAddress(operator), amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Keccak256(crypto.Keccak256(bz)), nil
+}
+
+// MerkleTree is a binary Merkle tree of sorted leaves whose nodes hash their
+// sorted children, so that its proofs verify with the OpenZeppelin MerkleProof
+// library. A node without sibling is carried to the next level as is.
+type MerkleTree struct {
+	levels [][][]byte
+}
+
+// NewMerkleTree returns the Merkle tree of leaves.
+func NewMerkleTree(leaves [][]byte) MerkleTree {
+	level := make([][]byte, len(leaves))
+	copy(level, leaves)
+	sort.Slice(level, func(i, j int) bool { return bytes.Compare(level[i], level[j]) < 0 })
+
+	tree := MerkleTree{levels: [][][]byte{level}}
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashPair(level[i], level[i+1]))
+		}
+		tree.levels = append(tree.levels, next)
+		level = next
+	}
+
+	return tree
+}
+
+// Root returns the root of the tree, the zero hash if it has no leaves.
+func (t MerkleTree) Root() []byte {
+	top := t.levels[len(t.levels)-1]
+	if len(top) == 0 {
+		return make([]byte, common.HashLength)
+	}
+	return top[0]
+}
+
+// Proof returns the proof of leaf, the siblings of the path from leaf to the
+// root, or false if leaf isn't in the tree.
+func (t MerkleTree) Proof(leaf []byte) ([][]byte, bool) {
+	leaves := t.levels[0]
+	index := sort.Search(len(leaves), func(i int) bool { return bytes.Compare(leaves[i], leaf) >= 0 })
+	if index == len(leaves) || !bytes.Equal(leaves[index], leaf) {
+		return nil, false
+	}
+
+	proof := [][]byte{}
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+
+	return proof, true
+}
+
+// VerifyMerkleProof returns true if proof proves that leaf is in the tree of
+// the given root.
+func VerifyMerkleProof(proof [][]byte, root, leaf []byte) bool {
+	hash := leaf
+	for _, sibling := range proof {
+		hash = hashPair(hash, sibling)
+	}
+	return bytes.Equal(hash, root)
+}
+
+// hashPair returns the keccak256 hash of the sorted concatenation of a and b.
+func hashPair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256(a, b)
+}
@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/symRewards/types"
+)
+
+func TestClaimLeaf(t *testing.T) {
+	operator := common.HexToAddress("0x5B38Da6a701c568545dCfcB03FcB875f56beddC4")
+
+	leaf, err := types.ClaimLeaf(operator.Hex(), math.NewInt(100))
+	require.NoError(t, err)
+
+	// abi.encode(address, uint256) is the left padded address and amount
+	encoded := append(common.LeftPadBytes(operator.Bytes(), 32), common.LeftPadBytes(big.NewInt(100).Bytes(), 32)...)
+	require.Equal(t, crypto.Keccak256(crypto.Keccak256(encoded)), leaf)
+
+	_, err = types.ClaimLeaf("operator", math.NewInt(100))
+	require.ErrorIs(t, err, types.ErrInvalidOperator)
+
+	_, err = types.ClaimLeaf(operator.Hex(), math.NewInt(-1))
+	require.Error(t, err)
+}
+
+func TestMerkleTree(t *testing.T) {
+	require.Equal(t, make([]byte, 32), types.NewMerkleTree(nil).Root())
+
+	for n := 1; n <= 7; n++ {
+		var leaves [][]byte
+		for i := 0; i < n; i++ {
+			leaf, err := types.ClaimLeaf(common.BigToAddress(big.NewInt(int64(i+1))).Hex(), math.NewInt(int64(i+1)*10))
+			require.NoError(t, err)
+			leaves = append(leaves, leaf)
+		}
+
+		tree := types.NewMerkleTree(leaves)
+		for _, leaf := range leaves {
+			proof, ok := tree.Proof(leaf)
+			require.True(t, ok)
+			require.True(t, types.VerifyMerkleProof(proof, tree.Root(), leaf))
+		}
+
+		// the root doesn't depend on the order of the leaves
+		reversed := make([][]byte, n)
+		for i, leaf := range leaves {
+			reversed[n-1-i] = leaf
+		}
+		require.Equal(t, tree.Root(), types.NewMerkleTree(reversed).Root())
+
+		other, err := types.ClaimLeaf(common.BigToAddress(big.NewInt(1)).Hex(), math.NewInt(11))
+		require.NoError(t, err)
+		_, ok := tree.Proof(other)
+		require.False(t, ok)
+		proof, _ := tree.Proof(leaves[0])
+		require.False(t, types.VerifyMerkleProof(proof, tree.Root(), other))
+	}
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultBlocksPerYear assumes a block every 5 seconds.
+const DefaultBlocksPerYear = uint64(60 * 60 * 8766 / 5)
+
+// DefaultInflationRate is the default annual inflation of the bond denom.
+var DefaultInflationRate = math.LegacyNewDecWithPrec(5, 2)
+
+// NewParams creates a new Params object
+func NewParams(inflationRate math.LegacyDec, blocksPerYear uint64) Params {
+	return Params{
+		InflationRate: inflationRate,
+		BlocksPerYear: blocksPerYear,
+	}
+}
+
+// DefaultParams defines the parameters for this module
+func DefaultParams() Params {
+	return NewParams(DefaultInflationRate, DefaultBlocksPerYear)
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	if err := validateInflationRate(p.InflationRate); err != nil {
+		return err
+	}
+	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateInflationRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("inflation rate cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("inflation rate cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("inflation rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateBlocksPerYear(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("blocks per year must be positive: %d", v)
+	}
+
+	return nil
+}
+
+// BlockProvision returns the rewards minted per block for the given supply of
+// the bond denom.
+func (p Params) BlockProvision(supply math.Int) math.Int {
+	return p.InflationRate.MulInt(supply).QuoInt64(int64(p.BlocksPerYear)).TruncateInt()
+}
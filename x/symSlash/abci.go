@@ -15,6 +15,19 @@ import (
 func BeginBlocker(ctx context.Context, k keeper.Keeper, cometService comet.Service) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
 
+	ci := cometService.CometInfo(ctx)
+
+	// Handle the double-signs committed by CometBFT. Light client attacks
+	// aren't handled as they can't be attributed to a single validator.
+	for _, evidence := range ci.Evidence {
+		if evidence.Type != comet.DuplicateVote {
+			continue
+		}
+		if err := k.HandleEquivocationEvidence(ctx, evidence); err != nil {
+			return err
+		}
+	}
+
 	// Iterate over all the validators which *should* have signed this block
 	// store whether or not they have actually signed it and slash/unbond any
 	// which have missed too many blocks in a row (downtime slashing)
@@ -22,7 +35,6 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, cometService comet.Servi
 	if err != nil {
 		return err
 	}
-	ci := cometService.CometInfo(ctx)
 	for _, vote := range ci.LastCommit.Votes {
 		err := k.HandleValidatorSignatureWithParams(ctx, params, vote.Validator.Address, vote.Validator.Power, vote.BlockIDFlag)
 		if err != nil {
@@ -34,6 +34,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod:      "SlashRequest",
+					Use:            "slash-request [id]",
+					Short:          "Query a slash request of the outbox by its hex encoded ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "SlashRequests",
+					Use:       "slash-requests",
+					Short:     "Query the slash requests of the outbox, optionally with a given status",
+					Example:   fmt.Sprintf("%s query slashing slash-requests --status SLASH_REQUEST_STATUS_PENDING", version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -54,7 +66,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "CompleteSlashRequest",
+					Use:            "complete-slash-request [id] [tx-hash]",
+					Short:          "Mark a slash request completed once its slash is observed in the Ethereum transaction tx-hash",
+					Example:        fmt.Sprintf("%s tx slashing complete-slash-request 6f1c...e2 0x9a3b...41 --from relayer", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "tx_hash"}},
+				},
 			},
+			EnhanceCustomCommand: true,
 		},
 	}
 }
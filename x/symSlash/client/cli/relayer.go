@@ -0,0 +1,191 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/symSlash/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	FlagEthAPIURL  = "eth-api-url"
+	FlagEthKeyFile = "eth-key-file"
+
+	SLASH_FUNCTION_NAME = "slash"
+	MIDDLEWARE_ABI      = `[
+		{
+			"type": "function",
+			"name": "slash",
+			"inputs": [
+				{
+					"name": "epoch",
+					"type": "uint48",
+					"internalType": "uint48"
+				},
+				{
+					"name": "operator",
+					"type": "address",
+					"internalType": "address"
+				},
+				{
+					"name": "amount",
+					"type": "uint256",
+					"internalType": "uint256"
+				}
+			],
+			"outputs": [],
+			"stateMutability": "nonpayable"
+		}
+	]`
+)
+
+// NewRelaySlashRequestsCmd returns a CLI command handler relaying the pending
+// slash requests to their Symbiotic middleware and creating a
+// MsgCompleteSlashRequest transaction for the slashes observed on Ethereum.
+func NewRelaySlashRequestsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-slash-requests",
+		Short: "Submit the pending slash requests to their Symbiotic middleware and mark them completed",
+		Long: `Submit every pending slash request to its Symbiotic middleware, wait for the slash to be mined,
+then mark the mined requests completed in a single transaction signed by a slash relayer.
+
+The Ethereum account of the key file must be allowed to slash in the middlewares. The hash of
+every mined slash is printed, so that a request whose completion wasn't committed can be
+completed with complete-slash-request instead of being slashed again.`,
+		Example: fmt.Sprintf("%s tx slashing relay-slash-requests --eth-api-url http://localhost:8545 --eth-key-file relayer.key --from relayer", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ethAPIURL, _ := cmd.Flags().GetString(FlagEthAPIURL)
+			ethKeyFile, _ := cmd.Flags().GetString(FlagEthKeyFile)
+
+			key, err := crypto.LoadECDSA(ethKeyFile)
+			if err != nil {
+				return fmt.Errorf("failed to load the ethereum key: %w", err)
+			}
+
+			ethClient, err := ethclient.DialContext(cmd.Context(), ethAPIURL)
+			if err != nil {
+				return err
+			}
+			defer ethClient.Close()
+
+			chainID, err := ethClient.ChainID(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			auth, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+			if err != nil {
+				return err
+			}
+
+			relayer, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			requests, err := pendingSlashRequests(cmd.Context(), types.NewQueryClient(clientCtx))
+			if err != nil {
+				return err
+			}
+
+			var msgs []sdk.Msg
+			for _, req := range requests {
+				txHash, err := slash(cmd.Context(), ethClient, auth, req)
+				if err != nil {
+					cmd.PrintErrf("failed to slash request %s: %s\n", req.Id, err)
+					continue
+				}
+
+				cmd.PrintErrf("slashed request %s in %s\n", req.Id, txHash.Hex())
+				msgs = append(msgs, types.NewMsgCompleteSlashRequest(relayer, req.Id, txHash.Hex()))
+			}
+
+			if len(msgs) == 0 {
+				return clientCtx.PrintString("no slash request relayed\n")
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().String(FlagEthAPIURL, "", "The execution layer JSON-RPC endpoint the slashes are submitted to")
+	cmd.Flags().String(FlagEthKeyFile, "", "The file holding the hex encoded secp256k1 key of the Ethereum account slashing in the middlewares")
+	_ = cmd.MarkFlagRequired(FlagEthAPIURL)
+	_ = cmd.MarkFlagRequired(FlagEthKeyFile)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// pendingSlashRequests returns every pending slash request of the outbox.
+func pendingSlashRequests(ctx context.Context, queryClient types.QueryClient) ([]types.SlashRequest, error) {
+	var requests []types.SlashRequest
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.SlashRequests(ctx, &types.QuerySlashRequestsRequest{
+			Status:     types.SlashRequestPending,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, res.SlashRequests...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return requests, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// slash submits the slash of a request to its middleware and waits for it to
+// be mined, returning the hash of the transaction.
+func slash(ctx context.Context, ethClient *ethclient.Client, auth *bind.TransactOpts, req types.SlashRequest) (common.Hash, error) {
+	contractABI, err := abi.JSON(strings.NewReader(MIDDLEWARE_ABI))
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	contract := bind.NewBoundContract(common.HexToAddress(req.MiddlewareAddress), contractABI, ethClient, ethClient, ethClient)
+
+	opts := *auth
+	opts.Context = ctx
+	ethTx, err := contract.Transact(&opts, SLASH_FUNCTION_NAME, new(big.Int).SetUint64(req.Epoch), common.HexToAddress(req.Operator), req.Amount.BigInt())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	receipt, err := bind.WaitMined(ctx, ethClient, ethTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return common.Hash{}, fmt.Errorf("slash reverted in %s", ethTx.Hash().Hex())
+	}
+
+	return ethTx.Hash(), nil
+}
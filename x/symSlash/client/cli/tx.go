@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/symSlash/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/symSlash transaction commands.
+func NewTxCmd() *cobra.Command {
+	slashingTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Slashing transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	slashingTxCmd.AddCommand(
+		NewRelaySlashRequestsCmd(),
+	)
+
+	return slashingTxCmd
+}
@@ -60,6 +60,16 @@ func (keeper Keeper) InitGenesis(ctx context.Context, stakingKeeper types.Stakin
 		}
 	}
 
+	for _, req := range data.SlashRequests {
+		id, err := types.ParseSlashRequestID(req.Id)
+		if err != nil {
+			return err
+		}
+		if err := keeper.SlashRequests.Set(ctx, id, req); err != nil {
+			return err
+		}
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -101,5 +111,17 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, er
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, signingInfos, missedBlocks), nil
+
+	slashRequests := make([]types.SlashRequest, 0)
+	err = keeper.SlashRequests.Walk(ctx, nil, func(_ []byte, req types.SlashRequest) (stop bool, err error) {
+		slashRequests = append(slashRequests, req)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genesis.SlashRequests = slashRequests
+	return genesis, nil
 }
@@ -80,3 +80,47 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// SlashRequest returns a slash request of the outbox by ID.
+func (k Querier) SlashRequest(ctx context.Context, req *types.QuerySlashRequestRequest) (*types.QuerySlashRequestResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	id, err := types.ParseSlashRequestID(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	slashRequest, err := k.Keeper.SlashRequests.Get(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "slash request %s not found", req.Id)
+	}
+
+	return &types.QuerySlashRequestResponse{SlashRequest: slashRequest}, nil
+}
+
+// SlashRequests returns the slash requests of the outbox, optionally with a
+// given status.
+func (k Querier) SlashRequests(ctx context.Context, req *types.QuerySlashRequestsRequest) (*types.QuerySlashRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	slashRequests, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.Keeper.SlashRequests,
+		req.Pagination,
+		func(_ []byte, slashRequest types.SlashRequest) (bool, error) {
+			return req.Status == types.SlashRequestUnspecified || slashRequest.Status == req.Status, nil
+		},
+		func(_ []byte, slashRequest types.SlashRequest) (types.SlashRequest, error) {
+			return slashRequest, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashRequestsResponse{SlashRequests: slashRequests, Pagination: pageRes}, nil
+}
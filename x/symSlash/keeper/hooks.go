@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/symSlash/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -65,6 +66,16 @@ func (h Hooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress
 	return h.k.AddrPubkeyRelation.Set(ctx, consPk.Address(), consPk)
 }
 
+// AfterConsensusPubKeyUpdate moves the signing info and the missed blocks of the
+// old consensus address to the new one and replaces the address-pubkey relation.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if err := h.k.performConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey); err != nil {
+		return err
+	}
+
+	return h.k.AddrPubkeyRelation.Remove(ctx, oldPubKey.Address())
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
 	return nil
 }
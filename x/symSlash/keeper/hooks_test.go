@@ -44,3 +44,40 @@ func (s *KeeperTestSuite) TestAfterValidatorCreatedOrRemoved() {
 	_, err = keeper.GetPubkey(ctx, addr.Bytes())
 	require.Error(err)
 }
+
+func (s *KeeperTestSuite) TestAfterConsensusPubKeyUpdate() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, oldPubKey, oldAddr := testdata.KeyTestPubAddr()
+	_, newPubKey, newAddr := testdata.KeyTestPubAddr()
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldAddr), sdk.ConsAddress(newAddr)
+
+	// a validator that never bonded has no signing info
+	require.NoError(keeper.AddrPubkeyRelation.Set(ctx, oldAddr, oldPubKey))
+	require.NoError(keeper.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey))
+	_, err := keeper.GetPubkey(ctx, oldAddr.Bytes())
+	require.Error(err)
+	ePubKey, err := keeper.GetPubkey(ctx, newAddr.Bytes())
+	require.NoError(err)
+	require.Equal(newPubKey, ePubKey)
+
+	require.NoError(keeper.Hooks().AfterValidatorBonded(ctx, newConsAddr, sdk.ValAddress(newAddr)))
+	require.NoError(keeper.SetMissedBlockBitmapValue(ctx, newConsAddr, 3, true))
+
+	// the signing info and missed blocks follow the key
+	require.NoError(keeper.Hooks().AfterConsensusPubKeyUpdate(ctx, newPubKey, oldPubKey))
+	require.False(keeper.HasValidatorSigningInfo(ctx, newConsAddr))
+	info, err := keeper.ValidatorSigningInfo.Get(ctx, oldConsAddr)
+	require.NoError(err)
+	oldConsStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(oldConsAddr)
+	require.NoError(err)
+	require.Equal(oldConsStr, info.Address)
+
+	missed, err := keeper.GetMissedBlockBitmapValue(ctx, oldConsAddr, 3)
+	require.NoError(err)
+	require.True(missed)
+	missedBlocks, err := keeper.GetValidatorMissedBlocks(ctx, newConsAddr)
+	require.NoError(err)
+	require.Empty(missedBlocks)
+}
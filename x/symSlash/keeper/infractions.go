@@ -172,6 +172,11 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 				return err
 			}
 
+			err = k.recordSlashRequests(ctx, validator, types.Infraction_INFRACTION_DOWNTIME, height, slashFractionDowntime)
+			if err != nil {
+				return err
+			}
+
 			k.Logger.Info(
 				"slashing and jailing validator due to liveness fault",
 				"height", height,
@@ -33,6 +33,8 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// SlashRequests key: ID | value: SlashRequest
+	SlashRequests collections.Map[[]byte, types.SlashRequest]
 }
 
 // NewKeeper creates a slashing keeper
@@ -66,6 +68,13 @@ func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyA
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		SlashRequests: collections.NewMap(
+			sb,
+			types.SlashRequestsKeyPrefix,
+			"slash_requests",
+			collections.BytesKey,
+			codec.CollValue[types.SlashRequest](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
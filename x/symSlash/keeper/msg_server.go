@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/symSlash/types"
 
@@ -54,3 +57,36 @@ func (k msgServer) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.Msg
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// CompleteSlashRequest implements MsgServer.CompleteSlashRequest method.
+// Slash relayers mark a slash request completed once its slash is observed on
+// Ethereum. This is a trusted-relayer shortcut: the transaction isn't checked
+// against Ethereum, as no RPC is queried while executing a block, so a slash
+// relayer can complete a request whose slash wasn't mined. Only the hash format
+// is validated.
+func (k msgServer) CompleteSlashRequest(ctx context.Context, msg *types.MsgCompleteSlashRequest) (*types.MsgCompleteSlashRequestResponse, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.IsSlashRelayer(msg.Relayer) {
+		return nil, errors.Wrapf(types.ErrNotSlashRelayer, "%s", msg.Relayer)
+	}
+
+	id, err := types.ParseSlashRequestID(msg.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	txHash, err := hexutil.Decode(msg.TxHash)
+	if err != nil || len(txHash) != common.HashLength {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid tx hash: %s", msg.TxHash)
+	}
+
+	if err := k.Keeper.CompleteSlashRequest(ctx, id, common.BytesToHash(txHash)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCompleteSlashRequestResponse{}, nil
+}
@@ -212,8 +212,13 @@ func (k Keeper) performConsensusPubKeyUpdate(ctx context.Context, oldPubKey, new
 		return err
 	}
 
-	// Migrate ValidatorSigningInfo from oldPubKey to newPubKey
-	signingInfo, err := k.ValidatorSigningInfo.Get(ctx, sdk.ConsAddress(oldPubKey.Address()))
+	// Migrate ValidatorSigningInfo from oldPubKey to newPubKey, a validator that
+	// never bonded has none
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	signingInfo, err := k.ValidatorSigningInfo.Get(ctx, oldConsAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
 	if err != nil {
 		return types.ErrInvalidConsPubKey.Wrap("failed to get signing info for old public key")
 	}
@@ -228,5 +233,29 @@ func (k Keeper) performConsensusPubKeyUpdate(ctx context.Context, oldPubKey, new
 		return err
 	}
 
-	return k.ValidatorSigningInfo.Remove(ctx, sdk.ConsAddress(oldPubKey.Address()))
+	if err := k.ValidatorSigningInfo.Remove(ctx, oldConsAddr); err != nil {
+		return err
+	}
+
+	// Migrate the missed blocks bitmap chunks
+	var chunks []collections.KeyValue[collections.Pair[[]byte, uint64], []byte]
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](oldConsAddr.Bytes())
+	if err := k.ValidatorMissedBlockBitmap.Walk(ctx, rng, func(key collections.Pair[[]byte, uint64], chunk []byte) (bool, error) {
+		chunks = append(chunks, collections.KeyValue[collections.Pair[[]byte, uint64], []byte]{Key: key, Value: chunk})
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	if err := k.DeleteMissedBlockBitmap(ctx, oldConsAddr); err != nil {
+		return err
+	}
+
+	for _, chunk := range chunks {
+		if err := k.SetMissedBlockBitmapChunk(ctx, sdk.ConsAddress(newPubKey.Address()), int64(chunk.Key.K2()), chunk.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
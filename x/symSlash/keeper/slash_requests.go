@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HandleEquivocationEvidence handles a double-sign of a validator reported by
+// CometBFT. The validator is jailed forever and tombstoned, and a slash of its
+// stake is requested in the Symbiotic middlewares. CometBFT only commits
+// evidence within the evidence max age, so the age isn't checked again.
+func (k Keeper) HandleEquivocationEvidence(ctx context.Context, evidence comet.Evidence) error {
+	consAddr := sdk.ConsAddress(evidence.Validator.Address)
+
+	validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		k.Logger.Info("ignored equivocation; validator not found", "validator", consAddr, "infraction_height", evidence.Height)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if validator.IsUnbonded() {
+		return nil
+	}
+
+	// read the cons address again because validator may've rotated it's key
+	valConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	consAddr = sdk.ConsAddress(valConsAddr)
+
+	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return err
+	}
+
+	if !k.HasValidatorSigningInfo(ctx, consAddr) {
+		return types.ErrNoSigningInfoFound.Wrapf("expected signing info for validator %s", consStr)
+	}
+
+	if k.IsTombstoned(ctx, consAddr) {
+		k.Logger.Info(
+			"ignored equivocation; validator already tombstoned",
+			"validator", consStr,
+			"infraction_height", evidence.Height,
+			"infraction_time", evidence.Time,
+		)
+		return nil
+	}
+
+	k.Logger.Info(
+		"confirmed equivocation",
+		"validator", consStr,
+		"infraction_height", evidence.Height,
+		"infraction_time", evidence.Time,
+	)
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSlash,
+		event.NewAttribute(types.AttributeKeyAddress, consStr),
+		event.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", evidence.Validator.Power)),
+		event.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+	); err != nil {
+		return err
+	}
+
+	if !validator.IsJailed() {
+		if err := k.Jail(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
+	if err := k.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime); err != nil {
+		return err
+	}
+
+	if err := k.Tombstone(ctx, consAddr); err != nil {
+		return err
+	}
+
+	slashFractionDoubleSign, err := k.SlashFractionDoubleSign(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.recordSlashRequests(ctx, validator, types.Infraction_INFRACTION_DOUBLE_SIGN, evidence.Height, slashFractionDoubleSign)
+}
+
+// recordSlashRequests records a pending slash request of the validator in every
+// middleware it has stake in. The amount is the slash fraction of its stake at
+// the last sync, in the epoch of the middleware validator set of that sync.
+func (k Keeper) recordSlashRequests(ctx context.Context, validator stakingtypes.Validator, infraction types.Infraction, height int64, fraction math.LegacyDec) error {
+	if validator.SymbioticOperator == "" {
+		k.Logger.Info("no slash request; validator has no symbiotic operator", "validator", validator.GetOperator(), "infraction", infraction)
+		return nil
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	stakes, err := k.sk.GetValidatorSymbioticStakes(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	operator := common.HexToAddress(validator.SymbioticOperator)
+	for _, stake := range stakes {
+		amount := math.LegacyNewDecFromInt(stake.Stake).Mul(fraction).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+
+		middleware := common.HexToAddress(stake.MiddlewareAddress)
+		id := types.SlashRequestID(infraction, height, valAddr, middleware)
+		has, err := k.SlashRequests.Has(ctx, id)
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+
+		req := types.NewSlashRequest(id, infraction, height, validator.GetOperator(), operator, middleware, stake.Epoch, amount)
+		if err := k.SlashRequests.Set(ctx, id, req); err != nil {
+			return err
+		}
+
+		k.Logger.Info(
+			"recorded slash request",
+			"id", req.Id,
+			"validator", req.ValidatorAddress,
+			"operator", req.Operator,
+			"middleware", req.MiddlewareAddress,
+			"amount", req.Amount,
+		)
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeSlashRequest,
+			event.NewAttribute(types.AttributeKeyID, req.Id),
+			event.NewAttribute(types.AttributeKeyInfraction, req.Infraction.String()),
+			event.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", req.Height)),
+			event.NewAttribute(types.AttributeKeyOperator, req.Operator),
+			event.NewAttribute(types.AttributeKeyMiddleware, req.MiddlewareAddress),
+			event.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", req.Epoch)),
+			event.NewAttribute(types.AttributeKeyAmount, req.Amount.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CompleteSlashRequest marks a pending slash request completed once its slash
+// is observed in the Ethereum transaction txHash. The transaction isn't
+// verified, the caller being trusted to have observed the slash.
+func (k Keeper) CompleteSlashRequest(ctx context.Context, id []byte, txHash common.Hash) error {
+	req, err := k.SlashRequests.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrSlashRequestNotFound.Wrapf("%x", id)
+	}
+	if err != nil {
+		return err
+	}
+
+	if req.Status == types.SlashRequestCompleted {
+		return types.ErrSlashRequestCompleted.Wrapf("%s in %s", req.Id, req.TxHash)
+	}
+
+	req.Status = types.SlashRequestCompleted
+	req.TxHash = txHash.Hex()
+	if err := k.SlashRequests.Set(ctx, id, req); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCompleteSlashRequest,
+		event.NewAttribute(types.AttributeKeyID, req.Id),
+		event.NewAttribute(types.AttributeKeyTxHash, req.TxHash),
+	)
+}
@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/core/comet"
+	sdkmath "cosmossdk.io/math"
+	slashingtypes "cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var (
+	testOperator    = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	testMiddleware  = common.HexToAddress("0x00000000000000000000000000000000000000b1")
+	otherMiddleware = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+)
+
+// setupSlashedValidator returns a bonded validator with a symbiotic operator
+// and signing info, along with its consensus address.
+func (s *KeeperTestSuite) setupSlashedValidator(operator common.Address) (stakingtypes.Validator, sdk.ValAddress, sdk.ConsAddress) {
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(addr)
+	require.NoError(err)
+
+	val, err := stakingtypes.NewValidator(valStr, pubKey, stakingtypes.Description{Moniker: "test"})
+	require.NoError(err)
+	val.Status = stakingtypes.Bonded
+	val.Tokens = sdkmath.NewInt(1000)
+	if operator != (common.Address{}) {
+		val.SymbioticOperator = operator.Hex()
+	}
+
+	info := slashingtypes.NewValidatorSigningInfo(consStr, 0, time.Unix(0, 0), false, 0)
+	require.NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, sdk.ConsAddress(addr), info))
+
+	return val, sdk.ValAddress(addr), sdk.ConsAddress(addr)
+}
+
+func (s *KeeperTestSuite) TestHandleEquivocationEvidence() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	val, valAddr, consAddr := s.setupSlashedValidator(testOperator)
+	evidence := comet.Evidence{
+		Type:      comet.DuplicateVote,
+		Validator: comet.Validator{Address: consAddr, Power: 1},
+		Height:    10,
+	}
+
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, consAddr).Return(val, nil).Times(2)
+	s.stakingKeeper.EXPECT().Jail(ctx, consAddr).Return(nil)
+	s.stakingKeeper.EXPECT().GetValidatorSymbioticStakes(ctx, valAddr).Return([]stakingtypes.SymbioticMiddlewareStake{
+		{MiddlewareAddress: testMiddleware.Hex(), Stake: sdkmath.NewInt(1000), WeightedStake: sdkmath.NewInt(1000), Epoch: 3},
+		{MiddlewareAddress: otherMiddleware.Hex(), Stake: sdkmath.NewInt(10), WeightedStake: sdkmath.NewInt(5), Epoch: 4},
+	}, nil)
+
+	require.NoError(keeper.HandleEquivocationEvidence(ctx, evidence))
+
+	info, err := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.True(info.Tombstoned)
+	require.Equal(slashingtypes.DoubleSignJailEndTime.Unix(), info.JailedUntil.Unix())
+
+	// the double sign slash fraction is 1/20, so nothing is requested for the
+	// stake of 10 in the other middleware
+	id := slashingtypes.SlashRequestID(slashingtypes.Infraction_INFRACTION_DOUBLE_SIGN, 10, valAddr, testMiddleware)
+	req, err := keeper.SlashRequests.Get(ctx, id)
+	require.NoError(err)
+	require.Equal(slashingtypes.NewSlashRequest(id, slashingtypes.Infraction_INFRACTION_DOUBLE_SIGN, 10, val.GetOperator(), testOperator, testMiddleware, 3, sdkmath.NewInt(50)), req)
+	require.NoError(req.Validate())
+
+	otherID := slashingtypes.SlashRequestID(slashingtypes.Infraction_INFRACTION_DOUBLE_SIGN, 10, valAddr, otherMiddleware)
+	has, err := keeper.SlashRequests.Has(ctx, otherID)
+	require.NoError(err)
+	require.False(has)
+
+	// the evidence of a tombstoned validator is ignored
+	require.NoError(keeper.HandleEquivocationEvidence(ctx, evidence))
+}
+
+func (s *KeeperTestSuite) TestHandleEquivocationEvidenceWithoutOperator() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	val, _, consAddr := s.setupSlashedValidator(common.Address{})
+
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, consAddr).Return(val, nil)
+	s.stakingKeeper.EXPECT().Jail(ctx, consAddr).Return(nil)
+
+	require.NoError(keeper.HandleEquivocationEvidence(ctx, comet.Evidence{
+		Type:      comet.DuplicateVote,
+		Validator: comet.Validator{Address: consAddr, Power: 1},
+		Height:    10,
+	}))
+
+	require.True(keeper.IsTombstoned(ctx, consAddr))
+
+	res, err := s.queryClient.SlashRequests(ctx, &slashingtypes.QuerySlashRequestsRequest{})
+	require.NoError(err)
+	require.Empty(res.SlashRequests)
+}
+
+func (s *KeeperTestSuite) TestCompleteSlashRequest() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, _, relayerAddr := testdata.KeyTestPubAddr()
+	relayer := relayerAddr.String()
+	p, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	p.SlashRelayers = []string{relayer}
+	require.NoError(keeper.Params.Set(ctx, p))
+
+	_, valAddr, _ := s.setupSlashedValidator(testOperator)
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(err)
+
+	id := slashingtypes.SlashRequestID(slashingtypes.Infraction_INFRACTION_DOWNTIME, 5, valAddr, testMiddleware)
+	req := slashingtypes.NewSlashRequest(id, slashingtypes.Infraction_INFRACTION_DOWNTIME, 5, valStr, testOperator, testMiddleware, 1, sdkmath.NewInt(7))
+	require.NoError(keeper.SlashRequests.Set(ctx, id, req))
+
+	txHash := common.HexToHash("0x01").Hex()
+	testCases := []struct {
+		name      string
+		msg       *slashingtypes.MsgCompleteSlashRequest
+		expErrMsg string
+	}{
+		{
+			name:      "not a slash relayer",
+			msg:       slashingtypes.NewMsgCompleteSlashRequest(valStr, req.Id, txHash),
+			expErrMsg: "signer is not a slash relayer",
+		},
+		{
+			name:      "invalid id",
+			msg:       slashingtypes.NewMsgCompleteSlashRequest(relayer, "0x01", txHash),
+			expErrMsg: "invalid slash request id",
+		},
+		{
+			name:      "invalid tx hash",
+			msg:       slashingtypes.NewMsgCompleteSlashRequest(relayer, req.Id, "0x01"),
+			expErrMsg: "invalid tx hash",
+		},
+		{
+			name:      "unknown request",
+			msg:       slashingtypes.NewMsgCompleteSlashRequest(relayer, common.Bytes2Hex(make([]byte, 32)), txHash),
+			expErrMsg: "slash request not found",
+		},
+		{
+			// the tx hash isn't checked against Ethereum: a slash relayer is
+			// trusted to have observed the slash in it
+			name: "unverified tx hash of a slash relayer",
+			msg:  slashingtypes.NewMsgCompleteSlashRequest(relayer, req.Id, txHash),
+		},
+		{
+			name:      "already completed",
+			msg:       slashingtypes.NewMsgCompleteSlashRequest(relayer, req.Id, txHash),
+			expErrMsg: "slash request already completed",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.CompleteSlashRequest(ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+
+	completed, err := keeper.SlashRequests.Get(ctx, id)
+	require.NoError(err)
+	require.Equal(slashingtypes.SlashRequestCompleted, completed.Status)
+	require.Equal(txHash, completed.TxHash)
+	require.NoError(completed.Validate())
+}
+
+func (s *KeeperTestSuite) TestGRPCSlashRequests() {
+	ctx, keeper, queryClient := s.ctx, s.slashingKeeper, s.queryClient
+	require := s.Require()
+
+	_, valAddr, _ := s.setupSlashedValidator(testOperator)
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(err)
+
+	var ids []string
+	for height := int64(1); height <= 3; height++ {
+		id := slashingtypes.SlashRequestID(slashingtypes.Infraction_INFRACTION_DOWNTIME, height, valAddr, testMiddleware)
+		req := slashingtypes.NewSlashRequest(id, slashingtypes.Infraction_INFRACTION_DOWNTIME, height, valStr, testOperator, testMiddleware, 1, sdkmath.NewInt(7))
+		if height == 2 {
+			req.Status = slashingtypes.SlashRequestCompleted
+			req.TxHash = common.HexToHash("0x02").Hex()
+		}
+		require.NoError(keeper.SlashRequests.Set(ctx, id, req))
+		ids = append(ids, req.Id)
+	}
+
+	res, err := queryClient.SlashRequest(ctx, &slashingtypes.QuerySlashRequestRequest{Id: ids[1]})
+	require.NoError(err)
+	require.Equal(int64(2), res.SlashRequest.Height)
+
+	_, err = queryClient.SlashRequest(ctx, &slashingtypes.QuerySlashRequestRequest{Id: "invalid"})
+	require.ErrorContains(err, "invalid slash request id")
+
+	_, err = queryClient.SlashRequest(ctx, &slashingtypes.QuerySlashRequestRequest{Id: common.Bytes2Hex(make([]byte, 32))})
+	require.ErrorContains(err, "not found")
+
+	all, err := queryClient.SlashRequests(ctx, &slashingtypes.QuerySlashRequestsRequest{})
+	require.NoError(err)
+	require.Len(all.SlashRequests, 3)
+
+	pending, err := queryClient.SlashRequests(ctx, &slashingtypes.QuerySlashRequestsRequest{
+		Status:     slashingtypes.SlashRequestPending,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(pending.SlashRequests, 2)
+	require.Equal(uint64(2), pending.Pagination.Total)
+	for _, req := range pending.SlashRequests {
+		require.Equal(slashingtypes.SlashRequestPending, req.Status)
+	}
+}
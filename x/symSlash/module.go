@@ -6,12 +6,14 @@ import (
 	"fmt"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/legacy"
 	"cosmossdk.io/core/registry"
+	"cosmossdk.io/x/symSlash/client/cli"
 	"cosmossdk.io/x/symSlash/keeper"
 	"cosmossdk.io/x/symSlash/simulation"
 	"cosmossdk.io/x/symSlash/types"
@@ -98,6 +100,11 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwrunt
 	}
 }
 
+// GetTxCmd returns the root tx command for the slashing module.
+func (AppModule) GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
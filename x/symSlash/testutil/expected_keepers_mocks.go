@@ -197,6 +197,21 @@ func (mr *MockStakingKeeperMockRecorder) GetAllValidators(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllValidators", reflect.TypeOf((*MockStakingKeeper)(nil).GetAllValidators), ctx)
 }
 
+// GetValidatorSymbioticStakes mocks base method.
+func (m *MockStakingKeeper) GetValidatorSymbioticStakes(ctx context.Context, addr types0.ValAddress) ([]types.SymbioticMiddlewareStake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorSymbioticStakes", ctx, addr)
+	ret0, _ := ret[0].([]types.SymbioticMiddlewareStake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorSymbioticStakes indicates an expected call of GetValidatorSymbioticStakes.
+func (mr *MockStakingKeeperMockRecorder) GetValidatorSymbioticStakes(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorSymbioticStakes", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidatorSymbioticStakes), ctx, addr)
+}
+
 // IsValidatorJailed mocks base method.
 func (m *MockStakingKeeper) IsValidatorJailed(ctx context.Context, addr types0.ConsAddress) (bool, error) {
 	m.ctrl.T.Helper()
@@ -14,6 +14,7 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/symSlash/Params")
 	legacy.RegisterAminoMsg(cdc, &MsgUnjail{}, "cosmos-sdk/MsgUnjail")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/symSlash/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCompleteSlashRequest{}, "cosmos-sdk/MsgCompleteSlashRequest")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -21,6 +22,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgUnjail{},
 		&MsgUpdateParams{},
+		&MsgCompleteSlashRequest{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
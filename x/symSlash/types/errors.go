@@ -14,4 +14,7 @@ var (
 	ErrValidatorTombstoned          = errors.Register(ModuleName, 9, "validator already tombstoned")
 	ErrInvalidSigner                = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
 	ErrInvalidConsPubKey            = errors.Register(ModuleName, 11, "invalid consensus pubkey")
+	ErrSlashRequestNotFound         = errors.Register(ModuleName, 12, "slash request not found")
+	ErrSlashRequestCompleted        = errors.Register(ModuleName, 13, "slash request already completed")
+	ErrNotSlashRelayer              = errors.Register(ModuleName, 14, "signer is not a slash relayer")
 )
@@ -2,8 +2,10 @@ package types
 
 // Slashing module event types
 const (
-	EventTypeSlash    = "slash"
-	EventTypeLiveness = "liveness"
+	EventTypeSlash                = "slash"
+	EventTypeLiveness             = "liveness"
+	EventTypeSlashRequest         = "slash_request"
+	EventTypeCompleteSlashRequest = "complete_slash_request"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -12,6 +14,13 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyID           = "id"
+	AttributeKeyInfraction   = "infraction"
+	AttributeKeyOperator     = "operator"
+	AttributeKeyMiddleware   = "middleware"
+	AttributeKeyEpoch        = "epoch"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyTxHash       = "tx_hash"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
@@ -44,6 +44,10 @@ type StakingKeeper interface {
 
 	// IsValidatorJailed returns if the validator is jailed.
 	IsValidatorJailed(ctx context.Context, addr sdk.ConsAddress) (bool, error)
+
+	// GetValidatorSymbioticStakes returns the stake of a validator in every
+	// Symbiotic middleware listing it at the last sync.
+	GetValidatorSymbioticStakes(ctx context.Context, addr sdk.ValAddress) ([]stakingtypes.SymbioticMiddlewareStake, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -29,9 +29,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:        DefaultParams(),
+		SigningInfos:  []SigningInfo{},
+		MissedBlocks:  []ValidatorMissedBlocks{},
+		SlashRequests: []SlashRequest{},
 	}
 }
 
@@ -62,5 +63,20 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateSlashRelayers(data.Params.SlashRelayers); err != nil {
+		return err
+	}
+
+	ids := make(map[string]bool, len(data.SlashRequests))
+	for _, req := range data.SlashRequests {
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		if ids[req.Id] {
+			return fmt.Errorf("duplicate slash request: %s", req.Id)
+		}
+		ids[req.Id] = true
+	}
+
 	return nil
 }
@@ -50,12 +50,15 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<slashRequestID (32 Bytes)>: SlashRequest
 
 var (
 	ParamsKey                           = collections.NewPrefix(0) // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = collections.NewPrefix(1) // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = collections.NewPrefix(2) // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = collections.NewPrefix(3) // Prefix for address-pubkey relation
+	SlashRequestsKeyPrefix              = collections.NewPrefix(4) // Prefix for slash requests
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -8,6 +8,7 @@ import (
 var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCompleteSlashRequest{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -16,3 +17,12 @@ func NewMsgUnjail(validatorAddr string) *MsgUnjail {
 		ValidatorAddr: validatorAddr,
 	}
 }
+
+// NewMsgCompleteSlashRequest creates a new MsgCompleteSlashRequest instance
+func NewMsgCompleteSlashRequest(relayer, id, txHash string) *MsgCompleteSlashRequest {
+	return &MsgCompleteSlashRequest{
+		Relayer: relayer,
+		Id:      id,
+		TxHash:  txHash,
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"cosmossdk.io/math"
@@ -13,6 +14,10 @@ const (
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
 )
 
+// DoubleSignJailEndTime period ends at Max Time supported by Amino
+// (Dec 31, 9999 - 23:59:59 GMT).
+var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
 var (
 	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
@@ -61,6 +66,9 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateSlashRelayers(p.SlashRelayers); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +155,36 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateSlashRelayers(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, relayer := range v {
+		if strings.TrimSpace(relayer) == "" {
+			return fmt.Errorf("slash relayer cannot be blank")
+		}
+		if seen[relayer] {
+			return fmt.Errorf("duplicate slash relayer: %s", relayer)
+		}
+		seen[relayer] = true
+	}
+
+	return nil
+}
+
+// IsSlashRelayer returns true if addr is one of the slash relayers.
+func (p Params) IsSlashRelayer(addr string) bool {
+	for _, relayer := range p.SlashRelayers {
+		if relayer == addr {
+			return true
+		}
+	}
+	return false
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
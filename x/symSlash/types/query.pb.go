@@ -309,6 +309,209 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySlashRequestRequest is the request type for the Query/SlashRequest RPC
+// method
+type QuerySlashRequestRequest struct {
+	// id is the hex encoded ID of the slash request
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QuerySlashRequestRequest) Reset()         { *m = QuerySlashRequestRequest{} }
+func (m *QuerySlashRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRequestRequest) ProtoMessage()    {}
+func (*QuerySlashRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd3f64af9241ce8, []int{6}
+}
+func (m *QuerySlashRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRequestRequest.Merge(m, src)
+}
+func (m *QuerySlashRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRequestRequest proto.InternalMessageInfo
+
+func (m *QuerySlashRequestRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QuerySlashRequestResponse is the response type for the Query/SlashRequest RPC
+// method
+type QuerySlashRequestResponse struct {
+	SlashRequest SlashRequest `protobuf:"bytes,1,opt,name=slash_request,json=slashRequest,proto3" json:"slash_request"`
+}
+
+func (m *QuerySlashRequestResponse) Reset()         { *m = QuerySlashRequestResponse{} }
+func (m *QuerySlashRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRequestResponse) ProtoMessage()    {}
+func (*QuerySlashRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd3f64af9241ce8, []int{7}
+}
+func (m *QuerySlashRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRequestResponse.Merge(m, src)
+}
+func (m *QuerySlashRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRequestResponse proto.InternalMessageInfo
+
+func (m *QuerySlashRequestResponse) GetSlashRequest() SlashRequest {
+	if m != nil {
+		return m.SlashRequest
+	}
+	return SlashRequest{}
+}
+
+// QuerySlashRequestsRequest is the request type for the Query/SlashRequests RPC
+// method
+type QuerySlashRequestsRequest struct {
+	// status filters the slash requests by status, all are returned if
+	// unspecified
+	Status     SlashRequestStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cosmos.symSlash.v1beta1.SlashRequestStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashRequestsRequest) Reset()         { *m = QuerySlashRequestsRequest{} }
+func (m *QuerySlashRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRequestsRequest) ProtoMessage()    {}
+func (*QuerySlashRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd3f64af9241ce8, []int{8}
+}
+func (m *QuerySlashRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRequestsRequest.Merge(m, src)
+}
+func (m *QuerySlashRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRequestsRequest proto.InternalMessageInfo
+
+func (m *QuerySlashRequestsRequest) GetStatus() SlashRequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SlashRequestUnspecified
+}
+
+func (m *QuerySlashRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySlashRequestsResponse is the response type for the Query/SlashRequests RPC
+// method
+type QuerySlashRequestsResponse struct {
+	SlashRequests []SlashRequest      `protobuf:"bytes,1,rep,name=slash_requests,json=slashRequests,proto3" json:"slash_requests"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashRequestsResponse) Reset()         { *m = QuerySlashRequestsResponse{} }
+func (m *QuerySlashRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRequestsResponse) ProtoMessage()    {}
+func (*QuerySlashRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd3f64af9241ce8, []int{9}
+}
+func (m *QuerySlashRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRequestsResponse.Merge(m, src)
+}
+func (m *QuerySlashRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRequestsResponse proto.InternalMessageInfo
+
+func (m *QuerySlashRequestsResponse) GetSlashRequests() []SlashRequest {
+	if m != nil {
+		return m.SlashRequests
+	}
+	return nil
+}
+
+func (m *QuerySlashRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.symSlash.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.symSlash.v1beta1.QueryParamsResponse")
@@ -316,6 +519,10 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.symSlash.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.symSlash.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.symSlash.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QuerySlashRequestRequest)(nil), "cosmos.symSlash.v1beta1.QuerySlashRequestRequest")
+	proto.RegisterType((*QuerySlashRequestResponse)(nil), "cosmos.symSlash.v1beta1.QuerySlashRequestResponse")
+	proto.RegisterType((*QuerySlashRequestsRequest)(nil), "cosmos.symSlash.v1beta1.QuerySlashRequestsRequest")
+	proto.RegisterType((*QuerySlashRequestsResponse)(nil), "cosmos.symSlash.v1beta1.QuerySlashRequestsResponse")
 }
 
 func init() {
@@ -323,43 +530,53 @@ func init() {
 }
 
 var fileDescriptor_dbd3f64af9241ce8 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x06, 0x3a, 0x29, 0xa2, 0x63, 0xa1, 0x6d, 0xd0, 0x8d, 0x5d, 0x21, 0x2d,
-	0xd5, 0xee, 0x98, 0x88, 0xf4, 0xe4, 0xc1, 0x28, 0x8a, 0xe0, 0x41, 0x13, 0x10, 0xf4, 0xb2, 0xcc,
-	0x36, 0xd3, 0x71, 0x70, 0x33, 0xb3, 0xdd, 0xd9, 0x04, 0x83, 0xe8, 0xc1, 0xb3, 0x07, 0xc1, 0xcf,
-	0x20, 0x78, 0x54, 0xf1, 0x43, 0xf4, 0x58, 0xf4, 0xe2, 0x49, 0x24, 0x11, 0xbc, 0xfb, 0x09, 0x64,
-	0x67, 0x26, 0xcd, 0x96, 0xb8, 0x9a, 0xd0, 0x4b, 0x98, 0xbc, 0x79, 0xff, 0xf7, 0xff, 0xbd, 0x97,
-	0x37, 0x81, 0x17, 0x77, 0xa4, 0xea, 0x48, 0x85, 0x55, 0xbf, 0xd3, 0x0a, 0x89, 0x7a, 0x82, 0x7b,
-	0xb5, 0x80, 0x26, 0xa4, 0x86, 0xf7, 0xba, 0x34, 0xee, 0x7b, 0x51, 0x2c, 0x13, 0x89, 0x96, 0x4d,
-	0x92, 0x37, 0x4a, 0xf2, 0x6c, 0x52, 0x79, 0xd3, 0xaa, 0x03, 0xa2, 0xa8, 0x51, 0x1c, 0xea, 0x23,
-	0xc2, 0xb8, 0x20, 0x09, 0x97, 0xc2, 0x14, 0x29, 0x2f, 0x31, 0xc9, 0xa4, 0x3e, 0xe2, 0xf4, 0x64,
-	0xa3, 0xe7, 0x98, 0x94, 0x2c, 0xa4, 0x98, 0x44, 0x1c, 0x13, 0x21, 0x64, 0xa2, 0x25, 0xca, 0xde,
-	0x56, 0xf3, 0xe8, 0x54, 0xfa, 0x8d, 0x0b, 0x66, 0xf3, 0x56, 0x4d, 0x9e, 0x6f, 0xca, 0x5b, 0x5a,
-	0x73, 0x75, 0x86, 0x74, 0xb8, 0x90, 0x58, 0x7f, 0x9a, 0x90, 0xbb, 0x04, 0xd1, 0x83, 0x94, 0xf5,
-	0x3e, 0x89, 0x49, 0x47, 0x35, 0xe9, 0x5e, 0x97, 0xaa, 0xc4, 0x7d, 0x04, 0xcf, 0x1e, 0x89, 0xaa,
-	0x48, 0x0a, 0x45, 0x51, 0x03, 0x16, 0x23, 0x1d, 0x59, 0x01, 0x17, 0xc0, 0x46, 0xa9, 0x5e, 0xf1,
-	0x72, 0x86, 0xe1, 0x19, 0x61, 0x63, 0x61, 0xff, 0x7b, 0xa5, 0xf0, 0xfe, 0xd7, 0x87, 0x4d, 0xd0,
-	0xb4, 0x4a, 0xd7, 0x87, 0xcb, 0xba, 0x74, 0x8b, 0x33, 0xc1, 0x05, 0xbb, 0x2b, 0x76, 0xa5, 0x75,
-	0x45, 0xb7, 0xe0, 0xe2, 0x8e, 0x14, 0xca, 0x27, 0xed, 0x76, 0x4c, 0x95, 0x31, 0x59, 0x68, 0xac,
-	0x7d, 0xf9, 0xbc, 0x75, 0xde, 0xfa, 0xdc, 0x4c, 0x31, 0x84, 0xea, 0xaa, 0x1b, 0x26, 0xa5, 0x95,
-	0xc4, 0x5c, 0xb0, 0x66, 0x29, 0x95, 0xd9, 0x90, 0xfb, 0x12, 0xae, 0x4c, 0x1a, 0xd8, 0x06, 0x02,
-	0x78, 0xba, 0x47, 0x42, 0x5f, 0x99, 0x2b, 0x9f, 0x8b, 0x5d, 0x69, 0x5b, 0xd9, 0xca, 0x6d, 0xe5,
-	0x21, 0x09, 0x79, 0x9b, 0x24, 0x32, 0xce, 0x14, 0xcc, 0x36, 0x76, 0xaa, 0x47, 0xc2, 0xcc, 0x95,
-	0x1b, 0x4c, 0xfa, 0x8f, 0xe6, 0x8a, 0x6e, 0x43, 0x38, 0xde, 0x05, 0xeb, 0x5c, 0x1d, 0x39, 0xa7,
-	0x8b, 0xe3, 0x99, 0x55, 0x1b, 0x8f, 0x91, 0x51, 0xab, 0x6d, 0x66, 0x94, 0xee, 0x27, 0x00, 0x57,
-	0xff, 0x62, 0x62, 0xbb, 0xbc, 0x07, 0x4f, 0xd8, 0xce, 0xe6, 0x8f, 0xd5, 0x99, 0xae, 0x82, 0xee,
-	0x1c, 0x61, 0x9e, 0xd3, 0xcc, 0xeb, 0xff, 0x65, 0x36, 0x28, 0x59, 0xe8, 0xfa, 0xef, 0x79, 0x78,
-	0x52, 0x43, 0xa3, 0xd7, 0x00, 0x16, 0xcd, 0x86, 0xa0, 0x4b, 0xb9, 0x74, 0x93, 0x6b, 0x59, 0xbe,
-	0x3c, 0x5d, 0xb2, 0xf1, 0x76, 0xd7, 0x5f, 0x7d, 0xfd, 0xf9, 0x76, 0x6e, 0x0d, 0x55, 0x70, 0xde,
-	0xcb, 0x31, 0x2b, 0x89, 0x3e, 0x02, 0x58, 0xca, 0x8c, 0x00, 0x5d, 0xf9, 0xb7, 0xcd, 0xe4, 0xe6,
-	0x96, 0x6b, 0x33, 0x28, 0x2c, 0xdd, 0x75, 0x4d, 0xb7, 0x8d, 0xae, 0xe5, 0xd2, 0x65, 0xb7, 0x54,
-	0xe1, 0xe7, 0xd9, 0xa7, 0xf1, 0x02, 0xbd, 0x03, 0x70, 0x31, 0xfb, 0xe3, 0xa3, 0xe9, 0x11, 0x0e,
-	0xc7, 0x59, 0x9f, 0x45, 0x62, 0xb1, 0x3d, 0x8d, 0xbd, 0x81, 0xaa, 0xd3, 0x61, 0x37, 0xb6, 0xf7,
-	0x07, 0x0e, 0x38, 0x18, 0x38, 0xe0, 0xc7, 0xc0, 0x01, 0x6f, 0x86, 0x4e, 0xe1, 0x60, 0xe8, 0x14,
-	0xbe, 0x0d, 0x9d, 0xc2, 0x63, 0xfb, 0xa6, 0x55, 0xfb, 0xa9, 0xc7, 0x25, 0x7e, 0x36, 0x2e, 0x94,
-	0xf4, 0x23, 0xaa, 0x82, 0xa2, 0xfe, 0x7f, 0xba, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x59, 0x67,
-	0xb1, 0x90, 0x95, 0x05, 0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xee, 0xf4, 0xf7, 0xb3, 0x09, 0x03, 0x34, 0x3a, 0x92, 0x00, 0x8d, 0x16, 0x59, 0x23, 0x20,
+	0xc2, 0x8e, 0x14, 0x0d, 0x27, 0x0f, 0x16, 0x83, 0x31, 0xf1, 0xa0, 0x6d, 0xd4, 0xe8, 0xa5, 0x99,
+	0xd2, 0x65, 0x9d, 0xd8, 0xce, 0x94, 0x9d, 0x2d, 0x91, 0x10, 0x3c, 0x78, 0xf6, 0x60, 0xe2, 0xdf,
+	0x60, 0x82, 0xf1, 0xa2, 0xc6, 0xb3, 0x67, 0x0e, 0x1e, 0x88, 0x5e, 0x3c, 0x19, 0x03, 0x26, 0xfe,
+	0x1b, 0x66, 0x67, 0x5e, 0xcb, 0x34, 0xed, 0x42, 0xab, 0x5e, 0xda, 0xed, 0xbc, 0xf7, 0xbd, 0xef,
+	0x7b, 0xdf, 0xbe, 0x7d, 0x5b, 0x7c, 0x7e, 0x55, 0xaa, 0x9a, 0x54, 0x54, 0x6d, 0xd6, 0x8a, 0x55,
+	0xa6, 0x1e, 0xd3, 0x8d, 0x85, 0xb2, 0x17, 0xb2, 0x05, 0xba, 0xde, 0xf0, 0x82, 0x4d, 0xb7, 0x1e,
+	0xc8, 0x50, 0x92, 0x51, 0x93, 0xe4, 0x36, 0x93, 0x5c, 0x48, 0xca, 0xcc, 0x02, 0xba, 0xcc, 0x94,
+	0x67, 0x10, 0x2d, 0x7c, 0x9d, 0xf9, 0x5c, 0xb0, 0x90, 0x4b, 0x61, 0x8a, 0x64, 0x46, 0x7c, 0xe9,
+	0x4b, 0x7d, 0x49, 0xa3, 0x2b, 0x38, 0x3d, 0xe3, 0x4b, 0xe9, 0x57, 0x3d, 0xca, 0xea, 0x9c, 0x32,
+	0x21, 0x64, 0xa8, 0x21, 0x0a, 0xa2, 0x53, 0x71, 0xea, 0x54, 0xf4, 0x8b, 0x0b, 0x1f, 0xf2, 0xc6,
+	0x4d, 0x5e, 0xc9, 0x94, 0x07, 0xb5, 0x26, 0x74, 0x8a, 0xd5, 0xb8, 0x90, 0x54, 0x7f, 0x9a, 0x23,
+	0x67, 0x04, 0x93, 0xbb, 0x91, 0xd6, 0x3b, 0x2c, 0x60, 0x35, 0x55, 0xf0, 0xd6, 0x1b, 0x9e, 0x0a,
+	0x9d, 0x87, 0xf8, 0x74, 0xdb, 0xa9, 0xaa, 0x4b, 0xa1, 0x3c, 0x92, 0xc7, 0xa9, 0xba, 0x3e, 0x19,
+	0x43, 0xe7, 0xd0, 0xcc, 0x60, 0x6e, 0xc2, 0x8d, 0x31, 0xc3, 0x35, 0xc0, 0xfc, 0xc0, 0xee, 0xf7,
+	0x89, 0xc4, 0xce, 0xaf, 0x77, 0xb3, 0xa8, 0x00, 0x48, 0xa7, 0x84, 0x47, 0x75, 0xe9, 0x22, 0xf7,
+	0x05, 0x17, 0xfe, 0x2d, 0xb1, 0x26, 0x81, 0x95, 0xdc, 0xc0, 0x43, 0xab, 0x52, 0xa8, 0x12, 0xab,
+	0x54, 0x02, 0x4f, 0x19, 0x92, 0x81, 0xfc, 0xe4, 0x97, 0x8f, 0xf3, 0x67, 0x81, 0x67, 0x39, 0x92,
+	0x21, 0x54, 0x43, 0x5d, 0x37, 0x29, 0xc5, 0x30, 0xe0, 0xc2, 0x2f, 0x0c, 0x46, 0x30, 0x38, 0x72,
+	0x9e, 0xe1, 0xb1, 0x4e, 0x02, 0x68, 0xa0, 0x8c, 0x4f, 0x6e, 0xb0, 0x6a, 0x49, 0x99, 0x50, 0x89,
+	0x8b, 0x35, 0x09, 0xad, 0xcc, 0xc7, 0xb6, 0x72, 0x9f, 0x55, 0x79, 0x85, 0x85, 0x32, 0xb0, 0x0a,
+	0xda, 0x8d, 0xa5, 0x37, 0x58, 0xd5, 0x0a, 0x39, 0xe5, 0x4e, 0xfe, 0xa6, 0xaf, 0x64, 0x05, 0xe3,
+	0xc3, 0x59, 0x00, 0xe6, 0xa9, 0x26, 0x73, 0x34, 0x38, 0xae, 0x19, 0xb5, 0x43, 0x1b, 0x7d, 0x0f,
+	0xb0, 0x05, 0x0b, 0xe9, 0x7c, 0x40, 0x78, 0xbc, 0x0b, 0x09, 0x74, 0x79, 0x1b, 0xff, 0x0f, 0x9d,
+	0xfd, 0xf7, 0x57, 0x9d, 0xe9, 0x2a, 0xe4, 0x66, 0x9b, 0xe6, 0xa4, 0xd6, 0x3c, 0x7d, 0xac, 0x66,
+	0x23, 0xa5, 0x4d, 0xf4, 0x6c, 0xd3, 0x98, 0x48, 0x44, 0xb3, 0x2b, 0xf3, 0x45, 0xd2, 0x38, 0xc9,
+	0x2b, 0xe6, 0x86, 0x17, 0x92, 0xbc, 0xe2, 0x04, 0x78, 0xbc, 0x4b, 0x2e, 0xf4, 0x77, 0x0f, 0x0f,
+	0xeb, 0x99, 0x2f, 0x05, 0x26, 0x00, 0x46, 0x5e, 0x88, 0x6d, 0xd4, 0xae, 0x62, 0x37, 0x38, 0xa4,
+	0xac, 0x80, 0xb3, 0x83, 0xba, 0x90, 0xb6, 0x6e, 0xdd, 0x32, 0x4e, 0xa9, 0x90, 0x85, 0x0d, 0x33,
+	0x96, 0xe9, 0xdc, 0xa5, 0x9e, 0xd8, 0x8a, 0x1a, 0x52, 0x00, 0x28, 0x59, 0xe9, 0xe2, 0xe5, 0x9f,
+	0xdc, 0xff, 0x4f, 0x08, 0x67, 0xba, 0x49, 0x05, 0x83, 0x1e, 0xe0, 0x74, 0x9b, 0x41, 0x0a, 0x46,
+	0xa1, 0x7f, 0x87, 0x86, 0x6d, 0x87, 0xd4, 0x3f, 0x9b, 0x85, 0xdc, 0xe7, 0x14, 0x3e, 0xa1, 0x1b,
+	0x20, 0x2f, 0x10, 0x4e, 0x99, 0x6d, 0x41, 0xe2, 0x2d, 0xed, 0x5c, 0x51, 0x99, 0xb9, 0xde, 0x92,
+	0x0d, 0xb7, 0x33, 0xfd, 0xfc, 0xeb, 0xcf, 0x57, 0xc9, 0x49, 0x32, 0x41, 0xe3, 0xb6, 0xa8, 0x59,
+	0x4f, 0xe4, 0x3d, 0xc2, 0x83, 0xd6, 0xe3, 0x40, 0x2e, 0x1f, 0x4d, 0xd3, 0xb9, 0xc5, 0x32, 0x0b,
+	0x7d, 0x20, 0x40, 0xdd, 0x35, 0xad, 0x6e, 0x89, 0x5c, 0x8d, 0x55, 0x67, 0x6f, 0x2c, 0x45, 0xb7,
+	0xec, 0x35, 0xb9, 0x4d, 0x5e, 0x23, 0x3c, 0x64, 0x95, 0x55, 0xa4, 0x77, 0x09, 0x2d, 0x3b, 0x73,
+	0xfd, 0x40, 0x40, 0xb6, 0xab, 0x65, 0xcf, 0x90, 0xa9, 0xde, 0x64, 0x93, 0xb7, 0x91, 0x4e, 0x6b,
+	0x9e, 0x8e, 0xd5, 0xd9, 0xb9, 0x28, 0x32, 0xb9, 0x7e, 0x20, 0xa0, 0xf3, 0x8a, 0xd6, 0xe9, 0x92,
+	0x39, 0x7a, 0xe4, 0x2b, 0xb4, 0xf5, 0xb4, 0xd0, 0x2d, 0x5e, 0xd9, 0x26, 0x6f, 0x10, 0x1e, 0x2e,
+	0xb6, 0x4d, 0x7f, 0x1f, 0xdc, 0x2d, 0x5f, 0x17, 0xfb, 0xc2, 0x80, 0x60, 0xaa, 0x05, 0x5f, 0x24,
+	0xd3, 0x3d, 0x0a, 0xce, 0x2f, 0xed, 0xee, 0x67, 0xd1, 0xde, 0x7e, 0x16, 0xfd, 0xd8, 0xcf, 0xa2,
+	0x97, 0x07, 0xd9, 0xc4, 0xde, 0x41, 0x36, 0xf1, 0xed, 0x20, 0x9b, 0x78, 0x04, 0x6f, 0x4e, 0x55,
+	0x79, 0xe2, 0x72, 0x49, 0x9f, 0x1e, 0x56, 0x0a, 0x37, 0xeb, 0x9e, 0x2a, 0xa7, 0xf4, 0xbf, 0x80,
+	0xc5, 0xdf, 0x03, 0x00, 0x06, 0x95, 0x4c, 0x17, 0xfb, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +597,11 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// SlashRequest queries a slash request of the outbox by ID
+	SlashRequest(ctx context.Context, in *QuerySlashRequestRequest, opts ...grpc.CallOption) (*QuerySlashRequestResponse, error)
+	// SlashRequests queries the slash requests of the outbox, optionally with a
+	// given status
+	SlashRequests(ctx context.Context, in *QuerySlashRequestsRequest, opts ...grpc.CallOption) (*QuerySlashRequestsResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +639,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) SlashRequest(ctx context.Context, in *QuerySlashRequestRequest, opts ...grpc.CallOption) (*QuerySlashRequestResponse, error) {
+	out := new(QuerySlashRequestResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symSlash.v1beta1.Query/SlashRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashRequests(ctx context.Context, in *QuerySlashRequestsRequest, opts ...grpc.CallOption) (*QuerySlashRequestsResponse, error) {
+	out := new(QuerySlashRequestsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symSlash.v1beta1.Query/SlashRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +665,11 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// SlashRequest queries a slash request of the outbox by ID
+	SlashRequest(context.Context, *QuerySlashRequestRequest) (*QuerySlashRequestResponse, error)
+	// SlashRequests queries the slash requests of the outbox, optionally with a
+	// given status
+	SlashRequests(context.Context, *QuerySlashRequestsRequest) (*QuerySlashRequestsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +685,12 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) SlashRequest(ctx context.Context, req *QuerySlashRequestRequest) (*QuerySlashRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRequest not implemented")
+}
+func (*UnimplementedQueryServer) SlashRequests(ctx context.Context, req *QuerySlashRequestsRequest) (*QuerySlashRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRequests not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +750,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symSlash.v1beta1.Query/SlashRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRequest(ctx, req.(*QuerySlashRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symSlash.v1beta1.Query/SlashRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRequests(ctx, req.(*QuerySlashRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.symSlash.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +802,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "SlashRequest",
+			Handler:    _Query_SlashRequest_Handler,
+		},
+		{
+			MethodName: "SlashRequests",
+			Handler:    _Query_SlashRequests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symSlash/v1beta1/query.proto",
@@ -723,100 +1018,609 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySlashRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuerySlashRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlashRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size, err := m.SlashRequest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlashRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
-}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SlashRequests) > 0 {
+		for iNdEx := len(m.SlashRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
 
 func (m *QuerySigningInfoResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.ValSigningInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.ValSigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		for _, e := range m.Info {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashRequest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySlashRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashRequests) > 0 {
+		for _, e := range m.SlashRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QuerySigningInfosRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QuerySigningInfosResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Info) > 0 {
-		for _, e := range m.Info {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -839,12 +1643,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -866,7 +1706,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -889,15 +1729,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -924,7 +1764,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Info = append(m.Info, ValidatorSigningInfo{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -949,7 +1826,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -972,15 +1849,15 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1008,7 +1885,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1031,7 +1908,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1054,15 +1931,15 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1089,7 +1966,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1114,7 +1991,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1137,13 +2014,32 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1200,7 +2096,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1223,15 +2119,15 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1258,8 +2154,8 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, ValidatorSigningInfo{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashRequests = append(m.SlashRequests, SlashRequest{})
+			if err := m.SlashRequests[len(m.SlashRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
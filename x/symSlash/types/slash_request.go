@@ -0,0 +1,95 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// SlashRequestID returns the ID of the slash request of a validator in a
+// middleware for an infraction at height. The ID only depends on these, so that
+// an infraction is never requested twice.
+func SlashRequestID(infraction Infraction, height int64, valAddr sdk.ValAddress, middleware common.Address) []byte {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint32(bz, uint32(infraction))
+	binary.BigEndian.PutUint64(bz[4:], uint64(height))
+
+	bz = append(bz, address.MustLengthPrefix(valAddr)...)
+	bz = append(bz, middleware.Bytes()...)
+
+	id := sha256.Sum256(bz)
+	return id[:]
+}
+
+// NewSlashRequest creates a new pending SlashRequest instance.
+func NewSlashRequest(
+	id []byte, infraction Infraction, height int64, valAddr string,
+	operator, middleware common.Address, epoch uint64, amount math.Int,
+) SlashRequest {
+	return SlashRequest{
+		Id:                hex.EncodeToString(id),
+		Infraction:        infraction,
+		Height:            height,
+		ValidatorAddress:  valAddr,
+		Operator:          operator.Hex(),
+		MiddlewareAddress: middleware.Hex(),
+		Epoch:             epoch,
+		Amount:            amount,
+		Status:            SlashRequestPending,
+	}
+}
+
+// ParseSlashRequestID decodes a hex encoded slash request ID.
+func ParseSlashRequestID(id string) ([]byte, error) {
+	bz, err := hex.DecodeString(id)
+	if err != nil || len(bz) != sha256.Size {
+		return nil, fmt.Errorf("invalid slash request id: %s", id)
+	}
+	return bz, nil
+}
+
+// Validate performs a stateless validation of the slash request.
+func (r SlashRequest) Validate() error {
+	if _, err := ParseSlashRequestID(r.Id); err != nil {
+		return err
+	}
+
+	if r.Infraction == Infraction_INFRACTION_UNSPECIFIED {
+		return fmt.Errorf("slash request %s has no infraction", r.Id)
+	}
+
+	if !common.IsHexAddress(r.Operator) {
+		return fmt.Errorf("slash request %s has an invalid operator: %s", r.Id, r.Operator)
+	}
+
+	if !common.IsHexAddress(r.MiddlewareAddress) {
+		return fmt.Errorf("slash request %s has an invalid middleware: %s", r.Id, r.MiddlewareAddress)
+	}
+
+	if r.Amount.IsNil() || !r.Amount.IsPositive() {
+		return fmt.Errorf("slash request %s amount must be positive", r.Id)
+	}
+
+	switch r.Status {
+	case SlashRequestPending:
+		if r.TxHash != "" {
+			return fmt.Errorf("pending slash request %s has a tx hash", r.Id)
+		}
+	case SlashRequestCompleted:
+		if r.TxHash == "" {
+			return fmt.Errorf("completed slash request %s has no tx hash", r.Id)
+		}
+	default:
+		return fmt.Errorf("slash request %s has an invalid status: %s", r.Id, r.Status)
+	}
+
+	return nil
+}
@@ -31,6 +31,70 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Infraction is the infraction a slash request is recorded for.
+type Infraction int32
+
+const (
+	// UNSPECIFIED defines an empty infraction.
+	Infraction_INFRACTION_UNSPECIFIED Infraction = 0
+	// DOUBLE_SIGN defines a validator that double-signs a block.
+	Infraction_INFRACTION_DOUBLE_SIGN Infraction = 1
+	// DOWNTIME defines a validator that missed signing too many blocks.
+	Infraction_INFRACTION_DOWNTIME Infraction = 2
+)
+
+var Infraction_name = map[int32]string{
+	0: "INFRACTION_UNSPECIFIED",
+	1: "INFRACTION_DOUBLE_SIGN",
+	2: "INFRACTION_DOWNTIME",
+}
+
+var Infraction_value = map[string]int32{
+	"INFRACTION_UNSPECIFIED": 0,
+	"INFRACTION_DOUBLE_SIGN": 1,
+	"INFRACTION_DOWNTIME":    2,
+}
+
+func (x Infraction) String() string {
+	return proto.EnumName(Infraction_name, int32(x))
+}
+
+func (Infraction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d2851e005d65ca8, []int{0}
+}
+
+// SlashRequestStatus is the status of a slash request in the outbox.
+type SlashRequestStatus int32
+
+const (
+	// UNSPECIFIED defines an invalid status.
+	SlashRequestUnspecified SlashRequestStatus = 0
+	// PENDING defines a request not submitted to the middleware yet.
+	SlashRequestPending SlashRequestStatus = 1
+	// COMPLETED defines a request whose slash was observed on Ethereum.
+	SlashRequestCompleted SlashRequestStatus = 2
+)
+
+var SlashRequestStatus_name = map[int32]string{
+	0: "SLASH_REQUEST_STATUS_UNSPECIFIED",
+	1: "SLASH_REQUEST_STATUS_PENDING",
+	2: "SLASH_REQUEST_STATUS_COMPLETED",
+}
+
+var SlashRequestStatus_value = map[string]int32{
+	"SLASH_REQUEST_STATUS_UNSPECIFIED": 0,
+	"SLASH_REQUEST_STATUS_PENDING":     1,
+	"SLASH_REQUEST_STATUS_COMPLETED":   2,
+}
+
+func (x SlashRequestStatus) String() string {
+	return proto.EnumName(SlashRequestStatus_name, int32(x))
+}
+
+func (SlashRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d2851e005d65ca8, []int{1}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -135,6 +199,9 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// slash_relayers are the accounts allowed to mark the slash requests
+	// completed once submitted to the Symbiotic middleware.
+	SlashRelayers []string `protobuf:"bytes,6,rep,name=slash_relayers,json=slashRelayers,proto3" json:"slash_relayers,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,9 +251,143 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetSlashRelayers() []string {
+	if m != nil {
+		return m.SlashRelayers
+	}
+	return nil
+}
+
+// SlashRequest is a request to slash the stake of an operator in a Symbiotic
+// middleware, recorded for an infraction of its validator.
+type SlashRequest struct {
+	// id is the hex encoded deterministic ID of the request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// infraction is the infraction the request is recorded for.
+	Infraction Infraction `protobuf:"varint,2,opt,name=infraction,proto3,enum=cosmos.symSlash.v1beta1.Infraction" json:"infraction,omitempty"`
+	// height is the height of the infraction.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// validator_address is the operator address of the slashed validator.
+	ValidatorAddress string `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// operator is the hex address of the Symbiotic operator of the validator.
+	Operator string `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	// middleware_address is the hex address of the middleware to slash in.
+	MiddlewareAddress string `protobuf:"bytes,6,opt,name=middleware_address,json=middlewareAddress,proto3" json:"middleware_address,omitempty"`
+	// epoch is the epoch of the middleware validator set the stake was synced
+	// from.
+	Epoch uint64 `protobuf:"varint,7,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// amount is the stake to slash, the slash fraction of the infraction applied
+	// to the stake of the validator in the middleware.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// status is the status of the request.
+	Status SlashRequestStatus `protobuf:"varint,9,opt,name=status,proto3,enum=cosmos.symSlash.v1beta1.SlashRequestStatus" json:"status,omitempty"`
+	// tx_hash is the hash of the Ethereum transaction the slash was observed in,
+	// once completed.
+	TxHash string `protobuf:"bytes,10,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *SlashRequest) Reset()         { *m = SlashRequest{} }
+func (m *SlashRequest) String() string { return proto.CompactTextString(m) }
+func (*SlashRequest) ProtoMessage()    {}
+func (*SlashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d2851e005d65ca8, []int{2}
+}
+func (m *SlashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRequest.Merge(m, src)
+}
+func (m *SlashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRequest proto.InternalMessageInfo
+
+func (m *SlashRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SlashRequest) GetInfraction() Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return Infraction_INFRACTION_UNSPECIFIED
+}
+
+func (m *SlashRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *SlashRequest) GetMiddlewareAddress() string {
+	if m != nil {
+		return m.MiddlewareAddress
+	}
+	return ""
+}
+
+func (m *SlashRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *SlashRequest) GetStatus() SlashRequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SlashRequestUnspecified
+}
+
+func (m *SlashRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.symSlash.v1beta1.Infraction", Infraction_name, Infraction_value)
+	proto.RegisterEnum("cosmos.symSlash.v1beta1.SlashRequestStatus", SlashRequestStatus_name, SlashRequestStatus_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.symSlash.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.symSlash.v1beta1.Params")
+	proto.RegisterType((*SlashRequest)(nil), "cosmos.symSlash.v1beta1.SlashRequest")
 }
 
 func init() {
@@ -194,47 +395,73 @@ func init() {
 }
 
 var fileDescriptor_3d2851e005d65ca8 = []byte{
-	// 637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x00, 0xa2, 0x4e, 0xeb, 0xc1, 0xb1, 0xc8, 0x52, 0x65, 0x5b, 0x48, 0x34, 0x0d, 0x09,
-	0xbb, 0x82, 0x89, 0x07, 0x38, 0x59, 0x1a, 0xa3, 0x86, 0x44, 0xd2, 0xfa, 0x23, 0xf1, 0xe0, 0x66,
-	0x76, 0x67, 0xba, 0x1d, 0xd9, 0x9d, 0x69, 0x76, 0x66, 0x05, 0xfe, 0x05, 0x13, 0x13, 0x8e, 0x1e,
-	0x3d, 0x72, 0xe4, 0xc0, 0xbf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0xb4, 0x07, 0xfc, 0x33,
-	0xcc, 0xce, 0xec, 0x0a, 0xc2, 0x8d, 0x4b, 0xd3, 0x7d, 0xdf, 0xf7, 0xbe, 0x6f, 0xdf, 0xf7, 0x5e,
-	0x16, 0x3e, 0x0c, 0x84, 0x8c, 0x85, 0x74, 0xe5, 0x4e, 0xdc, 0x8d, 0xb0, 0xec, 0xbb, 0x9f, 0x96,
-	0x7c, 0xaa, 0xf0, 0x92, 0x2b, 0xb3, 0x27, 0xc6, 0x43, 0x67, 0x90, 0x08, 0x25, 0xd0, 0xb4, 0xe1,
-	0x39, 0x05, 0xcf, 0xc9, 0x79, 0xb5, 0x6a, 0x28, 0x42, 0xa1, 0x39, 0x6e, 0xf6, 0xcf, 0xd0, 0x6b,
-	0x76, 0x28, 0x44, 0x18, 0x51, 0x57, 0x3f, 0xf9, 0x69, 0xcf, 0x25, 0x69, 0x82, 0x15, 0x13, 0x3c,
-	0xc7, 0xeb, 0x17, 0x71, 0xc5, 0x62, 0x2a, 0x15, 0x8e, 0x07, 0x39, 0x61, 0xc6, 0xf8, 0x79, 0x46,
-	0x39, 0x37, 0x37, 0xd0, 0x6d, 0x1c, 0x33, 0x2e, 0x5c, 0xfd, 0x6b, 0x4a, 0xf3, 0xdf, 0xc7, 0x60,
-	0xf5, 0x2d, 0x8e, 0x18, 0xc1, 0x4a, 0x24, 0x5d, 0x16, 0x72, 0xc6, 0xc3, 0x17, 0xbc, 0x27, 0xd0,
-	0x2a, 0xbc, 0x8e, 0x09, 0x49, 0xa8, 0x94, 0x16, 0x68, 0x80, 0xe6, 0xcd, 0xd6, 0xdc, 0xf1, 0xc1,
-	0xe2, 0x6c, 0x2e, 0xb7, 0x26, 0xb8, 0xa4, 0x5c, 0xa6, 0xf2, 0xa9, 0xa1, 0x74, 0x55, 0xc2, 0x78,
-	0xd8, 0x29, 0x3a, 0xd0, 0x1c, 0xac, 0x48, 0x85, 0x13, 0xe5, 0xf5, 0x29, 0x0b, 0xfb, 0xca, 0x1a,
-	0x6b, 0x80, 0xe6, 0x78, 0xa7, 0xac, 0x6b, 0xcf, 0x75, 0x09, 0x3d, 0x80, 0x15, 0xc6, 0x09, 0xdd,
-	0xf6, 0x44, 0xaf, 0x27, 0xa9, 0xb2, 0xc6, 0x33, 0x4a, 0x6b, 0xcc, 0x02, 0x9d, 0xb2, 0xae, 0xbf,
-	0xd2, 0x65, 0xb4, 0x0e, 0x2b, 0x1f, 0x31, 0x8b, 0x28, 0xf1, 0x52, 0xae, 0x58, 0x64, 0x4d, 0x34,
-	0x40, 0xb3, 0xbc, 0x5c, 0x73, 0x4c, 0x0a, 0x4e, 0x91, 0x82, 0xf3, 0xba, 0x48, 0xa1, 0x75, 0xeb,
-	0xf0, 0xa4, 0x5e, 0xda, 0xfd, 0x55, 0x07, 0x7b, 0xa7, 0xfb, 0x0b, 0xa0, 0x53, 0x36, 0xed, 0x6f,
-	0xb2, 0x6e, 0x64, 0x43, 0xa8, 0x44, 0xec, 0x4b, 0x25, 0x38, 0x25, 0xd6, 0xb5, 0x06, 0x68, 0xde,
-	0xe8, 0x9c, 0xab, 0xa0, 0x65, 0x38, 0x15, 0x33, 0x29, 0x29, 0xf1, 0xfc, 0x48, 0x04, 0x9b, 0xd2,
-	0x0b, 0x44, 0xca, 0x15, 0x4d, 0xac, 0x49, 0x3d, 0xc0, 0x1d, 0x03, 0xb6, 0x34, 0xb6, 0x66, 0xa0,
-	0x95, 0x89, 0x3f, 0xdf, 0xea, 0x60, 0xfe, 0xcb, 0x04, 0x9c, 0xdc, 0xc0, 0x09, 0x8e, 0x25, 0x7a,
-	0x04, 0xab, 0x92, 0x85, 0xfc, 0x4c, 0x64, 0x8b, 0x71, 0x22, 0xb6, 0x74, 0x8c, 0xe3, 0x1d, 0x64,
-	0x30, 0xa3, 0xf1, 0x4e, 0x23, 0x88, 0x65, 0xb6, 0xdc, 0xcb, 0xbb, 0x06, 0x34, 0x29, 0x5a, 0xb2,
-	0xdc, 0x2a, 0xad, 0x27, 0xd9, 0x44, 0x3f, 0x4f, 0xea, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x3a, 0x4c,
-	0xb8, 0x31, 0x56, 0x7d, 0x67, 0x9d, 0x86, 0x38, 0xd8, 0x69, 0xd3, 0xe0, 0xf8, 0x60, 0x11, 0xe6,
-	0xcb, 0x69, 0xd3, 0xc0, 0x8c, 0x8e, 0x62, 0xc6, 0xbb, 0x5a, 0x73, 0x83, 0x26, 0xb9, 0xd5, 0x07,
-	0x78, 0x97, 0x88, 0x2d, 0x9e, 0x1d, 0x8d, 0x97, 0x25, 0xe3, 0x15, 0xe7, 0xa5, 0x17, 0x50, 0x5e,
-	0x9e, 0xb9, 0x94, 0x6c, 0x3b, 0x27, 0x98, 0x60, 0xbf, 0xfe, 0x0b, 0xb6, 0x5a, 0xe8, 0xbc, 0xc4,
-	0x2c, 0x2a, 0x48, 0x48, 0xc2, 0x9a, 0xbe, 0x7f, 0xaf, 0x97, 0xe0, 0x20, 0xab, 0x78, 0x44, 0xa4,
-	0x7e, 0x44, 0xf5, 0x70, 0x7a, 0x7b, 0x57, 0x9f, 0x67, 0x5a, 0x2b, 0x3f, 0xcb, 0x85, 0xdb, 0x5a,
-	0x37, 0x9b, 0x0f, 0x71, 0x38, 0x7d, 0xc9, 0xd4, 0xbc, 0x9b, 0xde, 0xf1, 0xd5, 0x1d, 0xa7, 0x2e,
-	0x38, 0x1a, 0xd1, 0x95, 0xb9, 0xcf, 0xa7, 0xfb, 0x0b, 0xf7, 0x0d, 0x79, 0x51, 0x92, 0x4d, 0x77,
-	0xfb, 0xec, 0x2b, 0x60, 0x8e, 0xa0, 0xb5, 0xba, 0x37, 0xb4, 0xc1, 0xe1, 0xd0, 0x06, 0x47, 0x43,
-	0x1b, 0xfc, 0x1e, 0xda, 0x60, 0x77, 0x64, 0x97, 0x8e, 0x46, 0x76, 0xe9, 0xc7, 0xc8, 0x2e, 0xbd,
-	0x9f, 0xfd, 0xef, 0x3d, 0xce, 0x75, 0xab, 0x9d, 0x01, 0x95, 0xfe, 0xa4, 0x0e, 0xff, 0xf1, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0x13, 0x3f, 0xb7, 0x63, 0x04, 0x00, 0x00,
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x4f, 0x1b, 0xc7,
+	0x1b, 0xf6, 0x1a, 0x63, 0x60, 0x20, 0xc8, 0x0c, 0x06, 0x2f, 0x4e, 0x62, 0x0c, 0x3f, 0xfd, 0x2a,
+	0x8b, 0x0a, 0x3b, 0xa1, 0x52, 0xa5, 0x26, 0xaa, 0x2a, 0xfc, 0x87, 0xe0, 0x8a, 0x18, 0x77, 0xd7,
+	0x6e, 0xa4, 0x4a, 0xed, 0x6a, 0xec, 0x1d, 0xaf, 0xa7, 0xec, 0xce, 0xb8, 0x3b, 0x63, 0xfe, 0x7c,
+	0x83, 0x8a, 0x53, 0x8e, 0xbd, 0x20, 0x55, 0xea, 0x25, 0xc7, 0x1c, 0xf8, 0x0a, 0x95, 0x72, 0xaa,
+	0x22, 0x54, 0xa9, 0x55, 0x0f, 0x69, 0x05, 0x87, 0xf4, 0x63, 0x54, 0x3b, 0xb3, 0x0b, 0x06, 0x92,
+	0x53, 0x2e, 0x96, 0xe7, 0x7d, 0x9f, 0xe7, 0x79, 0xf7, 0x7d, 0xde, 0x99, 0x17, 0x7c, 0xd4, 0x65,
+	0xdc, 0x63, 0xbc, 0xc4, 0x8f, 0x3c, 0xd3, 0x45, 0xbc, 0x5f, 0xda, 0x7f, 0xd8, 0xc1, 0x02, 0x3d,
+	0x2c, 0xf1, 0xe0, 0x44, 0xa8, 0x53, 0x1c, 0xf8, 0x4c, 0x30, 0x98, 0x51, 0xb8, 0x62, 0x84, 0x2b,
+	0x86, 0xb8, 0x6c, 0xda, 0x61, 0x0e, 0x93, 0x98, 0x52, 0xf0, 0x4f, 0xc1, 0xb3, 0x39, 0x87, 0x31,
+	0xc7, 0xc5, 0x25, 0x79, 0xea, 0x0c, 0x7b, 0x25, 0x7b, 0xe8, 0x23, 0x41, 0x18, 0x0d, 0xf3, 0xcb,
+	0x37, 0xf3, 0x82, 0x78, 0x98, 0x0b, 0xe4, 0x0d, 0x42, 0xc0, 0x92, 0xaa, 0x67, 0x29, 0xe5, 0xb0,
+	0xb8, 0x4a, 0xcd, 0x21, 0x8f, 0x50, 0x56, 0x92, 0xbf, 0x2a, 0xb4, 0xfa, 0x6b, 0x1c, 0xa4, 0xbf,
+	0x46, 0x2e, 0xb1, 0x91, 0x60, 0xbe, 0x49, 0x1c, 0x4a, 0xa8, 0x53, 0xa7, 0x3d, 0x06, 0x1f, 0x83,
+	0x09, 0x64, 0xdb, 0x3e, 0xe6, 0x5c, 0xd7, 0xf2, 0x5a, 0x61, 0xaa, 0xbc, 0x72, 0x76, 0xba, 0x7e,
+	0x3f, 0x94, 0xab, 0x30, 0xca, 0x31, 0xe5, 0x43, 0xbe, 0xa9, 0x20, 0xa6, 0xf0, 0x09, 0x75, 0x8c,
+	0x88, 0x01, 0x57, 0xc0, 0x0c, 0x17, 0xc8, 0x17, 0x56, 0x1f, 0x13, 0xa7, 0x2f, 0xf4, 0x78, 0x5e,
+	0x2b, 0x8c, 0x19, 0xd3, 0x32, 0xb6, 0x2d, 0x43, 0xf0, 0xff, 0x60, 0x86, 0x50, 0x1b, 0x1f, 0x5a,
+	0xac, 0xd7, 0xe3, 0x58, 0xe8, 0x63, 0x01, 0xa4, 0x1c, 0xd7, 0x35, 0x63, 0x5a, 0xc6, 0x77, 0x65,
+	0x18, 0xee, 0x80, 0x99, 0xef, 0x11, 0x71, 0xb1, 0x6d, 0x0d, 0xa9, 0x20, 0xae, 0x9e, 0xc8, 0x6b,
+	0x85, 0xe9, 0x8d, 0x6c, 0x51, 0xb9, 0x50, 0x8c, 0x5c, 0x28, 0xb6, 0x22, 0x17, 0xca, 0x77, 0x5e,
+	0xbd, 0x59, 0x8e, 0x3d, 0xff, 0x7b, 0x59, 0x7b, 0xf1, 0xf6, 0xe5, 0x9a, 0x66, 0x4c, 0x2b, 0x7a,
+	0x3b, 0x60, 0xc3, 0x1c, 0x00, 0x82, 0x79, 0x1d, 0x2e, 0x18, 0xc5, 0xb6, 0x3e, 0x9e, 0xd7, 0x0a,
+	0x93, 0xc6, 0x48, 0x04, 0x6e, 0x80, 0x05, 0x8f, 0x70, 0x8e, 0x6d, 0xab, 0xe3, 0xb2, 0xee, 0x1e,
+	0xb7, 0xba, 0x6c, 0x48, 0x05, 0xf6, 0xf5, 0xa4, 0x6c, 0x60, 0x5e, 0x25, 0xcb, 0x32, 0x57, 0x51,
+	0xa9, 0x47, 0x89, 0x7f, 0x7f, 0x5e, 0xd6, 0x56, 0x7f, 0x4b, 0x80, 0x64, 0x13, 0xf9, 0xc8, 0xe3,
+	0xf0, 0x01, 0x48, 0x73, 0xe2, 0xd0, 0x2b, 0x91, 0x03, 0x42, 0x6d, 0x76, 0x20, 0x6d, 0x1c, 0x33,
+	0xa0, 0xca, 0x29, 0x8d, 0x67, 0x32, 0x03, 0x49, 0x50, 0x96, 0x5a, 0x21, 0x6b, 0x80, 0xfd, 0x88,
+	0x12, 0xf8, 0x36, 0x53, 0xfe, 0x34, 0xe8, 0xe8, 0xaf, 0x37, 0xcb, 0x77, 0x95, 0xfb, 0xdc, 0xde,
+	0x2b, 0x12, 0x56, 0xf2, 0x90, 0xe8, 0x17, 0x77, 0xb0, 0x83, 0xba, 0x47, 0x55, 0xdc, 0x3d, 0x3b,
+	0x5d, 0x07, 0xe1, 0x70, 0xaa, 0xb8, 0xab, 0x5a, 0x87, 0x1e, 0xa1, 0xa6, 0xd4, 0x6c, 0x62, 0x3f,
+	0x2c, 0xf5, 0x1d, 0x58, 0xb4, 0xd9, 0x01, 0x0d, 0x2e, 0x8d, 0x15, 0x38, 0x63, 0x45, 0xd7, 0x4b,
+	0x0e, 0x60, 0x7a, 0x63, 0xe9, 0x96, 0xb3, 0xd5, 0x10, 0xa0, 0x8c, 0xfd, 0xe9, 0xd2, 0xd8, 0x74,
+	0xa4, 0xf3, 0x25, 0x22, 0x6e, 0x04, 0x82, 0x1c, 0x64, 0xe5, 0xfd, 0xb7, 0x7a, 0x3e, 0xea, 0x06,
+	0x11, 0xcb, 0x66, 0xc3, 0x8e, 0x8b, 0x65, 0x73, 0x7a, 0xe2, 0x83, 0xfa, 0xc9, 0x48, 0xe5, 0xad,
+	0x50, 0xb8, 0x2a, 0x75, 0x83, 0xfe, 0x20, 0x05, 0x99, 0x5b, 0x45, 0xd5, 0xb7, 0xe9, 0xe3, 0x1f,
+	0x54, 0x71, 0xe1, 0x46, 0x45, 0x25, 0x0a, 0xbf, 0x00, 0xb3, 0xaa, 0x9e, 0x8f, 0x5d, 0x74, 0x84,
+	0x7d, 0xae, 0x27, 0xf3, 0x63, 0x85, 0xa9, 0xb2, 0x7e, 0x76, 0xba, 0x9e, 0x0e, 0x35, 0xae, 0xbf,
+	0x8c, 0x3b, 0x12, 0x6f, 0x84, 0xf0, 0x47, 0x2b, 0xc7, 0x6f, 0x5f, 0xae, 0xdd, 0x53, 0xc8, 0x75,
+	0x6e, 0xef, 0x95, 0x0e, 0xaf, 0xd6, 0x88, 0xba, 0x45, 0xab, 0xbf, 0x8f, 0x81, 0x19, 0x53, 0x91,
+	0x7e, 0x18, 0x62, 0x2e, 0xe0, 0x2c, 0x88, 0x13, 0x5b, 0xbd, 0x45, 0x23, 0x4e, 0x6c, 0x58, 0x01,
+	0x80, 0xd0, 0xa8, 0x61, 0x79, 0x53, 0x66, 0x37, 0xfe, 0x57, 0x7c, 0xcf, 0xb2, 0x29, 0xd6, 0x2f,
+	0xa1, 0xc6, 0x08, 0x0d, 0x2e, 0x82, 0x64, 0xf8, 0x44, 0xe5, 0xfb, 0x33, 0xc2, 0x13, 0x6c, 0x80,
+	0xb9, 0xfd, 0x68, 0x2b, 0x58, 0xd1, 0x1e, 0x48, 0xdc, 0xda, 0x03, 0x97, 0x9b, 0xe3, 0x7a, 0xb7,
+	0xa9, 0xfd, 0x1b, 0x71, 0x98, 0x05, 0x93, 0x6c, 0x80, 0xfd, 0x20, 0x24, 0x47, 0x32, 0x65, 0x5c,
+	0x9e, 0xe1, 0x3a, 0x80, 0x1e, 0xb1, 0x6d, 0x17, 0x1f, 0x20, 0x1f, 0x5f, 0x16, 0x4b, 0x4a, 0xd4,
+	0xdc, 0x55, 0x26, 0x92, 0x4a, 0x83, 0x71, 0x3c, 0x60, 0xdd, 0xbe, 0x3e, 0x91, 0xd7, 0x0a, 0x09,
+	0x43, 0x1d, 0xe0, 0x36, 0x48, 0x22, 0x2f, 0x78, 0x91, 0xfa, 0xa4, 0xfc, 0xca, 0x07, 0xe1, 0xc4,
+	0x17, 0x6e, 0x4f, 0xbc, 0x4e, 0xc5, 0xc8, 0xac, 0xeb, 0x54, 0xa8, 0x59, 0x87, 0x7c, 0x58, 0x01,
+	0x49, 0x2e, 0x90, 0x18, 0x72, 0x7d, 0x4a, 0x7a, 0xfa, 0xf1, 0x7b, 0x3d, 0x1d, 0x1d, 0x8f, 0x29,
+	0x29, 0x46, 0x48, 0x85, 0x19, 0x30, 0x21, 0x0e, 0xad, 0x3e, 0xe2, 0x7d, 0x1d, 0xc8, 0x46, 0x92,
+	0xe2, 0x70, 0x1b, 0xf1, 0xfe, 0xda, 0xb7, 0x00, 0x5c, 0x8d, 0x02, 0x66, 0xc1, 0x62, 0xbd, 0xb1,
+	0x65, 0x6c, 0x56, 0x5a, 0xf5, 0xdd, 0x86, 0xd5, 0x6e, 0x98, 0xcd, 0x5a, 0xa5, 0xbe, 0x55, 0xaf,
+	0x55, 0x53, 0xb1, 0x1b, 0xb9, 0xea, 0x6e, 0xbb, 0xbc, 0x53, 0xb3, 0xcc, 0xfa, 0x93, 0x46, 0x4a,
+	0x83, 0x19, 0x30, 0x7f, 0x2d, 0xf7, 0xac, 0xd1, 0xaa, 0x3f, 0xad, 0xa5, 0xe2, 0x6b, 0x7f, 0x68,
+	0x00, 0xde, 0xfe, 0x2c, 0xb8, 0x09, 0xf2, 0xe6, 0xce, 0xa6, 0xb9, 0x6d, 0x19, 0xb5, 0xaf, 0xda,
+	0x35, 0xb3, 0x65, 0x99, 0xad, 0xcd, 0x56, 0xdb, 0xbc, 0x5e, 0x31, 0x7b, 0xf7, 0xf8, 0x24, 0x9f,
+	0x19, 0x65, 0xb7, 0x29, 0x1f, 0xe0, 0x2e, 0xe9, 0x11, 0x6c, 0xc3, 0xcf, 0xc0, 0xbd, 0x77, 0x4a,
+	0x34, 0x6b, 0x8d, 0x6a, 0xbd, 0xf1, 0x24, 0xa5, 0x65, 0x33, 0xc7, 0x27, 0xf9, 0xf9, 0x51, 0x7a,
+	0x13, 0x53, 0x9b, 0x50, 0x07, 0x7e, 0x0e, 0x72, 0xef, 0xa4, 0x56, 0x76, 0x9f, 0x36, 0x77, 0x6a,
+	0xad, 0x5a, 0x35, 0x15, 0xcf, 0x2e, 0x1d, 0x9f, 0xe4, 0x17, 0x46, 0xc9, 0x15, 0xe6, 0x0d, 0x5c,
+	0x2c, 0xb0, 0x9d, 0x4d, 0xfc, 0xf8, 0x4b, 0x2e, 0x56, 0x7e, 0xfc, 0xe2, 0x3c, 0xa7, 0xbd, 0x3a,
+	0xcf, 0x69, 0xaf, 0xcf, 0x73, 0xda, 0x3f, 0xe7, 0x39, 0xed, 0xf9, 0x45, 0x2e, 0xf6, 0xfa, 0x22,
+	0x17, 0xfb, 0xf3, 0x22, 0x17, 0xfb, 0xe6, 0xfe, 0xb5, 0x31, 0x8f, 0xbc, 0x26, 0x71, 0x34, 0xc0,
+	0xbc, 0x93, 0x94, 0xdb, 0xec, 0x93, 0xff, 0x06, 0x00, 0x41, 0xc3, 0x36, 0x46, 0xb4, 0x07, 0x00,
+	0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -310,6 +537,65 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if len(this.SlashRelayers) != len(that1.SlashRelayers) {
+		return false
+	}
+	for i := range this.SlashRelayers {
+		if this.SlashRelayers[i] != that1.SlashRelayers[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *SlashRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashRequest)
+	if !ok {
+		that2, ok := that.(SlashRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Infraction != that1.Infraction {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if this.MiddlewareAddress != that1.MiddlewareAddress {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -395,6 +681,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashRelayers) > 0 {
+		for iNdEx := len(m.SlashRelayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashRelayers[iNdEx])
+			copy(dAtA[i:], m.SlashRelayers[iNdEx])
+			i = encodeVarintSlashing(dAtA, i, uint64(len(m.SlashRelayers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -441,6 +736,94 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Status != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Epoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MiddlewareAddress) > 0 {
+		i -= len(m.MiddlewareAddress)
+		copy(dAtA[i:], m.MiddlewareAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.MiddlewareAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Infraction != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -496,6 +879,55 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.SlashRelayers) > 0 {
+		for _, s := range m.SlashRelayers {
+			l = len(s)
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovSlashing(uint64(m.Infraction))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.MiddlewareAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovSlashing(uint64(m.Epoch))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovSlashing(uint64(m.Status))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
 	return n
 }
 
@@ -877,6 +1309,358 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRelayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRelayers = append(m.SlashRelayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiddlewareAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiddlewareAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -201,48 +201,158 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgCompleteSlashRequest is the Msg/CompleteSlashRequest request type.
+type MsgCompleteSlashRequest struct {
+	// relayer is the slash relayer marking the request completed.
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// id is the hex encoded ID of the slash request.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// tx_hash is the hash of the Ethereum transaction the slash was observed in.
+	TxHash string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *MsgCompleteSlashRequest) Reset()         { *m = MsgCompleteSlashRequest{} }
+func (m *MsgCompleteSlashRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteSlashRequest) ProtoMessage()    {}
+func (*MsgCompleteSlashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355fee11fee5766f, []int{4}
+}
+func (m *MsgCompleteSlashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompleteSlashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompleteSlashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCompleteSlashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteSlashRequest.Merge(m, src)
+}
+func (m *MsgCompleteSlashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompleteSlashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteSlashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCompleteSlashRequest proto.InternalMessageInfo
+
+func (m *MsgCompleteSlashRequest) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *MsgCompleteSlashRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MsgCompleteSlashRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// MsgCompleteSlashRequestResponse defines the response structure for executing a
+// MsgCompleteSlashRequest message.
+type MsgCompleteSlashRequestResponse struct {
+}
+
+func (m *MsgCompleteSlashRequestResponse) Reset()         { *m = MsgCompleteSlashRequestResponse{} }
+func (m *MsgCompleteSlashRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteSlashRequestResponse) ProtoMessage()    {}
+func (*MsgCompleteSlashRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355fee11fee5766f, []int{5}
+}
+func (m *MsgCompleteSlashRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompleteSlashRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompleteSlashRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCompleteSlashRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteSlashRequestResponse.Merge(m, src)
+}
+func (m *MsgCompleteSlashRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompleteSlashRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteSlashRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCompleteSlashRequestResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.symSlash.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.symSlash.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.symSlash.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.symSlash.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCompleteSlashRequest)(nil), "cosmos.symSlash.v1beta1.MsgCompleteSlashRequest")
+	proto.RegisterType((*MsgCompleteSlashRequestResponse)(nil), "cosmos.symSlash.v1beta1.MsgCompleteSlashRequestResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/symSlash/v1beta1/tx.proto", fileDescriptor_355fee11fee5766f) }
 
 var fileDescriptor_355fee11fee5766f = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xae, 0xcc, 0x0d, 0xce, 0x49, 0x2c, 0xce, 0xd0, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x58, 0x0c, 0xe2, 0x65, 0xe6, 0xa5, 0x43, 0xd5, 0x49,
-	0x42, 0xd4, 0xc5, 0x43, 0x0c, 0x80, 0xda, 0x01, 0x91, 0x82, 0xda, 0xa8, 0x9f, 0x5b, 0x9c, 0xae,
-	0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x22,
-	0xa4, 0xb4, 0x98, 0x91, 0x8b, 0xd3, 0xb7, 0x38, 0x3d, 0x34, 0x2f, 0x2b, 0x31, 0x33, 0x47, 0x28,
-	0x85, 0x8b, 0xaf, 0x2c, 0x31, 0x27, 0x33, 0x25, 0xb1, 0x24, 0xbf, 0x28, 0x3e, 0x31, 0x25, 0xa5,
-	0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xf6, 0xd5, 0x3d, 0x79, 0x76, 0x10, 0x3f, 0xb5,
-	0xb8, 0xf8, 0xd2, 0x16, 0x5d, 0x59, 0xa8, 0x75, 0x61, 0x30, 0xb5, 0x8e, 0x10, 0xa9, 0xe0, 0x92,
-	0xa2, 0xcc, 0xbc, 0xf4, 0x45, 0xcf, 0x37, 0x68, 0xc1, 0x14, 0xaf, 0x78, 0xbe, 0x41, 0x8b, 0x31,
-	0x88, 0xb7, 0x0c, 0x59, 0xa1, 0x95, 0x41, 0xc7, 0x02, 0x79, 0x86, 0xa6, 0xe7, 0x1b, 0xb4, 0xd0,
-	0x2c, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x04, 0x62, 0xb4, 0x6e, 0x71, 0x4a, 0xb6, 0x3e, 0xdc, 0x5d,
-	0x4a, 0xc2, 0x5c, 0x82, 0x70, 0x4e, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0xd2, 0x2d,
-	0x46, 0x2e, 0x7e, 0x90, 0x68, 0x41, 0x4a, 0x62, 0x49, 0x6a, 0x40, 0x62, 0x51, 0x62, 0x6e, 0xb1,
-	0x90, 0x19, 0x17, 0x67, 0x62, 0x69, 0x49, 0x46, 0x7e, 0x51, 0x66, 0x49, 0x25, 0xd4, 0xed, 0x12,
-	0x97, 0xb6, 0xe8, 0x42, 0x4d, 0xd5, 0x43, 0x71, 0x67, 0x10, 0x42, 0xa9, 0x90, 0x13, 0x17, 0x5b,
-	0x01, 0xd8, 0x04, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x79, 0x3d, 0x1c, 0xb1, 0xa6, 0x07,
-	0xb1, 0xc8, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x06, 0x88, 0xef, 0xa0, 0x3a, 0xad, 0xbc, 0x2f, 0x6d,
-	0xd1, 0xe5, 0x47, 0x38, 0x5f, 0xc1, 0x40, 0xcf, 0xc4, 0x1c, 0xe4, 0x4b, 0x84, 0x35, 0x20, 0x0f,
-	0xaa, 0x22, 0x79, 0xb0, 0x02, 0x11, 0xcb, 0x68, 0x1e, 0x51, 0xd2, 0xe3, 0x12, 0x47, 0x13, 0x82,
-	0xf9, 0xdb, 0x4a, 0x18, 0x8b, 0x3d, 0x46, 0xcf, 0x19, 0xb9, 0x98, 0x7d, 0x8b, 0xd3, 0x85, 0x22,
-	0xb8, 0xd8, 0xa0, 0x71, 0xa9, 0x84, 0xd3, 0x0b, 0xf0, 0xa0, 0x94, 0xd2, 0x22, 0xac, 0x06, 0x66,
-	0xad, 0x50, 0x3d, 0x17, 0x0f, 0x4a, 0x50, 0x6b, 0xe0, 0xd5, 0x8b, 0xa4, 0x52, 0xca, 0x80, 0x58,
-	0x95, 0xf0, 0xa8, 0x15, 0x3e, 0x85, 0xe9, 0x45, 0x29, 0xd6, 0x06, 0x50, 0x70, 0x3b, 0x59, 0xaf,
-	0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xd0, 0xa4,
-	0x59, 0x9c, 0x92, 0xad, 0x97, 0x99, 0x8f, 0x1c, 0xc0, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c,
-	0xe0, 0x54, 0x6f, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x29, 0x3b, 0x33, 0xb7, 0x03, 0x00,
-	0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x29, 0x26, 0x64, 0xd4, 0x96, 0x6e, 0x02, 0x89, 0x0b, 0x6e, 0xe2, 0x82, 0x12,
+	0x02, 0xd9, 0x4d, 0x52, 0x51, 0x89, 0x78, 0x30, 0x5e, 0x04, 0x29, 0x48, 0x8a, 0x22, 0x5e, 0xc2,
+	0xd4, 0x1d, 0x36, 0x6b, 0xb3, 0x99, 0x75, 0x67, 0x1a, 0x92, 0x93, 0xd2, 0x93, 0x78, 0xf2, 0x4f,
+	0x10, 0xbd, 0x14, 0xbc, 0xe4, 0x90, 0x3f, 0xa2, 0x78, 0x2a, 0x39, 0x89, 0x87, 0x22, 0xc9, 0x21,
+	0x20, 0xfe, 0x11, 0x32, 0x3b, 0xb3, 0xf9, 0x65, 0xd2, 0xea, 0x25, 0xc9, 0xcc, 0xfb, 0xbc, 0xf7,
+	0x7d, 0xdf, 0x7d, 0x2f, 0x0b, 0x73, 0xaf, 0x08, 0x75, 0x09, 0x35, 0x69, 0xcf, 0xdd, 0x6b, 0x21,
+	0xda, 0x34, 0x3b, 0xe5, 0x7d, 0xcc, 0x50, 0xd9, 0x64, 0x5d, 0xc3, 0xf3, 0x09, 0x23, 0x4a, 0x5a,
+	0x10, 0x46, 0x48, 0x18, 0x92, 0x50, 0x53, 0x36, 0xb1, 0x49, 0xc0, 0x98, 0xfc, 0x97, 0xc0, 0xd5,
+	0x5b, 0xeb, 0x0a, 0x52, 0x7e, 0x72, 0xda, 0xb6, 0xe4, 0xae, 0x09, 0xae, 0x21, 0x0a, 0x48, 0x0d,
+	0x11, 0x92, 0x8a, 0xa6, 0x4b, 0x6d, 0xb3, 0x53, 0xe6, 0x5f, 0x32, 0xb0, 0x8d, 0x5c, 0xa7, 0x4d,
+	0xcc, 0xe0, 0x53, 0x5c, 0xe9, 0x5f, 0x00, 0x4c, 0xec, 0x52, 0xfb, 0x59, 0xfb, 0x35, 0x72, 0x5a,
+	0x8a, 0x05, 0x37, 0x3b, 0xa8, 0xe5, 0x58, 0x88, 0x11, 0xbf, 0x81, 0x2c, 0xcb, 0xcf, 0x80, 0x1c,
+	0xc8, 0x27, 0x6a, 0x0f, 0x7e, 0x9d, 0x65, 0xe3, 0xfc, 0x8c, 0x29, 0x1d, 0x0e, 0x8a, 0xd7, 0xa5,
+	0xdc, 0xf3, 0x90, 0x7d, 0x28, 0x42, 0x7b, 0xcc, 0x77, 0xda, 0xf6, 0xe7, 0x49, 0xbf, 0x10, 0xc2,
+	0xc7, 0x93, 0x7e, 0x01, 0xd4, 0xaf, 0x76, 0xe6, 0xc1, 0x6a, 0xe9, 0xfd, 0xa7, 0x6c, 0xe4, 0x68,
+	0xd2, 0x2f, 0x2c, 0x89, 0x7d, 0x98, 0xf4, 0x0b, 0x29, 0x51, 0xba, 0x48, 0xad, 0x03, 0x73, 0xda,
+	0x97, 0x9e, 0x84, 0xdb, 0xd3, 0x43, 0x1d, 0x53, 0x8f, 0xb4, 0x29, 0xd6, 0x7f, 0x00, 0xb8, 0xc5,
+	0x6f, 0x3d, 0x0b, 0x31, 0xfc, 0x14, 0xf9, 0xc8, 0xa5, 0xca, 0x1d, 0x98, 0x40, 0x87, 0xac, 0x49,
+	0x7c, 0x87, 0xf5, 0x64, 0xef, 0x99, 0xe1, 0xa0, 0x28, 0xab, 0x1a, 0x0b, 0x7d, 0xd6, 0x67, 0xa8,
+	0x52, 0x83, 0x31, 0x2f, 0xa8, 0x90, 0x89, 0xe6, 0x40, 0xfe, 0x72, 0x25, 0x6b, 0xac, 0x99, 0x9a,
+	0x21, 0x84, 0x6a, 0x89, 0x93, 0xb3, 0x6c, 0x44, 0xb8, 0x93, 0x99, 0xd5, 0x27, 0xc3, 0x41, 0x71,
+	0x6b, 0xd6, 0x7e, 0xae, 0x64, 0xdc, 0xbe, 0xcb, 0x5d, 0xce, 0x64, 0xb8, 0xc1, 0x9b, 0x73, 0x06,
+	0xbb, 0xb3, 0x29, 0x2f, 0x19, 0xd1, 0x0d, 0x98, 0x5e, 0xba, 0x0a, 0x7d, 0x57, 0x93, 0x2b, 0x74,
+	0xf4, 0xaf, 0x20, 0x48, 0x78, 0x44, 0x5c, 0xaf, 0x85, 0x19, 0x0e, 0x8a, 0xd6, 0xf1, 0x9b, 0x43,
+	0x4c, 0x99, 0x52, 0x81, 0x71, 0x1f, 0xb7, 0x50, 0x0f, 0xfb, 0x17, 0x3e, 0x92, 0x10, 0x54, 0x36,
+	0x61, 0xd4, 0xb1, 0x82, 0x87, 0x91, 0xa8, 0x47, 0x1d, 0x4b, 0x49, 0xc3, 0x38, 0xeb, 0x36, 0x9a,
+	0x88, 0x36, 0x33, 0x1b, 0xc1, 0x65, 0x8c, 0x75, 0x1f, 0x23, 0xda, 0xac, 0xee, 0x70, 0x8b, 0x61,
+	0x1a, 0x37, 0xa8, 0x2f, 0x4e, 0x70, 0x55, 0x47, 0xfa, 0x0d, 0x98, 0x5d, 0x13, 0x0a, 0x5d, 0x56,
+	0x7e, 0x47, 0xe1, 0xc6, 0x2e, 0xb5, 0x95, 0x17, 0x30, 0x26, 0x97, 0x53, 0x5f, 0x3b, 0x93, 0xe9,
+	0x6e, 0xa8, 0x85, 0x8b, 0x99, 0x50, 0x41, 0x79, 0x0b, 0xaf, 0x2c, 0xec, 0x4e, 0xfe, 0xdc, 0xdc,
+	0x39, 0x52, 0x2d, 0xfd, 0x2b, 0x39, 0xdd, 0xd5, 0xe4, 0xb7, 0xbf, 0x67, 0xa6, 0x1c, 0x01, 0x98,
+	0x5a, 0x39, 0xb0, 0x73, 0xeb, 0xaf, 0xca, 0x50, 0xef, 0xfd, 0x6f, 0x46, 0xd8, 0x99, 0x7a, 0xe9,
+	0x1d, 0x5f, 0xe2, 0xda, 0xfd, 0xe3, 0x91, 0x06, 0x4e, 0x46, 0x1a, 0x38, 0x1d, 0x69, 0xe0, 0xe7,
+	0x48, 0x03, 0x1f, 0xc7, 0x5a, 0xe4, 0x74, 0xac, 0x45, 0xbe, 0x8f, 0xb5, 0xc8, 0x4b, 0xf9, 0x87,
+	0xa7, 0xd6, 0x81, 0xe1, 0x90, 0xf9, 0xb5, 0x65, 0x3d, 0x0f, 0xd3, 0xfd, 0x58, 0xf0, 0x2e, 0xd9,
+	0xf9, 0x33, 0x00, 0x08, 0xfe, 0x66, 0x8a, 0x0d, 0x05, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -338,6 +448,57 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgCompleteSlashRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCompleteSlashRequest)
+	if !ok {
+		that2, ok := that.(MsgCompleteSlashRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Relayer != that1.Relayer {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	return true
+}
+func (this *MsgCompleteSlashRequestResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCompleteSlashRequestResponse)
+	if !ok {
+		that2, ok := that.(MsgCompleteSlashRequestResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -358,6 +519,9 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/symSlash module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CompleteSlashRequest defines a method for a slash relayer to mark a slash
+	// request completed once its slash is observed on Ethereum.
+	CompleteSlashRequest(ctx context.Context, in *MsgCompleteSlashRequest, opts ...grpc.CallOption) (*MsgCompleteSlashRequestResponse, error)
 }
 
 type msgClient struct {
@@ -386,6 +550,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CompleteSlashRequest(ctx context.Context, in *MsgCompleteSlashRequest, opts ...grpc.CallOption) (*MsgCompleteSlashRequestResponse, error) {
+	out := new(MsgCompleteSlashRequestResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symSlash.v1beta1.Msg/CompleteSlashRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
@@ -395,6 +568,9 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/symSlash module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CompleteSlashRequest defines a method for a slash relayer to mark a slash
+	// request completed once its slash is observed on Ethereum.
+	CompleteSlashRequest(context.Context, *MsgCompleteSlashRequest) (*MsgCompleteSlashRequestResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -407,6 +583,9 @@ func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) CompleteSlashRequest(ctx context.Context, req *MsgCompleteSlashRequest) (*MsgCompleteSlashRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteSlashRequest not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -448,6 +627,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CompleteSlashRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteSlashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteSlashRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symSlash.v1beta1.Msg/CompleteSlashRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteSlashRequest(ctx, req.(*MsgCompleteSlashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.symSlash.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -460,6 +657,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CompleteSlashRequest",
+			Handler:    _Msg_CompleteSlashRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symSlash/v1beta1/tx.proto",
@@ -581,6 +782,73 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCompleteSlashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteSlashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteSlashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteSlashRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteSlashRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteSlashRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -638,6 +906,36 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCompleteSlashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCompleteSlashRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -941,6 +1239,202 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCompleteSlashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteSlashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteSlashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteSlashRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteSlashRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteSlashRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
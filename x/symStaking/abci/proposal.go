@@ -1,21 +1,22 @@
 package abci
 
 import (
+	"bytes"
 	"cosmossdk.io/log"
 	keeper2 "cosmossdk.io/x/symStaking/keeper"
 	stakingtypes "cosmossdk.io/x/symStaking/types"
-	"encoding/json"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum"
-	"os"
 )
 
 type ProposalHandler struct {
-	logger        log.Logger
-	keeper        *keeper2.Keeper
-	prevBlockTime uint64
+	logger log.Logger
+	keeper *keeper2.Keeper
 }
 
 func NewProposalHandler(logger log.Logger, keeper *keeper2.Keeper) *ProposalHandler {
@@ -25,31 +26,55 @@ func NewProposalHandler(logger log.Logger, keeper *keeper2.Keeper) *ProposalHand
 	}
 }
 
+// PrepareProposal injects at a sync height the block agreed on in the vote
+// extensions of the previous height, along with these vote extensions and the
+// validator set at the block. At another height, it only injects the vote
+// extensions if they carry checkpoint signatures.
 func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
 		proposalTxs := req.Txs
 
-		if req.Height%keeper2.SYMBIOTIC_SYNC_PERIOD != 0 {
+		isSyncHeight, err := h.keeper.IsSymbioticSyncHeight(ctx, req.Height)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isSyncHeight && !hasCheckpointSignatures(req.LocalLastCommit) {
 			return &abci.PrepareProposalResponse{
 				Txs: proposalTxs,
 			}, nil
 		}
 
-		blockHash, err := h.keeper.GetFinalizedBlockHash(ctx)
+		data := checkpointSignaturesData()
+		if isSyncHeight {
+			data, err = h.agreedSymbioticData(ctx, req.LocalLastCommit)
+			if err != nil {
+				return nil, err
+			}
+
+			// a proposer that can't read the validator set of the agreed block
+			// fails to propose rather than skipping the sync, which the other
+			// validators couldn't verify, so that the next round's proposer
+			// injects it
+			if data.BlockHash != keeper2.INVALID_BLOCKHASH && data.SkipReason == "" {
+				if err := h.fetchAgreedValidatorSet(ctx, &data); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		data.ExtendedCommitInfo, err = req.LocalLastCommit.Marshal()
 		if err != nil {
-			// anyway recovers in baseapp.abci so just skip
-			blockHash = keeper2.INVALID_BLOCKHASH
+			return nil, errors.New("failed to encode extended commit info")
 		}
 
-		// NOTE: We use stdlib JSON encoding, but an application may choose to use
-		// a performant mechanism. This is for demo purposes only.
-		bz, err := json.Marshal(blockHash)
+		bz, err := stakingtypes.EncodeInjectedTx(data)
 		if err != nil {
-			return nil, errors.New("failed to encode injected vote extension tx")
+			return nil, errors.New("failed to encode injected symbiotic tx")
 		}
 
 		// Inject a "fake" tx into the proposal s.t. validators can decode, verify,
-		// and store the canonical stake-weighted average prices.
+		// and sync the validator set with the same Ethereum block.
 		proposalTxs = append([][]byte{bz}, proposalTxs...)
 
 		return &abci.PrepareProposalResponse{
@@ -58,58 +83,386 @@ func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
 	}
 }
 
+// fetchAgreedValidatorSet sets the validator set at the agreed block of data,
+// carried in the block so that it is applied without querying Ethereum during
+// FinalizeBlock.
+func (h *ProposalHandler) fetchAgreedValidatorSet(ctx sdk.Context, data *stakingtypes.InjectedSymbioticData) error {
+	epoch, validatorSet, err := h.keeper.FetchSymbioticValidatorSet(ctx, data.BlockHash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch symbiotic validator set: %w", err)
+	}
+
+	if epoch != data.Epoch {
+		return fmt.Errorf("symbiotic epoch mismatch: expected %d, got %d", data.Epoch, epoch)
+	}
+
+	if err := verifyValidatorSet(validatorSet, data.ValidatorSetDigest); err != nil {
+		return err
+	}
+
+	data.ValidatorSet = validatorSet
+	return nil
+}
+
+// ProcessProposal verifies the tx injected by PrepareProposal. It rejects
+// proposals that omit it at a sync height, carry a malformed one, carry data
+// that doesn't match the vote extensions it was derived from, or smuggle such a
+// tx anywhere else. A sync skipped by the proposer as unavailable is only
+// accepted without agreement in the vote extensions. At another height, the
+// injected tx is optional
+// and may only carry vote extensions with checkpoint signatures.
+func (h *ProposalHandler) ProcessProposal() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		reject := &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}
+
+		isSyncHeight, err := h.keeper.IsSymbioticSyncHeight(ctx, req.Height)
+		if err != nil {
+			return nil, err
+		}
+
+		txs := req.Txs
+		if isSyncHeight {
+			if len(txs) == 0 {
+				h.logger.Error("ProcessProposal: missing injected tx", "height", req.Height)
+				return reject, nil
+			}
+
+			data, err := decodeInjectedTx(txs[0])
+			if err != nil {
+				h.logger.Error("ProcessProposal: invalid injected tx", "height", req.Height, "err", err)
+				return reject, nil
+			}
+
+			if err := h.verifyAgreedData(ctx, data); err != nil {
+				h.logger.Error("ProcessProposal: injected data not agreed on", "hash", data.BlockHash, "err", err)
+				return reject, nil
+			}
+
+			txs = txs[1:]
+		} else if len(txs) > 0 && stakingtypes.IsInjectedTx(txs[0]) {
+			data, err := decodeInjectedTx(txs[0])
+			if err != nil {
+				h.logger.Error("ProcessProposal: invalid injected tx", "height", req.Height, "err", err)
+				return reject, nil
+			}
+
+			if err := h.verifyCheckpointSignaturesData(ctx, data); err != nil {
+				h.logger.Error("ProcessProposal: invalid injected checkpoint signatures", "height", req.Height, "err", err)
+				return reject, nil
+			}
+
+			txs = txs[1:]
+		}
+
+		for _, tx := range txs {
+			if stakingtypes.IsInjectedTx(tx) {
+				h.logger.Error("ProcessProposal: unexpected injected tx", "height", req.Height)
+				return reject, nil
+			}
+		}
+
+		return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT}, nil
+	}
+}
+
 func (h *ProposalHandler) PreBlocker() sdk.PreBlocker {
 	return func(ctx sdk.Context, req *abci.FinalizeBlockRequest) error {
-		if req.Height%keeper2.SYMBIOTIC_SYNC_PERIOD != 0 || len(req.Txs) == 0 {
-			return nil
+		isSyncHeight, err := h.keeper.IsSymbioticSyncHeight(ctx, req.Height)
+		if err != nil {
+			return err
 		}
 
-		skipBlockHash := stakingtypes.CachedBlockHash{BlockHash: keeper2.INVALID_BLOCKHASH, Height: req.Height}
+		if len(req.Txs) == 0 || (!isSyncHeight && !stakingtypes.IsInjectedTx(req.Txs[0])) {
+			return nil
+		}
 
-		var blockHash string
-		if err := json.Unmarshal(req.Txs[0], &blockHash); err != nil {
+		data, err := decodeInjectedTx(req.Txs[0])
+		if err != nil {
 			return err
 		}
 
-		if blockHash == keeper2.INVALID_BLOCKHASH {
-			err := h.keeper.CacheBlockHash(ctx, skipBlockHash)
+		if err := h.addCheckpointSignatures(ctx, data.ExtendedCommitInfo); err != nil {
 			return err
 		}
 
-		block, err := h.keeper.GetBlockByHash(ctx, blockHash)
-		if errors.Is(err, ethereum.NotFound) {
-			h.logger.Error("Preblock: Block not found", "hash", blockHash)
-			err := h.keeper.CacheBlockHash(ctx, skipBlockHash)
-			return err
+		if !isSyncHeight {
+			return nil
 		}
-		if err != nil {
-			h.logger.Error("PreBlocker error get block by hash error", "err", err)
-			os.Exit(0) // panic recovers
+
+		if data.BlockHash == keeper2.INVALID_BLOCKHASH {
+			return h.keeper.SetSymbioticSyncPoint(ctx, stakingtypes.SymbioticSyncPoint{
+				Height:     req.Height,
+				SkipReason: data.SkipReason,
+			})
 		}
 
-		block, err = h.keeper.GetBlockByNumber(ctx, block.Number())
-		if err != nil {
-			h.logger.Error("PreBlocker error get block by hash error", "err", err)
-			os.Exit(0) // panic recovers
+		// the data was agreed on by the validators and verified in ProcessProposal,
+		// so no Ethereum RPC is queried here
+		data.ExtendedCommitInfo = nil
+		return h.keeper.InjectedSymbioticData.Set(ctx, req.Height, data)
+	}
+}
+
+// addCheckpointSignatures adds the checkpoint signatures carried in the vote
+// extensions of the encoded extCommit, verified in ProcessProposal. The
+// signatures that aren't by the operator of a validator of the checkpoint are
+// ignored.
+func (h *ProposalHandler) addCheckpointSignatures(ctx sdk.Context, bz []byte) error {
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(bz); err != nil {
+		return err
+	}
+
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
 		}
-		// very specific error caused by finalized check bug, ideally this check shouldn't exist
-		if block.Hash().String() != blockHash {
-			h.logger.Error("Preblock: Block is not finalized", "hash", blockHash)
-			err := h.keeper.CacheBlockHash(ctx, skipBlockHash)
-			return err
+
+		ve, err := decodeVoteExtension(vote.VoteExtension)
+		if err != nil || len(ve.CheckpointSignature) == 0 {
+			continue
 		}
 
-		if block.Time() < h.prevBlockTime || int64(block.Time()) >= ctx.HeaderInfo().Time.Unix() || block.Time() < h.keeper.GetMinBlockTimestamp(ctx) {
-			err := h.keeper.CacheBlockHash(ctx, skipBlockHash)
+		err = h.keeper.AddSymbioticCheckpointSignature(ctx, vote.Validator.Address, ve.CheckpointEpoch, ve.CheckpointSignature)
+		if errors.Is(err, stakingtypes.ErrInvalidCheckpointSig) {
+			h.logger.Error("ignored checkpoint signature", "validator", sdk.ConsAddress(vote.Validator.Address), "err", err)
+			continue
+		}
+		if err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
+
+// agreedSymbioticData returns the data to inject from the vote extensions in
+// extCommit: the block reported by validators holding more than 2/3 of the
+// voting power, or INVALID_BLOCKHASH with the skip reason if there is none or
+// its timestamp is out of the allowed window. A block reported without a
+// validator set digest is injected as epoch_not_advanced.
+func (h *ProposalHandler) agreedSymbioticData(ctx sdk.Context, extCommit abci.ExtendedCommitInfo) (stakingtypes.InjectedSymbioticData, error) {
+	ve, ok := tallyVoteExtensions(extCommit)
+	if !ok {
+		h.logger.Warn("no symbiotic block agreed on in vote extensions", "height", ctx.HeaderInfo().Height)
+		return skippedSymbioticData(stakingtypes.SyncSkipReasonNoAgreement), nil
+	}
+
+	minBlockTimestamp, err := h.keeper.GetMinBlockTimestamp(ctx)
+	if err != nil {
+		return stakingtypes.InjectedSymbioticData{}, err
+	}
+
+	last, found, err := h.keeper.GetLastInjectedSymbioticData(ctx)
+	if err != nil {
+		return stakingtypes.InjectedSymbioticData{}, err
+	}
+
+	if (found && ve.BlockTimestamp < last.BlockTimestamp) || int64(ve.BlockTimestamp) >= ctx.HeaderInfo().Time.Unix() || ve.BlockTimestamp < minBlockTimestamp {
+		h.logger.Warn("agreed symbiotic block out of window", "hash", ve.BlockHash, "time", ve.BlockTimestamp)
+		return skippedSymbioticData(stakingtypes.SyncSkipReasonOutOfWindow), nil
+	}
+
+	data := stakingtypes.InjectedSymbioticData{
+		Version:            stakingtypes.InjectedSymbioticDataVersion,
+		BlockHash:          ve.BlockHash,
+		BlockNumber:        ve.BlockNumber,
+		BlockTimestamp:     ve.BlockTimestamp,
+		Slot:               ve.Slot,
+		ValidatorSetDigest: ve.ValidatorSetDigest,
+		Epoch:              ve.Epoch,
+	}
+
+	// the validators didn't fetch the validator set of an epoch already synced
+	if len(ve.ValidatorSetDigest) == 0 {
+		data.SkipReason = stakingtypes.SyncSkipReasonEpochNotAdvanced
+	}
+
+	return data, nil
+}
 
-		if err := h.keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: blockHash, Height: req.Height}); err != nil {
+// verifyAgreedData checks that data carries valid vote extensions, matches the
+// data agreed on in them and carries the agreed validator set.
+func (h *ProposalHandler) verifyAgreedData(ctx sdk.Context, data stakingtypes.InjectedSymbioticData) error {
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(data.ExtendedCommitInfo); err != nil {
+		return err
+	}
+
+	if err := baseapp.ValidateVoteExtensions(ctx, h.keeper, extCommit); err != nil {
+		return err
+	}
+
+	expected, err := h.agreedSymbioticData(ctx, extCommit)
+	if err != nil {
+		return err
+	}
+
+	// a proposer can't skip an agreed block as unavailable
+	if data.SkipReason == stakingtypes.SyncSkipReasonUnavailable && expected.SkipReason == stakingtypes.SyncSkipReasonNoAgreement {
+		expected.SkipReason = stakingtypes.SyncSkipReasonUnavailable
+	}
+	expected.ExtendedCommitInfo = data.ExtendedCommitInfo
+
+	if expected.BlockHash != keeper2.INVALID_BLOCKHASH && expected.SkipReason == "" {
+		if err := verifyValidatorSet(data.ValidatorSet, expected.ValidatorSetDigest); err != nil {
 			return err
 		}
+		expected.ValidatorSet = data.ValidatorSet
+	}
+
+	bz, err := data.Marshal()
+	if err != nil {
+		return err
+	}
+
+	expectedBz, err := expected.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(bz, expectedBz) {
+		return fmt.Errorf("expected block %s, got %s", expected.BlockHash, data.BlockHash)
+	}
+
+	return nil
+}
 
-		h.prevBlockTime = block.Time()
+// verifyCheckpointSignaturesData checks that data only carries valid vote
+// extensions with checkpoint signatures.
+func (h *ProposalHandler) verifyCheckpointSignaturesData(ctx sdk.Context, data stakingtypes.InjectedSymbioticData) error {
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(data.ExtendedCommitInfo); err != nil {
+		return err
+	}
+
+	if err := baseapp.ValidateVoteExtensions(ctx, h.keeper, extCommit); err != nil {
+		return err
+	}
+
+	if !hasCheckpointSignatures(extCommit) {
+		return errors.New("no checkpoint signature")
+	}
 
-		return nil
+	expected := checkpointSignaturesData()
+	expected.ExtendedCommitInfo = data.ExtendedCommitInfo
+
+	bz, err := data.Marshal()
+	if err != nil {
+		return err
+	}
+
+	expectedBz, err := expected.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(bz, expectedBz) {
+		return errors.New("unexpected symbiotic data")
+	}
+
+	return nil
+}
+
+// hasCheckpointSignatures returns true if a vote extension of extCommit carries
+// a checkpoint signature.
+func hasCheckpointSignatures(extCommit abci.ExtendedCommitInfo) bool {
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		if ve, err := decodeVoteExtension(vote.VoteExtension); err == nil && len(ve.CheckpointSignature) != 0 {
+			return true
+		}
 	}
+
+	return false
+}
+
+// checkpointSignaturesData returns the data injected out of the sync heights,
+// only carrying the vote extensions with checkpoint signatures.
+func checkpointSignaturesData() stakingtypes.InjectedSymbioticData {
+	return skippedSymbioticData("")
+}
+
+// skippedSymbioticData returns the data injected when the sync is skipped for
+// the given reason.
+func skippedSymbioticData(reason string) stakingtypes.InjectedSymbioticData {
+	return stakingtypes.InjectedSymbioticData{
+		Version:    stakingtypes.InjectedSymbioticDataVersion,
+		BlockHash:  keeper2.INVALID_BLOCKHASH,
+		SkipReason: reason,
+	}
+}
+
+// verifyValidatorSet checks that validatorSet is an encoding of the middleware
+// validator sets with the given digest.
+func verifyValidatorSet(validatorSet, digest []byte) error {
+	if actual := sha256.Sum256(validatorSet); !bytes.Equal(actual[:], digest) {
+		return errors.New("validator set digest mismatch")
+	}
+
+	_, err := keeper2.UnpackSymbioticValidatorSets(validatorSet)
+	return err
+}
+
+// tallyVoteExtensions returns the vote extension reported by validators holding
+// more than 2/3 of the voting power of extCommit, if any. Malformed vote
+// extensions and the ones without a block are ignored.
+func tallyVoteExtensions(extCommit abci.ExtendedCommitInfo) (stakingtypes.SymbioticVoteExtension, bool) {
+	var totalPower int64
+	powers := make(map[string]int64)
+
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		ve, err := decodeVoteExtension(vote.VoteExtension)
+		if err != nil || ve.BlockHash == "" {
+			continue
+		}
+
+		// re-encode without the checkpoint signature so that equal blocks are
+		// tallied together
+		ve.CheckpointEpoch, ve.CheckpointSignature = 0, nil
+		bz, err := ve.Marshal()
+		if err != nil {
+			continue
+		}
+
+		powers[string(bz)] += vote.Validator.Power
+	}
+
+	// at most one vote extension can have more than 2/3 of the voting power
+	for bz, power := range powers {
+		if 3*power > 2*totalPower {
+			var ve stakingtypes.SymbioticVoteExtension
+			if err := ve.Unmarshal([]byte(bz)); err != nil {
+				return ve, false
+			}
+
+			return ve, true
+		}
+	}
+
+	return stakingtypes.SymbioticVoteExtension{}, false
+}
+
+// decodeInjectedTx decodes the tx injected by PrepareProposal. The block hash
+// must be either INVALID_BLOCKHASH or a 0x prefixed 32 bytes hash.
+func decodeInjectedTx(tx []byte) (stakingtypes.InjectedSymbioticData, error) {
+	data, err := stakingtypes.DecodeInjectedTx(tx)
+	if err != nil {
+		return data, err
+	}
+
+	if data.BlockHash == keeper2.INVALID_BLOCKHASH {
+		return data, nil
+	}
+
+	return data, validateBlockHash(data.BlockHash)
 }
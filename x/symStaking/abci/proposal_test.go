@@ -0,0 +1,694 @@
+package abci_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	storetypes "cosmossdk.io/store/types"
+	stakingabci "cosmossdk.io/x/symStaking/abci"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	stakingtestutil "cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const chainID = "chain-id"
+
+var middleware = common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+type fixture struct {
+	ctx        sdk.Context
+	keeper     *stakingkeeper.Keeper
+	source     *stakingtestutil.FakeSymbioticSource
+	proposal   *stakingabci.ProposalHandler
+	voteExt    *stakingabci.VoteExtensionHandler
+	validators []*ed25519.PrivKey
+}
+
+func setupFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(stakingtypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+
+	ctrl := gomock.NewController(t)
+	accountKeeper := stakingtestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	bankKeeper := stakingtestutil.NewMockBankKeeper(ctrl)
+
+	source := stakingtestutil.NewFakeSymbioticSource()
+	keeper := stakingkeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger()),
+		accountKeeper,
+		bankKeeper,
+		"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+		address.NewBech32Codec("cosmosvaloper"),
+		address.NewBech32Codec("cosmosvalcons"),
+		runtime.NewContextAwareCometInfoService(),
+		source,
+	)
+
+	params := stakingtypes.DefaultParams()
+	params.SymbioticMiddlewareAddress = middleware.Hex()
+	require.NoError(t, keeper.Params.Set(testCtx.Ctx, params))
+
+	f := &fixture{
+		keeper:   keeper,
+		source:   source,
+		proposal: stakingabci.NewProposalHandler(coretesting.NewNopLogger(), keeper),
+		voteExt:  stakingabci.NewVoteExtensionHandler(coretesting.NewNopLogger(), keeper),
+	}
+
+	for i := 0; i < 3; i++ {
+		privKey := ed25519.GenPrivKey()
+		operator := sdk.ValAddress(privKey.PubKey().Address())
+		validator := stakingtestutil.NewValidator(t, operator, privKey.PubKey())
+		require.NoError(t, keeper.SetValidator(testCtx.Ctx, validator))
+		require.NoError(t, keeper.SetValidatorByConsAddr(testCtx.Ctx, validator))
+		f.validators = append(f.validators, privKey)
+	}
+
+	profile := stakingtypes.NetworkProfiles[params.EthereumNetwork]
+	blockTime := time.Unix(int64(profile.BeaconGenesisTimestamp)+100*int64(profile.SlotsPerEpoch)*int64(profile.SlotDuration/time.Second), 0)
+	f.ctx = testCtx.Ctx.
+		WithHeaderInfo(header.Info{ChainID: chainID, Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: blockTime}).
+		WithConsensusParams(cmtproto.ConsensusParams{
+			Feature: &cmtproto.FeatureParams{VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 1}},
+		})
+
+	return f
+}
+
+// symbioticValidators returns the middleware entries of the fixture validators.
+func (f *fixture) symbioticValidators() []stakingtypes.SymbioticValidator {
+	var validators []stakingtypes.SymbioticValidator
+	for i, privKey := range f.validators {
+		var consAddr [32]byte
+		copy(consAddr[:], privKey.PubKey().Address())
+		validators = append(validators, stakingtypes.SymbioticValidator{
+			Stake:    big.NewInt(int64(i+1) * 1000000),
+			ConsAddr: consAddr,
+			Operator: common.BigToAddress(big.NewInt(int64(i + 1))),
+		})
+	}
+	return validators
+}
+
+// validatorSet returns the validator set holding the fixture validators.
+func (f *fixture) validatorSet(t *testing.T) []byte {
+	t.Helper()
+
+	bz, err := stakingkeeper.PackSymbioticValidatorSets([]stakingtypes.SymbioticValidatorSet{
+		{Middleware: middleware, Epoch: 1, Validators: f.symbioticValidators()},
+	})
+	require.NoError(t, err)
+	return bz
+}
+
+// addFinalizedBlock registers a finalized execution block with the fixture
+// validator set at the slot finalized at the fixture time, and returns its hash.
+func (f *fixture) addFinalizedBlock(t *testing.T, header *ethtypes.Header) string {
+	t.Helper()
+
+	hash := f.source.AddHeader(header)
+	f.source.AddBeaconBlock(finalizedSlot(), hash.String(), true)
+
+	contractABI, err := abi.JSON(strings.NewReader(stakingkeeper.CONTRACT_ABI))
+	require.NoError(t, err)
+
+	data, err := contractABI.Pack(stakingkeeper.GET_CURRENT_EPOCH_FUNCTION_NAME)
+	require.NoError(t, err)
+	f.source.AddCall(middleware, hash, data, common.LeftPadBytes([]byte{1}, 32))
+
+	validators := f.symbioticValidators()
+	data, err = contractABI.Pack(stakingkeeper.GET_VALIDATOR_SET_FUNCTION_NAME, big.NewInt(1))
+	require.NoError(t, err)
+	result, err := contractABI.Methods[stakingkeeper.GET_VALIDATOR_SET_FUNCTION_NAME].Outputs.Pack(validators)
+	require.NoError(t, err)
+	f.source.AddCall(middleware, hash, data, result)
+
+	for _, v := range validators {
+		data, err = contractABI.Pack(stakingkeeper.GET_OPERATOR_BY_KEY_FUNCTION_NAME, v.ConsAddr)
+		require.NoError(t, err)
+		f.source.AddCall(middleware, hash, data, common.LeftPadBytes(v.Operator.Bytes(), 32))
+	}
+
+	return hash.String()
+}
+
+// extendedCommit signs the vote extensions of the fixture validators at the
+// height before the fixture height, and sets the matching last commit.
+func (f *fixture) extendedCommit(t *testing.T, exts ...[]byte) abci.ExtendedCommitInfo {
+	t.Helper()
+
+	var extCommit abci.ExtendedCommitInfo
+	for i, privKey := range f.validators {
+		vote := abci.ExtendedVoteInfo{
+			Validator:   abci.Validator{Address: privKey.PubKey().Address(), Power: 10},
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		}
+
+		if i < len(exts) {
+			vote.VoteExtension = exts[i]
+		}
+
+		signBytes := cmttypes.VoteExtensionSignBytes(chainID, &cmtproto.Vote{
+			Extension: vote.VoteExtension,
+			Height:    f.ctx.HeaderInfo().Height - 1,
+		})
+
+		sig, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+		vote.ExtensionSignature = sig
+
+		extCommit.Votes = append(extCommit.Votes, vote)
+	}
+
+	sort.Slice(extCommit.Votes, func(i, j int) bool {
+		return bytes.Compare(extCommit.Votes[i].Validator.Address, extCommit.Votes[j].Validator.Address) < 0
+	})
+
+	lastCommit := comet.CommitInfo{}
+	for _, vote := range extCommit.Votes {
+		lastCommit.Votes = append(lastCommit.Votes, comet.VoteInfo{
+			Validator: comet.Validator{Address: vote.Validator.Address, Power: vote.Validator.Power},
+		})
+	}
+	f.ctx = f.ctx.WithCometInfo(comet.Info{LastCommit: lastCommit})
+
+	return extCommit
+}
+
+// finalizedSlot returns the slot finalized at the fixture time.
+func finalizedSlot() int64 {
+	profile := stakingtypes.NetworkProfiles[stakingtypes.DefaultEthereumNetwork]
+	return (100 - int64(profile.FinalityDepth)) * int64(profile.SlotsPerEpoch)
+}
+
+func (f *fixture) voteExtension(t *testing.T, header *ethtypes.Header, blockHash string) []byte {
+	t.Helper()
+
+	digest := sha256.Sum256(f.validatorSet(t))
+	bz, err := (&stakingtypes.SymbioticVoteExtension{
+		BlockHash:          blockHash,
+		BlockNumber:        header.Number.Uint64(),
+		BlockTimestamp:     header.Time,
+		Slot:               finalizedSlot(),
+		ValidatorSetDigest: digest[:],
+		Epoch:              1,
+	}).Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+// addCheckpoint sets a checkpoint key as the operator of each fixture validator
+// and stores the checkpoint of their validator set at epoch 1. It returns the
+// keys along with the digest of the checkpoint.
+func (f *fixture) addCheckpoint(t *testing.T) ([]*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+
+	checkpoint := stakingtypes.SymbioticCheckpoint{Epoch: 1, Height: 1}
+	var keys []*ecdsa.PrivateKey
+	for _, privKey := range f.validators {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys = append(keys, key)
+
+		validator, err := f.keeper.GetValidatorByConsAddr(f.ctx, sdk.ConsAddress(privKey.PubKey().Address()))
+		require.NoError(t, err)
+		validator.SymbioticOperator = crypto.PubkeyToAddress(key.PublicKey).Hex()
+		require.NoError(t, f.keeper.SetValidator(f.ctx, validator))
+
+		checkpoint.Validators = append(checkpoint.Validators, stakingtypes.SymbioticCheckpointValidator{Operator: validator.SymbioticOperator, Power: 10})
+		checkpoint.TotalPower += 10
+	}
+
+	var err error
+	checkpoint.ValidatorSetHash, err = stakingkeeper.SymbioticCheckpointValidatorSetHash(checkpoint.Validators)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SymbioticCheckpoints.Set(f.ctx, checkpoint.Epoch, checkpoint))
+
+	digest, err := stakingkeeper.SymbioticCheckpointDigest(checkpoint)
+	require.NoError(t, err)
+	return keys, digest
+}
+
+// checkpointVoteExtension returns a vote extension carrying only the signature
+// of the checkpoint digest by key.
+func checkpointVoteExtension(t *testing.T, digest []byte, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+
+	sig, err := crypto.Sign(digest, key)
+	require.NoError(t, err)
+	bz, err := (&stakingtypes.SymbioticVoteExtension{CheckpointEpoch: 1, CheckpointSignature: sig}).Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func injectedTx(t *testing.T, data stakingtypes.InjectedSymbioticData) []byte {
+	t.Helper()
+
+	data.Version = stakingtypes.InjectedSymbioticDataVersion
+	bz, err := stakingtypes.EncodeInjectedTx(data)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestPrepareProposal(t *testing.T) {
+	f := setupFixture(t)
+	syncHeight := stakingtypes.DefaultSymbioticSyncPeriod
+	tx := []byte{0x0a, 0x01, 0x02}
+
+	header := &ethtypes.Header{Number: big.NewInt(1), Time: uint64(f.ctx.HeaderInfo().Time.Unix()) - 1000}
+	blockHash := f.addFinalizedBlock(t, header)
+	ve := f.voteExtension(t, header, blockHash)
+	other := f.voteExtension(t, &ethtypes.Header{Number: big.NewInt(2), Time: header.Time}, blockHash)
+	unknownHeader := &ethtypes.Header{Number: big.NewInt(3), Time: header.Time}
+	unknown := f.voteExtension(t, unknownHeader, unknownHeader.Hash().String())
+
+	var nextEpochVe stakingtypes.SymbioticVoteExtension
+	require.NoError(t, nextEpochVe.Unmarshal(ve))
+	nextEpochVe.Epoch = 2
+	nextEpoch, err := nextEpochVe.Marshal()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		height     int64
+		exts       [][]byte
+		blockHash  string
+		skipReason string
+		expErr     bool
+	}{
+		{"no sync height", syncHeight + 1, [][]byte{ve, ve, ve}, "", "", false},
+		{"agreed", syncHeight, [][]byte{ve, ve, ve}, blockHash, "", false},
+		{"no supermajority", syncHeight, [][]byte{ve, ve, other}, stakingkeeper.INVALID_BLOCKHASH, stakingtypes.SyncSkipReasonNoAgreement, false},
+		{"abstained", syncHeight, [][]byte{ve, ve}, stakingkeeper.INVALID_BLOCKHASH, stakingtypes.SyncSkipReasonNoAgreement, false},
+		{"malformed", syncHeight, [][]byte{ve, ve, []byte("garbage")}, stakingkeeper.INVALID_BLOCKHASH, stakingtypes.SyncSkipReasonNoAgreement, false},
+		// the agreed block isn't skipped, so that the next round's proposer injects it
+		{"validator set unavailable", syncHeight, [][]byte{unknown, unknown, unknown}, "", "", true},
+		{"epoch mismatch", syncHeight, [][]byte{nextEpoch, nextEpoch, nextEpoch}, "", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			extCommit := f.extendedCommit(t, tc.exts...)
+			resp, err := f.proposal.PrepareProposal()(f.ctx, &abci.PrepareProposalRequest{Height: tc.height, Txs: [][]byte{tx}, LocalLastCommit: extCommit})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.blockHash == "" {
+				require.Equal(t, [][]byte{tx}, resp.Txs)
+				return
+			}
+
+			require.Len(t, resp.Txs, 2)
+			data, err := stakingtypes.DecodeInjectedTx(resp.Txs[0])
+			require.NoError(t, err)
+			require.Equal(t, tc.blockHash, data.BlockHash)
+			require.Equal(t, tc.skipReason, data.SkipReason)
+			require.NotEmpty(t, data.ExtendedCommitInfo)
+
+			if tc.blockHash == stakingkeeper.INVALID_BLOCKHASH {
+				require.Empty(t, data.ValidatorSet)
+			} else {
+				require.Equal(t, f.validatorSet(t), data.ValidatorSet)
+			}
+		})
+	}
+}
+
+func TestProcessProposal(t *testing.T) {
+	f := setupFixture(t)
+	syncHeight := stakingtypes.DefaultSymbioticSyncPeriod
+	now := uint64(f.ctx.HeaderInfo().Time.Unix())
+	tx := []byte{0x0a, 0x01, 0x02}
+
+	valid := &ethtypes.Header{Number: big.NewInt(1), Time: now - 1000}
+	validHash := f.addFinalizedBlock(t, valid)
+	old := &ethtypes.Header{Number: big.NewInt(2), Time: now - 100000}
+	oldHash := old.Hash().String()
+
+	proposed := func(exts ...[]byte) stakingtypes.InjectedSymbioticData {
+		extCommit := f.extendedCommit(t, exts...)
+		resp, err := f.proposal.PrepareProposal()(f.ctx, &abci.PrepareProposalRequest{Height: syncHeight, LocalLastCommit: extCommit})
+		require.NoError(t, err)
+		data, err := stakingtypes.DecodeInjectedTx(resp.Txs[0])
+		require.NoError(t, err)
+		return data
+	}
+
+	agreed := proposed(f.voteExtension(t, valid, validHash), f.voteExtension(t, valid, validHash), f.voteExtension(t, valid, validHash))
+	require.Equal(t, validHash, agreed.BlockHash)
+
+	withoutAgreement := agreed
+	withoutAgreement.BlockHash = stakingkeeper.INVALID_BLOCKHASH
+
+	tooOld := proposed(f.voteExtension(t, old, oldHash), f.voteExtension(t, old, oldHash), f.voteExtension(t, old, oldHash))
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, tooOld.BlockHash)
+	require.Equal(t, stakingtypes.SyncSkipReasonOutOfWindow, tooOld.SkipReason)
+
+	wrongSkipReason := tooOld
+	wrongSkipReason.SkipReason = stakingtypes.SyncSkipReasonNoAgreement
+
+	noAgreement := proposed(f.voteExtension(t, valid, validHash))
+	require.Equal(t, stakingtypes.SyncSkipReasonNoAgreement, noAgreement.SkipReason)
+
+	// the proposer may skip the sync as unavailable without agreement only
+	unavailable := noAgreement
+	unavailable.SkipReason = stakingtypes.SyncSkipReasonUnavailable
+
+	skippedAgreed := agreed
+	skippedAgreed.BlockHash = stakingkeeper.INVALID_BLOCKHASH
+	skippedAgreed.SkipReason = stakingtypes.SyncSkipReasonUnavailable
+	skippedAgreed.BlockNumber, skippedAgreed.BlockTimestamp, skippedAgreed.Slot, skippedAgreed.Epoch = 0, 0, 0, 0
+	skippedAgreed.ValidatorSetDigest, skippedAgreed.ValidatorSet = nil, nil
+
+	unavailableWithBlock := agreed
+	unavailableWithBlock.SkipReason = stakingtypes.SyncSkipReasonUnavailable
+
+	forgedHash := agreed
+	forgedHash.BlockHash = oldHash
+
+	forgedCommit := agreed
+	forgedCommit.ExtendedCommitInfo = []byte("garbage")
+
+	forgedValidatorSet := agreed
+	forgedValidatorSet.ValidatorSet = append(append([]byte{}, agreed.ValidatorSet...), 0x01)
+
+	withheldValidatorSet := agreed
+	withheldValidatorSet.ValidatorSet = nil
+
+	unsupportedVersion, err := stakingtypes.EncodeInjectedTx(stakingtypes.InjectedSymbioticData{Version: 2, BlockHash: validHash})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		height int64
+		txs    [][]byte
+		status abci.ProcessProposalStatus
+	}{
+		{"no sync height", syncHeight + 1, [][]byte{tx}, abci.PROCESS_PROPOSAL_STATUS_ACCEPT},
+		{"smuggled at no sync height", syncHeight + 1, [][]byte{injectedTx(t, agreed), tx}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"missing", syncHeight, nil, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"omitted", syncHeight, [][]byte{tx}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"malformed", syncHeight, [][]byte{injectedTx(t, stakingtypes.InjectedSymbioticData{BlockHash: "0x1234"})}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"unsupported version", syncHeight, [][]byte{unsupportedVersion}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"agreed", syncHeight, [][]byte{injectedTx(t, agreed), tx}, abci.PROCESS_PROPOSAL_STATUS_ACCEPT},
+		{"agreed block withheld", syncHeight, [][]byte{injectedTx(t, withoutAgreement), tx}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"block too old", syncHeight, [][]byte{injectedTx(t, tooOld), tx}, abci.PROCESS_PROPOSAL_STATUS_ACCEPT},
+		{"wrong skip reason", syncHeight, [][]byte{injectedTx(t, wrongSkipReason), tx}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"unavailable", syncHeight, [][]byte{injectedTx(t, unavailable), tx}, abci.PROCESS_PROPOSAL_STATUS_ACCEPT},
+		{"agreed block skipped as unavailable", syncHeight, [][]byte{injectedTx(t, skippedAgreed), tx}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"unavailable with a block", syncHeight, [][]byte{injectedTx(t, unavailableWithBlock), tx}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"forged block", syncHeight, [][]byte{injectedTx(t, forgedHash)}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"forged vote extensions", syncHeight, [][]byte{injectedTx(t, forgedCommit)}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"forged validator set", syncHeight, [][]byte{injectedTx(t, forgedValidatorSet)}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"validator set withheld", syncHeight, [][]byte{injectedTx(t, withheldValidatorSet)}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"smuggled at sync height", syncHeight, [][]byte{injectedTx(t, agreed), injectedTx(t, agreed)}, abci.PROCESS_PROPOSAL_STATUS_REJECT},
+	}
+
+	// the last commit must match the vote extensions of the agreed block
+	f.extendedCommit(t)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := f.proposal.ProcessProposal()(f.ctx, &abci.ProcessProposalRequest{Height: tc.height, Txs: tc.txs})
+			require.NoError(t, err)
+			require.Equal(t, tc.status, resp.Status)
+		})
+	}
+}
+
+func TestPreBlockerStoresInjectedData(t *testing.T) {
+	f := setupFixture(t)
+	syncHeight := stakingtypes.DefaultSymbioticSyncPeriod
+	blockHash := common.BytesToHash([]byte{1}).String()
+
+	// the Ethereum RPC is not queried once the block is decided
+	f.source.Err = errors.New("unavailable")
+
+	extCommit := f.extendedCommit(t)
+	extCommitBz, err := extCommit.Marshal()
+	require.NoError(t, err)
+
+	err = f.proposal.PreBlocker()(f.ctx, &abci.FinalizeBlockRequest{Height: syncHeight, Txs: [][]byte{injectedTx(t, stakingtypes.InjectedSymbioticData{
+		BlockHash:          blockHash,
+		BlockNumber:        1,
+		ExtendedCommitInfo: extCommitBz,
+		ValidatorSet:       f.validatorSet(t),
+	})}})
+	require.NoError(t, err)
+
+	resp, err := stakingkeeper.Querier{Keeper: f.keeper}.InjectedSymbioticData(f.ctx, &stakingtypes.QueryInjectedSymbioticDataRequest{Height: syncHeight})
+	require.NoError(t, err)
+	require.Equal(t, blockHash, resp.Data.BlockHash)
+	require.Equal(t, uint64(1), resp.Data.BlockNumber)
+	require.Empty(t, resp.Data.ExtendedCommitInfo)
+
+	require.NoError(t, f.keeper.SymbioticUpdateValidatorsPower(f.ctx))
+	for i, privKey := range f.validators {
+		validator, err := f.keeper.GetValidatorByConsAddr(f.ctx, sdk.ConsAddress(privKey.PubKey().Address()))
+		require.NoError(t, err)
+		require.Equal(t, int64(i+1)*1000000, validator.Tokens.Int64())
+	}
+
+	syncPoint, err := f.keeper.SymbioticSyncPoints.Get(f.ctx, syncHeight)
+	require.NoError(t, err)
+	require.Equal(t, blockHash, syncPoint.BlockHash)
+	require.Equal(t, uint32(len(f.validators)), syncPoint.ValidatorsUpdated)
+	require.Empty(t, syncPoint.SkipReason)
+}
+
+func TestPreBlockerRecordsSkippedSync(t *testing.T) {
+	f := setupFixture(t)
+	syncHeight := stakingtypes.DefaultSymbioticSyncPeriod
+
+	err := f.proposal.PreBlocker()(f.ctx, &abci.FinalizeBlockRequest{Height: syncHeight, Txs: [][]byte{injectedTx(t, stakingtypes.InjectedSymbioticData{
+		BlockHash:  stakingkeeper.INVALID_BLOCKHASH,
+		SkipReason: stakingtypes.SyncSkipReasonNoAgreement,
+	})}})
+	require.NoError(t, err)
+
+	_, err = f.keeper.InjectedSymbioticData.Get(f.ctx, syncHeight)
+	require.Error(t, err)
+
+	syncPoint, err := f.keeper.SymbioticSyncPoints.Get(f.ctx, syncHeight)
+	require.NoError(t, err)
+	require.Equal(t, stakingtypes.SyncSkipReasonNoAgreement, syncPoint.SkipReason)
+
+	// nothing to apply
+	require.NoError(t, f.keeper.SymbioticUpdateValidatorsPower(f.ctx))
+}
+
+func TestPreBlockerAddsCheckpointSignatures(t *testing.T) {
+	f := setupFixture(t)
+	syncHeight := stakingtypes.DefaultSymbioticSyncPeriod
+	keys, digest := f.addCheckpoint(t)
+
+	// the second validator signs with the key of the first one
+	extCommit := f.extendedCommit(t,
+		checkpointVoteExtension(t, digest, keys[0]),
+		checkpointVoteExtension(t, digest, keys[0]),
+		checkpointVoteExtension(t, digest, keys[2]),
+	)
+	extCommitBz, err := extCommit.Marshal()
+	require.NoError(t, err)
+
+	err = f.proposal.PreBlocker()(f.ctx, &abci.FinalizeBlockRequest{Height: syncHeight, Txs: [][]byte{injectedTx(t, stakingtypes.InjectedSymbioticData{
+		BlockHash:          stakingkeeper.INVALID_BLOCKHASH,
+		SkipReason:         stakingtypes.SyncSkipReasonNoAgreement,
+		ExtendedCommitInfo: extCommitBz,
+	})}})
+	require.NoError(t, err)
+
+	checkpoint, err := f.keeper.SymbioticCheckpoints.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, checkpoint.Signatures, 2)
+	require.Equal(t, int64(20), checkpoint.SignedPower)
+	require.False(t, checkpoint.HasQuorum())
+	require.True(t, checkpoint.HasSigned(crypto.PubkeyToAddress(keys[0].PublicKey).Hex()))
+	require.False(t, checkpoint.HasSigned(crypto.PubkeyToAddress(keys[1].PublicKey).Hex()))
+}
+
+func TestCheckpointQuorumAfterSyncHeight(t *testing.T) {
+	f := setupFixture(t)
+	syncHeight := stakingtypes.DefaultSymbioticSyncPeriod
+	keys, _ := f.addCheckpoint(t)
+
+	// no finalized block is observed, so the sync height is skipped
+	f.source.Err = errors.New("unavailable")
+
+	// extend returns the vote extension of the fixture validator i before height
+	extend := func(height int64, i int) []byte {
+		f.voteExt.SetCheckpointKey(keys[i])
+		resp, err := f.voteExt.ExtendVote()(f.ctx, &abci.ExtendVoteRequest{Height: height - 1, Time: f.ctx.HeaderInfo().Time})
+		require.NoError(t, err)
+		return resp.VoteExtension
+	}
+
+	// finalize proposes, processes and pre-blocks height with the vote
+	// extensions of the previous height, and returns the proposed txs
+	finalize := func(height int64, exts ...[]byte) [][]byte {
+		headerInfo := f.ctx.HeaderInfo()
+		headerInfo.Height = height
+		f.ctx = f.ctx.WithHeaderInfo(headerInfo)
+
+		extCommit := f.extendedCommit(t, exts...)
+		prepared, err := f.proposal.PrepareProposal()(f.ctx, &abci.PrepareProposalRequest{Height: height, LocalLastCommit: extCommit})
+		require.NoError(t, err)
+
+		processed, err := f.proposal.ProcessProposal()(f.ctx, &abci.ProcessProposalRequest{Height: height, Txs: prepared.Txs})
+		require.NoError(t, err)
+		require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_ACCEPT, processed.Status)
+
+		require.NoError(t, f.proposal.PreBlocker()(f.ctx, &abci.FinalizeBlockRequest{Height: height, Txs: prepared.Txs}))
+		return prepared.Txs
+	}
+
+	// only the first operator signs before the sync height
+	finalize(syncHeight, extend(syncHeight, 0))
+
+	syncPoint, err := f.keeper.SymbioticSyncPoints.Get(f.ctx, syncHeight)
+	require.NoError(t, err)
+	require.Equal(t, stakingtypes.SyncSkipReasonNoAgreement, syncPoint.SkipReason)
+
+	checkpoint, err := f.keeper.SymbioticCheckpoints.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, checkpoint.Signatures, 1)
+	require.False(t, checkpoint.HasQuorum())
+
+	// the others sign before the next height, which isn't a sync height
+	require.Empty(t, extend(syncHeight+1, 0))
+	txs := finalize(syncHeight+1, nil, extend(syncHeight+1, 1), extend(syncHeight+1, 2))
+	require.Len(t, txs, 1)
+
+	data, err := stakingtypes.DecodeInjectedTx(txs[0])
+	require.NoError(t, err)
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, data.BlockHash)
+	require.Empty(t, data.SkipReason)
+
+	_, err = f.keeper.SymbioticSyncPoints.Get(f.ctx, syncHeight+1)
+	require.Error(t, err)
+
+	checkpoint, err = f.keeper.SymbioticCheckpoints.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, checkpoint.Signatures, 3)
+	require.True(t, checkpoint.HasQuorum())
+
+	// the injected tx out of the sync heights only carries checkpoint signatures
+	withSkipReason := data
+	withSkipReason.SkipReason = stakingtypes.SyncSkipReasonNoAgreement
+	extCommit := f.extendedCommit(t)
+	extCommitBz, err := extCommit.Marshal()
+	require.NoError(t, err)
+	withoutSignatures := stakingtypes.InjectedSymbioticData{BlockHash: stakingkeeper.INVALID_BLOCKHASH, ExtendedCommitInfo: extCommitBz}
+
+	for _, tx := range [][]byte{injectedTx(t, withSkipReason), injectedTx(t, withoutSignatures)} {
+		resp, err := f.proposal.ProcessProposal()(f.ctx, &abci.ProcessProposalRequest{Height: syncHeight + 1, Txs: [][]byte{tx}})
+		require.NoError(t, err)
+		require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_REJECT, resp.Status)
+	}
+
+	// nothing is injected once the checkpoint has a quorum
+	require.Empty(t, finalize(syncHeight+2, extend(syncHeight+2, 0), extend(syncHeight+2, 1), extend(syncHeight+2, 2)))
+}
+
+func TestSyncEpochNotAdvanced(t *testing.T) {
+	f := setupFixture(t)
+	syncHeight := stakingtypes.DefaultSymbioticSyncPeriod
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.SymbioticEpochIdentifier = "hour"
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	require.NoError(t, f.keeper.SymbioticNextSyncHeight.Set(f.ctx, syncHeight))
+	require.NoError(t, f.keeper.SymbioticEpoch.Set(f.ctx, 1))
+
+	// only the current epoch is registered at the block, so the validators
+	// abstain if they fetch the validator set
+	header := &ethtypes.Header{Number: big.NewInt(1), Time: uint64(f.ctx.HeaderInfo().Time.Unix()) - 1000}
+	hash := f.source.AddHeader(header)
+	f.source.AddBeaconBlock(finalizedSlot(), hash.String(), true)
+	contractABI, err := abi.JSON(strings.NewReader(stakingkeeper.CONTRACT_ABI))
+	require.NoError(t, err)
+	data, err := contractABI.Pack(stakingkeeper.GET_CURRENT_EPOCH_FUNCTION_NAME)
+	require.NoError(t, err)
+	f.source.AddCall(middleware, hash, data, common.LeftPadBytes([]byte{1}, 32))
+
+	resp, err := f.voteExt.ExtendVote()(f.ctx, &abci.ExtendVoteRequest{Height: syncHeight - 1, Time: f.ctx.HeaderInfo().Time})
+	require.NoError(t, err)
+
+	var ve stakingtypes.SymbioticVoteExtension
+	require.NoError(t, ve.Unmarshal(resp.VoteExtension))
+	require.Equal(t, hash.String(), ve.BlockHash)
+	require.Equal(t, uint64(1), ve.Epoch)
+	require.Empty(t, ve.ValidatorSetDigest)
+
+	verified, err := f.voteExt.VerifyVoteExtension()(f.ctx, &abci.VerifyVoteExtensionRequest{Height: syncHeight - 1, VoteExtension: resp.VoteExtension})
+	require.NoError(t, err)
+	require.Equal(t, abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT, verified.Status)
+
+	extCommit := f.extendedCommit(t, resp.VoteExtension, resp.VoteExtension, resp.VoteExtension)
+	prepared, err := f.proposal.PrepareProposal()(f.ctx, &abci.PrepareProposalRequest{Height: syncHeight, LocalLastCommit: extCommit})
+	require.NoError(t, err)
+
+	injected, err := stakingtypes.DecodeInjectedTx(prepared.Txs[0])
+	require.NoError(t, err)
+	require.Equal(t, hash.String(), injected.BlockHash)
+	require.Equal(t, stakingtypes.SyncSkipReasonEpochNotAdvanced, injected.SkipReason)
+	require.Empty(t, injected.ValidatorSet)
+
+	processed, err := f.proposal.ProcessProposal()(f.ctx, &abci.ProcessProposalRequest{Height: syncHeight, Txs: prepared.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_ACCEPT, processed.Status)
+
+	require.NoError(t, f.proposal.PreBlocker()(f.ctx, &abci.FinalizeBlockRequest{Height: syncHeight, Txs: prepared.Txs}))
+	require.NoError(t, f.keeper.SymbioticUpdateValidatorsPower(f.ctx))
+
+	syncPoint, err := f.keeper.SymbioticSyncPoints.Get(f.ctx, syncHeight)
+	require.NoError(t, err)
+	require.Equal(t, stakingtypes.SyncSkipReasonEpochNotAdvanced, syncPoint.SkipReason)
+	require.Equal(t, hash.String(), syncPoint.BlockHash)
+
+	// the validator set is fetched at the retried sync once the epoch advanced
+	next, err := f.keeper.SymbioticNextSyncHeight.Get(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SymbioticEpoch.Set(f.ctx, 0))
+	resp, err = f.voteExt.ExtendVote()(f.ctx, &abci.ExtendVoteRequest{Height: next - 1, Time: f.ctx.HeaderInfo().Time})
+	require.NoError(t, err)
+	require.Empty(t, resp.VoteExtension)
+}
@@ -1,24 +1,266 @@
 package abci
 
 import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/log"
+	keeper2 "cosmossdk.io/x/symStaking/keeper"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-type VoteExtensionHandler struct{}
+// VoteExtensionHandler extends the votes of the height before a Symbiotic sync
+// height with the finalized execution block the validator observed locally, so
+// that the next proposer injects the block agreed on by the validators instead
+// of its own view of Ethereum. If a checkpoint key is set, the votes of every
+// height also carry the signature of the last Symbiotic checkpoint by the
+// operator of the validator until the checkpoint has a quorum.
+type VoteExtensionHandler struct {
+	logger        log.Logger
+	keeper        *keeper2.Keeper
+	checkpointKey *ecdsa.PrivateKey
+}
 
-func NewVoteExtensionHandler() *VoteExtensionHandler {
-	return &VoteExtensionHandler{}
+func NewVoteExtensionHandler(logger log.Logger, keeper *keeper2.Keeper) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		logger: logger,
+		keeper: keeper,
+	}
 }
 
+// SetCheckpointKey sets the secp256k1 key of the Symbiotic operator of the
+// validator, signing the checkpoints.
+func (h *VoteExtensionHandler) SetCheckpointKey(key *ecdsa.PrivateKey) {
+	h.checkpointKey = key
+}
+
+// ExtendVote extends the vote with the finalized execution block and the digest
+// of its validator set if the next height is a sync height, along with the
+// signature of the last checkpoint at any height. The block is omitted if it
+// can't be fetched or isn't finalized.
 func (h *VoteExtensionHandler) ExtendVote() sdk.ExtendVoteHandler {
-	return func(_ sdk.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
-		return &abci.ExtendVoteResponse{VoteExtension: nil}, nil
+	return func(ctx sdk.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+		isSyncHeight, err := h.keeper.IsSymbioticSyncHeight(ctx, req.Height+1)
+		if err != nil {
+			return nil, err
+		}
+
+		var ve stakingtypes.SymbioticVoteExtension
+		if isSyncHeight {
+			// the finalized slot is derived from the time of the block voted on, so
+			// that honest validators observe the same block
+			headerInfo := ctx.HeaderInfo()
+			headerInfo.Time = req.Time
+			ctx = ctx.WithHeaderInfo(headerInfo)
+
+			ve, err = h.observeFinalizedBlock(ctx)
+			if err != nil {
+				h.logger.Error("ExtendVote: failed to observe finalized block", "height", req.Height, "err", err)
+				ve = stakingtypes.SymbioticVoteExtension{}
+			}
+		}
+
+		if err := h.signCheckpoint(ctx, &ve); err != nil {
+			h.logger.Error("ExtendVote: failed to sign checkpoint", "height", req.Height, "err", err)
+		}
+
+		if ve.BlockHash == "" && len(ve.CheckpointSignature) == 0 {
+			return &abci.ExtendVoteResponse{}, nil
+		}
+
+		bz, err := ve.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode symbiotic vote extension: %w", err)
+		}
+
+		return &abci.ExtendVoteResponse{VoteExtension: bz}, nil
 	}
 }
 
+// VerifyVoteExtension verifies the format of the vote extension. Its content is
+// only trusted once agreed on by more than 2/3 of the voting power. Out of the
+// heights before a sync height, it may only carry a checkpoint signature.
 func (h *VoteExtensionHandler) VerifyVoteExtension() sdk.VerifyVoteExtensionHandler {
 	return func(ctx sdk.Context, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
+		reject := &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}
+
+		if len(req.VoteExtension) == 0 {
+			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
+		}
+
+		isSyncHeight, err := h.keeper.IsSymbioticSyncHeight(ctx, req.Height+1)
+		if err != nil {
+			return nil, err
+		}
+
+		ve, err := decodeVoteExtension(req.VoteExtension)
+		if err != nil {
+			h.logger.Error("VerifyVoteExtension: invalid vote extension", "height", req.Height, "err", err)
+			return reject, nil
+		}
+
+		if !isSyncHeight && (ve.BlockHash != "" || len(ve.CheckpointSignature) == 0) {
+			h.logger.Error("VerifyVoteExtension: unexpected vote extension", "height", req.Height)
+			return reject, nil
+		}
+
 		return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
 	}
 }
+
+// observeFinalizedBlock returns the vote extension of the finalized execution
+// block, or an error if there is none. The validator set isn't fetched if the
+// middleware epoch of the block didn't advance, as it wouldn't be applied: the
+// vote extension then carries no digest.
+func (h *VoteExtensionHandler) observeFinalizedBlock(ctx sdk.Context) (stakingtypes.SymbioticVoteExtension, error) {
+	var ve stakingtypes.SymbioticVoteExtension
+
+	data, err := h.keeper.GetFinalizedBlock(ctx)
+	if err != nil {
+		return ve, err
+	}
+
+	if data.BlockHash == keeper2.INVALID_BLOCKHASH {
+		return ve, fmt.Errorf("block at slot %d is not finalized", data.Slot)
+	}
+
+	block, err := h.keeper.GetBlockByNumber(ctx, new(big.Int).SetUint64(data.BlockNumber))
+	if err != nil {
+		return ve, err
+	}
+	// very specific error caused by finalized check bug, ideally this check shouldn't exist
+	if block.Hash().String() != data.BlockHash {
+		return ve, fmt.Errorf("block %s is not canonical", data.BlockHash)
+	}
+
+	epoch, err := h.keeper.FetchSymbioticEpoch(ctx, data.BlockHash)
+	if err != nil {
+		return ve, err
+	}
+
+	ve = stakingtypes.SymbioticVoteExtension{
+		BlockHash:      data.BlockHash,
+		BlockNumber:    data.BlockNumber,
+		BlockTimestamp: data.BlockTimestamp,
+		Slot:           data.Slot,
+		Epoch:          epoch,
+	}
+
+	advanced, err := h.keeper.IsSymbioticEpochAdvanced(ctx, epoch)
+	if err != nil || !advanced {
+		return ve, err
+	}
+
+	epoch, validatorSet, err := h.keeper.FetchSymbioticValidatorSet(ctx, data.BlockHash)
+	if err != nil {
+		return stakingtypes.SymbioticVoteExtension{}, err
+	}
+
+	if epoch != ve.Epoch {
+		return stakingtypes.SymbioticVoteExtension{}, fmt.Errorf("symbiotic epoch mismatch: expected %d, got %d", ve.Epoch, epoch)
+	}
+
+	digest := sha256.Sum256(validatorSet)
+	ve.ValidatorSetDigest = digest[:]
+	return ve, nil
+}
+
+// signCheckpoint signs the last checkpoint in ve if a checkpoint key is set, the
+// checkpoint has no quorum yet and its operator is a validator of the checkpoint
+// that didn't sign yet.
+func (h *VoteExtensionHandler) signCheckpoint(ctx sdk.Context, ve *stakingtypes.SymbioticVoteExtension) error {
+	if h.checkpointKey == nil {
+		return nil
+	}
+
+	checkpoint, found, err := h.keeper.GetLastSymbioticCheckpoint(ctx)
+	if err != nil || !found {
+		return err
+	}
+
+	operator := crypto.PubkeyToAddress(h.checkpointKey.PublicKey)
+	if checkpoint.HasQuorum() || checkpoint.HasSigned(operator.Hex()) {
+		return nil
+	}
+
+	isValidator := false
+	for _, v := range checkpoint.Validators {
+		if common.HexToAddress(v.Operator) == operator {
+			isValidator = true
+			break
+		}
+	}
+	if !isValidator {
+		return nil
+	}
+
+	digest, err := keeper2.SymbioticCheckpointDigest(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	signature, err := crypto.Sign(digest, h.checkpointKey)
+	if err != nil {
+		return err
+	}
+
+	ve.CheckpointEpoch = checkpoint.Epoch
+	ve.CheckpointSignature = signature
+	return nil
+}
+
+// decodeVoteExtension decodes a non-empty vote extension. The block hash must be
+// either empty, if the validator observed no finalized block, or a 0x prefixed
+// 32 bytes hash along with a sha256 digest, or none if the epoch didn't advance.
+// The checkpoint signature, if any, must be 65 bytes long.
+func decodeVoteExtension(bz []byte) (stakingtypes.SymbioticVoteExtension, error) {
+	var ve stakingtypes.SymbioticVoteExtension
+
+	if err := ve.Unmarshal(bz); err != nil {
+		return ve, err
+	}
+
+	if len(ve.CheckpointSignature) != 0 && len(ve.CheckpointSignature) != crypto.SignatureLength {
+		return ve, fmt.Errorf("invalid checkpoint signature length: %d", len(ve.CheckpointSignature))
+	}
+
+	if ve.BlockHash == "" {
+		if len(ve.ValidatorSetDigest) != 0 {
+			return ve, fmt.Errorf("unexpected validator set digest without block")
+		}
+		return ve, nil
+	}
+
+	if err := validateBlockHash(ve.BlockHash); err != nil {
+		return ve, err
+	}
+
+	if len(ve.ValidatorSetDigest) != 0 && len(ve.ValidatorSetDigest) != 32 {
+		return ve, fmt.Errorf("invalid validator set digest length: %d", len(ve.ValidatorSetDigest))
+	}
+
+	return ve, nil
+}
+
+// validateBlockHash checks that blockHash is a 0x prefixed 32 bytes hash.
+func validateBlockHash(blockHash string) error {
+	bz, err := hexutil.Decode(blockHash)
+	if err != nil {
+		return err
+	}
+
+	if len(bz) != 32 {
+		return fmt.Errorf("invalid block hash length: %d", len(bz))
+	}
+
+	return nil
+}
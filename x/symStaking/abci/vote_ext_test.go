@@ -0,0 +1,143 @@
+package abci_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExtendVote(t *testing.T) {
+	f := setupFixture(t)
+	syncHeight := stakingtypes.DefaultSymbioticSyncPeriod
+	now := f.ctx.HeaderInfo().Time
+
+	header := &ethtypes.Header{Number: big.NewInt(1), Time: uint64(now.Unix()) - 1000}
+	blockHash := f.addFinalizedBlock(t, header)
+
+	// the next height is not a sync height
+	resp, err := f.voteExt.ExtendVote()(f.ctx, &abci.ExtendVoteRequest{Height: syncHeight, Time: now})
+	require.NoError(t, err)
+	require.Empty(t, resp.VoteExtension)
+
+	resp, err = f.voteExt.ExtendVote()(f.ctx, &abci.ExtendVoteRequest{Height: syncHeight - 1, Time: now})
+	require.NoError(t, err)
+	require.Equal(t, f.voteExtension(t, header, blockHash), resp.VoteExtension)
+
+	// abstain if the finalized block can't be observed
+	f.source.Err = errors.New("unavailable")
+	resp, err = f.voteExt.ExtendVote()(f.ctx, &abci.ExtendVoteRequest{Height: syncHeight - 1, Time: now})
+	require.NoError(t, err)
+	require.Empty(t, resp.VoteExtension)
+}
+
+func TestExtendVoteSignsCheckpoint(t *testing.T) {
+	f := setupFixture(t)
+	syncHeight := stakingtypes.DefaultSymbioticSyncPeriod
+	now := f.ctx.HeaderInfo().Time
+	keys, digest := f.addCheckpoint(t)
+	f.voteExt.SetCheckpointKey(keys[0])
+
+	// the checkpoint is signed even if the finalized block can't be observed
+	f.source.Err = errors.New("unavailable")
+	resp, err := f.voteExt.ExtendVote()(f.ctx, &abci.ExtendVoteRequest{Height: syncHeight - 1, Time: now})
+	require.NoError(t, err)
+
+	var ve stakingtypes.SymbioticVoteExtension
+	require.NoError(t, ve.Unmarshal(resp.VoteExtension))
+	require.Empty(t, ve.BlockHash)
+	require.Equal(t, uint64(1), ve.CheckpointEpoch)
+	pubKey, err := crypto.SigToPub(digest, ve.CheckpointSignature)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(keys[0].PublicKey), crypto.PubkeyToAddress(*pubKey))
+
+	// and before a height that isn't a sync height
+	resp, err = f.voteExt.ExtendVote()(f.ctx, &abci.ExtendVoteRequest{Height: syncHeight, Time: now})
+	require.NoError(t, err)
+	require.NoError(t, ve.Unmarshal(resp.VoteExtension))
+	require.Empty(t, ve.BlockHash)
+	require.Equal(t, uint64(1), ve.CheckpointEpoch)
+	require.NotEmpty(t, ve.CheckpointSignature)
+
+	// nothing is signed by a key that isn't an operator of the checkpoint
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	f.voteExt.SetCheckpointKey(key)
+	resp, err = f.voteExt.ExtendVote()(f.ctx, &abci.ExtendVoteRequest{Height: syncHeight - 1, Time: now})
+	require.NoError(t, err)
+	require.Empty(t, resp.VoteExtension)
+
+	// nor once the operator signed
+	f.voteExt.SetCheckpointKey(keys[1])
+	sig, err := crypto.Sign(digest, keys[1])
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.AddSymbioticCheckpointSignature(f.ctx, sdk.ConsAddress(f.validators[1].PubKey().Address()), 1, sig))
+	resp, err = f.voteExt.ExtendVote()(f.ctx, &abci.ExtendVoteRequest{Height: syncHeight - 1, Time: now})
+	require.NoError(t, err)
+	require.Empty(t, resp.VoteExtension)
+
+	// nor once the checkpoint has a quorum
+	f.voteExt.SetCheckpointKey(keys[2])
+	checkpoint, err := f.keeper.SymbioticCheckpoints.Get(f.ctx, 1)
+	require.NoError(t, err)
+	checkpoint.SignedPower = checkpoint.TotalPower
+	require.NoError(t, f.keeper.SymbioticCheckpoints.Set(f.ctx, 1, checkpoint))
+	resp, err = f.voteExt.ExtendVote()(f.ctx, &abci.ExtendVoteRequest{Height: syncHeight, Time: now})
+	require.NoError(t, err)
+	require.Empty(t, resp.VoteExtension)
+}
+
+func TestVerifyVoteExtension(t *testing.T) {
+	f := setupFixture(t)
+	syncHeight := stakingtypes.DefaultSymbioticSyncPeriod
+
+	header := &ethtypes.Header{Number: big.NewInt(1), Time: 1}
+	ve := f.voteExtension(t, header, header.Hash().String())
+
+	badHash, err := (&stakingtypes.SymbioticVoteExtension{BlockHash: "0x1234", ValidatorSetDigest: make([]byte, 32)}).Marshal()
+	require.NoError(t, err)
+
+	badDigest, err := (&stakingtypes.SymbioticVoteExtension{BlockHash: header.Hash().String(), ValidatorSetDigest: []byte{1}}).Marshal()
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	checkpointOnly := checkpointVoteExtension(t, make([]byte, 32), key)
+
+	badSignature, err := (&stakingtypes.SymbioticVoteExtension{CheckpointEpoch: 1, CheckpointSignature: []byte{1}}).Marshal()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		height int64
+		ext    []byte
+		status abci.VerifyVoteExtensionStatus
+	}{
+		{"empty", syncHeight - 1, nil, abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT},
+		{"valid", syncHeight - 1, ve, abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT},
+		{"no sync height", syncHeight, ve, abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"garbage", syncHeight - 1, []byte("garbage"), abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"invalid block hash", syncHeight - 1, badHash, abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"invalid digest", syncHeight - 1, badDigest, abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"checkpoint signature only", syncHeight - 1, checkpointOnly, abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT},
+		{"checkpoint signature only at no sync height", syncHeight, checkpointOnly, abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT},
+		{"invalid checkpoint signature at no sync height", syncHeight, badSignature, abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"invalid checkpoint signature", syncHeight - 1, badSignature, abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := f.voteExt.VerifyVoteExtension()(f.ctx, &abci.VerifyVoteExtensionRequest{Height: tc.height, VoteExtension: tc.ext})
+			require.NoError(t, err)
+			require.Equal(t, tc.status, resp.Status)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package ante
+
+import (
+	"cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InjectedTxDecorator is an AnteDecorator that skips the rest of the ante chain for
+// the tx injected by the proposer, which has no signer nor fee. The injected tx is
+// only accepted when finalizing a block: it can't enter the mempool.
+// It must be the outermost AnteDecorator.
+type InjectedTxDecorator struct{}
+
+func NewInjectedTxDecorator() InjectedTxDecorator {
+	return InjectedTxDecorator{}
+}
+
+func (itd InjectedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if _, ok := tx.(*types.InjectedTx); !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	if ctx.ExecMode() != sdk.ExecModeFinalize {
+		return ctx, types.ErrInvalidInjectedTx.Wrap("injected tx is only accepted in finalize block")
+	}
+
+	return ctx, nil
+}
@@ -0,0 +1,51 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/symStaking/ante"
+	"cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var errNextCalled = errors.New("next called")
+
+func nextAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, errNextCalled
+}
+
+func TestInjectedTxDecorator(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	bz, err := types.EncodeInjectedTx(types.InjectedSymbioticData{Version: types.InjectedSymbioticDataVersion, BlockHash: "invalid"})
+	require.NoError(t, err)
+
+	// the wrapped decoder is not called for the injected tx
+	decoder := types.InjectedTxDecoder("authority", func([]byte) (sdk.Tx, error) {
+		return nil, errors.New("unexpected decode")
+	})
+	tx, err := decoder(bz)
+	require.NoError(t, err)
+	require.Len(t, tx.GetMsgs(), 1)
+
+	decorator := ante.NewInjectedTxDecorator()
+
+	// skipped in finalize block
+	_, err = decorator.AnteHandle(ctx.WithExecMode(sdk.ExecModeFinalize), tx, false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// rejected anywhere else
+	_, err = decorator.AnteHandle(ctx.WithExecMode(sdk.ExecModeCheck), tx, false, nextAnteHandler)
+	require.ErrorIs(t, err, types.ErrInvalidInjectedTx)
+
+	// other txs go through the chain
+	_, err = decorator.AnteHandle(ctx.WithExecMode(sdk.ExecModeFinalize), nil, false, nextAnteHandler)
+	require.ErrorIs(t, err, errNextCalled)
+}
@@ -0,0 +1,23 @@
+package ante
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	authante "cosmossdk.io/x/auth/ante"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SigVerificationGasConsumer is a SignatureVerificationGasConsumer that also
+// accepts ed25519 signers, so that the operator of a validator created from its
+// Symbiotic consensus key can sign with that key. The other keys are handled by
+// DefaultSigVerificationGasConsumer.
+func SigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error {
+	if _, ok := sig.PubKey.(*ed25519.PubKey); ok {
+		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
+		return nil
+	}
+
+	return authante.DefaultSigVerificationGasConsumer(meter, sig, params)
+}
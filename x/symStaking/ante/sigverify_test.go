@@ -0,0 +1,27 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/symStaking/ante"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestSigVerificationGasConsumer(t *testing.T) {
+	params := authtypes.DefaultParams()
+
+	meter := storetypes.NewInfiniteGasMeter()
+	require.NoError(t, ante.SigVerificationGasConsumer(meter, signing.SignatureV2{PubKey: ed25519.GenPrivKey().PubKey()}, params))
+	require.Equal(t, params.SigVerifyCostED25519, meter.GasConsumed())
+
+	meter = storetypes.NewInfiniteGasMeter()
+	require.NoError(t, ante.SigVerificationGasConsumer(meter, signing.SignatureV2{PubKey: secp256k1.GenPrivKey().PubKey()}, params))
+	require.Equal(t, params.SigVerifyCostSecp256k1, meter.GasConsumed())
+}
@@ -44,6 +44,37 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "InjectedSymbioticData",
+					Use:       "injected-symbiotic-data [height]",
+					Short:     "Query the Ethereum block the validator set was synced with at given height",
+					Example:   fmt.Sprintf("$ %s query staking injected-symbiotic-data 10", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "height"},
+					},
+				},
+				{
+					RpcMethod: "SymbioticSyncStatus",
+					Use:       "symbiotic-sync-status",
+					Short:     "Query the last sync points of the validator set with the Symbiotic middleware",
+					Long:      "Query the last sync point, the last sync point the validator set was updated at, and the next sync height.",
+				},
+				{
+					RpcMethod: "SymbioticSyncHistory",
+					Use:       "symbiotic-sync-history",
+					Short:     "Query the sync points of the validator set with the Symbiotic middleware",
+					Long:      "Query the outcome of every sync height, including why the sync was skipped.",
+					Example:   fmt.Sprintf("$ %s query staking symbiotic-sync-history --reverse --limit 10", version.AppName),
+				},
+				{
+					RpcMethod: "ValidatorSymbioticStakes",
+					Use:       "validator-symbiotic-stakes [validator-addr]",
+					Short:     "Query the stake of a validator in every Symbiotic middleware",
+					Long:      "Query the stake and weighted stake of a validator in every Symbiotic middleware listing it at the last sync.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -58,6 +89,28 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod: "InjectSymbioticData",
+					Skip:      true, // skipped because injected by the proposer
+				},
+				{
+					RpcMethod:      "UpdateSymbioticValidatorSet",
+					Use:            "update-symbiotic-validator-set-proposal [validators...]",
+					Short:          "Submit a proposal to set the tokens of the validators while the Symbiotic validator set is stale. The tokens of the other validators are zeroed.",
+					Example:        fmt.Sprintf(`%s tx staking update-symbiotic-validator-set-proposal '{ "validator_address": "cosmosvaloper1...", "tokens": "1000000" }' ...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validators", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod: "LinkOperator",
+					Use:       "link-operator [operator] [signature]",
+					Short:     "Link the Ethereum address of a Symbiotic operator to the validator of the --from key",
+					Long: fmt.Sprintf(`Link the Ethereum address of a Symbiotic operator to the validator of the --from key, with the EIP-191
+signature, in hex without 0x or in base64, by the operator key of "Link Symbiotic operator to validator <validator-addr> on chain <chain-id>".
+The validator address is the one of the --from key, shown by %s keys show mykey --bech val.`, version.AppName),
+					Example:        fmt.Sprintf(`%s tx staking link-operator 0x7099...79C8 5f3c...1b --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "operator"}, {ProtoField: "signature"}},
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
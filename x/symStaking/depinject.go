@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/spf13/cast"
 	"golang.org/x/exp/maps"
 
 	modulev1 "cosmossdk.io/api/cosmos/symStaking/module/v1"
@@ -13,11 +14,15 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	authtypes "cosmossdk.io/x/auth/types"
+	epochstypes "cosmossdk.io/x/epochs/types"
 	"cosmossdk.io/x/symStaking/keeper"
+	"cosmossdk.io/x/symStaking/lightclient"
 	"cosmossdk.io/x/symStaking/simulation"
+	"cosmossdk.io/x/symStaking/symbiotic"
 	"cosmossdk.io/x/symStaking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
@@ -46,6 +51,15 @@ type ModuleInputs struct {
 	Cdc                   codec.Codec
 	Environment           appmodule.Environment
 	CometInfoService      comet.Service
+	AppOpts               servertypes.AppOptions `optional:"true"` // server v0
+
+	// SymbioticSource is an optional source of Ethereum data for the validator set sync.
+	// If not provided, the default RPC source is used.
+	SymbioticSource types.SymbioticSource `optional:"true"`
+
+	// CircuitKeeper is the optional circuit breaker keeper disabling the
+	// messages under the halt stale policy, which can't be set without it.
+	CircuitKeeper types.CircuitKeeper `optional:"true"`
 }
 
 // Dependency Injection Outputs
@@ -54,6 +68,7 @@ type ModuleOutputs struct {
 
 	StakingKeeper *keeper.Keeper
 	Module        appmodule.AppModule
+	EpochHooks    epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -68,6 +83,39 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic(err)
 	}
 
+	var lightClient *lightclient.LightClient
+	symbioticSource := in.SymbioticSource
+	if symbioticSource == nil {
+		cfg := symbiotic.DefaultConfig()
+		if in.AppOpts != nil {
+			cfg.BeaconAPIURLs = cast.ToStringSlice(in.AppOpts.Get(symbiotic.FlagBeaconAPIURLs))
+			cfg.EthAPIURLs = cast.ToStringSlice(in.AppOpts.Get(symbiotic.FlagEthAPIURLs))
+			cfg.VerifyStorageProofs = cast.ToBool(in.AppOpts.Get(symbiotic.FlagVerifyStorageProofs))
+			cfg.LightClientCheckpoint = cast.ToString(in.AppOpts.Get(symbiotic.FlagLightClientCheckpoint))
+			if network := cast.ToString(in.AppOpts.Get(symbiotic.FlagLightClientNetwork)); network != "" {
+				cfg.LightClientNetwork = network
+			}
+			cfg.QuorumSize = cast.ToInt(in.AppOpts.Get(symbiotic.FlagQuorumSize))
+			cfg.QuorumThreshold = cast.ToInt(in.AppOpts.Get(symbiotic.FlagQuorumThreshold))
+		}
+
+		rpcSource := symbiotic.NewRPCSource(in.Environment.Logger, cfg.ApiUrls())
+		symbioticSource = cfg.NewSource(in.Environment.Logger, rpcSource)
+		if cfg.VerifyStorageProofs {
+			symbioticSource = symbiotic.NewVerifyingSource(symbioticSource, rpcSource)
+		}
+
+		if cfg.LightClientCheckpoint != "" {
+			lightClient, err = cfg.NewLightClient(in.Environment.Logger, rpcSource)
+			if err != nil {
+				panic(err)
+			}
+
+			// the light client is run by the app, see Keeper.StartLightClient
+			symbioticSource = lightclient.NewSource(symbioticSource, lightClient)
+		}
+	}
+
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.Environment,
@@ -77,9 +125,20 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.ValidatorAddressCodec,
 		in.ConsensusAddressCodec,
 		in.CometInfoService,
+		symbioticSource,
 	)
+	if lightClient != nil {
+		k.SetLightClient(lightClient)
+	}
+	if in.CircuitKeeper != nil {
+		k.SetCircuitKeeper(in.CircuitKeeper)
+	}
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper)
-	return ModuleOutputs{StakingKeeper: k, Module: m}
+	return ModuleOutputs{
+		StakingKeeper: k,
+		Module:        m,
+		EpochHooks:    epochstypes.EpochHooksWrapper{EpochHooks: k.EpochHooks()},
+	}
 }
 
 func InvokeSetStakingHooks(
@@ -1,10 +1,13 @@
 package symStaking
 
 import (
+	"errors"
 	"fmt"
 
 	cmttypes "github.com/cometbft/cometbft/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"cosmossdk.io/x/symStaking/keeper"
 	"cosmossdk.io/x/symStaking/types"
@@ -55,9 +58,40 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisSymbioticAnchor(data); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+// validateGenesisSymbioticAnchor checks that the anchor, if any, is an applied
+// sync point of a middleware configured in the params.
+func validateGenesisSymbioticAnchor(data *types.GenesisState) error {
+	anchor := data.SymbioticAnchor
+	if anchor == nil {
+		return nil
+	}
+
+	if len(data.Params.Middlewares()) == 0 {
+		return errors.New("symbiotic anchor in genesis state without symbiotic middleware")
+	}
+
+	if anchor.Height < 0 {
+		return fmt.Errorf("invalid symbiotic anchor height in genesis state: %d", anchor.Height)
+	}
+
+	if anchor.SkipReason != "" {
+		return fmt.Errorf("symbiotic anchor in genesis state is a skipped sync: %s", anchor.SkipReason)
+	}
+
+	if hash, err := hexutil.Decode(anchor.BlockHash); err != nil || len(hash) != common.HashLength {
+		return fmt.Errorf("invalid symbiotic anchor block hash in genesis state: %q", anchor.BlockHash)
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
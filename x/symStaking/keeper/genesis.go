@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/x/symStaking/types"
 
@@ -28,6 +30,10 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		ctx = sdkCtx
 	}
 
+	if err := k.validateSymbioticStalePolicy(data.Params); err != nil {
+		return nil, err
+	}
+
 	if err := k.Params.Set(ctx, data.Params); err != nil {
 		return nil, err
 	}
@@ -46,6 +52,10 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 			return nil, err
 		}
 
+		if err := k.SetValidatorBySymbioticOperator(ctx, validator); err != nil {
+			return nil, err
+		}
+
 		if err := k.SetValidatorByPowerIndex(ctx, validator); err != nil {
 			return nil, err
 		}
@@ -78,6 +88,21 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	// the validator set is the one of the anchor block and epoch
+	if anchor := data.SymbioticAnchor; anchor != nil {
+		if err := k.SymbioticSyncPoints.Set(ctx, anchor.Height, *anchor); err != nil {
+			return nil, err
+		}
+
+		if err := k.SymbioticEpoch.Set(ctx, anchor.Epoch); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.initSymbioticGenesis(ctx, data); err != nil {
+		return nil, err
+	}
+
 	// TODO: remove with genesis 2-phases refactor https://github.com/cosmos/cosmos-sdk/issues/2862
 
 	// don't need to run CometBFT updates if we exported
@@ -154,11 +179,168 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
-	return &types.GenesisState{
+	anchor, err := k.lastAppliedSymbioticSyncPoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := &types.GenesisState{
 		Params:              params,
 		LastTotalPower:      totalPower,
 		LastValidatorPowers: lastValidatorPowers,
 		Validators:          allValidators,
 		Exported:            true,
-	}, nil
+		SymbioticAnchor:     anchor,
+	}
+	if err := k.exportSymbioticGenesis(ctx, genesis); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+
+// initSymbioticGenesis sets the Symbiotic sync state of data, replaying the
+// consensus pubkey rotations in height order to index the validators by their
+// old consensus addresses.
+func (k Keeper) initSymbioticGenesis(ctx context.Context, data *types.GenesisState) error {
+	if data.SymbioticStaleness != nil {
+		if err := k.SymbioticStaleness.Set(ctx, *data.SymbioticStaleness); err != nil {
+			return err
+		}
+	}
+
+	if data.SymbioticNextSyncHeight != 0 {
+		if err := k.SymbioticNextSyncHeight.Set(ctx, data.SymbioticNextSyncHeight); err != nil {
+			return err
+		}
+	}
+
+	for _, stakes := range data.SymbioticStakes {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(stakes.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SymbioticStakes.Set(ctx, valAddr, types.SymbioticStakes{Stakes: stakes.Stakes}); err != nil {
+			return err
+		}
+	}
+
+	for _, checkpoint := range data.SymbioticCheckpoints {
+		if err := k.SymbioticCheckpoints.Set(ctx, checkpoint.Epoch, checkpoint); err != nil {
+			return err
+		}
+	}
+
+	for _, rotation := range data.ConsPubKeyRotations {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(rotation.OperatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.ConsPubKeyRotations.Set(ctx, collections.Join(rotation.Height, valAddr), rotation); err != nil {
+			return err
+		}
+	}
+
+	for _, old := range data.OldConsAddresses {
+		consAddr, err := k.consensusAddressCodec.StringToBytes(old.ConsAddress)
+		if err != nil {
+			return err
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(old.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.ValidatorByOldConsensusAddress.Set(ctx, consAddr, valAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportSymbioticGenesis adds the Symbiotic sync state to genesis.
+func (k Keeper) exportSymbioticGenesis(ctx context.Context, genesis *types.GenesisState) error {
+	staleness, err := k.SymbioticStaleness.Get(ctx)
+	switch {
+	case err == nil:
+		genesis.SymbioticStaleness = &staleness
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	genesis.SymbioticNextSyncHeight, err = k.SymbioticNextSyncHeight.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	err = k.SymbioticStakes.Walk(ctx, nil, func(valAddr sdk.ValAddress, stakes types.SymbioticStakes) (bool, error) {
+		addr, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		genesis.SymbioticStakes = append(genesis.SymbioticStakes, types.SymbioticValidatorStakes{ValidatorAddress: addr, Stakes: stakes.Stakes})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = k.SymbioticCheckpoints.Walk(ctx, nil, func(_ uint64, checkpoint types.SymbioticCheckpoint) (bool, error) {
+		genesis.SymbioticCheckpoints = append(genesis.SymbioticCheckpoints, checkpoint)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = k.ConsPubKeyRotations.Walk(ctx, nil, func(_ collections.Pair[int64, []byte], rotation types.ConsPubKeyRotation) (bool, error) {
+		genesis.ConsPubKeyRotations = append(genesis.ConsPubKeyRotations, rotation)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.ValidatorByOldConsensusAddress.Walk(ctx, nil, func(consAddr sdk.ConsAddress, valAddr sdk.ValAddress) (bool, error) {
+		consAddrStr, err := k.consensusAddressCodec.BytesToString(consAddr)
+		if err != nil {
+			return true, err
+		}
+
+		valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		genesis.OldConsAddresses = append(genesis.OldConsAddresses, types.ValidatorOldConsAddress{ConsAddress: consAddrStr, ValidatorAddress: valAddrStr})
+		return false, nil
+	})
+}
+
+// lastAppliedSymbioticSyncPoint returns the last sync point the validator set
+// was synced at, nil if there is none.
+func (k Keeper) lastAppliedSymbioticSyncPoint(ctx context.Context) (*types.SymbioticSyncPoint, error) {
+	iter, err := k.SymbioticSyncPoints.Iterate(ctx, new(collections.Range[int64]).Descending())
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		syncPoint, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		if syncPoint.SkipReason == "" {
+			return &syncPoint, nil
+		}
+	}
+
+	return nil, nil
 }
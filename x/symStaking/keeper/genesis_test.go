@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestGenesisSymbioticAnchor() {
+	require := s.Require()
+	ctx, keeper := s.ctx, s.stakingKeeper
+
+	params := stakingtypes.DefaultParams()
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+
+	anchor := stakingtypes.SymbioticSyncPoint{
+		BlockHash:         "0x5c2b0a51b2dd4f3d5e1e0d3e4ebd0c1f93e4f1c5f7c6b3ee0d4a1f0b0c9cd1e0",
+		BlockNumber:       21000000,
+		BlockTimestamp:    1730000000,
+		Epoch:             7,
+		ValidatorsUpdated: 3,
+	}
+
+	_, err := keeper.InitGenesis(ctx, &stakingtypes.GenesisState{
+		Params:          params,
+		LastTotalPower:  math.ZeroInt(),
+		SymbioticAnchor: &anchor,
+	})
+	require.NoError(err)
+
+	syncPoint, err := keeper.GetSymbioticSyncPoint(ctx, 0)
+	require.NoError(err)
+	require.Equal(anchor, syncPoint)
+
+	epoch, err := keeper.SymbioticEpoch.Get(ctx)
+	require.NoError(err)
+	require.Equal(uint64(7), epoch)
+
+	// the syncs skipped since are not exported as the anchor
+	require.NoError(keeper.SymbioticSyncPoints.Set(ctx, 10, stakingtypes.SymbioticSyncPoint{
+		Height:     10,
+		SkipReason: stakingtypes.SyncSkipReasonNoAgreement,
+	}))
+
+	genesis, err := keeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Equal(&anchor, genesis.SymbioticAnchor)
+}
+
+func (s *KeeperTestSuite) TestGenesisSymbioticRoundTrip() {
+	require := s.Require()
+	ctx, keeper := s.ctx, s.stakingKeeper
+
+	params := stakingtypes.DefaultParams()
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+	require.NoError(keeper.Params.Set(ctx, params))
+	require.NoError(keeper.LastTotalPower.Set(ctx, math.ZeroInt()))
+
+	// a validator linked to its operator, the consensus pubkey of which was
+	// rotated from PKs[0] to PKs[1] in the block not applied yet
+	valAddr := sdk.ValAddress(PKs[0].Address())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[1])
+	validator.SymbioticOperator = "0x00000000000000000000000000000000000000AA"
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(keeper.SetValidatorBySymbioticOperator(ctx, validator))
+
+	rotation, err := stakingtypes.NewConsPubKeyRotation(validator.GetOperator(), PKs[0], PKs[1], 5)
+	require.NoError(err)
+	require.NoError(keeper.ConsPubKeyRotations.Set(ctx, collections.Join(int64(5), []byte(valAddr)), rotation))
+	require.NoError(keeper.ValidatorByOldConsensusAddress.Set(ctx, sdk.ConsAddress(PKs[0].Address()), valAddr))
+
+	anchor := stakingtypes.SymbioticSyncPoint{
+		Height:         4,
+		BlockHash:      "0x5c2b0a51b2dd4f3d5e1e0d3e4ebd0c1f93e4f1c5f7c6b3ee0d4a1f0b0c9cd1e0",
+		BlockNumber:    21000000,
+		BlockTimestamp: 1730000000,
+		Epoch:          7,
+	}
+	require.NoError(keeper.SymbioticSyncPoints.Set(ctx, anchor.Height, anchor))
+	require.NoError(keeper.SymbioticEpoch.Set(ctx, anchor.Epoch))
+	require.NoError(keeper.SymbioticStaleness.Set(ctx, stakingtypes.SymbioticStaleness{SinceHeight: 8, SkippedSyncs: 2}))
+	require.NoError(keeper.SymbioticNextSyncHeight.Set(ctx, 12))
+	require.NoError(keeper.SymbioticStakes.Set(ctx, valAddr, stakingtypes.SymbioticStakes{Stakes: []stakingtypes.SymbioticMiddlewareStake{{
+		MiddlewareAddress: testMiddleware.Hex(),
+		Stake:             math.NewInt(1000),
+		WeightedStake:     math.NewInt(500),
+	}}}))
+	// pending, without quorum
+	require.NoError(keeper.SymbioticCheckpoints.Set(ctx, 7, stakingtypes.SymbioticCheckpoint{
+		Epoch:            7,
+		Height:           4,
+		ValidatorSetHash: []byte{1, 2, 3},
+		Validators:       []stakingtypes.SymbioticCheckpointValidator{{Operator: validator.SymbioticOperator, Power: 10}},
+		TotalPower:       10,
+	}))
+
+	exported, err := keeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.NotNil(exported.SymbioticStaleness)
+	require.Equal(int64(12), exported.SymbioticNextSyncHeight)
+	require.Len(exported.SymbioticStakes, 1)
+	require.Len(exported.SymbioticCheckpoints, 1)
+	require.Len(exported.ConsPubKeyRotations, 1)
+	require.Len(exported.OldConsAddresses, 1)
+
+	bz, err := s.cdc.MarshalJSON(exported)
+	require.NoError(err)
+
+	// import into a fresh store
+	s.SetupTest()
+	ctx, keeper = s.ctx, s.stakingKeeper
+
+	var imported stakingtypes.GenesisState
+	require.NoError(s.cdc.UnmarshalJSON(bz, &imported))
+	_, err = keeper.InitGenesis(ctx, &imported)
+	require.NoError(err)
+
+	reexported, err := keeper.ExportGenesis(ctx)
+	require.NoError(err)
+	reexportedBz, err := s.cdc.MarshalJSON(reexported)
+	require.NoError(err)
+	require.JSONEq(string(bz), string(reexportedBz))
+
+	// the indexes are rebuilt
+	linked, err := keeper.ValidatorBySymbioticOperator.Get(ctx, common.HexToAddress(validator.SymbioticOperator).Bytes())
+	require.NoError(err)
+	require.Equal(valAddr, sdk.ValAddress(linked))
+
+	rotated, err := keeper.ValidatorByOldConsensusAddress.Get(ctx, sdk.ConsAddress(PKs[0].Address()))
+	require.NoError(err)
+	require.Equal(valAddr, rotated)
+
+	epoch, err := keeper.SymbioticEpoch.Get(ctx)
+	require.NoError(err)
+	require.Equal(uint64(7), epoch)
+}
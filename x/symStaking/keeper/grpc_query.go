@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/symStaking/types"
 
@@ -126,3 +128,159 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	}
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// InjectedSymbioticData queries the data injected by the proposer at the given sync height
+func (k Querier) InjectedSymbioticData(ctx context.Context, req *types.QueryInjectedSymbioticDataRequest) (*types.QueryInjectedSymbioticDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	data, err := k.Keeper.InjectedSymbioticData.Get(ctx, req.Height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "injected symbiotic data for height %d not found", req.Height)
+	}
+
+	return &types.QueryInjectedSymbioticDataResponse{Data: data}, nil
+}
+
+// SymbioticSyncStatus queries the last sync points with the Symbiotic middleware and the next sync height
+func (k Querier) SymbioticSyncStatus(ctx context.Context, req *types.QuerySymbioticSyncStatusRequest) (*types.QuerySymbioticSyncStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	resp := &types.QuerySymbioticSyncStatusResponse{}
+
+	iter, err := k.Keeper.SymbioticSyncPoints.Iterate(ctx, new(collections.Range[int64]).Descending())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	for ; iter.Valid() && resp.LastAppliedSyncPoint == nil; iter.Next() {
+		syncPoint, err := iter.Value()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if resp.LastSyncPoint == nil {
+			resp.LastSyncPoint = &syncPoint
+		}
+
+		if syncPoint.SkipReason == "" {
+			resp.LastAppliedSyncPoint = &syncPoint
+		}
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params.Middlewares()) > 0 && params.SymbioticEpochIdentifier == "" {
+		height := k.HeaderService.HeaderInfo(ctx).Height
+		resp.NextSyncHeight = (height/params.SymbioticSyncPeriod + 1) * params.SymbioticSyncPeriod
+	} else if len(params.Middlewares()) > 0 {
+		// the next sync is only known once scheduled by the epoch hooks
+		next, err := k.Keeper.SymbioticNextSyncHeight.Get(ctx)
+		if err == nil && next > k.HeaderService.HeaderInfo(ctx).Height {
+			resp.NextSyncHeight = next
+		} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	staleness, err := k.Keeper.SymbioticStaleness.Get(ctx)
+	if err == nil {
+		resp.Staleness = &staleness
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// SymbioticSyncHistory queries the sync points with the Symbiotic middleware
+func (k Querier) SymbioticSyncHistory(ctx context.Context, req *types.QuerySymbioticSyncHistoryRequest) (*types.QuerySymbioticSyncHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	syncPoints, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.SymbioticSyncPoints, req.Pagination, func(_ int64, syncPoint types.SymbioticSyncPoint) (types.SymbioticSyncPoint, error) {
+		return syncPoint, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySymbioticSyncHistoryResponse{SyncPoints: syncPoints, Pagination: pageRes}, nil
+}
+
+// ValidatorSymbioticStakes queries the stake of a validator in every Symbiotic middleware listing it
+func (k Querier) ValidatorSymbioticStakes(ctx context.Context, req *types.QueryValidatorSymbioticStakesRequest) (*types.QueryValidatorSymbioticStakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.GetValidator(ctx, valAddr); err != nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	stakes, err := k.GetValidatorSymbioticStakes(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorSymbioticStakesResponse{Stakes: stakes}, nil
+}
+
+// SymbioticCheckpoint queries the Symbiotic checkpoint of an epoch along with its payload
+func (k Querier) SymbioticCheckpoint(ctx context.Context, req *types.QuerySymbioticCheckpointRequest) (*types.QuerySymbioticCheckpointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	checkpoint, err := k.Keeper.SymbioticCheckpoints.Get(ctx, req.Epoch)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "symbiotic checkpoint for epoch %d not found", req.Epoch)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	payload, err := SymbioticCheckpointPayload(checkpoint)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySymbioticCheckpointResponse{Checkpoint: checkpoint, Payload: payload, Quorum: checkpoint.HasQuorum()}, nil
+}
+
+// SymbioticCheckpoints queries the Symbiotic checkpoints
+func (k Querier) SymbioticCheckpoints(ctx context.Context, req *types.QuerySymbioticCheckpointsRequest) (*types.QuerySymbioticCheckpointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	checkpoints, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.SymbioticCheckpoints, req.Pagination, func(_ uint64, checkpoint types.SymbioticCheckpoint) (types.SymbioticCheckpoint, error) {
+		return checkpoint, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySymbioticCheckpointsResponse{Checkpoints: checkpoints, Pagination: pageRes}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/x/symStaking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func (s *KeeperTestSuite) TestGRPCQueryValidator() {
@@ -122,3 +123,47 @@ func (s *KeeperTestSuite) TestGRPCQueryValidators() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQuerySymbioticSync() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	status, err := queryClient.SymbioticSyncStatus(gocontext.Background(), &types.QuerySymbioticSyncStatusRequest{})
+	require.NoError(err)
+	require.Nil(status.LastSyncPoint)
+	require.Nil(status.LastAppliedSyncPoint)
+	require.Zero(status.NextSyncHeight)
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewareAddress = "0x0000000000000000000000000000000000000001"
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	applied := types.SymbioticSyncPoint{Height: 10, BlockHash: "0x01", BlockNumber: 1, Epoch: 2, ValidatorsUpdated: 3}
+	skipped := types.SymbioticSyncPoint{Height: 20, SkipReason: types.SyncSkipReasonNoAgreement}
+	require.NoError(keeper.SymbioticSyncPoints.Set(ctx, applied.Height, applied))
+	require.NoError(keeper.SymbioticSyncPoints.Set(ctx, skipped.Height, skipped))
+
+	status, err = queryClient.SymbioticSyncStatus(gocontext.Background(), &types.QuerySymbioticSyncStatusRequest{})
+	require.NoError(err)
+	require.Equal(&skipped, status.LastSyncPoint)
+	require.Equal(&applied, status.LastAppliedSyncPoint)
+	require.Equal(params.SymbioticSyncPeriod, status.NextSyncHeight)
+	require.Nil(status.Staleness)
+
+	staleness := types.SymbioticStaleness{SinceHeight: skipped.Height, SkippedSyncs: 1}
+	require.NoError(keeper.SymbioticStaleness.Set(ctx, staleness))
+
+	status, err = queryClient.SymbioticSyncStatus(gocontext.Background(), &types.QuerySymbioticSyncStatusRequest{})
+	require.NoError(err)
+	require.Equal(&staleness, status.Staleness)
+
+	history, err := queryClient.SymbioticSyncHistory(gocontext.Background(), &types.QuerySymbioticSyncHistoryRequest{})
+	require.NoError(err)
+	require.Equal([]types.SymbioticSyncPoint{applied, skipped}, history.SyncPoints)
+
+	history, err = queryClient.SymbioticSyncHistory(gocontext.Background(), &types.QuerySymbioticSyncHistoryRequest{Pagination: &query.PageRequest{Limit: 1, Reverse: true}})
+	require.NoError(err)
+	require.Equal([]types.SymbioticSyncPoint{skipped}, history.SyncPoints)
+	require.NotNil(history.Pagination.NextKey)
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/symStaking/types"
 )
 
@@ -41,11 +43,18 @@ func (k Keeper) TrackHistoricalInfo(ctx context.Context) error {
 		return nil
 	}
 
+	// the middleware epoch of the validator set, zero until the first sync
+	epoch, err := k.SymbioticEpoch.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
 	ci := k.cometInfoService.CometInfo(ctx)
 	historicalEntry := types.HistoricalRecord{
 		Time:           &headerInfo.Time,
 		ValidatorsHash: ci.ValidatorsHash,
 		Apphash:        headerInfo.AppHash,
+		SymbioticEpoch: epoch,
 	}
 
 	// Set latest HistoricalInfo at current height
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"os"
 	"time"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -41,21 +40,24 @@ func HistoricalInfoCodec(cdc codec.BinaryCodec) collcodec.ValueCodec[types.Histo
 type Keeper struct {
 	appmodule.Environment
 
-	cdc                      codec.BinaryCodec
-	authKeeper               types.AccountKeeper
-	bankKeeper               types.BankKeeper
-	hooks                    types.StakingHooks
-	authority                string
-	validatorAddressCodec    addresscodec.Codec
-	consensusAddressCodec    addresscodec.Codec
-	cometInfoService         comet.Service
-	apiUrls                  types.ApiUrls
-	networkMiddlewareAddress string
+	cdc                   codec.BinaryCodec
+	authKeeper            types.AccountKeeper
+	bankKeeper            types.BankKeeper
+	hooks                 types.StakingHooks
+	authority             string
+	validatorAddressCodec addresscodec.Codec
+	consensusAddressCodec addresscodec.Codec
+	cometInfoService      comet.Service
+	symbioticSource       types.SymbioticSource
+	circuitKeeper         types.CircuitKeeper
+	lightClient           *symbioticLightClient
 
 	Schema collections.Schema
 
-	// CachedBlockHash value: CachedBlockHash
-	CachedBlockHash collections.Item[[]byte]
+	// InjectedSymbioticData key: Height | value: InjectedSymbioticData
+	InjectedSymbioticData collections.Map[int64, types.InjectedSymbioticData]
+	// SymbioticSyncPoints key: Height | value: SymbioticSyncPoint
+	SymbioticSyncPoints collections.Map[int64, types.SymbioticSyncPoint]
 	// HistoricalInfo key: Height | value: HistoricalInfo
 	HistoricalInfo collections.Map[uint64, types.HistoricalRecord]
 	// LastTotalPower value: LastTotalPower
@@ -63,6 +65,24 @@ type Keeper struct {
 	UnbondingID    collections.Sequence
 	// ValidatorByConsensusAddress key: consAddr | value: valAddr
 	ValidatorByConsensusAddress collections.Map[sdk.ConsAddress, sdk.ValAddress]
+	// ValidatorBySymbioticKey key: symbiotic key | value: consAddr
+	ValidatorBySymbioticKey collections.Map[[]byte, sdk.ConsAddress]
+	// ValidatorBySymbioticOperator key: symbiotic operator | value: valAddr
+	ValidatorBySymbioticOperator collections.Map[[]byte, sdk.ValAddress]
+	// ValidatorByOldConsensusAddress key: rotated consAddr | value: valAddr
+	ValidatorByOldConsensusAddress collections.Map[sdk.ConsAddress, sdk.ValAddress]
+	// ConsPubKeyRotations key: height+valAddr | value: ConsPubKeyRotation
+	ConsPubKeyRotations collections.Map[collections.Pair[int64, []byte], types.ConsPubKeyRotation]
+	// SymbioticStakes key: valAddr | value: SymbioticStakes
+	SymbioticStakes collections.Map[sdk.ValAddress, types.SymbioticStakes]
+	// SymbioticStaleness value: SymbioticStaleness
+	SymbioticStaleness collections.Item[types.SymbioticStaleness]
+	// SymbioticCheckpoints key: epoch | value: SymbioticCheckpoint
+	SymbioticCheckpoints collections.Map[uint64, types.SymbioticCheckpoint]
+	// SymbioticEpoch value: middleware epoch of the last synced validator set
+	SymbioticEpoch collections.Item[uint64]
+	// SymbioticNextSyncHeight value: height of the next sync scheduled by the epoch hooks
+	SymbioticNextSyncHeight collections.Item[int64]
 	// UnbondingType key: unbondingID | value: index of UnbondingType
 	UnbondingType collections.Map[uint64, uint64]
 	// UnbondingIndex key:UnbondingID | value: ubdKey (ubdKey = [UnbondingDelegationKey(Prefix)+len(delAddr)+delAddr+len(valAddr)+valAddr])
@@ -87,6 +107,7 @@ func NewKeeper(
 	validatorAddressCodec addresscodec.Codec,
 	consensusAddressCodec addresscodec.Codec,
 	cometInfoService comet.Service,
+	symbioticSource types.SymbioticSource,
 ) *Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
@@ -99,30 +120,80 @@ func NewKeeper(
 		panic("validator and/or consensus address codec are nil")
 	}
 
-	networkMiddlewareAddress := os.Getenv("MIDDLEWARE_ADDRESS")
-
 	k := &Keeper{
-		Environment:              env,
-		cdc:                      cdc,
-		authKeeper:               ak,
-		bankKeeper:               bk,
-		hooks:                    nil,
-		authority:                authority,
-		validatorAddressCodec:    validatorAddressCodec,
-		consensusAddressCodec:    consensusAddressCodec,
-		cometInfoService:         cometInfoService,
-		apiUrls:                  types.NewApiUrls(),
-		networkMiddlewareAddress: networkMiddlewareAddress,
-		CachedBlockHash:          collections.NewItem(sb, types.CachedBlockHashKey, "cached_block_hash", collections.BytesValue),
-		LastTotalPower:           collections.NewItem(sb, types.LastTotalPowerKey, "last_total_power", sdk.IntValue),
-		HistoricalInfo:           collections.NewMap(sb, types.HistoricalInfoKey, "historical_info", collections.Uint64Key, HistoricalInfoCodec(cdc)),
-		UnbondingID:              collections.NewSequence(sb, types.UnbondingIDKey, "unbonding_id"),
+		Environment:           env,
+		cdc:                   cdc,
+		authKeeper:            ak,
+		bankKeeper:            bk,
+		hooks:                 nil,
+		authority:             authority,
+		validatorAddressCodec: validatorAddressCodec,
+		consensusAddressCodec: consensusAddressCodec,
+		cometInfoService:      cometInfoService,
+		symbioticSource:       symbioticSource,
+		lightClient:           &symbioticLightClient{},
+		InjectedSymbioticData: collections.NewMap(sb, types.InjectedSymbioticDataKey, "injected_symbiotic_data", collections.Int64Key, codec.CollValue[types.InjectedSymbioticData](cdc)),
+		SymbioticSyncPoints:   collections.NewMap(sb, types.SymbioticSyncPointsKey, "symbiotic_sync_points", collections.Int64Key, codec.CollValue[types.SymbioticSyncPoint](cdc)),
+		LastTotalPower:        collections.NewItem(sb, types.LastTotalPowerKey, "last_total_power", sdk.IntValue),
+		HistoricalInfo:        collections.NewMap(sb, types.HistoricalInfoKey, "historical_info", collections.Uint64Key, HistoricalInfoCodec(cdc)),
+		UnbondingID:           collections.NewSequence(sb, types.UnbondingIDKey, "unbonding_id"),
 		ValidatorByConsensusAddress: collections.NewMap(
 			sb, types.ValidatorsByConsAddrKey,
 			"validator_by_cons_addr",
 			sdk.LengthPrefixedAddressKey(sdk.ConsAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collcodec.KeyToValueCodec(sdk.ValAddressKey),
 		),
+		ValidatorBySymbioticKey: collections.NewMap(
+			sb, types.ValidatorsBySymbioticKey,
+			"validator_by_symbiotic_key",
+			collections.BytesKey,
+			collcodec.KeyToValueCodec(sdk.ConsAddressKey),
+		),
+		ValidatorBySymbioticOperator: collections.NewMap(
+			sb, types.ValidatorsBySymbioticOperatorKey,
+			"validator_by_symbiotic_operator",
+			collections.BytesKey,
+			collcodec.KeyToValueCodec(sdk.ValAddressKey),
+		),
+		ValidatorByOldConsensusAddress: collections.NewMap(
+			sb, types.ValidatorsByOldConsAddrKey,
+			"validator_by_old_cons_addr",
+			sdk.ConsAddressKey,
+			collcodec.KeyToValueCodec(sdk.ValAddressKey),
+		),
+		ConsPubKeyRotations: collections.NewMap(
+			sb, types.ConsPubKeyRotationsKey,
+			"cons_pub_key_rotations",
+			collections.PairKeyCodec(collections.Int64Key, collections.BytesKey),
+			codec.CollValue[types.ConsPubKeyRotation](cdc),
+		),
+		SymbioticStakes: collections.NewMap(
+			sb, types.SymbioticStakesKey,
+			"symbiotic_stakes",
+			sdk.ValAddressKey,
+			codec.CollValue[types.SymbioticStakes](cdc),
+		),
+		SymbioticStaleness: collections.NewItem(
+			sb, types.SymbioticStalenessKey,
+			"symbiotic_staleness",
+			codec.CollValue[types.SymbioticStaleness](cdc),
+		),
+		SymbioticCheckpoints: collections.NewMap(
+			sb, types.SymbioticCheckpointsKey,
+			"symbiotic_checkpoints",
+			collections.Uint64Key,
+			codec.CollValue[types.SymbioticCheckpoint](cdc),
+		),
+		SymbioticEpoch: collections.NewItem(
+			sb, types.SymbioticEpochKey,
+			"symbiotic_epoch",
+			collections.Uint64Value,
+		),
+		SymbioticNextSyncHeight: collections.NewItem(
+			sb, types.SymbioticNextSyncHeightKey,
+			"symbiotic_next_sync_height",
+			collections.Int64Value,
+		),
 		UnbondingType:  collections.NewMap(sb, types.UnbondingTypeKey, "unbonding_type", collections.Uint64Key, collections.Uint64Value),
 		UnbondingIndex: collections.NewMap(sb, types.UnbondingIndexKey, "unbonding_index", collections.Uint64Key, collections.BytesValue),
 		Validators:     collections.NewMap(sb, types.ValidatorsKey, "validators", sdk.LengthPrefixedBytesKey, codec.CollValue[types.Validator](cdc)), // sdk.LengthPrefixedBytesKey is needed to retain state compatibility
@@ -171,6 +242,16 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetCircuitKeeper sets the circuit breaker keeper disabling the messages under
+// the halt stale policy.
+func (k *Keeper) SetCircuitKeeper(ck types.CircuitKeeper) {
+	if k.circuitKeeper != nil {
+		panic("cannot set circuit keeper twice")
+	}
+
+	k.circuitKeeper = ck
+}
+
 // GetAuthority returns the x/symStaking module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -44,6 +44,7 @@ type KeeperTestSuite struct {
 	stakingKeeper *stakingkeeper.Keeper
 	bankKeeper    *stakingtestutil.MockBankKeeper
 	accountKeeper *stakingtestutil.MockAccountKeeper
+	source        *stakingtestutil.FakeSymbioticSource
 	queryClient   stakingtypes.QueryClient
 	msgServer     stakingtypes.MsgServer
 	key           *storetypes.KVStoreKey
@@ -86,6 +87,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger(), runtime.EnvWithQueryRouterService(queryHelper.GRPCQueryRouter), runtime.EnvWithMsgRouterService(s.baseApp.MsgServiceRouter()))
 	authority, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(stakingtypes.GovModuleName))
 	s.Require().NoError(err)
+	source := stakingtestutil.NewFakeSymbioticSource()
 	keeper := stakingkeeper.NewKeeper(
 		encCfg.Codec,
 		env,
@@ -95,6 +97,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		address.NewBech32Codec("cosmosvaloper"),
 		address.NewBech32Codec("cosmosvalcons"),
 		runtime.NewContextAwareCometInfoService(),
+		source,
 	)
 	require.NoError(keeper.Params.Set(ctx, stakingtypes.DefaultParams()))
 
@@ -102,6 +105,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.stakingKeeper = keeper
 	s.bankKeeper = bankKeeper
 	s.accountKeeper = accountKeeper
+	s.source = source
 
 	stakingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	stakingtypes.RegisterQueryServer(queryHelper, stakingkeeper.Querier{Keeper: keeper})
@@ -2,6 +2,11 @@ package keeper
 
 import (
 	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/symStaking/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -40,3 +45,114 @@ func (m Migrator) Migrate4to5(ctx context.Context) error {
 func (m Migrator) Migrate5to6(ctx context.Context) error {
 	return nil
 }
+
+// Migrate6to7 migrates x/symStaking state from consensus version 6 to 7. It
+// indexes the validators by symbiotic key and sets the default symbiotic key
+// type, which matches the previous behavior.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	validators, err := m.keeper.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, validator := range validators {
+		if err := m.keeper.SetValidatorBySymbioticKey(ctx, validator); err != nil {
+			return err
+		}
+	}
+
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.SymbioticKeyType = types.DefaultSymbioticKeyType
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate7to8 migrates x/symStaking state from consensus version 7 to 8. It
+// disables the symbiotic stake to power policies, which matches the previous
+// behavior.
+func (m Migrator) Migrate7to8(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.SymbioticMaxPowerShare = math.LegacyZeroDec()
+	params.SymbioticMaxPowerChange = math.LegacyZeroDec()
+	params.SymbioticMinStake = math.ZeroInt()
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate8to9 migrates x/symStaking state from consensus version 8 to 9. It
+// keeps the stale validator set indefinitely, which matches the previous
+// behavior.
+func (m Migrator) Migrate8to9(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.SymbioticMaxStaleSyncs = 0
+	params.SymbioticStalePolicy = types.DefaultSymbioticStalePolicy
+	params.SymbioticStaleDecay = math.LegacyZeroDec()
+	params.SymbioticHaltMsgTypeUrls = nil
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate9to10 migrates x/symStaking state from consensus version 9 to 10. It
+// clears the cached block hashes, no longer used, and prunes the injected data,
+// the sync points and the consensus pubkey rotations but the ones read by the
+// later heights.
+func (m Migrator) Migrate9to10(ctx context.Context) error {
+	store := m.keeper.KVStoreService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.CachedBlockHashKey, storetypes.PrefixEndBytes(types.CachedBlockHashKey))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	iter, err := m.keeper.InjectedSymbioticData.Iterate(ctx, new(collections.Range[int64]).Descending())
+	if err != nil {
+		return err
+	}
+
+	var last *collections.KeyValue[int64, types.InjectedSymbioticData]
+	if iter.Valid() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		last = &kv
+	}
+	iter.Close()
+
+	if last != nil {
+		if err := m.keeper.pruneInjectedSymbioticData(ctx, last.Key, last.Value); err != nil {
+			return err
+		}
+	}
+
+	if err := m.keeper.pruneSymbioticSyncPoints(ctx); err != nil {
+		return err
+	}
+
+	// the rotations of the previous blocks were already applied
+	height := m.keeper.HeaderService.HeaderInfo(ctx).Height
+	return m.keeper.ConsPubKeyRotations.Clear(ctx, new(collections.Range[collections.Pair[int64, []byte]]).EndExclusive(collections.Join(height, []byte{})))
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
 
 	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
@@ -213,6 +216,10 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	if err := k.validateSymbioticStalePolicy(msg.Params); err != nil {
+		return nil, err
+	}
+
 	// get previous staking params
 	previousParams, err := k.Params.Get(ctx)
 	if err != nil {
@@ -253,6 +260,133 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// InjectSymbioticData defines the method carried by the tx injected by the
+// proposer. The injected data is verified and stored by the PreBlocker, so it
+// only checks the authority.
+func (k msgServer) InjectSymbioticData(ctx context.Context, msg *types.MsgInjectSymbioticData) (*types.MsgInjectSymbioticDataResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	return &types.MsgInjectSymbioticDataResponse{}, nil
+}
+
+// UpdateSymbioticValidatorSet defines a method for governance to set the tokens
+// of the validators once the Symbiotic validator set is stale for more than the
+// max stale syncs. The tokens of the validators left out are zeroed, and the
+// syncs resume.
+func (k msgServer) UpdateSymbioticValidatorSet(ctx context.Context, msg *types.MsgUpdateSymbioticValidatorSet) (*types.MsgUpdateSymbioticValidatorSetResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	stale, err := k.IsSymbioticStale(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !stale {
+		return nil, types.ErrSymbioticNotStale
+	}
+
+	updated := make(map[string]bool, len(msg.Validators))
+	for _, v := range msg.Validators {
+		if v.Tokens.IsNil() || v.Tokens.IsNegative() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid tokens of validator %s: %s", v.ValidatorAddress, v.Tokens)
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(v.ValidatorAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		if updated[validator.GetOperator()] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate validator %s", v.ValidatorAddress)
+		}
+		updated[validator.GetOperator()] = true
+
+		if _, err := k.SetValidatorTokens(ctx, validator, v.Tokens); err != nil {
+			return nil, err
+		}
+	}
+
+	removed, err := k.removeUnsyncedValidators(ctx, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	// the middleware stakes no longer back the tokens
+	if err := k.SymbioticStakes.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	// the next sync applies the validator set of the middleware epoch even if
+	// it didn't advance
+	if err := k.SymbioticEpoch.Remove(ctx); err != nil {
+		return nil, err
+	}
+
+	staleness, _, err := k.clearSymbioticStaleness(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUpdateSymbioticValSet,
+		event.NewAttribute(types.AttributeKeyStaleSince, strconv.FormatInt(staleness.SinceHeight, 10)),
+		event.NewAttribute(types.AttributeKeySkippedSyncs, strconv.FormatUint(uint64(staleness.SkippedSyncs), 10)),
+		event.NewAttribute(types.AttributeKeyValidatorsUpdated, strconv.Itoa(len(updated))),
+		event.NewAttribute(types.AttributeKeyValidatorsRemoved, strconv.FormatUint(uint64(removed), 10)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateSymbioticValidatorSetResponse{}, nil
+}
+
+// LinkOperator defines a method for linking the Ethereum address of a Symbiotic
+// operator to a validator, with a signature of the operator key
+func (k msgServer) LinkOperator(ctx context.Context, msg *types.MsgLinkOperator) (*types.MsgLinkOperatorResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if !common.IsHexAddress(msg.Operator) {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid symbiotic operator address: %s", msg.Operator)
+	}
+	operator := common.HexToAddress(msg.Operator)
+
+	// validator must already be registered
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := k.HeaderService.HeaderInfo(ctx).ChainID
+	if err := verifySymbioticOperatorLink(msg.ValidatorAddress, chainID, msg.Signature, operator); err != nil {
+		return nil, err
+	}
+
+	if err := k.linkSymbioticOperator(ctx, validator, operator); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeLinkSymbioticOperator,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeySymbioticOperator, operator.Hex()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLinkOperatorResponse{}, nil
+}
+
 // checkConsKeyAlreadyUsed returns an error if the consensus public key is already used,
 // in ConsAddrToValidatorIdentifierMap, OldToNewConsAddrMap, or in the current block (RotationHistory).
 func (k msgServer) checkConsKeyAlreadyUsed(ctx context.Context, newConsPubKey cryptotypes.PubKey) error {
@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// createSymbioticCheckpoint creates the checkpoint of the bonded validators if
+// the validator set was synced at this height with a new middleware epoch. It
+// must be called once the validator set updates are applied.
+func (k Keeper) createSymbioticCheckpoint(ctx context.Context) error {
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+
+	syncPoint, err := k.SymbioticSyncPoints.Get(ctx, headerInfo.Height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if syncPoint.SkipReason != "" {
+		return nil
+	}
+
+	// the validator set is checkpointed once per epoch
+	has, err := k.SymbioticCheckpoints.Has(ctx, syncPoint.Epoch)
+	if err != nil {
+		return err
+	}
+	if has {
+		return nil
+	}
+
+	validators, err := k.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return err
+	}
+
+	checkpoint := stakingtypes.SymbioticCheckpoint{
+		Epoch:      syncPoint.Epoch,
+		Height:     headerInfo.Height,
+		AppHash:    headerInfo.AppHash,
+		Validators: []stakingtypes.SymbioticCheckpointValidator{},
+		Signatures: []stakingtypes.SymbioticCheckpointSignature{},
+	}
+
+	powerReduction := k.PowerReduction(ctx)
+	for _, val := range validators {
+		// only the validators with an operator can sign on Ethereum
+		if val.SymbioticOperator == "" {
+			continue
+		}
+
+		power := val.ConsensusPower(powerReduction)
+		checkpoint.Validators = append(checkpoint.Validators, stakingtypes.SymbioticCheckpointValidator{
+			Operator: common.HexToAddress(val.SymbioticOperator).Hex(),
+			Power:    power,
+		})
+		checkpoint.TotalPower += power
+	}
+
+	checkpoint.ValidatorSetHash, err = SymbioticCheckpointValidatorSetHash(checkpoint.Validators)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SymbioticCheckpoints.Set(ctx, checkpoint.Epoch, checkpoint); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		stakingtypes.EventTypeSymbioticCheckpoint,
+		event.NewAttribute(stakingtypes.AttributeKeyEpoch, strconv.FormatUint(checkpoint.Epoch, 10)),
+		event.NewAttribute(stakingtypes.AttributeKeyValidatorSetHash, common.Bytes2Hex(checkpoint.ValidatorSetHash)),
+		event.NewAttribute(stakingtypes.AttributeKeyTotalPower, strconv.FormatInt(checkpoint.TotalPower, 10)),
+	)
+}
+
+// GetLastSymbioticCheckpoint returns the checkpoint of the last epoch, if any.
+func (k Keeper) GetLastSymbioticCheckpoint(ctx context.Context) (stakingtypes.SymbioticCheckpoint, bool, error) {
+	iter, err := k.SymbioticCheckpoints.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return stakingtypes.SymbioticCheckpoint{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return stakingtypes.SymbioticCheckpoint{}, false, nil
+	}
+
+	checkpoint, err := iter.Value()
+	if err != nil {
+		return checkpoint, false, err
+	}
+
+	return checkpoint, true, nil
+}
+
+// AddSymbioticCheckpointSignature adds the signature of the checkpoint of an
+// epoch by the operator of the validator with the consensus address consAddr.
+// It returns ErrInvalidCheckpointSig if the validator can't sign the
+// checkpoint or the signature isn't by its operator. A validator signing again
+// is ignored. Once the checkpoint has a quorum, the checkpoints of the previous
+// epochs are removed.
+func (k Keeper) AddSymbioticCheckpointSignature(ctx context.Context, consAddr sdk.ConsAddress, epoch uint64, signature []byte) error {
+	checkpoint, err := k.SymbioticCheckpoints.Get(ctx, epoch)
+	if errors.Is(err, collections.ErrNotFound) {
+		return stakingtypes.ErrInvalidCheckpointSig.Wrapf("no checkpoint at epoch %d", epoch)
+	}
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.GetValidatorByConsAddr(ctx, consAddr)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return stakingtypes.ErrInvalidCheckpointSig.Wrapf("validator %s not found", consAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	if validator.SymbioticOperator == "" {
+		return stakingtypes.ErrInvalidCheckpointSig.Wrapf("validator %s has no symbiotic operator", validator.GetOperator())
+	}
+
+	operator := common.HexToAddress(validator.SymbioticOperator)
+	power, found := int64(0), false
+	for _, v := range checkpoint.Validators {
+		if common.HexToAddress(v.Operator) == operator {
+			power, found = v.Power, true
+			break
+		}
+	}
+	if !found {
+		return stakingtypes.ErrInvalidCheckpointSig.Wrapf("operator %s not in checkpoint at epoch %d", operator, epoch)
+	}
+
+	if checkpoint.HasSigned(operator.Hex()) {
+		return nil
+	}
+
+	digest, err := SymbioticCheckpointDigest(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	if err := verifySymbioticCheckpointSignature(digest, signature, operator); err != nil {
+		return err
+	}
+
+	hadQuorum := checkpoint.HasQuorum()
+	checkpoint.Signatures = append(checkpoint.Signatures, stakingtypes.SymbioticCheckpointSignature{
+		ValidatorAddress: validator.GetOperator(),
+		Operator:         operator.Hex(),
+		Signature:        signature,
+	})
+	checkpoint.SignedPower += power
+
+	if err := k.SymbioticCheckpoints.Set(ctx, epoch, checkpoint); err != nil {
+		return err
+	}
+
+	if hadQuorum || !checkpoint.HasQuorum() {
+		return nil
+	}
+
+	// the older checkpoints are superseded by the last one with a quorum
+	if err := k.SymbioticCheckpoints.Clear(ctx, new(collections.Range[uint64]).EndExclusive(epoch)); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		stakingtypes.EventTypeSymbioticCheckpointSigned,
+		event.NewAttribute(stakingtypes.AttributeKeyEpoch, strconv.FormatUint(checkpoint.Epoch, 10)),
+		event.NewAttribute(stakingtypes.AttributeKeySignedPower, strconv.FormatInt(checkpoint.SignedPower, 10)),
+		event.NewAttribute(stakingtypes.AttributeKeyTotalPower, strconv.FormatInt(checkpoint.TotalPower, 10)),
+	)
+}
+
+// verifySymbioticCheckpointSignature checks that signature is a 65 bytes
+// [R || S || V] signature of digest by operator.
+func verifySymbioticCheckpointSignature(digest, signature []byte, operator common.Address) error {
+	if len(signature) != crypto.SignatureLength {
+		return stakingtypes.ErrInvalidCheckpointSig.Wrapf("invalid signature length: %d", len(signature))
+	}
+
+	pubKey, err := crypto.SigToPub(digest, signature)
+	if err != nil {
+		return stakingtypes.ErrInvalidCheckpointSig.Wrap(err.Error())
+	}
+
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != operator {
+		return stakingtypes.ErrInvalidCheckpointSig.Wrapf("expected signer %s, got %s", operator, signer)
+	}
+
+	return nil
+}
+
+// symbioticCheckpointValidatorsArguments returns the encoding of the validators
+// of a checkpoint hashed into the validator set hash.
+func symbioticCheckpointValidatorsArguments() (abi.Arguments, error) {
+	addressesType, err := abi.NewType("address[]", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	powersType, err := abi.NewType("uint256[]", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Arguments{{Name: "operators", Type: addressesType}, {Name: "powers", Type: powersType}}, nil
+}
+
+// symbioticCheckpointArguments returns the encoding of a checkpoint submitted to
+// the middleware.
+func symbioticCheckpointArguments() (abi.Arguments, error) {
+	epochType, err := abi.NewType("uint48", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hashType, err := abi.NewType("bytes32", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Arguments{
+		{Name: "epoch", Type: epochType},
+		{Name: "validatorSetHash", Type: hashType},
+		{Name: "appHash", Type: hashType},
+	}, nil
+}
+
+// SymbioticCheckpointValidatorSetHash returns the keccak256 hash of
+// abi.encode(address[] operators, uint256[] powers) of the validators.
+func SymbioticCheckpointValidatorSetHash(validators []stakingtypes.SymbioticCheckpointValidator) ([]byte, error) {
+	arguments, err := symbioticCheckpointValidatorsArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	operators := make([]common.Address, len(validators))
+	powers := make([]*big.Int, len(validators))
+	for i, v := range validators {
+		if !common.IsHexAddress(v.Operator) {
+			return nil, fmt.Errorf("invalid checkpoint operator %q", v.Operator)
+		}
+		operators[i] = common.HexToAddress(v.Operator)
+		powers[i] = big.NewInt(v.Power)
+	}
+
+	bz, err := arguments.Pack(operators, powers)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Keccak256(bz), nil
+}
+
+// SymbioticCheckpointPayload returns the payload of a checkpoint submitted to
+// the middleware, abi.encode(uint48 epoch, bytes32 validatorSetHash, bytes32 appHash).
+func SymbioticCheckpointPayload(checkpoint stakingtypes.SymbioticCheckpoint) ([]byte, error) {
+	arguments, err := symbioticCheckpointArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	return arguments.Pack(
+		new(big.Int).SetUint64(checkpoint.Epoch),
+		common.BytesToHash(checkpoint.ValidatorSetHash),
+		common.BytesToHash(checkpoint.AppHash),
+	)
+}
+
+// SymbioticCheckpointDigest returns the keccak256 digest of the payload of a
+// checkpoint, signed by the operators.
+func SymbioticCheckpointDigest(checkpoint stakingtypes.SymbioticCheckpoint) ([]byte, error) {
+	payload, err := SymbioticCheckpointPayload(checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Keccak256(payload), nil
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/core/header"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	"cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestSymbioticCheckpoint() {
+	require := s.Require()
+	height := stakingtypes.DefaultSymbioticSyncPeriod + 1
+	appHash := []byte("app hash")
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: height, Time: s.ctx.HeaderInfo().Time, AppHash: appHash})
+
+	params, err := s.stakingKeeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+	require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+
+	// the last validator has no operator and can't sign
+	var keys []*ecdsa.PrivateKey
+	var consAddrs []sdk.ConsAddress
+	for i := 0; i < 3; i++ {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address()), PKs[i])
+		if i < 2 {
+			key, err := crypto.GenerateKey()
+			require.NoError(err)
+			keys = append(keys, key)
+			validator.SymbioticOperator = crypto.PubkeyToAddress(key.PublicKey).Hex()
+		}
+		require.NoError(s.stakingKeeper.SetValidator(ctx, validator))
+		require.NoError(s.stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+		_, err = s.stakingKeeper.SetValidatorTokens(ctx, validator, s.stakingKeeper.TokensFromConsensusPower(ctx, int64(i+1)*10))
+		require.NoError(err)
+		consAddrs = append(consAddrs, sdk.ConsAddress(PKs[i].Address()))
+	}
+
+	// no sync at this height
+	_, err = s.stakingKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(err)
+	_, found, err := s.stakingKeeper.GetLastSymbioticCheckpoint(ctx)
+	require.NoError(err)
+	require.False(found)
+
+	require.NoError(s.stakingKeeper.SymbioticSyncPoints.Set(ctx, height, stakingtypes.SymbioticSyncPoint{Height: height, BlockHash: "0x01", Epoch: 3}))
+	_, err = s.stakingKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(err)
+
+	checkpoint, found, err := s.stakingKeeper.GetLastSymbioticCheckpoint(ctx)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(uint64(3), checkpoint.Epoch)
+	require.Equal(height, checkpoint.Height)
+	require.Equal(appHash, checkpoint.AppHash)
+	require.Equal([]stakingtypes.SymbioticCheckpointValidator{
+		{Operator: crypto.PubkeyToAddress(keys[1].PublicKey).Hex(), Power: 20},
+		{Operator: crypto.PubkeyToAddress(keys[0].PublicKey).Hex(), Power: 10},
+	}, checkpoint.Validators)
+	require.Equal(int64(30), checkpoint.TotalPower)
+
+	hash, err := stakingkeeper.SymbioticCheckpointValidatorSetHash(checkpoint.Validators)
+	require.NoError(err)
+	require.Equal(hash, checkpoint.ValidatorSetHash)
+
+	digest, err := stakingkeeper.SymbioticCheckpointDigest(checkpoint)
+	require.NoError(err)
+	sign := func(key *ecdsa.PrivateKey) []byte {
+		sig, err := crypto.Sign(digest, key)
+		require.NoError(err)
+		return sig
+	}
+
+	// signatures that aren't by the operator of a validator of the checkpoint
+	for _, tc := range []struct {
+		consAddr sdk.ConsAddress
+		epoch    uint64
+		sig      []byte
+	}{
+		{consAddrs[0], 2, sign(keys[0])},
+		{consAddrs[0], 3, sign(keys[1])},
+		{consAddrs[0], 3, []byte{1}},
+		{consAddrs[2], 3, sign(keys[0])},
+		{sdk.ConsAddress(PKs[3].Address()), 3, sign(keys[0])},
+	} {
+		err := s.stakingKeeper.AddSymbioticCheckpointSignature(ctx, tc.consAddr, tc.epoch, tc.sig)
+		require.ErrorIs(err, stakingtypes.ErrInvalidCheckpointSig)
+	}
+
+	// a checkpoint of a previous epoch that never reached a quorum
+	require.NoError(s.stakingKeeper.SymbioticCheckpoints.Set(ctx, 2, stakingtypes.SymbioticCheckpoint{Epoch: 2}))
+
+	require.NoError(s.stakingKeeper.AddSymbioticCheckpointSignature(ctx, consAddrs[0], 3, sign(keys[0])))
+	checkpoint, err = s.stakingKeeper.SymbioticCheckpoints.Get(ctx, 3)
+	require.NoError(err)
+	require.Equal(int64(10), checkpoint.SignedPower)
+	require.False(checkpoint.HasQuorum())
+
+	// signing again is ignored
+	require.NoError(s.stakingKeeper.AddSymbioticCheckpointSignature(ctx, consAddrs[0], 3, sign(keys[0])))
+	has, err := s.stakingKeeper.SymbioticCheckpoints.Has(ctx, 2)
+	require.NoError(err)
+	require.True(has)
+	require.NoError(s.stakingKeeper.AddSymbioticCheckpointSignature(ctx, consAddrs[1], 3, sign(keys[1])))
+
+	// the previous checkpoints are removed once one has a quorum
+	has, err = s.stakingKeeper.SymbioticCheckpoints.Has(ctx, 2)
+	require.NoError(err)
+	require.False(has)
+
+	// the checkpoint isn't created again at a later sync of the same epoch
+	_, err = s.stakingKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(err)
+
+	resp, err := s.queryClient.SymbioticCheckpoint(ctx, &stakingtypes.QuerySymbioticCheckpointRequest{Epoch: 3})
+	require.NoError(err)
+	require.True(resp.Quorum)
+	require.Equal(int64(30), resp.Checkpoint.SignedPower)
+	require.Len(resp.Checkpoint.Signatures, 2)
+	require.Equal(digest, crypto.Keccak256(resp.Payload))
+	for _, sig := range resp.Checkpoint.Signatures {
+		pubKey, err := crypto.SigToPub(digest, sig.Signature)
+		require.NoError(err)
+		require.Equal(common.HexToAddress(sig.Operator), crypto.PubkeyToAddress(*pubKey))
+	}
+
+	var signedEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == stakingtypes.EventTypeSymbioticCheckpointSigned {
+			signedEvents++
+		}
+	}
+	require.Equal(1, signedEvents)
+
+	_, err = s.queryClient.SymbioticCheckpoint(ctx, &stakingtypes.QuerySymbioticCheckpointRequest{Epoch: 4})
+	require.Error(err)
+
+	checkpoints, err := s.queryClient.SymbioticCheckpoints(ctx, &stakingtypes.QuerySymbioticCheckpointsRequest{})
+	require.NoError(err)
+	require.Len(checkpoints.Checkpoints, 1)
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+)
+
+// symbioticSyncDelay is the minimum number of blocks a sync is scheduled
+// ahead. The vote extensions of a sync height are extended at the previous
+// height on the state committed before it, so a sync scheduled at a height is
+// only known to them two blocks later.
+const symbioticSyncDelay int64 = 2
+
+// EpochHooks are the x/epochs hooks syncing the validator set at the start of
+// the epochs of the symbiotic epoch identifier.
+type EpochHooks struct {
+	k *Keeper
+}
+
+// EpochHooks returns the x/epochs hooks of the staking keeper.
+func (k *Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// GetModuleName implements the x/epochs EpochHooks interface.
+func (EpochHooks) GetModuleName() string {
+	return stakingtypes.ModuleName
+}
+
+// AfterEpochEnd implements the x/epochs EpochHooks interface.
+func (EpochHooks) AfterEpochEnd(context.Context, string, int64) error {
+	return nil
+}
+
+// BeforeEpochStart schedules a sync of the validator set at the start of an
+// epoch of the symbiotic epoch identifier, unless a sync is already pending
+// by then. A sync backed off past it is brought forward.
+func (h EpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if epochIdentifier != params.SymbioticEpochIdentifier || len(params.Middlewares()) == 0 {
+		return nil
+	}
+
+	height := h.k.HeaderService.HeaderInfo(ctx).Height
+	next, err := h.k.SymbioticNextSyncHeight.Get(ctx)
+	if err == nil && next >= height && next <= height+symbioticSyncDelay {
+		return nil
+	}
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	next = height + symbioticSyncDelay
+	if err := h.k.SymbioticNextSyncHeight.Set(ctx, next); err != nil {
+		return err
+	}
+
+	return h.k.EventService.EventManager(ctx).EmitKV(
+		stakingtypes.EventTypeSymbioticSyncScheduled,
+		event.NewAttribute(stakingtypes.AttributeKeyEpochIdentifier, epochIdentifier),
+		event.NewAttribute(stakingtypes.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+		event.NewAttribute(stakingtypes.AttributeKeySyncHeight, strconv.FormatInt(next, 10)),
+	)
+}
+
+// retrySymbioticSync schedules a sync a sync period after a skipped sync
+// height, under the symbiotic epoch identifier.
+func (k *Keeper) retrySymbioticSync(ctx context.Context, height int64, params stakingtypes.Params) error {
+	if params.SymbioticEpochIdentifier == "" {
+		return nil
+	}
+
+	return k.SymbioticNextSyncHeight.Set(ctx, height+max(params.SymbioticSyncPeriod, symbioticSyncDelay))
+}
+
+// IsSymbioticEpochAdvanced returns true if the validator set of the given
+// middleware epoch would be applied at a sync, so that it needs to be fetched.
+func (k *Keeper) IsSymbioticEpochAdvanced(ctx context.Context, epoch uint64) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return k.isSymbioticEpochAdvanced(ctx, epoch, params)
+}
+
+// isSymbioticEpochAdvanced returns true if the validator set of the given
+// middleware epoch must be applied. Under the symbiotic epoch identifier, the
+// validator set of an epoch doesn't change, so it is only applied once its
+// epoch is past the one of the last synced validator set, or if the validator
+// set is stale. Otherwise it is applied at every sync.
+func (k *Keeper) isSymbioticEpochAdvanced(ctx context.Context, epoch uint64, params stakingtypes.Params) (bool, error) {
+	if params.SymbioticEpochIdentifier == "" {
+		return true, nil
+	}
+
+	stale, err := k.SymbioticStaleness.Has(ctx)
+	if err != nil || stale {
+		return stale, err
+	}
+
+	last, err := k.SymbioticEpoch.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return epoch > last, nil
+}
+
+// backOffSymbioticEpoch schedules the retry of a sync height whose middleware
+// epoch didn't advance, under the symbiotic epoch identifier. Each retry of the
+// same epoch doubles the delay since the previous attempt, so that an epoch is
+// only polled a few times until the next one starts, whose sync is scheduled by
+// BeforeEpochStart.
+func (k *Keeper) backOffSymbioticEpoch(ctx context.Context, height int64, epoch uint64, params stakingtypes.Params) error {
+	if params.SymbioticEpochIdentifier == "" {
+		return nil
+	}
+
+	delay := max(params.SymbioticSyncPeriod, symbioticSyncDelay)
+
+	iter, err := k.SymbioticSyncPoints.Iterate(ctx, new(collections.Range[int64]).Descending())
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	if iter.Valid() {
+		previous, err := iter.Value()
+		if err != nil {
+			return err
+		}
+
+		if previous.SkipReason == stakingtypes.SyncSkipReasonEpochNotAdvanced && previous.Epoch == epoch && previous.Height < height {
+			delay = max(delay, 2*(height-previous.Height))
+		}
+	}
+
+	return k.SymbioticNextSyncHeight.Set(ctx, height+delay)
+}
+
+// skipSymbioticEpoch records a sync height whose middleware epoch didn't
+// advance, keeping the validator set of the epoch. As the Ethereum block the
+// sync is agreed on may lag behind the start of the epoch, the sync is retried
+// under the symbiotic epoch identifier, backing off until the epoch advances.
+func (k *Keeper) skipSymbioticEpoch(ctx context.Context, height int64, data stakingtypes.InjectedSymbioticData, params stakingtypes.Params) error {
+	if err := k.backOffSymbioticEpoch(ctx, height, data.Epoch, params); err != nil {
+		return err
+	}
+
+	return k.SetSymbioticSyncPoint(ctx, stakingtypes.SymbioticSyncPoint{
+		Height:         height,
+		BlockHash:      data.BlockHash,
+		BlockNumber:    data.BlockNumber,
+		BlockTimestamp: data.BlockTimestamp,
+		Epoch:          data.Epoch,
+		SkipReason:     stakingtypes.SyncSkipReasonEpochNotAdvanced,
+	})
+}
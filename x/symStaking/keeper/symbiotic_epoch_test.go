@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestSymbioticEpochHooks() {
+	require := s.Require()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 5, Time: s.ctx.HeaderInfo().Time})
+	hooks := s.stakingKeeper.EpochHooks()
+
+	params, err := s.stakingKeeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SymbioticEpochIdentifier = "hour"
+	require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+
+	// no middleware to sync with
+	require.NoError(hooks.BeforeEpochStart(ctx, "hour", 1))
+	_, err = s.stakingKeeper.SymbioticNextSyncHeight.Get(ctx)
+	require.Error(err)
+
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+	require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+
+	// the sync period doesn't apply under the epoch identifier
+	ok, err := s.stakingKeeper.IsSymbioticSyncHeight(ctx, stakingtypes.DefaultSymbioticSyncPeriod)
+	require.NoError(err)
+	require.False(ok)
+
+	require.NoError(hooks.BeforeEpochStart(ctx, "day", 1))
+	_, err = s.stakingKeeper.SymbioticNextSyncHeight.Get(ctx)
+	require.Error(err)
+
+	require.NoError(hooks.BeforeEpochStart(ctx, "hour", 2))
+	next, err := s.stakingKeeper.SymbioticNextSyncHeight.Get(ctx)
+	require.NoError(err)
+	require.Equal(int64(7), next)
+
+	ok, err = s.stakingKeeper.IsSymbioticSyncHeight(ctx, 7)
+	require.NoError(err)
+	require.True(ok)
+
+	ok, err = s.stakingKeeper.IsSymbioticSyncHeight(ctx, stakingtypes.DefaultSymbioticSyncPeriod)
+	require.NoError(err)
+	require.False(ok)
+
+	status, err := s.queryClient.SymbioticSyncStatus(ctx, &stakingtypes.QuerySymbioticSyncStatusRequest{})
+	require.NoError(err)
+	require.Equal(int64(7), status.NextSyncHeight)
+
+	// a pending sync isn't postponed
+	require.NoError(hooks.BeforeEpochStart(ctx.WithHeaderInfo(header.Info{Height: 7}), "hour", 3))
+	next, err = s.stakingKeeper.SymbioticNextSyncHeight.Get(ctx)
+	require.NoError(err)
+	require.Equal(int64(7), next)
+
+	require.NoError(hooks.BeforeEpochStart(ctx.WithHeaderInfo(header.Info{Height: 8}), "hour", 3))
+	next, err = s.stakingKeeper.SymbioticNextSyncHeight.Get(ctx)
+	require.NoError(err)
+	require.Equal(int64(10), next)
+
+	var scheduled int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == stakingtypes.EventTypeSymbioticSyncScheduled {
+			scheduled++
+		}
+	}
+	require.Equal(2, scheduled)
+}
+
+func (s *KeeperTestSuite) TestSymbioticUpdateValidatorsPowerEpochAdvance() {
+	require := s.Require()
+	height := int64(7)
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: height, Time: s.ctx.HeaderInfo().Time})
+
+	params, err := s.stakingKeeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+	params.SymbioticEpochIdentifier = "hour"
+	require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+	require.NoError(s.stakingKeeper.SymbioticNextSyncHeight.Set(ctx, height))
+
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address()), PKs[0])
+	require.NoError(s.stakingKeeper.SetValidator(ctx, validator))
+	require.NoError(s.stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+
+	var consAddr [32]byte
+	copy(consAddr[:], PKs[0].Address())
+	inject := func(height int64, epoch uint64, power int64) {
+		require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, height, stakingtypes.InjectedSymbioticData{
+			BlockHash: "0x01",
+			Epoch:     epoch,
+			ValidatorSet: s.packValidatorSet([]stakingtypes.SymbioticValidator{
+				{Stake: s.stakingKeeper.TokensFromConsensusPower(ctx, power).BigInt(), ConsAddr: consAddr},
+			}),
+		}))
+	}
+	tokens := func() int64 {
+		validator, err := s.stakingKeeper.GetValidator(ctx, sdk.ValAddress(PKs[0].Address()))
+		require.NoError(err)
+		return s.stakingKeeper.TokensToConsensusPower(ctx, validator.Tokens)
+	}
+
+	inject(height, 3, 10)
+	require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+	require.Equal(int64(10), tokens())
+
+	epoch, err := s.stakingKeeper.SymbioticEpoch.Get(ctx)
+	require.NoError(err)
+	require.Equal(uint64(3), epoch)
+
+	require.NoError(s.stakingKeeper.TrackHistoricalInfo(ctx))
+	record, err := s.stakingKeeper.HistoricalInfo.Get(ctx, uint64(height))
+	require.NoError(err)
+	require.Equal(uint64(3), record.SymbioticEpoch)
+
+	// the middleware epoch didn't advance yet, the sync is retried
+	inject(height, 3, 20)
+	require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+	require.Equal(int64(10), tokens())
+
+	syncPoint, err := s.stakingKeeper.SymbioticSyncPoints.Get(ctx, height)
+	require.NoError(err)
+	require.Equal(stakingtypes.SyncSkipReasonEpochNotAdvanced, syncPoint.SkipReason)
+
+	next, err := s.stakingKeeper.SymbioticNextSyncHeight.Get(ctx)
+	require.NoError(err)
+	require.Equal(height+stakingtypes.DefaultSymbioticSyncPeriod, next)
+
+	staleness, err := s.stakingKeeper.SymbioticStaleness.Has(ctx)
+	require.NoError(err)
+	require.False(staleness)
+
+	// the retries of the same epoch back off
+	period := stakingtypes.DefaultSymbioticSyncPeriod
+	for _, delay := range []int64{2 * period, 4 * period} {
+		height = next
+		ctx = ctx.WithHeaderInfo(header.Info{Height: height, Time: ctx.HeaderInfo().Time})
+		inject(height, 3, 20)
+		require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+		require.Equal(int64(10), tokens())
+
+		next, err = s.stakingKeeper.SymbioticNextSyncHeight.Get(ctx)
+		require.NoError(err)
+		require.Equal(height+delay, next)
+	}
+
+	// the start of the next epoch brings the backed off sync forward
+	require.NoError(s.stakingKeeper.EpochHooks().BeforeEpochStart(ctx.WithHeaderInfo(header.Info{Height: height + 1}), "hour", 4))
+	next, err = s.stakingKeeper.SymbioticNextSyncHeight.Get(ctx)
+	require.NoError(err)
+	require.Equal(height+3, next)
+
+	height = next
+	ctx = ctx.WithHeaderInfo(header.Info{Height: height, Time: ctx.HeaderInfo().Time})
+	inject(height, 4, 20)
+	require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+	require.Equal(int64(20), tokens())
+
+	syncPoint, err = s.stakingKeeper.SymbioticSyncPoints.Get(ctx, height)
+	require.NoError(err)
+	require.Empty(syncPoint.SkipReason)
+	require.Equal(uint64(4), syncPoint.Epoch)
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+
+	"cosmossdk.io/x/symStaking/types"
+)
+
+// symbioticLightClient runs the beacon light client of the Symbiotic source in
+// the background, between StartLightClient and StopLightClient.
+type symbioticLightClient struct {
+	mu     sync.Mutex
+	client types.SymbioticLightClient
+	cancel context.CancelFunc
+}
+
+// SetLightClient sets the beacon light client the Symbiotic source reads the
+// finalized blocks from, run by StartLightClient.
+func (k *Keeper) SetLightClient(client types.SymbioticLightClient) {
+	k.lightClient.mu.Lock()
+	defer k.lightClient.mu.Unlock()
+
+	if k.lightClient.client != nil {
+		panic("cannot set light client twice")
+	}
+
+	k.lightClient.client = client
+}
+
+// StartLightClient runs the light client, if any, until ctx is done or
+// StopLightClient is called. It must be started along with the node, as the
+// light client must follow every finality update to know the finalized blocks
+// the validator set is synced with. Starting it again is a no-op.
+func (k Keeper) StartLightClient(ctx context.Context) {
+	k.lightClient.mu.Lock()
+	defer k.lightClient.mu.Unlock()
+
+	if k.lightClient.client == nil || k.lightClient.cancel != nil {
+		return
+	}
+
+	ctx, k.lightClient.cancel = context.WithCancel(ctx)
+	go k.lightClient.client.Run(ctx)
+}
+
+// StopLightClient stops the light client started by StartLightClient.
+func (k Keeper) StopLightClient() {
+	k.lightClient.mu.Lock()
+	defer k.lightClient.mu.Unlock()
+
+	if k.lightClient.cancel != nil {
+		k.lightClient.cancel()
+		k.lightClient.cancel = nil
+	}
+}
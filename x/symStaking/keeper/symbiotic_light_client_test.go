@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/symStaking/lightclient"
+)
+
+// bootstrapAPI reports the context of every bootstrap request of the light
+// client, failing them so that it waits for the next sync.
+type bootstrapAPI struct {
+	requests chan context.Context
+}
+
+func (a bootstrapAPI) LightClientBootstrap(ctx context.Context, _ common.Hash) (lightclient.Bootstrap, error) {
+	a.requests <- ctx
+	return lightclient.Bootstrap{}, errors.New("unavailable")
+}
+
+func (bootstrapAPI) LightClientUpdates(context.Context, uint64, uint64) ([]lightclient.Update, error) {
+	return nil, errors.New("unavailable")
+}
+
+func (bootstrapAPI) LightClientFinalityUpdate(context.Context) (lightclient.Update, error) {
+	return lightclient.Update{}, errors.New("unavailable")
+}
+
+func (s *KeeperTestSuite) TestLightClientLifecycle() {
+	require := s.Require()
+	keeper := s.stakingKeeper
+
+	// no light client to run
+	keeper.StartLightClient(context.Background())
+	keeper.StopLightClient()
+
+	config, err := lightclient.GetNetworkConfig("mainnet")
+	require.NoError(err)
+	api := bootstrapAPI{requests: make(chan context.Context, 1)}
+	keeper.SetLightClient(lightclient.NewLightClient(log.NewNopLogger(), api, config, common.Hash{1}))
+	require.Panics(func() {
+		keeper.SetLightClient(lightclient.NewLightClient(log.NewNopLogger(), api, config, common.Hash{1}))
+	})
+
+	keeper.StartLightClient(context.Background())
+	// started once
+	keeper.StartLightClient(context.Background())
+
+	var ctx context.Context
+	select {
+	case ctx = <-api.requests:
+	case <-time.After(5 * time.Second):
+		s.FailNow("light client not started")
+	}
+	require.NoError(ctx.Err())
+
+	keeper.StopLightClient()
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		s.FailNow("light client not stopped")
+	}
+}
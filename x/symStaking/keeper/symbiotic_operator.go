@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/collections"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+)
+
+// verifySymbioticOperatorLink checks that signature is a 65 bytes
+// [R || S || V] EIP-191 signature by operator of the link message of the
+// validator address on the chain. V may be 0/1 or 27/28, as signed by wallets.
+func verifySymbioticOperatorLink(validatorAddress, chainID string, signature []byte, operator common.Address) error {
+	if len(signature) != crypto.SignatureLength {
+		return stakingtypes.ErrInvalidOperatorLink.Wrapf("invalid signature length: %d", len(signature))
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	digest := accounts.TextHash([]byte(stakingtypes.SymbioticOperatorLinkMessage(validatorAddress, chainID)))
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return stakingtypes.ErrInvalidOperatorLink.Wrap(err.Error())
+	}
+
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != operator {
+		return stakingtypes.ErrInvalidOperatorLink.Wrapf("expected signer %s, got %s", operator, signer)
+	}
+
+	return nil
+}
+
+// linkSymbioticOperator binds a validator to an operator. A validator already
+// bound to another operator, or an operator already bound to another validator,
+// can't be linked, as the syncs attribute the keys of the operator to it.
+func (k *Keeper) linkSymbioticOperator(ctx context.Context, validator stakingtypes.Validator, operator common.Address) error {
+	if validator.SymbioticOperator != "" {
+		if bound := common.HexToAddress(validator.SymbioticOperator); bound != operator {
+			return stakingtypes.ErrInvalidOperatorLink.Wrapf("validator %s is linked to operator %s", validator.GetOperator(), bound)
+		}
+		return nil
+	}
+
+	linked, err := k.ValidatorBySymbioticOperator.Get(ctx, operator.Bytes())
+	if err == nil {
+		linkedAddr, err := k.validatorAddressCodec.BytesToString(linked)
+		if err != nil {
+			return err
+		}
+		return stakingtypes.ErrInvalidOperatorLink.Wrapf("operator %s is linked to validator %s", operator, linkedAddr)
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	validator.SymbioticOperator = operator.Hex()
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	return k.SetValidatorBySymbioticOperator(ctx, validator)
+}
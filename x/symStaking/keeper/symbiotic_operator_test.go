@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestMsgLinkOperator() {
+	require := s.Require()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: s.ctx.HeaderInfo().Time, ChainID: "symapp-1"})
+	keeper, msgServer := s.stakingKeeper, s.msgServer
+
+	var valAddrs []string
+	for _, pk := range PKs[:2] {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(pk.Address()), pk)
+		require.NoError(keeper.SetValidator(ctx, validator))
+		valAddrs = append(valAddrs, validator.GetOperator())
+	}
+
+	operatorKey, err := crypto.GenerateKey()
+	require.NoError(err)
+	operator := crypto.PubkeyToAddress(operatorKey.PublicKey)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(err)
+
+	// signed as by wallets, with a 27/28 recovery id
+	sign := func(key *ecdsa.PrivateKey, valAddr, chainID string) []byte {
+		sig, err := crypto.Sign(accounts.TextHash([]byte(stakingtypes.SymbioticOperatorLinkMessage(valAddr, chainID))), key)
+		require.NoError(err)
+		sig[crypto.RecoveryIDOffset] += 27
+		return sig
+	}
+
+	testCases := []struct {
+		name      string
+		input     *stakingtypes.MsgLinkOperator
+		expErrMsg string
+	}{
+		{
+			name:      "invalid operator",
+			input:     &stakingtypes.MsgLinkOperator{ValidatorAddress: valAddrs[0], Operator: "0x1234", Signature: sign(operatorKey, valAddrs[0], "symapp-1")},
+			expErrMsg: "invalid symbiotic operator address",
+		},
+		{
+			name:      "invalid signature length",
+			input:     &stakingtypes.MsgLinkOperator{ValidatorAddress: valAddrs[0], Operator: operator.Hex(), Signature: []byte{1, 2, 3}},
+			expErrMsg: "invalid signature length",
+		},
+		{
+			name:      "signed by another key",
+			input:     &stakingtypes.MsgLinkOperator{ValidatorAddress: valAddrs[0], Operator: operator.Hex(), Signature: sign(otherKey, valAddrs[0], "symapp-1")},
+			expErrMsg: "expected signer",
+		},
+		{
+			name:      "signed for another validator",
+			input:     &stakingtypes.MsgLinkOperator{ValidatorAddress: valAddrs[0], Operator: operator.Hex(), Signature: sign(operatorKey, valAddrs[1], "symapp-1")},
+			expErrMsg: "expected signer",
+		},
+		{
+			name:      "signed for another chain",
+			input:     &stakingtypes.MsgLinkOperator{ValidatorAddress: valAddrs[0], Operator: operator.Hex(), Signature: sign(operatorKey, valAddrs[0], "symapp-2")},
+			expErrMsg: "expected signer",
+		},
+		{
+			name:  "valid link",
+			input: &stakingtypes.MsgLinkOperator{ValidatorAddress: valAddrs[0], Operator: operator.Hex(), Signature: sign(operatorKey, valAddrs[0], "symapp-1")},
+		},
+		{
+			name:  "same link",
+			input: &stakingtypes.MsgLinkOperator{ValidatorAddress: valAddrs[0], Operator: operator.Hex(), Signature: sign(operatorKey, valAddrs[0], "symapp-1")},
+		},
+		{
+			name:      "operator linked to another validator",
+			input:     &stakingtypes.MsgLinkOperator{ValidatorAddress: valAddrs[1], Operator: operator.Hex(), Signature: sign(operatorKey, valAddrs[1], "symapp-1")},
+			expErrMsg: "is linked to validator",
+		},
+		{
+			name: "validator linked to another operator",
+			input: &stakingtypes.MsgLinkOperator{
+				ValidatorAddress: valAddrs[0],
+				Operator:         crypto.PubkeyToAddress(otherKey.PublicKey).Hex(),
+				Signature:        sign(otherKey, valAddrs[0], "symapp-1"),
+			},
+			expErrMsg: "is linked to operator",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.LinkOperator(ctx, tc.input)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+
+	validator, err := keeper.GetValidator(ctx, sdk.ValAddress(PKs[0].Address()))
+	require.NoError(err)
+	require.Equal(operator.Hex(), validator.SymbioticOperator)
+
+	// the syncs find the validator of the operator
+	valAddr, err := keeper.ValidatorBySymbioticOperator.Get(ctx, operator.Bytes())
+	require.NoError(err)
+	require.Equal(sdk.ValAddress(PKs[0].Address()), sdk.ValAddress(valAddr))
+}
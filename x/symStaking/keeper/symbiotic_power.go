@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"bytes"
+	"slices"
+
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// symbioticPower is a validator synced with its combined middleware stake,
+// along with the tokens it gets from it.
+type symbioticPower struct {
+	validator stakingtypes.Validator
+	valAddr   sdk.ValAddress
+	stake     *symbioticStake
+	tokens    math.Int
+}
+
+// applySymbioticPowerPolicy sets the tokens of the synced validators from their
+// stake according to the params, so that a single large deposit can't swing
+// the validator set at once:
+//
+//   - a stake below the min stake gives no tokens,
+//   - the tokens move toward the stake by at most the max power change relative
+//     to the current tokens, the rest of the change being applied over the next
+//     syncs. A validator without tokens, or without stake, gets it at once,
+//   - the tokens are capped to the max power share of the synced tokens.
+func applySymbioticPowerPolicy(powers []*symbioticPower, params stakingtypes.Params) {
+	for _, power := range powers {
+		target := math.NewIntFromBigInt(power.stake.Stake)
+		if target.LT(params.SymbioticMinStake) {
+			target = math.ZeroInt()
+		}
+
+		current := power.validator.Tokens
+		power.tokens = target
+		if !params.SymbioticMaxPowerChange.IsPositive() || !current.IsPositive() || !target.IsPositive() {
+			continue
+		}
+
+		// the change is at least a token so that the tokens always reach the stake
+		maxChange := math.MaxInt(params.SymbioticMaxPowerChange.MulInt(current).TruncateInt(), math.OneInt())
+		switch {
+		case target.GT(current.Add(maxChange)):
+			power.tokens = current.Add(maxChange)
+		case target.LT(current.Sub(maxChange)):
+			power.tokens = current.Sub(maxChange)
+		}
+	}
+
+	capSymbioticPowerShares(powers, params.SymbioticMaxPowerShare)
+}
+
+// capSymbioticPowerShares lowers the largest tokens so that no validator holds
+// more than maxShare of the total tokens, if positive. The validators are
+// ordered by tokens, then by operator address, so that the outcome doesn't
+// depend on the order of the middleware validator set. If there are too few
+// validators with tokens to honor the share, they all get the smallest tokens.
+func capSymbioticPowerShares(powers []*symbioticPower, maxShare math.LegacyDec) {
+	if !maxShare.IsPositive() || maxShare.GTE(math.LegacyOneDec()) {
+		return
+	}
+
+	var sorted []*symbioticPower
+	total := math.ZeroInt()
+	for _, power := range powers {
+		if power.tokens.IsPositive() {
+			sorted = append(sorted, power)
+			total = total.Add(power.tokens)
+		}
+	}
+	if len(sorted) == 0 {
+		return
+	}
+
+	slices.SortFunc(sorted, func(a, b *symbioticPower) int {
+		if c := b.tokens.BigInt().Cmp(a.tokens.BigInt()); c != 0 {
+			return c
+		}
+		return bytes.Compare(a.valAddr, b.valAddr)
+	})
+
+	// find the number k of the largest validators to cap so that the cap c is
+	// maxShare of the total tokens, i.e. c = maxShare * (k*c + rest), rest being
+	// the tokens of the other validators
+	rest := total
+	for k, power := range sorted {
+		denominator := math.LegacyOneDec().Sub(maxShare.MulInt64(int64(k)))
+		if !denominator.IsPositive() {
+			break
+		}
+
+		maxTokens := maxShare.MulInt(rest).Quo(denominator).TruncateInt()
+		if power.tokens.LTE(maxTokens) {
+			for _, capped := range sorted[:k] {
+				capped.tokens = maxTokens
+			}
+			return
+		}
+
+		rest = rest.Sub(power.tokens)
+	}
+
+	smallest := sorted[len(sorted)-1].tokens
+	for _, capped := range sorted {
+		capped.tokens = smallest
+	}
+}
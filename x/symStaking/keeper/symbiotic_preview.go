@@ -0,0 +1,320 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SymbioticValidatorPreview is a validator as it would be after a sync of the
+// validator set, previewed off-chain by PreviewSymbioticSync.
+type SymbioticValidatorPreview struct {
+	// Validator is the validator after the sync, with its synced tokens and
+	// rotated consensus pubkey.
+	Validator stakingtypes.Validator
+	// ValAddr is the operator address of the validator.
+	ValAddr sdk.ValAddress
+	// Stake is the middleware key of the validator along with its combined
+	// weighted stake, nil if the validator is missing from the validator sets.
+	Stake *stakingtypes.SymbioticValidator
+	// PreviousTokens are the tokens of the validator before the sync.
+	PreviousTokens math.Int
+	// PreviousPower is the CometBFT power of the validator before the sync,
+	// zero if it isn't bonded.
+	PreviousPower int64
+	// PreviousConsPubKey is the consensus pubkey of the validator before the
+	// sync, if rotated.
+	PreviousConsPubKey cryptotypes.PubKey
+	// Created is true if the validator would be created by the sync.
+	Created bool
+}
+
+// SymbioticSkippedKey is a key of the middleware validator sets that doesn't
+// sync any validator.
+type SymbioticSkippedKey struct {
+	stakingtypes.SymbioticValidator
+	Reason string
+}
+
+// PreviewSymbioticSync returns the validators as SymbioticUpdateValidatorsPower
+// would leave them after applying the middleware validator sets under params,
+// along with the keys that wouldn't sync any validator. The validators are the
+// ones on chain, and the keys are matched with their consensus address, or
+// pubkey under the pubkey key types.
+func PreviewSymbioticSync(
+	logger log.Logger,
+	validatorAddressCodec address.Codec,
+	params stakingtypes.Params,
+	powerReduction math.Int,
+	validators []stakingtypes.Validator,
+	sets []stakingtypes.SymbioticValidatorSet,
+) ([]*SymbioticValidatorPreview, []SymbioticSkippedKey, error) {
+	previews := make([]*SymbioticValidatorPreview, len(validators))
+	byKey := make(map[[32]byte]*SymbioticValidatorPreview, len(validators))
+	byOperator := make(map[common.Address]*SymbioticValidatorPreview, len(validators))
+	for i, val := range validators {
+		valAddr, err := validatorAddressCodec.StringToBytes(val.GetOperator())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		preview := &SymbioticValidatorPreview{
+			Validator:      val,
+			ValAddr:        valAddr,
+			PreviousTokens: val.Tokens,
+			PreviousPower:  val.ConsensusPower(powerReduction),
+		}
+		previews[i] = preview
+
+		key, ok, err := symbioticPreviewKey(val, params.SymbioticKeyType)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			byKey[key] = preview
+		}
+		if val.SymbioticOperator != "" {
+			byOperator[common.HexToAddress(val.SymbioticOperator)] = preview
+		}
+	}
+
+	var (
+		powers         []*symbioticPower
+		syncedPreviews []*SymbioticValidatorPreview
+		skipped        []SymbioticSkippedKey
+		synced         = make(map[*SymbioticValidatorPreview]bool, len(validators))
+	)
+	for _, stake := range combineSymbioticStakes(logger, sets, params.Middlewares()) {
+		v := stake.SymbioticValidator
+
+		preview, err := previewSymbioticValidator(validatorAddressCodec, v, params, byKey, byOperator, synced)
+		if err == nil {
+			err = previewSymbioticOperator(preview, v.Operator, byOperator)
+		}
+		if err == nil && synced[preview] {
+			err = stakingtypes.ErrAmbiguousSymbioticKey.Wrapf("validator %s already synced", preview.Validator.GetOperator())
+		}
+		if err != nil {
+			if !errors.Is(err, stakingtypes.ErrNoValidatorFound) && !errors.Is(err, stakingtypes.ErrAmbiguousSymbioticKey) {
+				return nil, nil, err
+			}
+			skipped = append(skipped, SymbioticSkippedKey{SymbioticValidator: v, Reason: err.Error()})
+			continue
+		}
+
+		if preview.Created {
+			previews = append(previews, preview)
+		}
+		preview.Stake = &v
+
+		powers = append(powers, &symbioticPower{validator: preview.Validator, valAddr: preview.ValAddr, stake: stake})
+		syncedPreviews = append(syncedPreviews, preview)
+		synced[preview] = true
+	}
+
+	applySymbioticPowerPolicy(powers, params)
+
+	// the validators missing from the validator sets are removed
+	for _, preview := range previews {
+		preview.Validator.Tokens = math.ZeroInt()
+	}
+	for i, power := range powers {
+		syncedPreviews[i].Validator.Tokens = power.tokens
+	}
+
+	return previews, skipped, nil
+}
+
+// previewSymbioticOperator binds a previewed validator to the operator of its
+// key, following setSymbioticOperator.
+func previewSymbioticOperator(preview *SymbioticValidatorPreview, operator common.Address, byOperator map[common.Address]*SymbioticValidatorPreview) error {
+	if operator == (common.Address{}) {
+		return nil
+	}
+
+	if preview.Validator.SymbioticOperator != "" {
+		if bound := common.HexToAddress(preview.Validator.SymbioticOperator); bound != operator {
+			return stakingtypes.ErrAmbiguousSymbioticKey.Wrapf("validator %s of operator %s has a key of operator %s", preview.Validator.GetOperator(), bound, operator)
+		}
+		return nil
+	}
+
+	if _, ok := byOperator[operator]; ok {
+		return stakingtypes.ErrAmbiguousSymbioticKey.Wrapf("operator %s already has a validator", operator)
+	}
+
+	preview.Validator.SymbioticOperator = operator.Hex()
+	byOperator[operator] = preview
+	return nil
+}
+
+// previewSymbioticValidator returns the preview of the validator of a
+// middleware validator set entry, following getSymbioticValidator,
+// rotateSymbioticValidator and createSymbioticValidator.
+func previewSymbioticValidator(
+	validatorAddressCodec address.Codec,
+	v stakingtypes.SymbioticValidator,
+	params stakingtypes.Params,
+	byKey map[[32]byte]*SymbioticValidatorPreview,
+	byOperator map[common.Address]*SymbioticValidatorPreview,
+	synced map[*SymbioticValidatorPreview]bool,
+) (*SymbioticValidatorPreview, error) {
+	if err := v.Validate(params.SymbioticKeyType); err != nil {
+		return nil, err
+	}
+
+	if preview, ok := byKey[v.ConsAddr]; ok {
+		return preview, nil
+	}
+
+	// the operator may have updated its key
+	if preview, ok := byOperator[v.Operator]; ok && v.Operator != (common.Address{}) {
+		if synced[preview] {
+			return nil, stakingtypes.ErrAmbiguousSymbioticKey.Wrapf("operator %s has several keys", v.Operator)
+		}
+		if params.SymbioticKeyType != stakingtypes.SymbioticKeyTypeEd25519 {
+			return nil, stakingtypes.ErrAmbiguousSymbioticKey.Wrapf("can't rotate the validator of operator %s to a %s key", v.Operator, params.SymbioticKeyType)
+		}
+
+		previous, err := preview.Validator.ConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+		pkAny, err := codectypes.NewAnyWithValue(&ed25519.PubKey{Key: v.ConsAddr[:]})
+		if err != nil {
+			return nil, err
+		}
+		preview.Validator.ConsensusPubkey = pkAny
+		preview.PreviousConsPubKey = previous
+		return preview, nil
+	}
+
+	if !params.SymbioticAutoCreateValidators || v.Stake.Sign() <= 0 || math.NewIntFromBigInt(v.Stake).LT(params.SymbioticMinStake) {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+
+	pk := &ed25519.PubKey{Key: v.ConsAddr[:]}
+	valAddr := sdk.ValAddress(pk.Address())
+	operator, err := validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := stakingtypes.NewValidator(operator, pk, stakingtypes.Description{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SymbioticValidatorPreview{Validator: validator, ValAddr: valAddr, PreviousTokens: math.ZeroInt(), Created: true}, nil
+}
+
+// symbioticPreviewKey returns the middleware key of a validator under the
+// symbiotic key type, or false if its consensus pubkey isn't of that type.
+func symbioticPreviewKey(val stakingtypes.Validator, keyType string) ([32]byte, bool, error) {
+	var key [32]byte
+	if keyType == stakingtypes.SymbioticKeyTypeAddress {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return key, false, err
+		}
+		copy(key[:], consAddr)
+		return key, true, nil
+	}
+
+	pk, err := val.ConsPubKey()
+	if err != nil {
+		return key, false, err
+	}
+
+	symbioticKey, ok := stakingtypes.SymbioticKey(pk)
+	if !ok || pk.Type() != keyType {
+		return key, false, nil
+	}
+
+	copy(key[:], symbioticKey)
+	return key, true, nil
+}
+
+// PreviewSymbioticValidatorUpdates returns the CometBFT validator updates of
+// the validators previewed by PreviewSymbioticSync, as returned by
+// ApplyAndReturnValidatorSetUpdates at the end of the sync block.
+func PreviewSymbioticValidatorUpdates(previews []*SymbioticValidatorPreview, maxValidators uint32, powerReduction math.Int) ([]appmodule.ValidatorUpdate, error) {
+	var bonded []*SymbioticValidatorPreview
+	for _, preview := range previews {
+		if !preview.Validator.Jailed && preview.Validator.PotentialConsensusPower(powerReduction) > 0 {
+			bonded = append(bonded, preview)
+		}
+	}
+
+	// the order of the power index
+	slices.SortStableFunc(bonded, func(a, b *SymbioticValidatorPreview) int {
+		powerA, powerB := a.Validator.PotentialConsensusPower(powerReduction), b.Validator.PotentialConsensusPower(powerReduction)
+		if powerA != powerB {
+			if powerA > powerB {
+				return -1
+			}
+			return 1
+		}
+		if len(a.ValAddr) != len(b.ValAddr) {
+			return len(a.ValAddr) - len(b.ValAddr)
+		}
+		return bytes.Compare(a.ValAddr, b.ValAddr)
+	})
+	if len(bonded) > int(maxValidators) {
+		bonded = bonded[:maxValidators]
+	}
+
+	var updates []appmodule.ValidatorUpdate
+	inSet := make(map[*SymbioticValidatorPreview]bool, len(bonded))
+	for _, preview := range bonded {
+		inSet[preview] = true
+
+		power := preview.Validator.PotentialConsensusPower(powerReduction)
+		if power == preview.PreviousPower && preview.PreviousConsPubKey == nil {
+			continue
+		}
+
+		pk, err := preview.Validator.ConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, appmodule.ValidatorUpdate{PubKey: pk.Bytes(), PubKeyType: pk.Type(), Power: power})
+	}
+
+	// the validators leaving the set, and the previous keys of the rotated ones
+	var removed []*SymbioticValidatorPreview
+	for _, preview := range previews {
+		if preview.PreviousPower > 0 && (!inSet[preview] || preview.PreviousConsPubKey != nil) {
+			removed = append(removed, preview)
+		}
+	}
+	slices.SortFunc(removed, func(a, b *SymbioticValidatorPreview) int {
+		return bytes.Compare(a.ValAddr, b.ValAddr)
+	})
+
+	for _, preview := range removed {
+		pk := preview.PreviousConsPubKey
+		if pk == nil {
+			var err error
+			if pk, err = preview.Validator.ConsPubKey(); err != nil {
+				return nil, err
+			}
+		}
+		updates = append(updates, appmodule.ValidatorUpdate{PubKey: pk.Bytes(), PubKeyType: pk.Type(), Power: 0})
+	}
+
+	return updates, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	"cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestPreviewSymbioticSync() {
+	require := s.Require()
+	ctx := s.ctx
+	powerReduction := s.stakingKeeper.PowerReduction(ctx)
+
+	params, err := s.stakingKeeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+	params.SymbioticKeyType = stakingtypes.SymbioticKeyTypeEd25519
+	params.SymbioticAutoCreateValidators = true
+
+	operator := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	bonded := func(i int, power int64) stakingtypes.Validator {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address()), PKs[i])
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = s.stakingKeeper.TokensFromConsensusPower(ctx, power)
+		return validator
+	}
+	validators := []stakingtypes.Validator{bonded(0, 10), bonded(1, 5), bonded(2, 3)}
+	validators[1].SymbioticOperator = operator.Hex()
+
+	key := func(pk []byte) (key [32]byte) {
+		copy(key[:], pk)
+		return key
+	}
+	rotatedKey := ed25519.GenPrivKey().PubKey()
+	newKey := ed25519.GenPrivKey().PubKey()
+	sets := []stakingtypes.SymbioticValidatorSet{{
+		Middleware: testMiddleware,
+		Epoch:      3,
+		Validators: []stakingtypes.SymbioticValidator{
+			{Stake: s.stakingKeeper.TokensFromConsensusPower(ctx, 20).BigInt(), ConsAddr: key(PKs[0].Bytes())},
+			{Stake: s.stakingKeeper.TokensFromConsensusPower(ctx, 5).BigInt(), ConsAddr: key(rotatedKey.Bytes()), Operator: operator},
+			{Stake: s.stakingKeeper.TokensFromConsensusPower(ctx, 7).BigInt(), ConsAddr: key(newKey.Bytes())},
+			{Stake: s.stakingKeeper.TokensFromConsensusPower(ctx, 1).BigInt()},
+		},
+	}}
+
+	previews, skipped, err := stakingkeeper.PreviewSymbioticSync(log.NewNopLogger(), s.stakingKeeper.ValidatorAddressCodec(), params, powerReduction, validators, sets)
+	require.NoError(err)
+	require.Len(previews, 4)
+	require.Len(skipped, 1)
+
+	// the validator of the key is updated
+	require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 20), previews[0].Validator.Tokens)
+	require.Equal(int64(10), previews[0].PreviousPower)
+
+	// the validator of the operator is rotated to its new key
+	require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 5), previews[1].Validator.Tokens)
+	require.Equal(PKs[1], previews[1].PreviousConsPubKey)
+	pk, err := previews[1].Validator.ConsPubKey()
+	require.NoError(err)
+	require.Equal(rotatedKey, pk)
+
+	// the validator missing from the validator set is removed
+	require.True(previews[2].Validator.Tokens.IsZero())
+	require.Nil(previews[2].Stake)
+
+	// the validator of the unknown key is created
+	require.True(previews[3].Created)
+	require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 7), previews[3].Validator.Tokens)
+	require.Equal(sdk.ValAddress(newKey.Address()), previews[3].ValAddr)
+
+	updates, err := stakingkeeper.PreviewSymbioticValidatorUpdates(previews, params.MaxValidators, powerReduction)
+	require.NoError(err)
+
+	powers := make(map[string]int64, len(updates))
+	for _, update := range updates {
+		powers[string(update.PubKey)] = update.Power
+	}
+	require.Equal(map[string]int64{
+		string(PKs[0].Bytes()):     20,
+		string(rotatedKey.Bytes()): 5,
+		string(newKey.Bytes()):     7,
+		string(PKs[1].Bytes()):     0,
+		string(PKs[2].Bytes()):     0,
+	}, powers)
+
+	// the validators beyond the max validators leave the set
+	updates, err = stakingkeeper.PreviewSymbioticValidatorUpdates(previews, 1, powerReduction)
+	require.NoError(err)
+	require.Equal(appmodule.ValidatorUpdate{PubKey: PKs[0].Bytes(), PubKeyType: PKs[0].Type(), Power: 20}, updates[0])
+	require.Len(updates, 3)
+}
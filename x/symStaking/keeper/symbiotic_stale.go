@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+)
+
+// isSymbioticStale returns true if the validator set is stale for more than
+// the max stale syncs, so that the stale policy applies.
+func isSymbioticStale(staleness stakingtypes.SymbioticStaleness, params stakingtypes.Params) bool {
+	return params.SymbioticMaxStaleSyncs > 0 && staleness.SkippedSyncs > params.SymbioticMaxStaleSyncs
+}
+
+// IsSymbioticStale returns true if the validator set is stale for more than
+// the max stale syncs.
+func (k Keeper) IsSymbioticStale(ctx context.Context) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	staleness, err := k.SymbioticStaleness.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return isSymbioticStale(staleness, params), nil
+}
+
+// validateSymbioticStalePolicy rejects the halt stale policy if no circuit
+// keeper is set to disable the messages.
+func (k Keeper) validateSymbioticStalePolicy(params stakingtypes.Params) error {
+	if params.SymbioticStalePolicy == stakingtypes.SymbioticStalePolicyHalt && k.circuitKeeper == nil {
+		return stakingtypes.ErrSymbioticNoCircuit
+	}
+
+	return nil
+}
+
+// skipSymbioticSync records a skipped sync height in the staleness of the
+// validator set. The last synced validator set is kept for the max stale
+// syncs, then the stale policy applies at each skipped sync. Under the
+// symbiotic epoch identifier, the sync is retried a sync period later.
+func (k *Keeper) skipSymbioticSync(ctx context.Context, height int64, params stakingtypes.Params) error {
+	staleness, err := k.SymbioticStaleness.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		staleness = stakingtypes.SymbioticStaleness{SinceHeight: height}
+	} else if err != nil {
+		return err
+	}
+
+	staleness.SkippedSyncs++
+	if err := k.SymbioticStaleness.Set(ctx, staleness); err != nil {
+		return err
+	}
+
+	if err := k.retrySymbioticSync(ctx, height, params); err != nil {
+		return err
+	}
+
+	policy := stakingtypes.SymbioticStalePolicyKeep
+	if isSymbioticStale(staleness, params) {
+		policy = params.SymbioticStalePolicy
+		k.Logger.Error("symbiotic validator set is stale", "since", staleness.SinceHeight, "skipped_syncs", staleness.SkippedSyncs, "policy", policy)
+	}
+
+	switch policy {
+	case stakingtypes.SymbioticStalePolicyDecay:
+		if err := k.decaySymbioticValidators(ctx, params.SymbioticStaleDecay); err != nil {
+			return err
+		}
+
+	case stakingtypes.SymbioticStalePolicyHalt:
+		// the messages stay disabled until the circuit breaker is reset. The
+		// policy can't be set without a circuit keeper, unless it was removed
+		// from the app since.
+		if k.circuitKeeper == nil {
+			k.Logger.Error("can't halt the stale symbiotic validator set, no circuit keeper set")
+			break
+		}
+		if err := k.circuitKeeper.DisableMsgs(ctx, params.SymbioticHaltMsgTypeUrls...); err != nil {
+			return err
+		}
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		stakingtypes.EventTypeSymbioticSyncStale,
+		event.NewAttribute(stakingtypes.AttributeKeyStaleSince, strconv.FormatInt(staleness.SinceHeight, 10)),
+		event.NewAttribute(stakingtypes.AttributeKeySkippedSyncs, strconv.FormatUint(uint64(staleness.SkippedSyncs), 10)),
+		event.NewAttribute(stakingtypes.AttributeKeyStalePolicy, policy),
+	)
+}
+
+// clearSymbioticStaleness clears the staleness of the validator set once it is
+// updated, returning it if the validator set was stale.
+func (k *Keeper) clearSymbioticStaleness(ctx context.Context) (stakingtypes.SymbioticStaleness, bool, error) {
+	staleness, err := k.SymbioticStaleness.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return staleness, false, nil
+	}
+	if err != nil {
+		return staleness, false, err
+	}
+
+	return staleness, true, k.SymbioticStaleness.Remove(ctx)
+}
+
+// recoverSymbioticSync clears the staleness of the validator set once it is
+// synced, emitting the recovery if it was stale.
+func (k *Keeper) recoverSymbioticSync(ctx context.Context) error {
+	staleness, wasStale, err := k.clearSymbioticStaleness(ctx)
+	if err != nil || !wasStale {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		stakingtypes.EventTypeSymbioticSyncRecovered,
+		event.NewAttribute(stakingtypes.AttributeKeyStaleSince, strconv.FormatInt(staleness.SinceHeight, 10)),
+		event.NewAttribute(stakingtypes.AttributeKeySkippedSyncs, strconv.FormatUint(uint64(staleness.SkippedSyncs), 10)),
+	)
+}
+
+// decaySymbioticValidators removes the decay share of the tokens of every
+// validator.
+func (k *Keeper) decaySymbioticValidators(ctx context.Context, decay math.LegacyDec) error {
+	validators, err := k.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, val := range validators {
+		if val.GetTokens().IsZero() {
+			continue
+		}
+
+		tokens := math.LegacyNewDecFromInt(val.GetTokens()).Mul(math.LegacyOneDec().Sub(decay)).TruncateInt()
+		if _, err := k.SetValidatorTokens(ctx, val, tokens); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
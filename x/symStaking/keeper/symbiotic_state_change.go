@@ -2,74 +2,32 @@ package keeper
 
 import (
 	"context"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/log"
 	"cosmossdk.io/math"
 	stakingtypes "cosmossdk.io/x/symStaking/types"
-	"encoding/json"
+	"crypto/sha256"
 	"errors"
 	"fmt"
-	"github.com/ethereum/go-ethereum"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
-	"io/ioutil"
 	"math/big"
-	"net/http"
-	"strconv"
 	"strings"
 	"time"
 )
 
-// Struct to unmarshal the response from the Beacon Chain API
-type Block struct {
-	Finalized bool `json:"finalized"`
-	Data      struct {
-		Message struct {
-			Body struct {
-				ExecutionPayload struct {
-					BlockHash string `json:"block_hash"`
-				} `json:"execution_payload"`
-			} `json:"body"`
-		} `json:"message"`
-	} `json:"data"`
-}
-
-type RPCRequest struct {
-	Jsonrpc string        `json:"jsonrpc"`
-	Method  string        `json:"method"`
-	Params  []interface{} `json:"params"`
-	ID      int           `json:"id"`
-}
-
-type RPCResponse struct {
-	Jsonrpc string          `json:"jsonrpc"`
-	ID      int             `json:"id"`
-	Result  json.RawMessage `json:"result"`
-	Error   *RPCError       `json:"error,omitempty"`
-}
-
-type RPCError struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-}
-
-type Validator struct {
-	Stake    *big.Int
-	ConsAddr [32]byte
-}
-
 const (
-	SYMBIOTIC_SYNC_PERIOD           = 10
-	SLEEP_ON_RETRY                  = 200
-	RETRIES                         = 5
-	BEACON_GENESIS_TIMESTAMP        = 1695902400
-	SLOTS_IN_EPOCH                  = 32
-	SLOT_DURATION                   = 12
-	INVALID_BLOCKHASH               = "invalid"
-	BLOCK_PATH                      = "/eth/v2/beacon/blocks/"
-	GET_VALIDATOR_SET_FUNCTION_NAME = "getValidatorSet"
-	GET_CURRENT_EPOCH_FUNCTION_NAME = "getCurrentEpoch"
-	CONTRACT_ABI                    = `[
+	INVALID_BLOCKHASH                 = "invalid"
+	GET_VALIDATOR_SET_FUNCTION_NAME   = "getValidatorSet"
+	GET_CURRENT_EPOCH_FUNCTION_NAME   = "getCurrentEpoch"
+	GET_OPERATOR_BY_KEY_FUNCTION_NAME = "getOperatorByKey"
+	CONTRACT_ABI                      = `[
 		{
 			"type": "function",
 			"name": "getCurrentEpoch",
@@ -112,283 +70,995 @@ const (
 				}
 			],
 			"stateMutability": "view"
+		},
+		{
+			"type": "function",
+			"name": "getOperatorByKey",
+			"inputs": [
+				{
+					"name": "key",
+					"type": "bytes32",
+					"internalType": "bytes32"
+				}
+			],
+			"outputs": [
+				{
+					"name": "",
+					"type": "address",
+					"internalType": "address"
+				}
+			],
+			"stateMutability": "view"
 		}
 	]`
 )
 
-func (k *Keeper) CacheBlockHash(ctx context.Context, blockHash stakingtypes.CachedBlockHash) error {
-	bz, err := json.Marshal(blockHash)
+// IsSymbioticSyncHeight returns true if the validator set must be synced with
+// the Symbiotic middleware at the given height: the height scheduled by the
+// epoch hooks under the symbiotic epoch identifier, or every sync period.
+func (k Keeper) IsSymbioticSyncHeight(ctx context.Context, height int64) (bool, error) {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
+		return false, err
+	}
+
+	if len(params.Middlewares()) == 0 {
+		return false, nil
+	}
+
+	if params.SymbioticEpochIdentifier == "" {
+		return height%params.SymbioticSyncPeriod == 0, nil
+	}
+
+	next, err := k.SymbioticNextSyncHeight.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return height == next, nil
+}
+
+// GetSymbioticSyncPoint returns the sync point stored at the given sync height.
+// Only the last sync point and the last applied one are kept.
+func (k Keeper) GetSymbioticSyncPoint(ctx context.Context, height int64) (stakingtypes.SymbioticSyncPoint, error) {
+	return k.SymbioticSyncPoints.Get(ctx, height)
+}
+
+// SetSymbioticSyncPoint stores the sync point of its height and prunes the
+// older ones, but the last applied one.
+func (k Keeper) SetSymbioticSyncPoint(ctx context.Context, syncPoint stakingtypes.SymbioticSyncPoint) error {
+	if err := k.SymbioticSyncPoints.Set(ctx, syncPoint.Height, syncPoint); err != nil {
 		return err
 	}
-	err = k.CachedBlockHash.Set(ctx, bz)
-	return err
+
+	return k.pruneSymbioticSyncPoints(ctx)
 }
 
-func (k *Keeper) SymbioticUpdateValidatorsPower(ctx context.Context) error {
-	if k.networkMiddlewareAddress == "" {
-		panic("middleware address is not set")
+// pruneSymbioticSyncPoints removes the sync points but the last one and the
+// last applied one, the only ones read by the later heights.
+func (k Keeper) pruneSymbioticSyncPoints(ctx context.Context) error {
+	iter, err := k.SymbioticSyncPoints.Iterate(ctx, new(collections.Range[int64]).Descending())
+	if err != nil {
+		return err
 	}
+	defer iter.Close()
 
-	height := k.HeaderService.HeaderInfo(ctx).Height
+	var pruned []int64
+	last, appliedFound := true, false
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
 
-	if height%SYMBIOTIC_SYNC_PERIOD != 0 {
-		return nil
+		applied := kv.Value.SkipReason == ""
+		if !last && (appliedFound || !applied) {
+			pruned = append(pruned, kv.Key)
+		}
+		last, appliedFound = false, appliedFound || applied
 	}
 
-	exist, err := k.CachedBlockHash.Has(ctx)
+	for _, height := range pruned {
+		if err := k.SymbioticSyncPoints.Remove(ctx, height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneInjectedSymbioticData removes the data injected before height, and the
+// validator set of the data injected at height once read. Only the last
+// injected data is read by the later heights, the validator set being
+// identified by its digest.
+func (k Keeper) pruneInjectedSymbioticData(ctx context.Context, height int64, data stakingtypes.InjectedSymbioticData) error {
+	if err := k.InjectedSymbioticData.Clear(ctx, new(collections.Range[int64]).EndExclusive(height)); err != nil {
+		return err
+	}
+
+	data.ValidatorSet = nil
+	return k.InjectedSymbioticData.Set(ctx, height, data)
+}
+
+// SymbioticUpdateValidatorsPower updates the validators tokens at a sync height
+// from the validator set injected in the block.
+func (k *Keeper) SymbioticUpdateValidatorsPower(ctx context.Context) error {
+	height := k.HeaderService.HeaderInfo(ctx).Height
+
+	isSyncHeight, err := k.IsSymbioticSyncHeight(ctx, height)
 	if err != nil {
 		return err
 	}
 
-	if !exist {
+	if !isSyncHeight {
 		return nil
 	}
 
-	data, err := k.CachedBlockHash.Get(ctx)
+	// the validator set was carried in the block and verified against the
+	// digest agreed on in the vote extensions, so no Ethereum RPC is queried here
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
 
-	var cachedBlockHash stakingtypes.CachedBlockHash
-
-	if err := json.Unmarshal(data, &cachedBlockHash); err != nil {
+	data, err := k.InjectedSymbioticData.Get(ctx, height)
+	if errors.Is(err, collections.ErrNotFound) {
+		// the sync was skipped in the PreBlocker
+		return k.skipSymbioticSync(ctx, height, params)
+	}
+	if err != nil {
 		return err
 	}
 
-	if cachedBlockHash.Height != height { // TODO need to research failures on processProposal, mb better to skip block if height is old
-		return fmt.Errorf("symbiotic no blockhash cache, actual cached height %v, expected %v", cachedBlockHash.Height, height)
+	if err := k.pruneInjectedSymbioticData(ctx, height, data); err != nil {
+		return err
 	}
 
-	if cachedBlockHash.BlockHash == INVALID_BLOCKHASH {
-		return nil
+	stale, err := k.IsSymbioticStale(ctx)
+	if err != nil {
+		return err
 	}
 
-	var validators []Validator
-
-	for i := 0; i < RETRIES; i++ {
-		validators, err = k.getSymbioticValidatorSet(ctx, cachedBlockHash.BlockHash)
-		if err == nil {
-			break
+	if stale && params.SymbioticStalePolicy == stakingtypes.SymbioticStalePolicyManual {
+		if err := k.SetSymbioticSyncPoint(ctx, stakingtypes.SymbioticSyncPoint{
+			Height:     height,
+			BlockHash:  INVALID_BLOCKHASH,
+			SkipReason: stakingtypes.SyncSkipReasonManualUpdateRequired,
+		}); err != nil {
+			return err
 		}
 
-		if strings.HasSuffix(err.Error(), "is not currently canonical") {
-			k.Logger.Warn("not canonical block hash", "hash", cachedBlockHash.BlockHash)
-			err = nil
-			break
-		}
+		return k.skipSymbioticSync(ctx, height, params)
+	}
 
-		k.apiUrls.RotateEthUrl()
-		time.Sleep(time.Millisecond * SLEEP_ON_RETRY)
+	advanced, err := k.isSymbioticEpochAdvanced(ctx, data.Epoch, params)
+	if err != nil {
+		return err
 	}
 
+	// the validator set isn't carried if the validators saw no advanced epoch
+	if !advanced || data.SkipReason == stakingtypes.SyncSkipReasonEpochNotAdvanced {
+		return k.skipSymbioticEpoch(ctx, height, data, params)
+	}
+
+	sets, err := UnpackSymbioticValidatorSets(data.ValidatorSet)
 	if err != nil {
 		return err
 	}
 
-	for _, v := range validators {
-		val, err := k.GetValidatorByConsAddr(ctx, v.ConsAddr[:20])
+	// the middleware stakes are recorded anew for the synced validators
+	if err := k.SymbioticStakes.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	stakes := combineSymbioticStakes(k.Logger, sets, params.Middlewares())
+
+	var rotated uint32
+	var powers []*symbioticPower
+	synced := make(map[string]bool, len(stakes))
+	for _, stake := range stakes {
+		v := stake.SymbioticValidator
+
+		val, err := k.getSymbioticValidator(ctx, v, params)
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			// the operator may have updated its key
+			val, err = k.rotateSymbioticValidator(ctx, v, params, synced)
+			if err == nil {
+				rotated++
+			}
+		}
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) && params.SymbioticAutoCreateValidators &&
+			v.Stake.Sign() > 0 && math.NewIntFromBigInt(v.Stake).GTE(params.SymbioticMinStake) {
+			val, err = k.createSymbioticValidator(ctx, &ed25519.PubKey{Key: v.ConsAddr[:]})
+		}
+		if err == nil {
+			val, err = k.setSymbioticOperator(ctx, val, v.Operator)
+		}
+		if err == nil && synced[val.GetOperator()] {
+			err = stakingtypes.ErrAmbiguousSymbioticKey.Wrapf("validator %s already synced", val.GetOperator())
+		}
 		if err != nil {
 			if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
 				continue
 			}
+			if errors.Is(err, stakingtypes.ErrAmbiguousSymbioticKey) {
+				k.Logger.Error("skipping symbiotic validator", "err", err)
+				continue
+			}
+			return err
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(val.GetOperator())
+		if err != nil {
 			return err
 		}
 
-		k.SetValidatorTokens(ctx, val, math.NewIntFromBigInt(v.Stake))
+		powers = append(powers, &symbioticPower{validator: val, valAddr: valAddr, stake: stake})
+		synced[val.GetOperator()] = true
 	}
 
-	return nil
+	applySymbioticPowerPolicy(powers, params)
+
+	for _, power := range powers {
+		if _, err := k.SetValidatorTokens(ctx, power.validator, power.tokens); err != nil {
+			return err
+		}
+
+		if err := k.SymbioticStakes.Set(ctx, power.valAddr, stakingtypes.SymbioticStakes{Stakes: power.stake.stakes}); err != nil {
+			return err
+		}
+	}
+
+	removed, err := k.removeUnsyncedValidators(ctx, synced)
+	if err != nil {
+		return err
+	}
+
+	if err := k.recoverSymbioticSync(ctx); err != nil {
+		return err
+	}
+
+	if err := k.SymbioticEpoch.Set(ctx, data.Epoch); err != nil {
+		return err
+	}
+
+	return k.SetSymbioticSyncPoint(ctx, stakingtypes.SymbioticSyncPoint{
+		Height:            height,
+		BlockHash:         data.BlockHash,
+		BlockNumber:       data.BlockNumber,
+		BlockTimestamp:    data.BlockTimestamp,
+		Epoch:             data.Epoch,
+		ValidatorsUpdated: uint32(len(powers)),
+		ValidatorsRemoved: removed,
+		ValidatorsRotated: rotated,
+	})
 }
 
-func (k *Keeper) GetFinalizedBlockHash(ctx context.Context) (string, error) {
-	var err error
-	var block Block
-
-	for i := 0; i < RETRIES; i++ {
-		slot := k.getSlot(ctx)
-		block, err = k.parseBlock(slot)
-
-		for errors.Is(err, stakingtypes.ErrSymbioticNotFound) { // some slots on api may be omitted
-			for i := 1; i < SLOTS_IN_EPOCH; i++ {
-				block, err = k.parseBlock(slot - i)
-				if err == nil {
-					break
-				}
-				if !errors.Is(err, stakingtypes.ErrSymbioticNotFound) {
-					return "", err
-				}
+// symbioticStake is a middleware validator set key along with its stake
+// combined over the middlewares listing it.
+type symbioticStake struct {
+	stakingtypes.SymbioticValidator
+	stakes []stakingtypes.SymbioticMiddlewareStake
+}
+
+// combineSymbioticStakes sums the weighted stakes of each key over the
+// validator sets of the middlewares, in the order of the params. A key listed
+// twice by a middleware can't be mapped to a single stake, so that middleware
+// doesn't count for it. The operator of a key is the one reported by the first
+// middleware listing it.
+func combineSymbioticStakes(logger log.Logger, sets []stakingtypes.SymbioticValidatorSet, middlewares []stakingtypes.SymbioticMiddleware) []*symbioticStake {
+	setsByMiddleware := make(map[common.Address]stakingtypes.SymbioticValidatorSet, len(sets))
+	for _, set := range sets {
+		setsByMiddleware[set.Middleware] = set
+	}
+
+	var stakes []*symbioticStake
+	stakesByKey := make(map[[32]byte]*symbioticStake)
+	for _, m := range middlewares {
+		set, ok := setsByMiddleware[common.HexToAddress(m.Address)]
+		if !ok {
+			logger.Error("missing symbiotic middleware validator set", "middleware", m.Address)
+			continue
+		}
+
+		occurrences := make(map[[32]byte]int, len(set.Validators))
+		for _, v := range set.Validators {
+			occurrences[v.ConsAddr]++
+		}
+
+		for _, v := range set.Validators {
+			if occurrences[v.ConsAddr] > 1 {
+				logger.Error("skipping duplicate symbiotic key", "middleware", m.Address, "key", common.Bytes2Hex(v.ConsAddr[:]))
+				continue
 			}
+
+			stake, ok := stakesByKey[v.ConsAddr]
+			if !ok {
+				stake = &symbioticStake{SymbioticValidator: stakingtypes.SymbioticValidator{Stake: new(big.Int), ConsAddr: v.ConsAddr}}
+				stakesByKey[v.ConsAddr] = stake
+				stakes = append(stakes, stake)
+			}
+
+			weighted := m.WeightedStake(v.Stake)
+			total := new(big.Int).Add(stake.Stake, weighted)
+			if total.BitLen() > math.MaxBitLen {
+				logger.Error("skipping overflowing symbiotic stake", "middleware", m.Address, "key", common.Bytes2Hex(v.ConsAddr[:]))
+				continue
+			}
+
+			stake.Stake = total
+			if stake.Operator == (common.Address{}) {
+				stake.Operator = v.Operator
+			}
+			stake.stakes = append(stake.stakes, stakingtypes.SymbioticMiddlewareStake{
+				MiddlewareAddress: set.Middleware.Hex(),
+				Stake:             math.NewIntFromBigInt(v.Stake),
+				WeightedStake:     math.NewIntFromBigInt(weighted),
+				Epoch:             set.Epoch,
+			})
 		}
+	}
 
-		if err == nil {
-			break
+	return stakes
+}
+
+// getSymbioticValidator returns the validator of a middleware validator set
+// entry, whose 32-byte key is interpreted according to the symbiotic key type.
+// A padded consensus address is looked up directly while a pubkey or pubkey
+// hash is looked up in the symbiotic key index.
+func (k *Keeper) getSymbioticValidator(ctx context.Context, v stakingtypes.SymbioticValidator, params stakingtypes.Params) (stakingtypes.Validator, error) {
+	if err := v.Validate(params.SymbioticKeyType); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if params.SymbioticKeyType == stakingtypes.SymbioticKeyTypeAddress {
+		return k.GetValidatorByConsAddr(ctx, v.ConsAddr[:20])
+	}
+
+	consAddr, err := k.ValidatorBySymbioticKey.Get(ctx, v.ConsAddr[:])
+	if err == nil {
+		val, err := k.GetValidatorByConsAddr(ctx, consAddr)
+		if err != nil {
+			return val, err
+		}
+
+		// the index holds the keys of every supported type, named after their pubkey type
+		pk, err := val.ConsPubKey()
+		if err != nil {
+			return val, err
+		}
+		if pk.Type() != params.SymbioticKeyType {
+			return val, stakingtypes.ErrAmbiguousSymbioticKey.Wrapf("key %x is a %s key", v.ConsAddr, pk.Type())
 		}
 
-		k.apiUrls.RotateBeaconUrl()
-		time.Sleep(time.Millisecond * SLEEP_ON_RETRY)
+		return val, nil
 	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return stakingtypes.Validator{}, err
+	}
+
+	return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+}
 
+// rotateSymbioticValidator returns the validator of the operator of a middleware
+// validator set entry whose key is unknown, after rotating its consensus pubkey
+// to the key. Only an ed25519 key can be rotated to, as the other key types
+// don't hold the pubkey.
+func (k *Keeper) rotateSymbioticValidator(ctx context.Context, v stakingtypes.SymbioticValidator, params stakingtypes.Params, synced map[string]bool) (stakingtypes.Validator, error) {
+	if v.Operator == (common.Address{}) {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+
+	valAddr, err := k.ValidatorBySymbioticOperator.Get(ctx, v.Operator.Bytes())
+	if errors.Is(err, collections.ErrNotFound) {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
 	if err != nil {
-		return "", err
+		return stakingtypes.Validator{}, err
 	}
 
-	if !block.Finalized {
-		return INVALID_BLOCKHASH, nil
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return stakingtypes.Validator{}, err
 	}
 
-	return block.Data.Message.Body.ExecutionPayload.BlockHash, nil
+	// the operator can't hold several keys of the validator set
+	if synced[validator.GetOperator()] {
+		return stakingtypes.Validator{}, stakingtypes.ErrAmbiguousSymbioticKey.Wrapf("operator %s has several keys", v.Operator)
+	}
+
+	if params.SymbioticKeyType != stakingtypes.SymbioticKeyTypeEd25519 {
+		return stakingtypes.Validator{}, stakingtypes.ErrAmbiguousSymbioticKey.Wrapf("can't rotate the validator of operator %s to a %s key", v.Operator, params.SymbioticKeyType)
+	}
+
+	return k.rotateConsPubKey(ctx, validator, &ed25519.PubKey{Key: v.ConsAddr[:]})
 }
 
-func (k *Keeper) GetBlockByHash(ctx context.Context, blockHash string) (*types.Block, error) {
-	var block *types.Block
-	client, err := ethclient.Dial(k.apiUrls.GetEthApiUrl())
+// rotateConsPubKey replaces the consensus pubkey of a validator, keeping its
+// operator, commission and signing info. The old consensus address keeps
+// resolving to the validator, as CometBFT still expects signatures of the old
+// key until the validator set update of ApplyAndReturnValidatorSetUpdates
+// applies.
+func (k *Keeper) rotateConsPubKey(ctx context.Context, validator stakingtypes.Validator, newPk cryptotypes.PubKey) (stakingtypes.Validator, error) {
+	oldPk, err := validator.ConsPubKey()
 	if err != nil {
-		return nil, err
+		return stakingtypes.Validator{}, err
 	}
-	defer client.Close()
 
-	for i := 0; i < RETRIES; i++ {
-		block, err = client.BlockByHash(ctx, common.HexToHash(blockHash))
-		if err == nil {
-			break
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPk.Address()), sdk.ConsAddress(newPk.Address())
+	if err := k.ValidatorByConsensusAddress.Remove(ctx, oldConsAddr); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if key, ok := stakingtypes.SymbioticKey(oldPk); ok {
+		if err := k.ValidatorBySymbioticKey.Remove(ctx, key); err != nil {
+			return stakingtypes.Validator{}, err
 		}
+	}
+
+	if err := k.ValidatorByOldConsensusAddress.Set(ctx, oldConsAddr, valAddr); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	// a key rotated back to is no longer an old one
+	if err := k.ValidatorByOldConsensusAddress.Remove(ctx, newConsAddr); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if validator.ConsensusPubkey, err = codectypes.NewAnyWithValue(newPk); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return stakingtypes.Validator{}, err
+	}
 
-		k.apiUrls.RotateEthUrl()
-		time.Sleep(time.Millisecond * SLEEP_ON_RETRY)
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return stakingtypes.Validator{}, err
 	}
 
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	rotation, err := stakingtypes.NewConsPubKeyRotation(validator.GetOperator(), oldPk, newPk, height)
 	if err != nil {
-		return nil, err
+		return stakingtypes.Validator{}, err
 	}
 
-	return block, nil
+	if err := k.ConsPubKeyRotations.Set(ctx, collections.Join(height, valAddr), rotation); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPk, newPk); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	oldConsStr, err := k.consensusAddressCodec.BytesToString(oldConsAddr)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	newConsStr, err := k.consensusAddressCodec.BytesToString(newConsAddr)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		stakingtypes.EventTypeRotateConsPubKey,
+		event.NewAttribute(stakingtypes.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(stakingtypes.AttributeKeySymbioticOperator, validator.SymbioticOperator),
+		event.NewAttribute(stakingtypes.AttributeKeyOldConsAddress, oldConsStr),
+		event.NewAttribute(stakingtypes.AttributeKeyNewConsAddress, newConsStr),
+	); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	return validator, nil
 }
 
-func (k *Keeper) GetBlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
-	var block *types.Block
-	client, err := ethclient.Dial(k.apiUrls.GetEthApiUrl())
+// setSymbioticOperator binds a validator to the operator that registered its
+// key, once known, so that the operator key updates rotate its consensus pubkey.
+// A validator can't change operator, nor an operator have several validators.
+func (k *Keeper) setSymbioticOperator(ctx context.Context, validator stakingtypes.Validator, operator common.Address) (stakingtypes.Validator, error) {
+	if operator == (common.Address{}) {
+		return validator, nil
+	}
+
+	if validator.SymbioticOperator != "" {
+		if bound := common.HexToAddress(validator.SymbioticOperator); bound != operator {
+			return validator, stakingtypes.ErrAmbiguousSymbioticKey.Wrapf("validator %s of operator %s has a key of operator %s", validator.GetOperator(), bound, operator)
+		}
+		return validator, nil
+	}
+
+	has, err := k.ValidatorBySymbioticOperator.Has(ctx, operator.Bytes())
 	if err != nil {
-		return nil, err
+		return validator, err
+	}
+	if has {
+		return validator, stakingtypes.ErrAmbiguousSymbioticKey.Wrapf("operator %s already has a validator", operator)
 	}
-	defer client.Close()
 
-	for i := 0; i < RETRIES; i++ {
-		block, err = client.BlockByNumber(ctx, number)
-		if err == nil {
-			break
+	validator.SymbioticOperator = operator.Hex()
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return validator, err
+	}
+
+	return validator, k.SetValidatorBySymbioticOperator(ctx, validator)
+}
+
+// createSymbioticValidator creates the validator of a consensus pubkey
+// registered in the middleware. Its operator is the account of the consensus
+// key, which can edit its description and commission with MsgEditValidator.
+func (k *Keeper) createSymbioticValidator(ctx context.Context, pk *ed25519.PubKey) (stakingtypes.Validator, error) {
+	valAddr := sdk.ValAddress(pk.Address())
+	if _, err := k.GetValidator(ctx, valAddr); err == nil {
+		// the operator address is used by a validator with another consensus key
+		k.Logger.Error("can't create symbiotic validator, operator already exists", "operator", valAddr)
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+
+	operator, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	validator, err := stakingtypes.NewValidator(operator, pk, stakingtypes.Description{})
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	minCommissionRate, err := k.MinCommissionRate(ctx)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	// the operator can set any rate above the minimum afterwards
+	commission := stakingtypes.NewCommissionWithTime(minCommissionRate, math.LegacyOneDec(), math.LegacyOneDec(), k.HeaderService.HeaderInfo(ctx).Time)
+	if validator, err = validator.SetInitialCommission(commission); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.SetNewValidatorByPowerIndex(ctx, validator); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.Hooks().AfterValidatorCreated(ctx, valAddr); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		stakingtypes.EventTypeCreateValidator,
+		event.NewAttribute(stakingtypes.AttributeKeyValidator, operator),
+	); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	return validator, nil
+}
+
+// removeUnsyncedValidators zeroes the tokens of the validators missing from the
+// middleware validator set, e.g. unregistered or paused operators. The bonded
+// ones are unbonded by ApplyAndReturnValidatorSetUpdates in the same block.
+func (k *Keeper) removeUnsyncedValidators(ctx context.Context, synced map[string]bool) (uint32, error) {
+	validators, err := k.GetAllValidators(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var removed uint32
+	for _, val := range validators {
+		if synced[val.GetOperator()] || val.GetTokens().IsZero() {
+			continue
+		}
+
+		tokens := val.GetTokens()
+		if _, err := k.SetValidatorTokens(ctx, val, math.ZeroInt()); err != nil {
+			return 0, err
 		}
 
-		k.apiUrls.RotateEthUrl()
-		time.Sleep(time.Millisecond * SLEEP_ON_RETRY)
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			stakingtypes.EventTypeSymbioticValidatorRemoved,
+			event.NewAttribute(stakingtypes.AttributeKeyValidator, val.GetOperator()),
+			event.NewAttribute(stakingtypes.AttributeKeyTokens, tokens.String()),
+		); err != nil {
+			return 0, err
+		}
+		removed++
 	}
 
+	return removed, nil
+}
+
+func (k *Keeper) GetFinalizedBlockHash(ctx context.Context) (string, error) {
+	_, blockHash, err := k.getFinalizedBeaconBlock(ctx)
+	return blockHash, err
+}
+
+// GetFinalizedBlock returns the data of the finalized execution block the
+// validator set must be synced with, to be injected by the proposer.
+func (k *Keeper) GetFinalizedBlock(ctx context.Context) (stakingtypes.InjectedSymbioticData, error) {
+	data := stakingtypes.InjectedSymbioticData{Version: stakingtypes.InjectedSymbioticDataVersion}
+
+	slot, blockHash, err := k.getFinalizedBeaconBlock(ctx)
 	if err != nil {
-		return nil, err
+		return data, err
 	}
 
-	return block, nil
+	data.BlockHash = blockHash
+	if blockHash == INVALID_BLOCKHASH {
+		return data, nil
+	}
+
+	block, err := k.GetBlockByHash(ctx, blockHash)
+	if err != nil {
+		return data, err
+	}
+
+	data.BlockNumber = block.Number.Uint64()
+	data.BlockTimestamp = block.Time
+	data.Slot = slot
+	return data, nil
 }
 
-func (k Keeper) GetMinBlockTimestamp(ctx context.Context) uint64 {
-	return uint64(k.getSlot(ctx)-SLOTS_IN_EPOCH)*12 + BEACON_GENESIS_TIMESTAMP
+// SetGenesisSymbioticData fetches the validator set at the execution block
+// pinned in the genesis and stores it as the data injected at the current
+// height, to be applied by SymbioticUpdateValidatorsPower.
+func (k *Keeper) SetGenesisSymbioticData(ctx context.Context, blockHash string) error {
+	height := k.HeaderService.HeaderInfo(ctx).Height
+
+	isSyncHeight, err := k.IsSymbioticSyncHeight(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	if !isSyncHeight {
+		return nil
+	}
+
+	block, err := k.GetBlockByHash(ctx, blockHash)
+	if err != nil {
+		return err
+	}
+
+	epoch, validatorSet, err := k.FetchSymbioticValidatorSet(ctx, blockHash)
+	if err != nil {
+		return err
+	}
+
+	digest := sha256.Sum256(validatorSet)
+	data := stakingtypes.InjectedSymbioticData{
+		Version:            stakingtypes.InjectedSymbioticDataVersion,
+		BlockHash:          blockHash,
+		BlockNumber:        block.Number.Uint64(),
+		BlockTimestamp:     block.Time,
+		ValidatorSetDigest: digest[:],
+		ValidatorSet:       validatorSet,
+		Epoch:              epoch,
+	}
+
+	return k.InjectedSymbioticData.Set(ctx, height, data)
 }
 
-func (k Keeper) getSymbioticValidatorSet(ctx context.Context, blockHash string) ([]Validator, error) {
-	client, err := ethclient.Dial(k.apiUrls.GetEthApiUrl())
+// GetLastInjectedSymbioticData returns the data injected at the last sync
+// height the validator set was synced at, if any.
+func (k Keeper) GetLastInjectedSymbioticData(ctx context.Context) (stakingtypes.InjectedSymbioticData, bool, error) {
+	iter, err := k.InjectedSymbioticData.Iterate(ctx, new(collections.Range[int64]).Descending())
 	if err != nil {
-		k.Logger.Error("rpc error: ethclient dial error", "url", k.apiUrls.GetEthApiUrl(), "err", err)
-		return nil, err
+		return stakingtypes.InjectedSymbioticData{}, false, err
 	}
-	defer client.Close()
+	defer iter.Close()
 
-	contractABI, err := abi.JSON(strings.NewReader(CONTRACT_ABI))
+	if !iter.Valid() {
+		return stakingtypes.InjectedSymbioticData{}, false, nil
+	}
+
+	data, err := iter.Value()
 	if err != nil {
-		return nil, err
+		return stakingtypes.InjectedSymbioticData{}, false, err
 	}
 
-	contractAddress := common.HexToAddress(k.networkMiddlewareAddress)
+	return data, true, nil
+}
 
-	data, err := contractABI.Pack(GET_CURRENT_EPOCH_FUNCTION_NAME)
+func (k *Keeper) getFinalizedBeaconBlock(ctx context.Context) (int64, string, error) {
+	profile, err := k.networkProfile(ctx)
 	if err != nil {
-		return nil, err
+		return 0, "", err
 	}
 
-	query := ethereum.CallMsg{
-		To:   &contractAddress,
-		Data: data,
+	return FetchFinalizedBeaconBlock(ctx, k.symbioticSource, profile, k.HeaderService.HeaderInfo(ctx).Time)
+}
+
+// FetchFinalizedBeaconBlock returns the slot and the execution block hash of
+// the beacon block the validator set is synced with at the given time, the
+// first block of the last finalized epoch of the network, or INVALID_BLOCKHASH
+// if the block isn't finalized.
+func FetchFinalizedBeaconBlock(ctx context.Context, source stakingtypes.SymbioticSource, profile stakingtypes.NetworkProfile, t time.Time) (int64, string, error) {
+	slot := symbioticFinalizedSlot(profile, t)
+	block, err := source.BeaconBlock(ctx, slot)
+
+	// some slots on api may be omitted
+	for i := int64(1); i < int64(profile.SlotsPerEpoch) && errors.Is(err, stakingtypes.ErrSymbioticNotFound); i++ {
+		slot--
+		block, err = source.BeaconBlock(ctx, slot)
 	}
-	result, err := client.CallContractAtHash(ctx, query, common.HexToHash(blockHash))
+
 	if err != nil {
-		k.Logger.Error("rpc error: eth_call error", "url", k.apiUrls.GetEthApiUrl(), "err", err)
-		return nil, err
+		return 0, "", err
+	}
+
+	if !block.Finalized {
+		return slot, INVALID_BLOCKHASH, nil
 	}
 
-	currentEpoch := new(big.Int).SetBytes(result)
+	return slot, block.Data.Message.Body.ExecutionPayload.BlockHash, nil
+}
+
+func (k *Keeper) GetBlockByHash(ctx context.Context, blockHash string) (*types.Header, error) {
+	return k.symbioticSource.HeaderByHash(ctx, common.HexToHash(blockHash))
+}
+
+func (k *Keeper) GetBlockByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return k.symbioticSource.HeaderByNumber(ctx, number)
+}
 
-	data, err = contractABI.Pack(GET_VALIDATOR_SET_FUNCTION_NAME, currentEpoch)
+func (k Keeper) GetMinBlockTimestamp(ctx context.Context) (uint64, error) {
+	profile, err := k.networkProfile(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	query = ethereum.CallMsg{
-		To:   &contractAddress,
-		Data: data,
+	// clamped at the beacon genesis while the finalized slot is in the first epoch
+	minSlot := k.getSlot(ctx, profile) - int64(profile.SlotsPerEpoch)
+	if minSlot < 0 {
+		minSlot = 0
 	}
-	result, err = client.CallContractAtHash(ctx, query, common.HexToHash(blockHash))
+
+	return uint64(minSlot)*uint64(profile.SlotDuration/time.Second) + profile.BeaconGenesisTimestamp, nil
+}
+
+// FetchSymbioticValidatorSet returns the current epoch of the first middleware
+// and the encoded validator sets of every middleware at the given execution
+// block: the getValidatorSet entries of its current epoch along with the
+// operator of their key.
+func (k Keeper) FetchSymbioticValidatorSet(ctx context.Context, blockHash string) (uint64, []byte, error) {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		k.Logger.Error("rpc error: eth_call error", "url", k.apiUrls.GetEthApiUrl(), "err", err)
-		return nil, err
+		return 0, nil, err
+	}
+
+	sets, err := FetchMiddlewareValidatorSets(ctx, k.symbioticSource, params.Middlewares(), common.HexToHash(blockHash), nil)
+	if err != nil {
+		return 0, nil, err
 	}
 
-	var validators []Validator
-	err = contractABI.UnpackIntoInterface(&validators, GET_VALIDATOR_SET_FUNCTION_NAME, result)
+	validatorSet, err := PackSymbioticValidatorSets(sets)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return sets[0].Epoch, validatorSet, nil
+}
+
+// FetchMiddlewareValidatorSets returns the validator sets of the middlewares at
+// the given execution block, at the given epoch or at the current epoch of each
+// middleware if nil.
+func FetchMiddlewareValidatorSets(
+	ctx context.Context,
+	source stakingtypes.SymbioticSource,
+	middlewares []stakingtypes.SymbioticMiddleware,
+	hash common.Hash,
+	epoch *uint64,
+) ([]stakingtypes.SymbioticValidatorSet, error) {
+	contractABI, err := abi.JSON(strings.NewReader(CONTRACT_ABI))
 	if err != nil {
 		return nil, err
 	}
 
-	return validators, nil
+	if len(middlewares) == 0 {
+		return nil, errors.New("no symbiotic middleware configured")
+	}
+
+	// every middleware is read at the same block
+	sets := make([]stakingtypes.SymbioticValidatorSet, len(middlewares))
+	for i, m := range middlewares {
+		sets[i], err = fetchMiddlewareValidatorSet(ctx, source, contractABI, common.HexToAddress(m.Address), hash, epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sets, nil
 }
 
-func (k Keeper) getSlot(ctx context.Context) int {
-	slot := (k.HeaderService.HeaderInfo(ctx).Time.Unix() - BEACON_GENESIS_TIMESTAMP) / SLOT_DURATION // get beacon slot
-	slot = slot / SLOTS_IN_EPOCH * SLOTS_IN_EPOCH                                                    // first slot of epoch
-	slot -= 3 * SLOTS_IN_EPOCH                                                                       // get finalized slot
-	return int(slot)
+// FetchSymbioticEpoch returns the current epoch of the first middleware at the
+// given execution block, without fetching its validator set.
+func (k Keeper) FetchSymbioticEpoch(ctx context.Context, blockHash string) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	middlewares := params.Middlewares()
+	if len(middlewares) == 0 {
+		return 0, errors.New("no symbiotic middleware configured")
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(CONTRACT_ABI))
+	if err != nil {
+		return 0, err
+	}
+
+	return fetchMiddlewareEpoch(ctx, k.symbioticSource, contractABI, common.HexToAddress(middlewares[0].Address), common.HexToHash(blockHash))
 }
 
-func (k Keeper) parseBlock(slot int) (Block, error) {
-	url := k.apiUrls.GetBeaconApiUrl() + BLOCK_PATH + strconv.Itoa(slot)
+// fetchMiddlewareEpoch returns the current epoch of a middleware at the given
+// execution block.
+func fetchMiddlewareEpoch(
+	ctx context.Context,
+	source stakingtypes.SymbioticSource,
+	contractABI abi.ABI,
+	contractAddress common.Address,
+	hash common.Hash,
+) (uint64, error) {
+	data, err := contractABI.Pack(GET_CURRENT_EPOCH_FUNCTION_NAME)
+	if err != nil {
+		return 0, err
+	}
 
-	var block Block
-	resp, err := http.Get(url)
+	result, err := source.CallContract(ctx, contractAddress, data, hash)
 	if err != nil {
-		k.Logger.Error("rpc error: beacon rpc call error", "url", url, "err", err)
-		return block, fmt.Errorf("error making HTTP request: %v", err)
+		return 0, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		k.Logger.Error("rpc error: beacon rpc call error", "url", k.apiUrls.GetEthApiUrl(), "err", "no err", "status", resp.StatusCode)
+	epoch := new(big.Int).SetBytes(result)
+	if !epoch.IsUint64() {
+		return 0, fmt.Errorf("invalid middleware %s epoch %s", contractAddress, epoch)
 	}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return block, stakingtypes.ErrSymbioticNotFound
+	return epoch.Uint64(), nil
+}
+
+// fetchMiddlewareValidatorSet returns the validator set of a middleware at the
+// given execution block, at the given epoch or at its current epoch if nil.
+func fetchMiddlewareValidatorSet(
+	ctx context.Context,
+	source stakingtypes.SymbioticSource,
+	contractABI abi.ABI,
+	contractAddress common.Address,
+	hash common.Hash,
+	epoch *uint64,
+) (stakingtypes.SymbioticValidatorSet, error) {
+	set := stakingtypes.SymbioticValidatorSet{Middleware: contractAddress}
+
+	if epoch != nil {
+		set.Epoch = *epoch
+	} else {
+		var err error
+		if set.Epoch, err = fetchMiddlewareEpoch(ctx, source, contractABI, contractAddress, hash); err != nil {
+			return set, err
+		}
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return block, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	data, err := contractABI.Pack(GET_VALIDATOR_SET_FUNCTION_NAME, new(big.Int).SetUint64(set.Epoch))
+	if err != nil {
+		return set, err
+	}
+
+	result, err := source.CallContract(ctx, contractAddress, data, hash)
+	if err != nil {
+		return set, err
+	}
+
+	if err := contractABI.UnpackIntoInterface(&set.Validators, GET_VALIDATOR_SET_FUNCTION_NAME, result); err != nil {
+		return set, err
+	}
+
+	// the operators identify the validators across key updates
+	for i := range set.Validators {
+		data, err := contractABI.Pack(GET_OPERATOR_BY_KEY_FUNCTION_NAME, set.Validators[i].ConsAddr)
+		if err != nil {
+			return set, err
+		}
+
+		result, err := source.CallContract(ctx, contractAddress, data, hash)
+		if err != nil {
+			return set, err
+		}
+
+		if err := contractABI.UnpackIntoInterface(&set.Validators[i].Operator, GET_OPERATOR_BY_KEY_FUNCTION_NAME, result); err != nil {
+			return set, err
+		}
+	}
+
+	return set, nil
+}
+
+// symbioticValidatorSetsArguments returns the encoding of the validator sets of
+// the middlewares, each a getValidatorSet result extended with the operator of
+// each key.
+func symbioticValidatorSetsArguments() (abi.Arguments, error) {
+	validatorSetsType, err := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+		{Name: "middleware", Type: "address"},
+		{Name: "epoch", Type: "uint64"},
+		{Name: "validators", Type: "tuple[]", Components: []abi.ArgumentMarshaling{
+			{Name: "stake", Type: "uint256"},
+			{Name: "consAddr", Type: "bytes32"},
+			{Name: "operator", Type: "address"},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Arguments{{Name: "validatorSets", Type: validatorSetsType}}, nil
+}
+
+// PackSymbioticValidatorSets encodes the validator sets of the middlewares.
+func PackSymbioticValidatorSets(sets []stakingtypes.SymbioticValidatorSet) ([]byte, error) {
+	arguments, err := symbioticValidatorSetsArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	return arguments.Pack(sets)
+}
+
+// UnpackSymbioticValidatorSets decodes the validator sets of the middlewares.
+func UnpackSymbioticValidatorSets(validatorSets []byte) ([]stakingtypes.SymbioticValidatorSet, error) {
+	arguments, err := symbioticValidatorSetsArguments()
+	if err != nil {
+		return nil, err
 	}
 
-	body, err := ioutil.ReadAll(resp.Body)
+	values, err := arguments.Unpack(validatorSets)
 	if err != nil {
-		return block, fmt.Errorf("error reading response body: %v", err)
+		return nil, err
+	}
+
+	var sets []stakingtypes.SymbioticValidatorSet
+	if err := arguments.Copy(&sets, values); err != nil {
+		return nil, err
 	}
 
-	err = json.Unmarshal(body, &block)
+	return sets, nil
+}
+
+func (k Keeper) networkProfile(ctx context.Context) (stakingtypes.NetworkProfile, error) {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		return block, fmt.Errorf("error unmarshaling JSON: %v", err)
+		return stakingtypes.NetworkProfile{}, err
 	}
 
-	return block, nil
+	return params.NetworkProfile()
+}
+
+func (k Keeper) getSlot(ctx context.Context, profile stakingtypes.NetworkProfile) int64 {
+	return symbioticFinalizedSlot(profile, k.HeaderService.HeaderInfo(ctx).Time)
+}
+
+// symbioticFinalizedSlot returns the first slot of the last finalized epoch of
+// the network at the given time.
+func symbioticFinalizedSlot(profile stakingtypes.NetworkProfile, t time.Time) int64 {
+	slotsPerEpoch := int64(profile.SlotsPerEpoch)
+
+	slot := (t.Unix() - int64(profile.BeaconGenesisTimestamp)) / int64(profile.SlotDuration/time.Second) // get beacon slot
+	slot = slot / slotsPerEpoch * slotsPerEpoch                                                          // first slot of epoch
+	slot -= int64(profile.FinalityDepth) * slotsPerEpoch                                                 // get finalized slot
+	return slot
 }
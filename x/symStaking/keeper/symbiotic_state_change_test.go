@@ -0,0 +1,995 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	"cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestGetFinalizedBlockHash() {
+	require := s.Require()
+
+	profile := stakingtypes.NetworkProfiles[stakingtypes.DefaultEthereumNetwork]
+	slotsPerEpoch := int64(profile.SlotsPerEpoch)
+	slotDuration := int64(profile.SlotDuration / time.Second)
+	blockTime := time.Unix(int64(profile.BeaconGenesisTimestamp)+100*slotsPerEpoch*slotDuration, 0)
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: blockTime})
+	finalizedSlot := (100 - int64(profile.FinalityDepth)) * slotsPerEpoch
+
+	// no block at all in the epoch
+	_, err := s.stakingKeeper.GetFinalizedBlockHash(ctx)
+	require.Error(err)
+
+	// the first slots of the epoch were skipped
+	s.source.AddBeaconBlock(finalizedSlot-2, "0x01", true)
+	hash, err := s.stakingKeeper.GetFinalizedBlockHash(ctx)
+	require.NoError(err)
+	require.Equal("0x01", hash)
+
+	s.source.AddBeaconBlock(finalizedSlot, "0x02", true)
+	hash, err = s.stakingKeeper.GetFinalizedBlockHash(ctx)
+	require.NoError(err)
+	require.Equal("0x02", hash)
+
+	s.source.AddBeaconBlock(finalizedSlot, "0x03", false)
+	hash, err = s.stakingKeeper.GetFinalizedBlockHash(ctx)
+	require.NoError(err)
+	require.Equal(stakingkeeper.INVALID_BLOCKHASH, hash)
+
+	s.source.Err = errors.New("unavailable")
+	_, err = s.stakingKeeper.GetFinalizedBlockHash(ctx)
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestIsSymbioticSyncHeight() {
+	require := s.Require()
+
+	// no middleware configured
+	ok, err := s.stakingKeeper.IsSymbioticSyncHeight(s.ctx, 10)
+	require.NoError(err)
+	require.False(ok)
+
+	params, err := s.stakingKeeper.Params.Get(s.ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+	params.SymbioticSyncPeriod = 5
+	require.NoError(s.stakingKeeper.Params.Set(s.ctx, params))
+
+	ok, err = s.stakingKeeper.IsSymbioticSyncHeight(s.ctx, 10)
+	require.NoError(err)
+	require.True(ok)
+
+	ok, err = s.stakingKeeper.IsSymbioticSyncHeight(s.ctx, 11)
+	require.NoError(err)
+	require.False(ok)
+}
+
+func (s *KeeperTestSuite) TestGetMinBlockTimestampCustomNetwork() {
+	require := s.Require()
+
+	params, err := s.stakingKeeper.Params.Get(s.ctx)
+	require.NoError(err)
+	params.EthereumNetwork = stakingtypes.EthereumNetworkCustom
+	params.BeaconGenesisTimestamp = 1000
+	params.SlotDuration = 6 * time.Second
+	params.SlotsPerEpoch = 8
+	params.FinalityDepth = 2
+	require.NoError(params.Validate())
+	require.NoError(s.stakingKeeper.Params.Set(s.ctx, params))
+
+	// slot 100, first slot of epoch 96, finalized slot 80
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(1000+100*6, 0)})
+	minTimestamp, err := s.stakingKeeper.GetMinBlockTimestamp(ctx)
+	require.NoError(err)
+	require.Equal(uint64(1000+(80-8)*6), minTimestamp)
+
+	// slot 20, finalized slot 0, clamped at the beacon genesis
+	ctx = s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(1000+20*6, 0)})
+	minTimestamp, err = s.stakingKeeper.GetMinBlockTimestamp(ctx)
+	require.NoError(err)
+	require.Equal(uint64(1000), minTimestamp)
+
+	// before the beacon genesis
+	ctx = s.ctx.WithHeaderInfo(header.Info{Time: time.Unix(500, 0)})
+	minTimestamp, err = s.stakingKeeper.GetMinBlockTimestamp(ctx)
+	require.NoError(err)
+	require.Equal(uint64(1000), minTimestamp)
+}
+
+// testMiddleware is the middleware address set by the tests.
+var testMiddleware = common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+// packValidatorSet returns the encoded validator set of testMiddleware.
+func (s *KeeperTestSuite) packValidatorSet(validators []stakingtypes.SymbioticValidator) []byte {
+	validatorSet, err := stakingkeeper.PackSymbioticValidatorSets([]stakingtypes.SymbioticValidatorSet{
+		{Middleware: testMiddleware, Validators: validators},
+	})
+	s.Require().NoError(err)
+	return validatorSet
+}
+
+// addValidatorSetCalls registers the calls to middleware returning validators
+// at the given epoch and block, and returns the expected validator set.
+func (s *KeeperTestSuite) addValidatorSetCalls(middleware common.Address, blockHash common.Hash, epoch int64, validators []stakingtypes.SymbioticValidator) stakingtypes.SymbioticValidatorSet {
+	require := s.Require()
+
+	contractABI, err := abi.JSON(strings.NewReader(stakingkeeper.CONTRACT_ABI))
+	require.NoError(err)
+
+	data, err := contractABI.Pack(stakingkeeper.GET_CURRENT_EPOCH_FUNCTION_NAME)
+	require.NoError(err)
+	s.source.AddCall(middleware, blockHash, data, common.LeftPadBytes(big.NewInt(epoch).Bytes(), 32))
+
+	data, err = contractABI.Pack(stakingkeeper.GET_VALIDATOR_SET_FUNCTION_NAME, big.NewInt(epoch))
+	require.NoError(err)
+	result, err := contractABI.Methods[stakingkeeper.GET_VALIDATOR_SET_FUNCTION_NAME].Outputs.Pack(validators)
+	require.NoError(err)
+	s.source.AddCall(middleware, blockHash, data, result)
+
+	for _, v := range validators {
+		data, err = contractABI.Pack(stakingkeeper.GET_OPERATOR_BY_KEY_FUNCTION_NAME, v.ConsAddr)
+		require.NoError(err)
+		s.source.AddCall(middleware, blockHash, data, common.LeftPadBytes(v.Operator.Bytes(), 32))
+	}
+
+	return stakingtypes.SymbioticValidatorSet{Middleware: middleware, Epoch: uint64(epoch), Validators: validators}
+}
+
+func (s *KeeperTestSuite) TestFetchSymbioticValidatorSet() {
+	require := s.Require()
+
+	blockHash := common.BytesToHash([]byte{1})
+	otherMiddleware := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	expected := []stakingtypes.SymbioticValidatorSet{
+		s.addValidatorSetCalls(testMiddleware, blockHash, 2, []stakingtypes.SymbioticValidator{
+			{Stake: big.NewInt(10), ConsAddr: [32]byte{1}, Operator: common.HexToAddress("0x01")},
+			{Stake: big.NewInt(20), ConsAddr: [32]byte{2}, Operator: common.HexToAddress("0x02")},
+		}),
+		s.addValidatorSetCalls(otherMiddleware, blockHash, 5, []stakingtypes.SymbioticValidator{
+			{Stake: big.NewInt(30), ConsAddr: [32]byte{2}, Operator: common.HexToAddress("0x02")},
+		}),
+	}
+
+	// no middleware configured
+	_, _, err := s.stakingKeeper.FetchSymbioticValidatorSet(s.ctx, blockHash.String())
+	require.Error(err)
+
+	params, err := s.stakingKeeper.Params.Get(s.ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewares = []stakingtypes.SymbioticMiddleware{
+		{Address: testMiddleware.Hex(), Weight: math.LegacyOneDec(), Cap: math.ZeroInt()},
+		{Address: otherMiddleware.Hex(), Weight: math.LegacyOneDec(), Cap: math.ZeroInt()},
+	}
+	require.NoError(s.stakingKeeper.Params.Set(s.ctx, params))
+
+	epoch, validatorSet, err := s.stakingKeeper.FetchSymbioticValidatorSet(s.ctx, blockHash.String())
+	require.NoError(err)
+	require.Equal(uint64(2), epoch)
+
+	unpacked, err := stakingkeeper.UnpackSymbioticValidatorSets(validatorSet)
+	require.NoError(err)
+	require.Equal(expected, unpacked)
+
+	_, _, err = s.stakingKeeper.FetchSymbioticValidatorSet(s.ctx, common.BytesToHash([]byte{2}).String())
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestGetLastInjectedSymbioticData() {
+	require := s.Require()
+
+	_, found, err := s.stakingKeeper.GetLastInjectedSymbioticData(s.ctx)
+	require.NoError(err)
+	require.False(found)
+
+	require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(s.ctx, 10, stakingtypes.InjectedSymbioticData{BlockHash: "0x01"}))
+	require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(s.ctx, 20, stakingtypes.InjectedSymbioticData{BlockHash: "0x02"}))
+
+	data, found, err := s.stakingKeeper.GetLastInjectedSymbioticData(s.ctx)
+	require.NoError(err)
+	require.True(found)
+	require.Equal("0x02", data.BlockHash)
+}
+
+func (s *KeeperTestSuite) TestSetSymbioticSyncPointPrunes() {
+	require := s.Require()
+
+	heights := func() []int64 {
+		var heights []int64
+		require.NoError(s.stakingKeeper.SymbioticSyncPoints.Walk(s.ctx, nil, func(height int64, _ stakingtypes.SymbioticSyncPoint) (bool, error) {
+			heights = append(heights, height)
+			return false, nil
+		}))
+		return heights
+	}
+
+	require.NoError(s.stakingKeeper.SetSymbioticSyncPoint(s.ctx, stakingtypes.SymbioticSyncPoint{Height: 10, BlockHash: "0x01"}))
+	require.NoError(s.stakingKeeper.SetSymbioticSyncPoint(s.ctx, stakingtypes.SymbioticSyncPoint{Height: 20, BlockHash: "0x02"}))
+	require.Equal([]int64{20}, heights())
+
+	// the last applied sync point is kept while the syncs are skipped
+	require.NoError(s.stakingKeeper.SetSymbioticSyncPoint(s.ctx, stakingtypes.SymbioticSyncPoint{Height: 30, SkipReason: stakingtypes.SyncSkipReasonNoAgreement}))
+	require.NoError(s.stakingKeeper.SetSymbioticSyncPoint(s.ctx, stakingtypes.SymbioticSyncPoint{Height: 40, SkipReason: stakingtypes.SyncSkipReasonNoAgreement}))
+	require.Equal([]int64{20, 40}, heights())
+
+	require.NoError(s.stakingKeeper.SetSymbioticSyncPoint(s.ctx, stakingtypes.SymbioticSyncPoint{Height: 50, BlockHash: "0x05"}))
+	require.Equal([]int64{50}, heights())
+}
+
+func (s *KeeperTestSuite) TestMigrate9to10() {
+	require := s.Require()
+
+	store := s.ctx.KVStore(s.key)
+	cachedKey := append(stakingtypes.CachedBlockHashKey.Bytes(), 0x01)
+	store.Set(cachedKey, []byte("0x01"))
+
+	validatorSet := s.packValidatorSet(nil)
+	for height, blockHash := range map[int64]string{10: "0x01", 20: "0x02"} {
+		require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(s.ctx, height, stakingtypes.InjectedSymbioticData{
+			BlockHash:    blockHash,
+			ValidatorSet: validatorSet,
+		}))
+		require.NoError(s.stakingKeeper.SymbioticSyncPoints.Set(s.ctx, height, stakingtypes.SymbioticSyncPoint{Height: height, BlockHash: blockHash}))
+	}
+
+	rotation := stakingtypes.ConsPubKeyRotation{OperatorAddress: s.valAddressToString(PKs[0].Address())}
+	for _, height := range []int64{10, 20} {
+		require.NoError(s.stakingKeeper.ConsPubKeyRotations.Set(s.ctx, collections.Join(height, []byte(PKs[0].Address())), rotation))
+	}
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 20})
+	require.NoError(stakingkeeper.NewMigrator(s.stakingKeeper).Migrate9to10(ctx))
+	require.False(store.Has(cachedKey))
+
+	has, err := s.stakingKeeper.InjectedSymbioticData.Has(s.ctx, 10)
+	require.NoError(err)
+	require.False(has)
+	data, err := s.stakingKeeper.InjectedSymbioticData.Get(s.ctx, 20)
+	require.NoError(err)
+	require.Equal("0x02", data.BlockHash)
+	require.Empty(data.ValidatorSet)
+
+	has, err = s.stakingKeeper.SymbioticSyncPoints.Has(s.ctx, 10)
+	require.NoError(err)
+	require.False(has)
+	has, err = s.stakingKeeper.SymbioticSyncPoints.Has(s.ctx, 20)
+	require.NoError(err)
+	require.True(has)
+
+	// the rotations of the current block are still to be applied
+	has, err = s.stakingKeeper.ConsPubKeyRotations.Has(s.ctx, collections.Join(int64(10), []byte(PKs[0].Address())))
+	require.NoError(err)
+	require.False(has)
+	has, err = s.stakingKeeper.ConsPubKeyRotations.Has(s.ctx, collections.Join(int64(20), []byte(PKs[0].Address())))
+	require.NoError(err)
+	require.True(has)
+}
+
+func (s *KeeperTestSuite) TestSetGenesisSymbioticData() {
+	require := s.Require()
+
+	blockHash := s.source.AddHeader(&ethtypes.Header{Number: big.NewInt(7), Time: 1000})
+	set := s.addValidatorSetCalls(testMiddleware, blockHash, 1, []stakingtypes.SymbioticValidator{
+		{Stake: big.NewInt(10), ConsAddr: [32]byte{1}, Operator: common.HexToAddress("0x01")},
+	})
+	validatorSet, err := stakingkeeper.PackSymbioticValidatorSets([]stakingtypes.SymbioticValidatorSet{set})
+	require.NoError(err)
+
+	// no middleware configured
+	require.NoError(s.stakingKeeper.SetGenesisSymbioticData(s.ctx, blockHash.String()))
+	_, found, err := s.stakingKeeper.GetLastInjectedSymbioticData(s.ctx)
+	require.NoError(err)
+	require.False(found)
+
+	params, err := s.stakingKeeper.Params.Get(s.ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+	require.NoError(s.stakingKeeper.Params.Set(s.ctx, params))
+
+	require.NoError(s.stakingKeeper.SetGenesisSymbioticData(s.ctx, blockHash.String()))
+	injected, err := s.stakingKeeper.InjectedSymbioticData.Get(s.ctx, 0)
+	require.NoError(err)
+	require.Equal(blockHash.String(), injected.BlockHash)
+	require.Equal(uint64(7), injected.BlockNumber)
+	require.Equal(validatorSet, injected.ValidatorSet)
+	digest := sha256.Sum256(validatorSet)
+	require.Equal(digest[:], injected.ValidatorSetDigest)
+	require.Equal(uint64(1), injected.Epoch)
+}
+
+func (s *KeeperTestSuite) TestSymbioticUpdateValidatorsPowerRemovesMissingValidators() {
+	require := s.Require()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: s.ctx.HeaderInfo().Time})
+
+	params, err := s.stakingKeeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+	require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+
+	var validators []stakingtypes.Validator
+	for i := 0; i < 2; i++ {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address()), PKs[i])
+		require.NoError(s.stakingKeeper.SetValidator(ctx, validator))
+		require.NoError(s.stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+		validator, err = s.stakingKeeper.SetValidatorTokens(ctx, validator, s.stakingKeeper.TokensFromConsensusPower(ctx, 10))
+		require.NoError(err)
+		validators = append(validators, validator)
+	}
+
+	_, err = s.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+
+	// the second validator left the middleware validator set
+	var consAddr [32]byte
+	copy(consAddr[:], PKs[0].Address())
+	validatorSet := s.packValidatorSet([]stakingtypes.SymbioticValidator{
+		{Stake: s.stakingKeeper.TokensFromConsensusPower(ctx, 20).BigInt(), ConsAddr: consAddr},
+	})
+	require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+		BlockHash:    "0x01",
+		ValidatorSet: validatorSet,
+	}))
+
+	require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+
+	kept, err := s.stakingKeeper.GetValidator(ctx, sdk.ValAddress(PKs[0].Address()))
+	require.NoError(err)
+	require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 20), kept.Tokens)
+
+	removed, err := s.stakingKeeper.GetValidator(ctx, sdk.ValAddress(PKs[1].Address()))
+	require.NoError(err)
+	require.True(removed.Tokens.IsZero())
+
+	syncPoint, err := s.stakingKeeper.SymbioticSyncPoints.Get(ctx, stakingtypes.DefaultSymbioticSyncPeriod)
+	require.NoError(err)
+	require.Equal(uint32(1), syncPoint.ValidatorsUpdated)
+	require.Equal(uint32(1), syncPoint.ValidatorsRemoved)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != stakingtypes.EventTypeSymbioticValidatorRemoved {
+			continue
+		}
+		found = true
+		attr, ok := event.GetAttribute(stakingtypes.AttributeKeyValidator)
+		require.True(ok)
+		require.Equal(validators[1].GetOperator(), attr.Value)
+	}
+	require.True(found)
+
+	// the removed validator leaves the bonded set
+	updates, err := s.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+	require.Len(updates, 2)
+
+	removed, err = s.stakingKeeper.GetValidator(ctx, sdk.ValAddress(PKs[1].Address()))
+	require.NoError(err)
+	require.True(removed.IsUnbonding())
+
+	// a validator already at zero isn't removed again
+	require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+		BlockHash:    "0x01",
+		ValidatorSet: validatorSet,
+	}))
+	require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+	syncPoint, err = s.stakingKeeper.SymbioticSyncPoints.Get(ctx, stakingtypes.DefaultSymbioticSyncPeriod)
+	require.NoError(err)
+	require.Zero(syncPoint.ValidatorsRemoved)
+}
+
+func (s *KeeperTestSuite) TestSymbioticUpdateValidatorsPowerCreatesValidators() {
+	require := s.Require()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: s.ctx.HeaderInfo().Time})
+
+	params, err := s.stakingKeeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+	require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+
+	consKey := ed25519.GenPrivKey().PubKey()
+	var key [32]byte
+	copy(key[:], consKey.Bytes())
+
+	validatorSet := s.packValidatorSet([]stakingtypes.SymbioticValidator{
+		{Stake: s.stakingKeeper.TokensFromConsensusPower(ctx, 10).BigInt(), ConsAddr: key},
+	})
+	require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+		BlockHash:    "0x01",
+		ValidatorSet: validatorSet,
+	}))
+
+	// the key isn't a padded consensus address
+	require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+	_, err = s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(consKey.Address()))
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	params.SymbioticAutoCreateValidators = true
+	require.Error(params.Validate())
+	params.SymbioticKeyType = stakingtypes.SymbioticKeyTypeEd25519
+	require.NoError(params.Validate())
+	require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+
+	require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+		BlockHash:    "0x01",
+		ValidatorSet: validatorSet,
+	}))
+	require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err := s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(consKey.Address()))
+	require.NoError(err)
+	require.Equal(s.valAddressToString(consKey.Address()), validator.GetOperator())
+	require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 10), validator.Tokens)
+	require.Equal(math.LegacyOneDec(), validator.Commission.MaxRate)
+
+	syncPoint, err := s.stakingKeeper.SymbioticSyncPoints.Get(ctx, stakingtypes.DefaultSymbioticSyncPeriod)
+	require.NoError(err)
+	require.Equal(uint32(1), syncPoint.ValidatorsUpdated)
+
+	// the next sync updates the same validator
+	nextHeight := 2 * stakingtypes.DefaultSymbioticSyncPeriod
+	require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, nextHeight, stakingtypes.InjectedSymbioticData{
+		BlockHash:    "0x02",
+		ValidatorSet: validatorSet,
+	}))
+	require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx.WithHeaderInfo(header.Info{Height: nextHeight, Time: ctx.HeaderInfo().Time})))
+	validators, err := s.stakingKeeper.GetAllValidators(ctx)
+	require.NoError(err)
+	require.Len(validators, 1)
+
+	// only the last injected data is kept, without its validator set
+	has, err := s.stakingKeeper.InjectedSymbioticData.Has(ctx, stakingtypes.DefaultSymbioticSyncPeriod)
+	require.NoError(err)
+	require.False(has)
+	data, err := s.stakingKeeper.InjectedSymbioticData.Get(ctx, nextHeight)
+	require.NoError(err)
+	require.Equal("0x02", data.BlockHash)
+	require.Empty(data.ValidatorSet)
+
+	// the operator can edit the validator
+	rate := math.LegacyNewDecWithPrec(5, 2)
+	_, err = s.msgServer.EditValidator(ctx.WithHeaderInfo(header.Info{Time: ctx.HeaderInfo().Time.Add(48 * time.Hour)}), &stakingtypes.MsgEditValidator{
+		Description:      stakingtypes.Description{Moniker: "symbiotic"},
+		ValidatorAddress: validator.GetOperator(),
+		CommissionRate:   &rate,
+	})
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestSymbioticUpdateValidatorsPowerKeyTypes() {
+	require := s.Require()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: s.ctx.HeaderInfo().Time})
+
+	params, err := s.stakingKeeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+
+	edKey := ed25519.GenPrivKey().PubKey()
+	secpKey := secp256k1.GenPrivKey().PubKey()
+	pks := []cryptotypes.PubKey{edKey, secpKey}
+	for _, pk := range pks {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(pk.Address()), pk)
+		require.NoError(s.stakingKeeper.SetValidator(ctx, validator))
+		require.NoError(s.stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	}
+
+	var edSymbioticKey, secpSymbioticKey, paddedAddress, unpaddedAddress [32]byte
+	copy(edSymbioticKey[:], edKey.Bytes())
+	secpHash := sha256.Sum256(secpKey.Bytes())
+	copy(secpSymbioticKey[:], secpHash[:])
+	copy(paddedAddress[:], edKey.Address())
+	copy(unpaddedAddress[:], secpKey.Address())
+	unpaddedAddress[31] = 1
+
+	testCases := []struct {
+		name     string
+		keyType  string
+		entries  map[[32]byte]int64
+		dup      *[32]byte
+		expPower []int64
+	}{
+		{
+			name:     "address",
+			keyType:  stakingtypes.SymbioticKeyTypeAddress,
+			entries:  map[[32]byte]int64{paddedAddress: 1, unpaddedAddress: 2},
+			expPower: []int64{1, 0},
+		},
+		{
+			name:     "ed25519",
+			keyType:  stakingtypes.SymbioticKeyTypeEd25519,
+			entries:  map[[32]byte]int64{edSymbioticKey: 3, secpSymbioticKey: 4},
+			expPower: []int64{3, 0},
+		},
+		{
+			name:     "secp256k1",
+			keyType:  stakingtypes.SymbioticKeyTypeSecp256k1,
+			entries:  map[[32]byte]int64{edSymbioticKey: 5, secpSymbioticKey: 6},
+			expPower: []int64{0, 6},
+		},
+		{
+			name:     "duplicate key",
+			keyType:  stakingtypes.SymbioticKeyTypeSecp256k1,
+			entries:  map[[32]byte]int64{secpSymbioticKey: 7},
+			dup:      &secpSymbioticKey,
+			expPower: []int64{0, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			params.SymbioticKeyType = tc.keyType
+			require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+
+			var entries []stakingtypes.SymbioticValidator
+			for key, power := range tc.entries {
+				entries = append(entries, stakingtypes.SymbioticValidator{Stake: s.stakingKeeper.TokensFromConsensusPower(ctx, power).BigInt(), ConsAddr: key})
+			}
+			if tc.dup != nil {
+				entries = append(entries, stakingtypes.SymbioticValidator{Stake: big.NewInt(1), ConsAddr: *tc.dup})
+			}
+
+			validatorSet := s.packValidatorSet(entries)
+			require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+				BlockHash:    "0x01",
+				ValidatorSet: validatorSet,
+			}))
+
+			require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+
+			for i, pk := range pks {
+				validator, err := s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(pk.Address()))
+				require.NoError(err)
+				require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, tc.expPower[i]), validator.Tokens)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSymbioticUpdateValidatorsPowerRotatesConsPubKey() {
+	require := s.Require()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: s.ctx.HeaderInfo().Time})
+
+	params, err := s.stakingKeeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+	params.SymbioticKeyType = stakingtypes.SymbioticKeyTypeEd25519
+	require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+
+	oldPk := ed25519.GenPrivKey().PubKey()
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(oldPk.Address()), oldPk)
+	validator.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2))
+	require.NoError(s.stakingKeeper.SetValidator(ctx, validator))
+	require.NoError(s.stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	validator, err = s.stakingKeeper.SetValidatorTokens(ctx, validator, s.stakingKeeper.TokensFromConsensusPower(ctx, 10))
+	require.NoError(err)
+
+	_, err = s.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+
+	operator := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	sync := func(pk cryptotypes.PubKey, power int64) {
+		var key [32]byte
+		copy(key[:], pk.Bytes())
+		validatorSet := s.packValidatorSet([]stakingtypes.SymbioticValidator{
+			{Stake: s.stakingKeeper.TokensFromConsensusPower(ctx, power).BigInt(), ConsAddr: key, Operator: operator},
+		})
+		require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+			BlockHash:    "0x01",
+			ValidatorSet: validatorSet,
+		}))
+		require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+	}
+
+	// the first sync binds the validator to the operator of its key
+	sync(oldPk, 10)
+	validator, err = s.stakingKeeper.GetValidator(ctx, sdk.ValAddress(oldPk.Address()))
+	require.NoError(err)
+	require.Equal(operator.Hex(), validator.SymbioticOperator)
+
+	// the operator updated its key
+	newPk := ed25519.GenPrivKey().PubKey()
+	sync(newPk, 20)
+
+	validator, err = s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(newPk.Address()))
+	require.NoError(err)
+	require.Equal(s.valAddressToString(oldPk.Address()), validator.GetOperator())
+	require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 20), validator.Tokens)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+	consPk, err := validator.ConsPubKey()
+	require.NoError(err)
+	require.True(newPk.Equals(consPk))
+
+	// the old consensus address still resolves the validator for slashing
+	old, err := s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(oldPk.Address()))
+	require.NoError(err)
+	require.Equal(validator.GetOperator(), old.GetOperator())
+
+	syncPoint, err := s.stakingKeeper.SymbioticSyncPoints.Get(ctx, stakingtypes.DefaultSymbioticSyncPeriod)
+	require.NoError(err)
+	require.Equal(uint32(1), syncPoint.ValidatorsRotated)
+	require.Equal(uint32(1), syncPoint.ValidatorsUpdated)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != stakingtypes.EventTypeRotateConsPubKey {
+			continue
+		}
+		found = true
+		attr, ok := event.GetAttribute(stakingtypes.AttributeKeyNewConsAddress)
+		require.True(ok)
+		require.Equal(sdk.ConsAddress(newPk.Address()).String(), attr.Value)
+	}
+	require.True(found)
+
+	// CometBFT removes the old key and adds the new one
+	updates, err := s.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+	require.Len(updates, 2)
+	require.Equal(oldPk.Bytes(), updates[0].PubKey)
+	require.Zero(updates[0].Power)
+	require.Equal(newPk.Bytes(), updates[1].PubKey)
+	require.Equal(int64(20), updates[1].Power)
+
+	// the rotation is removed once applied
+	rotations, err := s.stakingKeeper.ConsPubKeyRotations.Iterate(ctx, nil)
+	require.NoError(err)
+	defer rotations.Close()
+	require.False(rotations.Valid())
+}
+
+func (s *KeeperTestSuite) TestSymbioticUpdateValidatorsPowerCombinesMiddlewares() {
+	require := s.Require()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: s.ctx.HeaderInfo().Time})
+	tokens := func(power int64) math.Int { return s.stakingKeeper.TokensFromConsensusPower(ctx, power) }
+
+	otherMiddleware := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	params, err := s.stakingKeeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewares = []stakingtypes.SymbioticMiddleware{
+		{Address: testMiddleware.Hex(), Weight: math.LegacyOneDec(), Cap: math.ZeroInt()},
+		{Address: otherMiddleware.Hex(), Weight: math.LegacyNewDecWithPrec(5, 1), Cap: tokens(3)},
+	}
+	require.NoError(params.Validate())
+	require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+
+	var keys [2][32]byte
+	for i := range keys {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address()), PKs[i])
+		require.NoError(s.stakingKeeper.SetValidator(ctx, validator))
+		require.NoError(s.stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+		copy(keys[i][:], PKs[i].Address())
+	}
+
+	validatorSet, err := stakingkeeper.PackSymbioticValidatorSets([]stakingtypes.SymbioticValidatorSet{
+		{Middleware: testMiddleware, Epoch: 1, Validators: []stakingtypes.SymbioticValidator{
+			{Stake: tokens(10).BigInt(), ConsAddr: keys[0]},
+			{Stake: tokens(4).BigInt(), ConsAddr: keys[1]},
+		}},
+		{Middleware: otherMiddleware, Epoch: 7, Validators: []stakingtypes.SymbioticValidator{
+			{Stake: tokens(10).BigInt(), ConsAddr: keys[0]},
+			{Stake: tokens(2).BigInt(), ConsAddr: keys[1]},
+			{Stake: tokens(2).BigInt(), ConsAddr: keys[1]},
+		}},
+	})
+	require.NoError(err)
+	require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+		BlockHash:    "0x01",
+		ValidatorSet: validatorSet,
+	}))
+
+	require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+
+	// the stake in the second middleware is halved and capped, and a key listed
+	// twice there only counts in the first middleware
+	expected := []struct {
+		tokens math.Int
+		stakes []stakingtypes.SymbioticMiddlewareStake
+	}{
+		{
+			tokens: tokens(13),
+			stakes: []stakingtypes.SymbioticMiddlewareStake{
+				{MiddlewareAddress: testMiddleware.Hex(), Stake: tokens(10), WeightedStake: tokens(10), Epoch: 1},
+				{MiddlewareAddress: otherMiddleware.Hex(), Stake: tokens(10), WeightedStake: tokens(3), Epoch: 7},
+			},
+		},
+		{
+			tokens: tokens(4),
+			stakes: []stakingtypes.SymbioticMiddlewareStake{
+				{MiddlewareAddress: testMiddleware.Hex(), Stake: tokens(4), WeightedStake: tokens(4), Epoch: 1},
+			},
+		},
+	}
+	for i, exp := range expected {
+		validator, err := s.stakingKeeper.GetValidator(ctx, sdk.ValAddress(PKs[i].Address()))
+		require.NoError(err)
+		require.Equal(exp.tokens, validator.Tokens)
+
+		res, err := s.queryClient.ValidatorSymbioticStakes(ctx, &stakingtypes.QueryValidatorSymbioticStakesRequest{ValidatorAddr: validator.GetOperator()})
+		require.NoError(err)
+		require.Equal(exp.stakes, res.Stakes)
+	}
+
+	// the breakdown of a validator missing from every middleware is dropped
+	validatorSet = s.packValidatorSet([]stakingtypes.SymbioticValidator{{Stake: tokens(1).BigInt(), ConsAddr: keys[0]}})
+	require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+		BlockHash:    "0x02",
+		ValidatorSet: validatorSet,
+	}))
+	require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+
+	res, err := s.queryClient.ValidatorSymbioticStakes(ctx, &stakingtypes.QueryValidatorSymbioticStakesRequest{ValidatorAddr: s.valAddressToString(PKs[1].Address())})
+	require.NoError(err)
+	require.Empty(res.Stakes)
+}
+
+func (s *KeeperTestSuite) TestSymbioticUpdateValidatorsPowerPolicy() {
+	require := s.Require()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: s.ctx.HeaderInfo().Time})
+	tokens := func(power int64) math.Int { return s.stakingKeeper.TokensFromConsensusPower(ctx, power) }
+
+	params, err := s.stakingKeeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+	params.SymbioticMaxPowerShare = math.LegacyNewDecWithPrec(5, 1)
+	params.SymbioticMaxPowerChange = math.LegacyNewDecWithPrec(5, 1)
+	params.SymbioticMinStake = tokens(2)
+	require.NoError(params.Validate())
+	require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+
+	var keys [3][32]byte
+	for i := range keys {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address()), PKs[i])
+		require.NoError(s.stakingKeeper.SetValidator(ctx, validator))
+		require.NoError(s.stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+		require.NoError(s.stakingKeeper.SetNewValidatorByPowerIndex(ctx, validator))
+		copy(keys[i][:], PKs[i].Address())
+	}
+
+	// sync syncs the stakes, listed in reverse order if reversed, and returns
+	// the resulting CometBFT power updates
+	reversed := false
+	sync := func(stakes ...int64) map[string]int64 {
+		var validators []stakingtypes.SymbioticValidator
+		for i, stake := range stakes {
+			validators = append(validators, stakingtypes.SymbioticValidator{Stake: tokens(stake).BigInt(), ConsAddr: keys[i]})
+		}
+		if reversed {
+			slices.Reverse(validators)
+		}
+		require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+			BlockHash:    "0x01",
+			ValidatorSet: s.packValidatorSet(validators),
+		}))
+		require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+
+		updates, err := s.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		require.NoError(err)
+
+		powers := make(map[string]int64, len(updates))
+		for _, update := range updates {
+			powers[string(update.PubKey)] = update.Power
+		}
+		return powers
+	}
+	key := func(i int) string { return string(PKs[i].Bytes()) }
+
+	// the stake below the min stake gives no power, and the validators without
+	// tokens get their stake at once
+	require.Equal(map[string]int64{key(0): 10, key(1): 10}, sync(10, 10, 1))
+
+	// the whale deposit is smoothed to +50% and capped to half of the power,
+	// and the third validator reaching the min stake enters at once
+	require.Equal(map[string]int64{key(0): 14, key(2): 4}, sync(100, 10, 4))
+
+	// the cap still binds while the smoothed power grows
+	require.Empty(sync(100, 10, 4))
+
+	// the withdrawal is smoothed to -50%, while falling below the min stake
+	// removes the power at once, leaving two validators capped to half of the
+	// power each
+	require.Equal(map[string]int64{key(0): 7}, sync(2, 10, 4))
+	require.Equal(map[string]int64{key(0): 0, key(1): 4}, sync(1, 10, 4))
+
+	// the outcome doesn't depend on the order of the validator set
+	reversed = true
+	require.Empty(sync(1, 10, 4))
+}
+
+func (s *KeeperTestSuite) TestSymbioticUpdateValidatorsPowerStalePolicy() {
+	var validators []stakingtypes.SymbioticValidator
+	for i := 0; i < 2; i++ {
+		var consAddr [32]byte
+		copy(consAddr[:], PKs[i].Address())
+		validators = append(validators, stakingtypes.SymbioticValidator{Stake: big.NewInt(10_000_000), ConsAddr: consAddr})
+	}
+
+	// setup returns a context at the sync height and two validators of 10
+	// tokens, with the validator set stale after 2 skipped syncs
+	setup := func(policy string) sdk.Context {
+		s.SetupTest()
+		require := s.Require()
+		ctx := s.ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: s.ctx.HeaderInfo().Time})
+
+		params, err := s.stakingKeeper.Params.Get(ctx)
+		require.NoError(err)
+		params.SymbioticMiddlewareAddress = testMiddleware.Hex()
+		params.SymbioticMaxStaleSyncs = 2
+		params.SymbioticStalePolicy = policy
+		params.SymbioticStaleDecay = math.LegacyNewDecWithPrec(5, 1)
+		params.SymbioticHaltMsgTypeUrls = []string{sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{})}
+		require.NoError(params.Validate())
+		require.NoError(s.stakingKeeper.Params.Set(ctx, params))
+
+		for i := range validators {
+			validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address()), PKs[i])
+			require.NoError(s.stakingKeeper.SetValidator(ctx, validator))
+			require.NoError(s.stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+			_, err = s.stakingKeeper.SetValidatorTokens(ctx, validator, s.stakingKeeper.TokensFromConsensusPower(ctx, 10))
+			require.NoError(err)
+		}
+
+		return ctx
+	}
+
+	// skip runs the skipped syncs and returns the stale policy of the last one
+	skip := func(ctx sdk.Context, syncs int) string {
+		var policy string
+		for i := 0; i < syncs; i++ {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			s.Require().NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+
+			policy = ""
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == stakingtypes.EventTypeSymbioticSyncStale {
+					attr, ok := event.GetAttribute(stakingtypes.AttributeKeyStalePolicy)
+					s.Require().True(ok)
+					policy = attr.Value
+				}
+			}
+		}
+		return policy
+	}
+
+	tokens := func(ctx sdk.Context, i int) math.Int {
+		validator, err := s.stakingKeeper.GetValidator(ctx, sdk.ValAddress(PKs[i].Address()))
+		s.Require().NoError(err)
+		return validator.Tokens
+	}
+
+	s.Run("keep", func() {
+		require := s.Require()
+		ctx := setup(stakingtypes.SymbioticStalePolicyKeep)
+
+		require.Equal(stakingtypes.SymbioticStalePolicyKeep, skip(ctx, 3))
+		require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 10), tokens(ctx, 0))
+
+		staleness, err := s.stakingKeeper.SymbioticStaleness.Get(ctx)
+		require.NoError(err)
+		require.Equal(stakingtypes.SymbioticStaleness{SinceHeight: stakingtypes.DefaultSymbioticSyncPeriod, SkippedSyncs: 3}, staleness)
+
+		// an applied sync clears the staleness
+		require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+			BlockHash:    "0x01",
+			ValidatorSet: s.packValidatorSet(validators),
+		}))
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+
+		has, err := s.stakingKeeper.SymbioticStaleness.Has(ctx)
+		require.NoError(err)
+		require.False(has)
+		require.True(slices.ContainsFunc(ctx.EventManager().Events(), func(event sdk.Event) bool {
+			return event.Type == stakingtypes.EventTypeSymbioticSyncRecovered
+		}))
+	})
+
+	s.Run("decay", func() {
+		require := s.Require()
+		ctx := setup(stakingtypes.SymbioticStalePolicyDecay)
+
+		require.Equal(stakingtypes.SymbioticStalePolicyKeep, skip(ctx, 2))
+		require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 10), tokens(ctx, 1))
+
+		require.Equal(stakingtypes.SymbioticStalePolicyDecay, skip(ctx, 1))
+		require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 5), tokens(ctx, 1))
+
+		skip(ctx, 1)
+		require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 10).QuoRaw(4), tokens(ctx, 1))
+	})
+
+	s.Run("halt", func() {
+		require := s.Require()
+		ctx := setup(stakingtypes.SymbioticStalePolicyHalt)
+
+		// the policy can't be set without a circuit keeper
+		params, err := s.stakingKeeper.Params.Get(ctx)
+		require.NoError(err)
+		msg := &stakingtypes.MsgUpdateParams{Authority: s.stakingKeeper.GetAuthority(), Params: params}
+		_, err = s.msgServer.UpdateParams(ctx, msg)
+		require.ErrorIs(err, stakingtypes.ErrSymbioticNoCircuit)
+
+		circuitKeeper := testutil.NewMockCircuitKeeper(gomock.NewController(s.T()))
+		s.stakingKeeper.SetCircuitKeeper(circuitKeeper)
+		_, err = s.msgServer.UpdateParams(ctx, msg)
+		require.NoError(err)
+
+		require.Equal(stakingtypes.SymbioticStalePolicyKeep, skip(ctx, 2))
+
+		circuitKeeper.EXPECT().DisableMsgs(gomock.Any(), sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{})).Return(nil)
+		require.Equal(stakingtypes.SymbioticStalePolicyHalt, skip(ctx, 1))
+	})
+
+	s.Run("manual", func() {
+		require := s.Require()
+		ctx := setup(stakingtypes.SymbioticStalePolicyManual)
+
+		msg := &stakingtypes.MsgUpdateSymbioticValidatorSet{
+			Authority: s.stakingKeeper.GetAuthority(),
+			Validators: []stakingtypes.SymbioticValidatorTokens{{
+				ValidatorAddress: sdk.ValAddress(PKs[0].Address()).String(),
+				Tokens:           s.stakingKeeper.TokensFromConsensusPower(ctx, 7),
+			}},
+		}
+
+		// the validator set can only be updated once stale
+		_, err := s.msgServer.UpdateSymbioticValidatorSet(ctx, msg)
+		require.ErrorIs(err, stakingtypes.ErrSymbioticNotStale)
+
+		require.Equal(stakingtypes.SymbioticStalePolicyManual, skip(ctx, 3))
+
+		// the syncs are suspended once stale
+		require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+			BlockHash:    "0x01",
+			ValidatorSet: s.packValidatorSet(validators),
+		}))
+		require.Equal(stakingtypes.SymbioticStalePolicyManual, skip(ctx, 1))
+
+		syncPoint, err := s.stakingKeeper.SymbioticSyncPoints.Get(ctx, stakingtypes.DefaultSymbioticSyncPeriod)
+		require.NoError(err)
+		require.Equal(stakingtypes.SyncSkipReasonManualUpdateRequired, syncPoint.SkipReason)
+
+		invalid := *msg
+		invalid.Authority = sdk.AccAddress(PKs[0].Address()).String()
+		_, err = s.msgServer.UpdateSymbioticValidatorSet(ctx, &invalid)
+		require.ErrorIs(err, stakingtypes.ErrInvalidSigner)
+
+		invalid = *msg
+		invalid.Validators = append(invalid.Validators, invalid.Validators[0])
+		_, err = s.msgServer.UpdateSymbioticValidatorSet(ctx, &invalid)
+		require.ErrorContains(err, "duplicate validator")
+
+		_, err = s.msgServer.UpdateSymbioticValidatorSet(ctx, msg)
+		require.NoError(err)
+		require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 7), tokens(ctx, 0))
+		require.True(tokens(ctx, 1).IsZero())
+
+		// the syncs resume
+		has, err := s.stakingKeeper.SymbioticStaleness.Has(ctx)
+		require.NoError(err)
+		require.False(has)
+
+		require.NoError(s.stakingKeeper.InjectedSymbioticData.Set(ctx, stakingtypes.DefaultSymbioticSyncPeriod, stakingtypes.InjectedSymbioticData{
+			BlockHash:    "0x01",
+			ValidatorSet: s.packValidatorSet(validators),
+		}))
+		require.NoError(s.stakingKeeper.SymbioticUpdateValidatorsPower(ctx))
+		require.Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 10), tokens(ctx, 1))
+	})
+}
@@ -6,15 +6,19 @@ import (
 	"errors"
 	"fmt"
 	gogotypes "github.com/cosmos/gogoproto/types"
-	"os"
+	"slices"
 	"sort"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	types "cosmossdk.io/x/symStaking/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // BlockValidatorUpdates calculates the ValidatorUpdates for the current block
@@ -23,8 +27,7 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]appmodule.Validato
 	// Calculate validator set changes.
 	//
 	if err := k.SymbioticUpdateValidatorsPower(ctx); err != nil {
-		k.Logger.Error("Symbiotic val update panic", "err", err)
-		os.Exit(0) // TODO somehow fix, just to restart on failure, panic will be recovered
+		return nil, err
 	}
 	// NOTE: ApplyAndReturnValidatorSetUpdates has to come before
 	// UnbondAllMatureValidatorQueue.
@@ -38,6 +41,11 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]appmodule.Validato
 		return nil, err
 	}
 
+	// checkpoint the bonded validators once the sync is applied
+	if err := k.createSymbioticCheckpoint(ctx); err != nil {
+		return nil, err
+	}
+
 	// unbond all mature validators from the unbonding queue
 	err = k.UnbondAllMatureValidators(ctx)
 	if err != nil {
@@ -76,6 +84,12 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 		return nil, err
 	}
 
+	// the bonded validators that rotated their consensus pubkey in this block
+	rotations, err := k.getBlockConsPubKeyRotations(ctx, last)
+	if err != nil {
+		return nil, err
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator, err := k.ValidatorsPowerStoreIterator(ctx)
 	if err != nil {
@@ -169,6 +183,11 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 		updates = append(updates, validator.ModuleValidatorUpdateZero())
 	}
 
+	updates, err = k.applyConsPubKeyRotations(ctx, rotations, updates)
+	if err != nil {
+		return nil, err
+	}
+
 	// set total power on lookup index if there are any updates
 	if len(updates) > 0 {
 		if err = k.LastTotalPower.Set(ctx, totalPower); err != nil {
@@ -179,6 +198,82 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 	return updates, err
 }
 
+// getBlockConsPubKeyRotations returns the consensus pubkey rotations of the
+// current block of the validators in last.
+func (k Keeper) getBlockConsPubKeyRotations(ctx context.Context, last validatorsByAddr) ([]types.ConsPubKeyRotation, error) {
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	iterator, err := k.ConsPubKeyRotations.Iterate(ctx, collections.NewPrefixedPairRange[int64, []byte](height))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var rotations []types.ConsPubKeyRotation
+	for ; iterator.Valid(); iterator.Next() {
+		rotation, err := iterator.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, found := last[rotation.OperatorAddress]; found {
+			rotations = append(rotations, rotation)
+		}
+	}
+
+	return rotations, nil
+}
+
+// applyConsPubKeyRotations replaces the validators under their old consensus
+// pubkey in CometBFT by the validators under their new one, if still bonded.
+// The updates computed for the new pubkeys are superseded, as CometBFT doesn't
+// know them yet. The rotations of the block are then removed, as they aren't
+// read again.
+func (k Keeper) applyConsPubKeyRotations(ctx context.Context, rotations []types.ConsPubKeyRotation, updates []appmodule.ValidatorUpdate) ([]appmodule.ValidatorUpdate, error) {
+	powerReduction := k.PowerReduction(ctx)
+	for _, rotation := range rotations {
+		oldPk, ok := rotation.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", rotation.OldConsPubkey.GetCachedValue())
+		}
+
+		newPk, ok := rotation.NewConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", rotation.NewConsPubkey.GetCachedValue())
+		}
+
+		updates = slices.DeleteFunc(updates, func(update appmodule.ValidatorUpdate) bool {
+			return bytes.Equal(update.PubKey, newPk.Bytes())
+		})
+
+		updates = append(updates, appmodule.ValidatorUpdate{
+			PubKey:     oldPk.Bytes(),
+			PubKeyType: oldPk.Type(),
+			Power:      0,
+		})
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(rotation.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		if validator.IsBonded() {
+			updates = append(updates, validator.ModuleValidatorUpdate(powerReduction))
+		}
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if err := k.ConsPubKeyRotations.Clear(ctx, collections.NewPrefixedPairRange[int64, []byte](height)); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
+
 // Validator state transitions
 
 func (k Keeper) bondedToUnbonding(ctx context.Context, validator types.Validator) (types.Validator, error) {
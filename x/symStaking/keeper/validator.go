@@ -8,6 +8,7 @@ import (
 	"time"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"cosmossdk.io/collections"
 	corestore "cosmossdk.io/core/store"
@@ -38,10 +39,15 @@ func (k Keeper) GetValidator(ctx context.Context, addr sdk.ValAddress) (validato
 func (k Keeper) GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (validator types.Validator, err error) {
 	opAddr, err := k.ValidatorByConsensusAddress.Get(ctx, consAddr)
 	if err != nil {
-		// if the validator not found try to find it in the map of `OldToNewConsAddrMap` because validator may've rotated it's key.
+		// if the validator not found try to find it in the map of `ValidatorByOldConsensusAddress` because validator may've rotated it's key.
 		if !errors.Is(err, collections.ErrNotFound) {
 			return types.Validator{}, err
 		}
+
+		opAddr, err = k.ValidatorByOldConsensusAddress.Get(ctx, consAddr)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return types.Validator{}, err
+		}
 	}
 
 	if opAddr == nil {
@@ -51,6 +57,16 @@ func (k Keeper) GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAdd
 	return k.GetValidator(ctx, opAddr)
 }
 
+// GetValidatorSymbioticStakes returns the stake of a validator in every
+// Symbiotic middleware listing it at the last sync.
+func (k Keeper) GetValidatorSymbioticStakes(ctx context.Context, addr sdk.ValAddress) ([]types.SymbioticMiddlewareStake, error) {
+	stakes, err := k.SymbioticStakes.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	return stakes.Stakes, nil
+}
+
 // SetValidator sets the main record holding validator details
 func (k Keeper) SetValidator(ctx context.Context, validator types.Validator) error {
 	valBz, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
@@ -71,7 +87,47 @@ func (k Keeper) SetValidatorByConsAddr(ctx context.Context, validator types.Vali
 	if err != nil {
 		return err
 	}
-	return k.ValidatorByConsensusAddress.Set(ctx, consPk, bz)
+
+	if err := k.ValidatorByConsensusAddress.Set(ctx, consPk, bz); err != nil {
+		return err
+	}
+
+	return k.SetValidatorBySymbioticKey(ctx, validator)
+}
+
+// SetValidatorBySymbioticKey indexes a validator by the full key identifying
+// its consensus pubkey in the Symbiotic middleware, if its type has one.
+func (k Keeper) SetValidatorBySymbioticKey(ctx context.Context, validator types.Validator) error {
+	pk, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	key, ok := types.SymbioticKey(pk)
+	if !ok {
+		return nil
+	}
+
+	return k.ValidatorBySymbioticKey.Set(ctx, key, sdk.ConsAddress(pk.Address()))
+}
+
+// SetValidatorBySymbioticOperator indexes a validator by the Ethereum address
+// of its Symbiotic operator, if known.
+func (k Keeper) SetValidatorBySymbioticOperator(ctx context.Context, validator types.Validator) error {
+	if validator.SymbioticOperator == "" {
+		return nil
+	}
+
+	if !common.IsHexAddress(validator.SymbioticOperator) {
+		return fmt.Errorf("invalid symbiotic operator %q", validator.SymbioticOperator)
+	}
+
+	bz, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	return k.ValidatorBySymbioticOperator.Set(ctx, common.HexToAddress(validator.SymbioticOperator).Bytes(), bz)
 }
 
 // SetValidatorByPowerIndex sets a validator by power index
@@ -226,6 +282,27 @@ func (k Keeper) RemoveValidator(ctx context.Context, address sdk.ValAddress) err
 		return err
 	}
 
+	pk, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	if key, ok := types.SymbioticKey(pk); ok {
+		if err = k.ValidatorBySymbioticKey.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	if validator.SymbioticOperator != "" {
+		if err = k.ValidatorBySymbioticOperator.Remove(ctx, common.HexToAddress(validator.SymbioticOperator).Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if err = k.SymbioticStakes.Remove(ctx, address); err != nil {
+		return err
+	}
+
 	if err = store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx), k.validatorAddressCodec)); err != nil {
 		return err
 	}
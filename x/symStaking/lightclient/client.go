@@ -0,0 +1,358 @@
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/beacon/params"
+	beacontypes "github.com/ethereum/go-ethereum/beacon/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	// SyncInterval is the delay between two syncs of the light client.
+	SyncInterval = 12 * time.Second
+	// MaxUpdatesPerRequest is the number of updates requested at once, as
+	// limited by the beacon API.
+	MaxUpdatesPerRequest = 128
+	// HistorySize is the number of finalized headers kept by the light client.
+	HistorySize = 1024
+)
+
+// ErrNotBootstrapped is returned by Sync when the light client can't be
+// bootstrapped from its checkpoint.
+var ErrNotBootstrapped = errors.New("light client not bootstrapped")
+
+// API defines the beacon API light client endpoints.
+type API interface {
+	// LightClientBootstrap returns the bootstrap of the given beacon block root.
+	LightClientBootstrap(ctx context.Context, blockRoot common.Hash) (Bootstrap, error)
+	// LightClientUpdates returns the best updates of count periods from startPeriod.
+	LightClientUpdates(ctx context.Context, startPeriod, count uint64) ([]Update, error)
+	// LightClientFinalityUpdate returns the latest finality update.
+	LightClientFinalityUpdate(ctx context.Context) (Update, error)
+}
+
+// committee is a sync committee along with its deserialized pubkeys.
+type committee struct {
+	serialized *SyncCommittee
+	keys       *beacontypes.SyncCommittee
+}
+
+func newCommittee(serialized *SyncCommittee) (*committee, error) {
+	keys, err := serialized.Deserialize()
+	if err != nil {
+		return nil, fmt.Errorf("invalid sync committee: %w", err)
+	}
+	return &committee{serialized: serialized, keys: keys}, nil
+}
+
+// LightClient is an Ethereum beacon chain sync committee light client. It
+// bootstraps from a trusted beacon block root, follows the sync committee
+// through light client updates and verifies the finality updates, so that the
+// finalized execution headers it offers don't depend on the honesty of the
+// beacon API.
+//
+// Only updates signed by a supermajority of the sync committee are applied.
+type LightClient struct {
+	logger     log.Logger
+	api        API
+	config     NetworkConfig
+	checkpoint common.Hash
+
+	mu        sync.RWMutex
+	current   *committee
+	next      *committee
+	finalized *LightClientHeader
+	// history holds the execution headers of the finalized beacon blocks, by slot
+	history map[uint64]ExecutionHeader
+}
+
+// NewLightClient creates a light client of the network described by config,
+// bootstrapped from the trusted checkpoint beacon block root on first sync.
+func NewLightClient(logger log.Logger, api API, config NetworkConfig, checkpoint common.Hash) *LightClient {
+	return &LightClient{
+		logger:     logger,
+		api:        api,
+		config:     config,
+		checkpoint: checkpoint,
+		history:    make(map[uint64]ExecutionHeader),
+	}
+}
+
+// Run syncs the light client every SyncInterval until ctx is done.
+func (c *LightClient) Run(ctx context.Context) {
+	ticker := time.NewTicker(SyncInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Sync(ctx); err != nil {
+			c.logger.Error("light client sync error", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync bootstraps the light client if needed, catches up with the sync
+// committee periods and applies the latest finality update.
+func (c *LightClient) Sync(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.finalized == nil {
+		bootstrap, err := c.api.LightClientBootstrap(ctx, c.checkpoint)
+		if err != nil {
+			return errors.Join(ErrNotBootstrapped, err)
+		}
+
+		if err := c.bootstrap(bootstrap); err != nil {
+			return errors.Join(ErrNotBootstrapped, err)
+		}
+	}
+
+	finalityUpdate, err := c.api.LightClientFinalityUpdate(ctx)
+	if err != nil {
+		return err
+	}
+
+	// catch up with the period of the finality update, fetching the next sync
+	// committee of the current period if unknown
+	target := syncPeriod(finalityUpdate.SignatureSlot)
+	for c.period() < target || c.next == nil {
+		start, knowsNext := c.period(), c.next != nil
+		count := target - start + 1
+		if count > MaxUpdatesPerRequest {
+			count = MaxUpdatesPerRequest
+		}
+
+		updates, err := c.api.LightClientUpdates(ctx, start, count)
+		if err != nil {
+			return err
+		}
+
+		for i := range updates {
+			if err := c.processUpdate(&updates[i]); err != nil {
+				return fmt.Errorf("invalid light client update: %w", err)
+			}
+		}
+
+		// the updates didn't move the store forward, e.g. they aren't available yet
+		if c.period() == start && (c.next != nil) == knowsNext {
+			break
+		}
+	}
+
+	if finalityUpdate.FinalizedHeader.Beacon.Slot <= c.finalized.Beacon.Slot {
+		return nil
+	}
+
+	if err := c.processUpdate(&finalityUpdate); err != nil {
+		return fmt.Errorf("invalid light client finality update: %w", err)
+	}
+
+	return nil
+}
+
+// FinalizedHeader returns the verified execution header of the finalized beacon
+// block at slot, if known, along with the slot of the latest finalized block.
+func (c *LightClient) FinalizedHeader(slot uint64) (ExecutionHeader, uint64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.finalized == nil {
+		return ExecutionHeader{}, 0, false
+	}
+
+	header, ok := c.history[slot]
+	return header, c.finalized.Beacon.Slot, ok
+}
+
+// FinalizedHeaderByHash returns the verified execution header of the finalized
+// block with the given hash, if known.
+func (c *LightClient) FinalizedHeaderByHash(blockHash common.Hash) (ExecutionHeader, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, header := range c.history {
+		if header.BlockHash == blockHash {
+			return header, true
+		}
+	}
+
+	return ExecutionHeader{}, false
+}
+
+// period returns the sync committee period of the store.
+func (c *LightClient) period() uint64 {
+	return syncPeriod(c.finalized.Beacon.Slot)
+}
+
+// bootstrap initializes the store from the bootstrap of the checkpoint.
+func (c *LightClient) bootstrap(bootstrap Bootstrap) error {
+	header := bootstrap.Header
+	if root := header.Beacon.Root(); root != c.checkpoint {
+		return fmt.Errorf("bootstrap header root %s doesn't match checkpoint %s", root, c.checkpoint)
+	}
+
+	if err := c.verifyHeader(header); err != nil {
+		return err
+	}
+
+	if bootstrap.CurrentSyncCommittee == nil {
+		return errors.New("missing current sync committee")
+	}
+
+	epoch := header.Beacon.Slot / slotsPerEpoch
+	gindex := c.config.currentSyncCommitteeGindex(epoch)
+	if err := verifyProof(header.Beacon.StateRoot, gindex, bootstrap.CurrentSyncCommitteeBranch, bootstrap.CurrentSyncCommittee.Root()); err != nil {
+		return fmt.Errorf("invalid current sync committee proof: %w", err)
+	}
+
+	current, err := newCommittee(bootstrap.CurrentSyncCommittee)
+	if err != nil {
+		return err
+	}
+
+	c.current = current
+	c.setFinalized(header)
+	return nil
+}
+
+// processUpdate validates the update against the store and applies it.
+func (c *LightClient) processUpdate(update *Update) error {
+	attested, finalized := update.AttestedHeader, update.FinalizedHeader
+
+	if signers := update.SyncAggregate.SignerCount(); signers < params.SyncCommitteeSupermajority {
+		return fmt.Errorf("insufficient sync committee participation: %d", signers)
+	}
+
+	if !(update.SignatureSlot > attested.Beacon.Slot && attested.Beacon.Slot >= finalized.Beacon.Slot) {
+		return fmt.Errorf("invalid slots: signature %d, attested %d, finalized %d", update.SignatureSlot, attested.Beacon.Slot, finalized.Beacon.Slot)
+	}
+
+	storePeriod := c.period()
+	signaturePeriod := syncPeriod(update.SignatureSlot)
+
+	var signers *committee
+	switch {
+	case signaturePeriod == storePeriod:
+		signers = c.current
+	case signaturePeriod == storePeriod+1 && c.next != nil:
+		signers = c.next
+	default:
+		return fmt.Errorf("signature period %d doesn't follow the store period %d", signaturePeriod, storePeriod)
+	}
+
+	if err := c.verifyHeader(attested); err != nil {
+		return fmt.Errorf("attested header: %w", err)
+	}
+
+	if err := c.verifyHeader(finalized); err != nil {
+		return fmt.Errorf("finalized header: %w", err)
+	}
+
+	attestedEpoch := attested.Beacon.Slot / slotsPerEpoch
+	gindex := c.config.finalizedRootGindex(attestedEpoch)
+	if err := verifyProof(attested.Beacon.StateRoot, gindex, update.FinalityBranch, finalized.Beacon.Root()); err != nil {
+		return fmt.Errorf("invalid finality proof: %w", err)
+	}
+
+	var next *committee
+	if update.NextSyncCommittee != nil {
+		gindex := c.config.nextSyncCommitteeGindex(attestedEpoch)
+		if err := verifyProof(attested.Beacon.StateRoot, gindex, update.NextSyncCommitteeBranch, update.NextSyncCommittee.Root()); err != nil {
+			return fmt.Errorf("invalid next sync committee proof: %w", err)
+		}
+
+		if syncPeriod(attested.Beacon.Slot) == storePeriod && c.next != nil && *c.next.serialized != *update.NextSyncCommittee {
+			return errors.New("next sync committee doesn't match the known one")
+		}
+
+		var err error
+		if next, err = newCommittee(update.NextSyncCommittee); err != nil {
+			return err
+		}
+	}
+
+	signingRoot, err := c.config.signingRoot(attested.Beacon.Root(), update.SignatureSlot)
+	if err != nil {
+		return err
+	}
+
+	if !signers.keys.VerifySignature(signingRoot, &update.SyncAggregate) {
+		return errors.New("invalid sync committee signature")
+	}
+
+	// the next sync committee is only trusted once its period is finalized
+	finalizedPeriod := syncPeriod(finalized.Beacon.Slot)
+	if next != nil && finalizedPeriod != syncPeriod(attested.Beacon.Slot) {
+		next = nil
+	}
+
+	switch {
+	case c.next == nil:
+		// only an update finalized in the store period can set the next committee
+		if finalizedPeriod == storePeriod {
+			c.next = next
+		}
+	case finalizedPeriod == storePeriod+1:
+		c.current, c.next = c.next, next
+	}
+
+	if finalized.Beacon.Slot > c.finalized.Beacon.Slot {
+		c.setFinalized(finalized)
+	}
+
+	return nil
+}
+
+// verifyHeader verifies the execution payload header proof of the header.
+func (c *LightClient) verifyHeader(header LightClientHeader) error {
+	epoch := header.Beacon.Slot / slotsPerEpoch
+	if !c.config.isActive(ForkCapella, epoch) {
+		return fmt.Errorf("header at slot %d predates capella", header.Beacon.Slot)
+	}
+
+	root, err := header.Execution.Root(c.config.isActive(ForkDeneb, epoch))
+	if err != nil {
+		return err
+	}
+
+	if err := verifyProof(header.Beacon.BodyRoot, executionPayloadGindex, header.ExecutionBranch, root); err != nil {
+		return fmt.Errorf("invalid execution payload proof: %w", err)
+	}
+
+	return nil
+}
+
+// setFinalized sets the finalized header and records its execution header,
+// dropping the oldest ones past HistorySize.
+func (c *LightClient) setFinalized(header LightClientHeader) {
+	c.finalized = &header
+	c.history[header.Beacon.Slot] = header.Execution
+
+	if len(c.history) <= HistorySize {
+		return
+	}
+
+	slots := make([]uint64, 0, len(c.history))
+	for slot := range c.history {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+
+	for _, slot := range slots[:len(slots)-HistorySize] {
+		delete(c.history, slot)
+	}
+}
@@ -0,0 +1,194 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/x/symStaking/testutil"
+	"cosmossdk.io/x/symStaking/types"
+)
+
+// fakeAPI serves the recorded light client responses.
+type fakeAPI struct {
+	bootstrap      Bootstrap
+	updates        []Update
+	finalityUpdate Update
+}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	t.Helper()
+
+	api := &fakeAPI{}
+	readFixture(t, bootstrapFixture, &api.bootstrap)
+	readFixture(t, updatesFixture, &api.updates)
+	readFixture(t, finalityUpdateFixture, &api.finalityUpdate)
+	return api
+}
+
+func (api *fakeAPI) LightClientBootstrap(_ context.Context, blockRoot common.Hash) (Bootstrap, error) {
+	if api.bootstrap.Header.Beacon.Root() != blockRoot {
+		return Bootstrap{}, types.ErrSymbioticNotFound
+	}
+	return api.bootstrap, nil
+}
+
+func (api *fakeAPI) LightClientUpdates(_ context.Context, startPeriod, count uint64) ([]Update, error) {
+	var updates []Update
+	for _, update := range api.updates {
+		if period := syncPeriod(update.AttestedHeader.Beacon.Slot); period >= startPeriod && period < startPeriod+count {
+			updates = append(updates, update)
+		}
+	}
+	return updates, nil
+}
+
+func (api *fakeAPI) LightClientFinalityUpdate(context.Context) (Update, error) {
+	return api.finalityUpdate, nil
+}
+
+func newTestClient(api *fakeAPI) *LightClient {
+	return NewLightClient(coretesting.NewNopLogger(), api, testConfig, api.bootstrap.Header.Beacon.Root())
+}
+
+func TestLightClientSync(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(api *fakeAPI, checkpoint *common.Hash)
+		expErr   string
+	}{
+		{
+			name:     "valid",
+			malleate: func(*fakeAPI, *common.Hash) {},
+		},
+		{
+			name: "unknown checkpoint",
+			malleate: func(_ *fakeAPI, checkpoint *common.Hash) {
+				checkpoint[0]++
+			},
+			expErr: ErrNotBootstrapped.Error(),
+		},
+		{
+			name: "invalid current sync committee",
+			malleate: func(api *fakeAPI, _ *common.Hash) {
+				api.bootstrap.CurrentSyncCommittee = api.updates[0].NextSyncCommittee
+			},
+			expErr: "invalid current sync committee proof",
+		},
+		{
+			name: "invalid signature",
+			malleate: func(api *fakeAPI, _ *common.Hash) {
+				api.updates[0].SyncAggregate.Signers[0] &^= 1
+			},
+			expErr: "invalid sync committee signature",
+		},
+		{
+			name: "insufficient participation",
+			malleate: func(api *fakeAPI, _ *common.Hash) {
+				for i := 0; i < 30; i++ {
+					api.updates[1].SyncAggregate.Signers[i] = 0
+				}
+			},
+			expErr: "insufficient sync committee participation",
+		},
+		{
+			name: "forged finalized execution header",
+			malleate: func(api *fakeAPI, _ *common.Hash) {
+				api.updates[0].FinalizedHeader.Execution.BlockHash = common.HexToHash("0x01")
+			},
+			expErr: "invalid execution payload proof",
+		},
+		{
+			name: "forged next sync committee",
+			malleate: func(api *fakeAPI, _ *common.Hash) {
+				api.updates[1].NextSyncCommittee = api.updates[0].NextSyncCommittee
+			},
+			expErr: "invalid next sync committee proof",
+		},
+		{
+			name: "finality update signed by an unknown committee",
+			malleate: func(api *fakeAPI, _ *common.Hash) {
+				api.finalityUpdate.SyncAggregate = api.updates[0].SyncAggregate
+			},
+			expErr: "invalid sync committee signature",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+			checkpoint := api.bootstrap.Header.Beacon.Root()
+			tc.malleate(api, &checkpoint)
+
+			client := NewLightClient(coretesting.NewNopLogger(), api, testConfig, checkpoint)
+
+			err := client.Sync(context.Background())
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, slot := range []uint64{96, 7936, 16128, 16224} {
+				header, finalizedSlot, ok := client.FinalizedHeader(slot)
+				require.True(t, ok, slot)
+				require.Equal(t, uint64(16224), finalizedSlot)
+				require.Equal(t, testEthHeader(slot).Hash(), header.BlockHash)
+			}
+
+			_, _, ok := client.FinalizedHeader(8000)
+			require.False(t, ok)
+
+			// syncing again without new updates is a no-op
+			require.NoError(t, client.Sync(context.Background()))
+		})
+	}
+}
+
+func TestSourceBeaconBlock(t *testing.T) {
+	client := newTestClient(newFakeAPI(t))
+	source := NewSource(testutil.NewFakeSymbioticSource(), client)
+
+	block, err := source.BeaconBlock(context.Background(), 16128)
+	require.NoError(t, err)
+	require.True(t, block.Finalized)
+	require.Equal(t, testEthHeader(16128).Hash().String(), block.Data.Message.Body.ExecutionPayload.BlockHash)
+
+	block, err = source.BeaconBlock(context.Background(), 20000)
+	require.NoError(t, err)
+	require.False(t, block.Finalized)
+
+	_, err = source.BeaconBlock(context.Background(), 8000)
+	require.ErrorIs(t, err, types.ErrSymbioticNotFound)
+}
+
+// headerSource serves header for any block hash.
+type headerSource struct {
+	types.SymbioticSource
+	header *ethtypes.Header
+}
+
+func (s headerSource) HeaderByHash(context.Context, common.Hash) (*ethtypes.Header, error) {
+	return s.header, nil
+}
+
+func TestSourceHeaderByHash(t *testing.T) {
+	client := newTestClient(newFakeAPI(t))
+	require.NoError(t, client.Sync(context.Background()))
+
+	ethHeader := testEthHeader(16224)
+	fake := testutil.NewFakeSymbioticSource()
+	hash := fake.AddHeader(ethHeader)
+
+	header, err := NewSource(fake, client).HeaderByHash(context.Background(), hash)
+	require.NoError(t, err)
+	require.Equal(t, hash, header.Hash())
+
+	forged := testEthHeader(16225)
+	_, err = NewSource(headerSource{fake, forged}, client).HeaderByHash(context.Background(), hash)
+	require.ErrorContains(t, err, "doesn't match the finalized header")
+}
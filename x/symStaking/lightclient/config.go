@@ -0,0 +1,171 @@
+package lightclient
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/x/symStaking/types"
+)
+
+// Fork names the light client depends on.
+const (
+	ForkAltair    = "altair"
+	ForkBellatrix = "bellatrix"
+	ForkCapella   = "capella"
+	ForkDeneb     = "deneb"
+	ForkElectra   = "electra"
+	ForkFulu      = "fulu"
+)
+
+// domainSyncCommittee is the signature domain type of the sync committee.
+var domainSyncCommittee = [4]byte{0x07, 0x00, 0x00, 0x00}
+
+// Generalized indices of the light client proofs.
+const (
+	// executionPayloadGindex is the index of the execution payload in the block body.
+	executionPayloadGindex = 25
+
+	finalizedRootGindex        = 105
+	currentSyncCommitteeGindex = 54
+	nextSyncCommitteeGindex    = 55
+
+	// the beacon state has more than 32 fields since electra
+	finalizedRootGindexElectra        = 169
+	currentSyncCommitteeGindexElectra = 86
+	nextSyncCommitteeGindexElectra    = 87
+)
+
+// Fork is a beacon chain fork.
+type Fork struct {
+	Name    string
+	Epoch   uint64
+	Version [4]byte
+}
+
+// NetworkConfig holds the beacon chain constants needed to verify the sync
+// committee signatures of a network.
+type NetworkConfig struct {
+	GenesisValidatorsRoot common.Hash
+	// Forks are sorted by epoch, starting with altair.
+	Forks []Fork
+}
+
+// NetworkConfigs holds the configs of the named Ethereum networks.
+var NetworkConfigs = map[string]NetworkConfig{
+	types.EthereumNetworkMainnet: {
+		GenesisValidatorsRoot: common.HexToHash("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"),
+		Forks: []Fork{
+			{ForkAltair, 74240, [4]byte{0x01, 0x00, 0x00, 0x00}},
+			{ForkBellatrix, 144896, [4]byte{0x02, 0x00, 0x00, 0x00}},
+			{ForkCapella, 194048, [4]byte{0x03, 0x00, 0x00, 0x00}},
+			{ForkDeneb, 269568, [4]byte{0x04, 0x00, 0x00, 0x00}},
+			{ForkElectra, 364032, [4]byte{0x05, 0x00, 0x00, 0x00}},
+			{ForkFulu, 411392, [4]byte{0x06, 0x00, 0x00, 0x00}},
+		},
+	},
+	types.EthereumNetworkHolesky: {
+		GenesisValidatorsRoot: common.HexToHash("0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1"),
+		Forks: []Fork{
+			{ForkAltair, 0, [4]byte{0x02, 0x01, 0x70, 0x00}},
+			{ForkBellatrix, 0, [4]byte{0x03, 0x01, 0x70, 0x00}},
+			{ForkCapella, 256, [4]byte{0x04, 0x01, 0x70, 0x00}},
+			{ForkDeneb, 29696, [4]byte{0x05, 0x01, 0x70, 0x00}},
+			{ForkElectra, 115968, [4]byte{0x06, 0x01, 0x70, 0x00}},
+			{ForkFulu, 165120, [4]byte{0x07, 0x01, 0x70, 0x00}},
+		},
+	},
+	types.EthereumNetworkSepolia: {
+		GenesisValidatorsRoot: common.HexToHash("0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078"),
+		Forks: []Fork{
+			{ForkAltair, 50, [4]byte{0x90, 0x00, 0x00, 0x70}},
+			{ForkBellatrix, 100, [4]byte{0x90, 0x00, 0x00, 0x71}},
+			{ForkCapella, 56832, [4]byte{0x90, 0x00, 0x00, 0x72}},
+			{ForkDeneb, 132608, [4]byte{0x90, 0x00, 0x00, 0x73}},
+			{ForkElectra, 222464, [4]byte{0x90, 0x00, 0x00, 0x74}},
+			{ForkFulu, 272640, [4]byte{0x90, 0x00, 0x00, 0x75}},
+		},
+	},
+}
+
+// GetNetworkConfig returns the config of the named Ethereum network.
+func GetNetworkConfig(network string) (NetworkConfig, error) {
+	config, ok := NetworkConfigs[network]
+	if !ok {
+		return NetworkConfig{}, fmt.Errorf("light client doesn't support ethereum network: %s", network)
+	}
+	return config, nil
+}
+
+// fork returns the fork active at epoch.
+func (c NetworkConfig) fork(epoch uint64) (Fork, error) {
+	for i := len(c.Forks) - 1; i >= 0; i-- {
+		if epoch >= c.Forks[i].Epoch {
+			return c.Forks[i], nil
+		}
+	}
+	return Fork{}, fmt.Errorf("no light client fork at epoch %d", epoch)
+}
+
+// isActive returns true if the named fork is active at epoch.
+func (c NetworkConfig) isActive(name string, epoch uint64) bool {
+	for _, fork := range c.Forks {
+		if fork.Name == name {
+			return epoch >= fork.Epoch
+		}
+	}
+	return false
+}
+
+// finalizedRootGindex returns the index of the finalized checkpoint root in the
+// beacon state at epoch.
+func (c NetworkConfig) finalizedRootGindex(epoch uint64) uint64 {
+	if c.isActive(ForkElectra, epoch) {
+		return finalizedRootGindexElectra
+	}
+	return finalizedRootGindex
+}
+
+// currentSyncCommitteeGindex returns the index of the current sync committee in
+// the beacon state at epoch.
+func (c NetworkConfig) currentSyncCommitteeGindex(epoch uint64) uint64 {
+	if c.isActive(ForkElectra, epoch) {
+		return currentSyncCommitteeGindexElectra
+	}
+	return currentSyncCommitteeGindex
+}
+
+// nextSyncCommitteeGindex returns the index of the next sync committee in the
+// beacon state at epoch.
+func (c NetworkConfig) nextSyncCommitteeGindex(epoch uint64) uint64 {
+	if c.isActive(ForkElectra, epoch) {
+		return nextSyncCommitteeGindexElectra
+	}
+	return nextSyncCommitteeGindex
+}
+
+// signingRoot returns the root signed by the sync committee at signatureSlot
+// for the given header root.
+func (c NetworkConfig) signingRoot(headerRoot common.Hash, signatureSlot uint64) (common.Hash, error) {
+	// the signature is made with the fork version of the slot before the signature slot
+	forkSlot := signatureSlot
+	if forkSlot > 0 {
+		forkSlot--
+	}
+
+	fork, err := c.fork(forkSlot / slotsPerEpoch)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	var version [32]byte
+	copy(version[:], fork.Version[:])
+	forkDataRoot := sha256.Sum256(append(version[:], c.GenesisValidatorsRoot[:]...))
+
+	var domain [32]byte
+	copy(domain[:], domainSyncCommittee[:])
+	copy(domain[4:], forkDataRoot[:28])
+
+	return sha256.Sum256(append(headerRoot[:], domain[:]...)), nil
+}
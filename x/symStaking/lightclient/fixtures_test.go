@@ -0,0 +1,281 @@
+package lightclient
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"flag"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/beacon/merkle"
+	"github.com/ethereum/go-ethereum/beacon/params"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	bls "github.com/protolambda/bls12-381-util"
+	"github.com/stretchr/testify/require"
+)
+
+var updateFixtures = flag.Bool("update", false, "regenerate the light client fixtures in testdata")
+
+const (
+	bootstrapFixture      = "bootstrap.json"
+	updatesFixture        = "updates.json"
+	finalityUpdateFixture = "finality_update.json"
+
+	// number of distinct keys of a fixture sync committee
+	committeeKeys = 16
+	genesisTime   = 1_700_000_000
+)
+
+// blsOrder is the order of the BLS12-381 scalar field.
+var blsOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// testConfig is the network of the fixtures, with electra activated during the
+// second sync committee period.
+var testConfig = NetworkConfig{
+	GenesisValidatorsRoot: common.HexToHash("0x6c69676874636c69656e74"),
+	Forks: []Fork{
+		{ForkAltair, 0, [4]byte{0x01, 0x00, 0x00, 0x42}},
+		{ForkBellatrix, 0, [4]byte{0x02, 0x00, 0x00, 0x42}},
+		{ForkCapella, 0, [4]byte{0x03, 0x00, 0x00, 0x42}},
+		{ForkDeneb, 0, [4]byte{0x04, 0x00, 0x00, 0x42}},
+		{ForkElectra, 500, [4]byte{0x05, 0x00, 0x00, 0x42}},
+	},
+}
+
+// testCommittee is a sync committee of the fixtures along with its secret keys.
+type testCommittee struct {
+	serialized *SyncCommittee
+	secrets    [params.SyncCommitteeSize]*big.Int
+}
+
+// newTestCommittee derives the committee of period from a small set of keys
+// shared by its members.
+func newTestCommittee(t *testing.T, period int) *testCommittee {
+	t.Helper()
+
+	var keys [committeeKeys]*big.Int
+	var pubkeys [committeeKeys]*bls.Pubkey
+	for j := range keys {
+		seed := sha256.Sum256([]byte{byte(period), byte(j)})
+		keys[j] = new(big.Int).Mod(new(big.Int).SetBytes(seed[:]), blsOrder)
+
+		pubkey, err := bls.SkToPk(secretKey(t, keys[j]))
+		require.NoError(t, err)
+		pubkeys[j] = pubkey
+	}
+
+	c := &testCommittee{serialized: new(SyncCommittee)}
+	members := make([]*bls.Pubkey, params.SyncCommitteeSize)
+	for i := range members {
+		c.secrets[i] = keys[i%committeeKeys]
+		members[i] = pubkeys[i%committeeKeys]
+
+		pubkey := members[i].Serialize()
+		copy(c.serialized[i*params.BLSPubkeySize:], pubkey[:])
+	}
+
+	aggregate, err := bls.AggregatePubkeys(members)
+	require.NoError(t, err)
+	serialized := aggregate.Serialize()
+	copy(c.serialized[params.SyncCommitteeSize*params.BLSPubkeySize:], serialized[:])
+
+	return c
+}
+
+// sign returns the aggregate signature of all the members over signingRoot.
+func (c *testCommittee) sign(t *testing.T, signingRoot common.Hash) SyncAggregate {
+	t.Helper()
+
+	var aggregate SyncAggregate
+	sum := new(big.Int)
+	for i, secret := range c.secrets {
+		aggregate.Signers[i/8] |= 1 << (i % 8)
+		sum.Add(sum, secret)
+	}
+
+	// the aggregate signature is the signature of the sum of the secret keys
+	signature := bls.Sign(secretKey(t, sum.Mod(sum, blsOrder)), signingRoot[:])
+	aggregate.Signature = signature.Serialize()
+	return aggregate
+}
+
+func secretKey(t *testing.T, key *big.Int) *bls.SecretKey {
+	t.Helper()
+
+	var serialized [32]byte
+	key.FillBytes(serialized[:])
+
+	sk := new(bls.SecretKey)
+	require.NoError(t, sk.Deserialize(&serialized))
+	return sk
+}
+
+// testTree is a sparse merkle tree with the given leaves, the other leaves
+// being zero.
+type testTree map[uint64]common.Hash
+
+func (tree testTree) depth() int {
+	var depth int
+	for gindex := range tree {
+		d := 0
+		for g := gindex; g > 1; g >>= 1 {
+			d++
+		}
+		if d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+func (tree testTree) node(gindex uint64) common.Hash {
+	if leaf, ok := tree[gindex]; ok {
+		return leaf
+	}
+	if gindex >= 1<<tree.depth() {
+		return common.Hash{}
+	}
+	return common.Hash(hashPair(merkle.Value(tree.node(2*gindex)), merkle.Value(tree.node(2*gindex+1))))
+}
+
+func (tree testTree) root() common.Hash {
+	return tree.node(1)
+}
+
+func (tree testTree) branch(gindex uint64) []common.Hash {
+	var branch []common.Hash
+	for g := gindex; g > 1; g >>= 1 {
+		branch = append(branch, tree.node(g^1))
+	}
+	return branch
+}
+
+// testEthHeader returns the execution header of the block proposed at slot.
+func testEthHeader(slot uint64) *ethtypes.Header {
+	return &ethtypes.Header{
+		Difficulty: new(big.Int),
+		Number:     new(big.Int).SetUint64(slot + 1000),
+		GasLimit:   30_000_000,
+		Time:       genesisTime + slot*12,
+		BaseFee:    big.NewInt(7),
+	}
+}
+
+// newTestHeader returns the light client header of the block at slot, with the
+// given leaves of its beacon state.
+func newTestHeader(t *testing.T, slot uint64, state testTree) LightClientHeader {
+	t.Helper()
+
+	ethHeader := testEthHeader(slot)
+	execution := ExecutionHeader{
+		ParentHash:    common.BigToHash(new(big.Int).SetUint64(slot)),
+		StateRoot:     ethHeader.Root,
+		LogsBloom:     make([]byte, logsBloomLength),
+		BlockNumber:   ethHeader.Number.Uint64(),
+		GasLimit:      ethHeader.GasLimit,
+		Timestamp:     ethHeader.Time,
+		BaseFeePerGas: (*math.Decimal256)(ethHeader.BaseFee),
+		BlockHash:     ethHeader.Hash(),
+	}
+
+	executionRoot, err := execution.Root(testConfig.isActive(ForkDeneb, slot/slotsPerEpoch))
+	require.NoError(t, err)
+
+	body := testTree{executionPayloadGindex: executionRoot, executionPayloadGindex - 1: common.HexToHash("0xb0d7")}
+	if len(state) == 0 {
+		state = testTree{2: common.HexToHash("0x57a7e")}
+	}
+
+	return LightClientHeader{
+		Beacon: BeaconHeader{
+			Slot:          slot,
+			ProposerIndex: slot % 7,
+			ParentRoot:    common.BigToHash(new(big.Int).SetUint64(slot)),
+			StateRoot:     state.root(),
+			BodyRoot:      body.root(),
+		},
+		Execution:       execution,
+		ExecutionBranch: body.branch(executionPayloadGindex),
+	}
+}
+
+// newTestUpdate returns an update finalizing finalizedSlot, attested at
+// attestedSlot and signed by signers the slot after.
+func newTestUpdate(t *testing.T, attestedSlot, finalizedSlot uint64, signers, next *testCommittee) Update {
+	t.Helper()
+
+	epoch := attestedSlot / slotsPerEpoch
+	finalized := newTestHeader(t, finalizedSlot, nil)
+
+	state := testTree{testConfig.finalizedRootGindex(epoch): finalized.Beacon.Root()}
+	if next != nil {
+		state[testConfig.nextSyncCommitteeGindex(epoch)] = next.serialized.Root()
+	}
+	attested := newTestHeader(t, attestedSlot, state)
+
+	update := Update{
+		AttestedHeader:  attested,
+		FinalizedHeader: finalized,
+		FinalityBranch:  state.branch(testConfig.finalizedRootGindex(epoch)),
+		SignatureSlot:   attestedSlot + 1,
+	}
+	if next != nil {
+		update.NextSyncCommittee = next.serialized
+		update.NextSyncCommitteeBranch = state.branch(testConfig.nextSyncCommitteeGindex(epoch))
+	}
+
+	signingRoot, err := testConfig.signingRoot(attested.Beacon.Root(), update.SignatureSlot)
+	require.NoError(t, err)
+	update.SyncAggregate = signers.sign(t, signingRoot)
+
+	return update
+}
+
+// TestGenerateFixtures regenerates the recorded light client responses when run
+// with -update. The fixtures follow a chain through two sync committee periods,
+// across the electra fork.
+func TestGenerateFixtures(t *testing.T) {
+	if !*updateFixtures {
+		t.Skip("run with -update to regenerate the fixtures")
+	}
+
+	committees := []*testCommittee{newTestCommittee(t, 0), newTestCommittee(t, 1), newTestCommittee(t, 2)}
+
+	bootstrapState := testTree{testConfig.currentSyncCommitteeGindex(3): committees[0].serialized.Root()}
+	bootstrap := Bootstrap{
+		Header:                     newTestHeader(t, 96, bootstrapState),
+		CurrentSyncCommittee:       committees[0].serialized,
+		CurrentSyncCommitteeBranch: bootstrapState.branch(testConfig.currentSyncCommitteeGindex(3)),
+	}
+
+	updates := []Update{
+		newTestUpdate(t, 8000, 7936, committees[0], committees[1]),
+		newTestUpdate(t, 16192, 16128, committees[1], committees[2]),
+	}
+
+	finalityUpdate := newTestUpdate(t, 16256, 16224, committees[1], nil)
+
+	writeFixture(t, bootstrapFixture, bootstrap)
+	writeFixture(t, updatesFixture, updates)
+	writeFixture(t, finalityUpdateFixture, finalityUpdate)
+}
+
+func writeFixture(t *testing.T, name string, v interface{}) {
+	t.Helper()
+
+	bz, err := json.MarshalIndent(v, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join("testdata", name), bz, 0o600))
+}
+
+func readFixture(t *testing.T, name string, v interface{}) {
+	t.Helper()
+
+	bz, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, v))
+}
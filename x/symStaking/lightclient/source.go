@@ -0,0 +1,72 @@
+package lightclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"cosmossdk.io/x/symStaking/types"
+)
+
+var _ types.SymbioticSource = (*Source)(nil)
+
+// Source is a SymbioticSource that takes the finalized beacon blocks from a
+// light client instead of trusting the finalized flag of the beacon API. The
+// execution headers served by the wrapped source are checked against the
+// verified ones when known.
+type Source struct {
+	types.SymbioticSource
+	client *LightClient
+}
+
+// NewSource wraps source to serve the finalized blocks verified by client.
+func NewSource(source types.SymbioticSource, client *LightClient) *Source {
+	return &Source{
+		SymbioticSource: source,
+		client:          client,
+	}
+}
+
+// BeaconBlock implements types.SymbioticSource. A slot past the latest
+// finalized block isn't finalized, while a slot before it is only known if the
+// light client observed its finalization.
+func (s *Source) BeaconBlock(ctx context.Context, slot int64) (types.BeaconBlock, error) {
+	var block types.BeaconBlock
+
+	header, finalizedSlot, ok := s.client.FinalizedHeader(uint64(slot))
+	if !ok && uint64(slot) > finalizedSlot {
+		if err := s.client.Sync(ctx); err != nil {
+			return block, err
+		}
+		header, finalizedSlot, ok = s.client.FinalizedHeader(uint64(slot))
+	}
+
+	switch {
+	case ok:
+		block.Finalized = true
+		block.Data.Message.Body.ExecutionPayload.BlockHash = header.BlockHash.String()
+		return block, nil
+	case uint64(slot) > finalizedSlot:
+		return block, nil
+	default:
+		return block, types.ErrSymbioticNotFound
+	}
+}
+
+// HeaderByHash implements types.SymbioticSource.
+func (s *Source) HeaderByHash(ctx context.Context, hash common.Hash) (*ethtypes.Header, error) {
+	header, err := s.SymbioticSource.HeaderByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if verified, ok := s.client.FinalizedHeaderByHash(hash); ok {
+		if header.Number == nil || header.Number.Uint64() != verified.BlockNumber || header.Time != verified.Timestamp {
+			return nil, fmt.Errorf("header %s doesn't match the finalized header", hash)
+		}
+	}
+
+	return header, nil
+}
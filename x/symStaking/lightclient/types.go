@@ -0,0 +1,207 @@
+package lightclient
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/beacon/merkle"
+	"github.com/ethereum/go-ethereum/beacon/params"
+	beacontypes "github.com/ethereum/go-ethereum/beacon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+const (
+	slotsPerEpoch    = params.EpochLength
+	slotsPerPeriod   = params.SyncPeriodLength
+	logsBloomLength  = 256
+	maxExtraDataSize = 32
+)
+
+// syncPeriod returns the sync committee period of slot.
+func syncPeriod(slot uint64) uint64 {
+	return slot / slotsPerPeriod
+}
+
+// BeaconHeader is a beacon block header as served by the beacon API.
+type BeaconHeader struct {
+	Slot          uint64      `json:"slot,string"`
+	ProposerIndex uint64      `json:"proposer_index,string"`
+	ParentRoot    common.Hash `json:"parent_root"`
+	StateRoot     common.Hash `json:"state_root"`
+	BodyRoot      common.Hash `json:"body_root"`
+}
+
+// Root returns the hash tree root of the header, i.e. the beacon block root.
+func (h BeaconHeader) Root() common.Hash {
+	header := beacontypes.Header{
+		Slot:          h.Slot,
+		ProposerIndex: h.ProposerIndex,
+		ParentRoot:    h.ParentRoot,
+		StateRoot:     h.StateRoot,
+		BodyRoot:      h.BodyRoot,
+	}
+	return header.Hash()
+}
+
+// ExecutionHeader is the header of the execution payload of a beacon block.
+type ExecutionHeader struct {
+	ParentHash       common.Hash      `json:"parent_hash"`
+	FeeRecipient     common.Address   `json:"fee_recipient"`
+	StateRoot        common.Hash      `json:"state_root"`
+	ReceiptsRoot     common.Hash      `json:"receipts_root"`
+	LogsBloom        hexutil.Bytes    `json:"logs_bloom"`
+	PrevRandao       common.Hash      `json:"prev_randao"`
+	BlockNumber      uint64           `json:"block_number,string"`
+	GasLimit         uint64           `json:"gas_limit,string"`
+	GasUsed          uint64           `json:"gas_used,string"`
+	Timestamp        uint64           `json:"timestamp,string"`
+	ExtraData        hexutil.Bytes    `json:"extra_data"`
+	BaseFeePerGas    *math.Decimal256 `json:"base_fee_per_gas"`
+	BlockHash        common.Hash      `json:"block_hash"`
+	TransactionsRoot common.Hash      `json:"transactions_root"`
+	WithdrawalsRoot  common.Hash      `json:"withdrawals_root"`
+	BlobGasUsed      uint64           `json:"blob_gas_used,string,omitempty"`
+	ExcessBlobGas    uint64           `json:"excess_blob_gas,string,omitempty"`
+}
+
+// Root returns the hash tree root of the header. The blob gas fields are part
+// of the header since deneb.
+func (h ExecutionHeader) Root(deneb bool) (common.Hash, error) {
+	if len(h.LogsBloom) != logsBloomLength {
+		return common.Hash{}, fmt.Errorf("invalid logs bloom length: %d", len(h.LogsBloom))
+	}
+
+	if len(h.ExtraData) > maxExtraDataSize {
+		return common.Hash{}, fmt.Errorf("invalid extra data length: %d", len(h.ExtraData))
+	}
+
+	baseFee := new(big.Int)
+	if h.BaseFeePerGas != nil {
+		baseFee = (*big.Int)(h.BaseFeePerGas)
+	}
+	if baseFee.Sign() < 0 || baseFee.BitLen() > 256 {
+		return common.Hash{}, fmt.Errorf("invalid base fee: %s", baseFee)
+	}
+
+	var bloomChunks []merkle.Value
+	for i := 0; i < logsBloomLength; i += 32 {
+		bloomChunks = append(bloomChunks, merkle.Value(h.LogsBloom[i:i+32]))
+	}
+
+	var extraData merkle.Value
+	copy(extraData[:], h.ExtraData)
+
+	// base fee is a little endian uint256
+	var baseFeeChunk merkle.Value
+	baseFee.FillBytes(baseFeeChunk[:])
+	for i, j := 0, len(baseFeeChunk)-1; i < j; i, j = i+1, j-1 {
+		baseFeeChunk[i], baseFeeChunk[j] = baseFeeChunk[j], baseFeeChunk[i]
+	}
+
+	fields := []merkle.Value{
+		merkle.Value(h.ParentHash),
+		addressChunk(h.FeeRecipient),
+		merkle.Value(h.StateRoot),
+		merkle.Value(h.ReceiptsRoot),
+		merkleize(bloomChunks),
+		merkle.Value(h.PrevRandao),
+		uint64Chunk(h.BlockNumber),
+		uint64Chunk(h.GasLimit),
+		uint64Chunk(h.GasUsed),
+		uint64Chunk(h.Timestamp),
+		hashPair(extraData, uint64Chunk(uint64(len(h.ExtraData)))),
+		baseFeeChunk,
+		merkle.Value(h.BlockHash),
+		merkle.Value(h.TransactionsRoot),
+		merkle.Value(h.WithdrawalsRoot),
+	}
+
+	if deneb {
+		fields = append(fields, uint64Chunk(h.BlobGasUsed), uint64Chunk(h.ExcessBlobGas))
+	}
+
+	return common.Hash(merkleize(fields)), nil
+}
+
+// LightClientHeader is a beacon block header along with its execution payload
+// header and the proof of the latter against the block body root.
+type LightClientHeader struct {
+	Beacon          BeaconHeader    `json:"beacon"`
+	Execution       ExecutionHeader `json:"execution"`
+	ExecutionBranch []common.Hash   `json:"execution_branch"`
+}
+
+// SyncAggregate is the aggregated signature of the sync committee members
+// marked in the bitfield.
+type SyncAggregate = beacontypes.SyncAggregate
+
+// SyncCommittee is the serialized sync committee, pubkeys and aggregate pubkey.
+type SyncCommittee = beacontypes.SerializedSyncCommittee
+
+// Bootstrap is the light client bootstrap of a trusted beacon block root.
+type Bootstrap struct {
+	Header                     LightClientHeader `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee    `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []common.Hash     `json:"current_sync_committee_branch"`
+}
+
+// Update is a light client update or finality update. A finality update
+// doesn't carry the next sync committee.
+type Update struct {
+	AttestedHeader          LightClientHeader `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee    `json:"next_sync_committee,omitempty"`
+	NextSyncCommitteeBranch []common.Hash     `json:"next_sync_committee_branch,omitempty"`
+	FinalizedHeader         LightClientHeader `json:"finalized_header"`
+	FinalityBranch          []common.Hash     `json:"finality_branch"`
+	SyncAggregate           SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           uint64            `json:"signature_slot,string"`
+}
+
+// verifyProof verifies the merkle branch of leaf at gindex against root.
+func verifyProof(root common.Hash, gindex uint64, branch []common.Hash, leaf common.Hash) error {
+	values := make(merkle.Values, len(branch))
+	for i, node := range branch {
+		values[i] = merkle.Value(node)
+	}
+	return merkle.VerifyProof(root, gindex, values, merkle.Value(leaf))
+}
+
+// merkleize returns the root of the binary merkle tree of chunks, padded with
+// zero chunks to a power of two.
+func merkleize(chunks []merkle.Value) merkle.Value {
+	size := 1
+	for size < len(chunks) {
+		size *= 2
+	}
+
+	layer := make([]merkle.Value, size)
+	copy(layer, chunks)
+	for len(layer) > 1 {
+		for i := 0; i < len(layer)/2; i++ {
+			layer[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = layer[:len(layer)/2]
+	}
+
+	return layer[0]
+}
+
+func hashPair(a, b merkle.Value) merkle.Value {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+func uint64Chunk(v uint64) merkle.Value {
+	var chunk merkle.Value
+	binary.LittleEndian.PutUint64(chunk[:8], v)
+	return chunk
+}
+
+func addressChunk(addr common.Address) merkle.Value {
+	var chunk merkle.Value
+	copy(chunk[:], addr[:])
+	return chunk
+}
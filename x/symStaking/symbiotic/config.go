@@ -0,0 +1,174 @@
+package symbiotic
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/symStaking/lightclient"
+	"cosmossdk.io/x/symStaking/types"
+)
+
+const (
+	// FlagBeaconAPIURLs is the app.toml key holding the beacon API endpoints.
+	FlagBeaconAPIURLs = "symbiotic.beacon-api-urls"
+	// FlagEthAPIURLs is the app.toml key holding the execution layer JSON-RPC endpoints.
+	FlagEthAPIURLs = "symbiotic.eth-api-urls"
+	// FlagVerifyStorageProofs is the app.toml key enabling the verification of
+	// the middleware calls with storage proofs.
+	FlagVerifyStorageProofs = "symbiotic.verify-storage-proofs"
+	// FlagLightClientCheckpoint is the app.toml key holding the trusted beacon
+	// block root the light client is bootstrapped from.
+	FlagLightClientCheckpoint = "symbiotic.light-client-checkpoint"
+	// FlagLightClientNetwork is the app.toml key holding the Ethereum network
+	// followed by the light client.
+	FlagLightClientNetwork = "symbiotic.light-client-network"
+	// FlagCheckpointKeyFile is the app.toml key holding the file of the
+	// Symbiotic operator key signing the checkpoints.
+	FlagCheckpointKeyFile = "symbiotic.checkpoint-key-file"
+	// FlagQuorumSize is the app.toml key holding the number of endpoints
+	// queried by every quorum read.
+	FlagQuorumSize = "symbiotic.quorum-size"
+	// FlagQuorumThreshold is the app.toml key holding the number of endpoints
+	// that must agree on a quorum read.
+	FlagQuorumThreshold = "symbiotic.quorum-threshold"
+)
+
+// Config defines the [symbiotic] section of app.toml.
+type Config struct {
+	// BeaconAPIURLs are the beacon API endpoints, tried in order.
+	BeaconAPIURLs []string `mapstructure:"beacon-api-urls"`
+	// EthAPIURLs are the execution layer JSON-RPC endpoints, tried in order.
+	EthAPIURLs []string `mapstructure:"eth-api-urls"`
+	// VerifyStorageProofs verifies the middleware calls against the state root
+	// of the execution block instead of trusting the endpoints.
+	VerifyStorageProofs bool `mapstructure:"verify-storage-proofs"`
+	// LightClientCheckpoint is the trusted beacon block root the light client
+	// is bootstrapped from. The light client is disabled if empty.
+	LightClientCheckpoint string `mapstructure:"light-client-checkpoint"`
+	// LightClientNetwork is the Ethereum network followed by the light client.
+	LightClientNetwork string `mapstructure:"light-client-network"`
+	// CheckpointKeyFile is the file holding the hex encoded secp256k1 key of
+	// the Symbiotic operator of the validator, signing the checkpoints. The
+	// checkpoints aren't signed if empty.
+	CheckpointKeyFile string `mapstructure:"checkpoint-key-file"`
+	// QuorumSize is the number of healthiest endpoints queried concurrently by
+	// every read, all of them if 0.
+	QuorumSize int `mapstructure:"quorum-size"`
+	// QuorumThreshold is the number of queried endpoints that must agree on
+	// every read. The endpoints are tried in order instead if 0.
+	QuorumThreshold int `mapstructure:"quorum-threshold"`
+}
+
+// DefaultConfig returns the default Config. No endpoint is configured by
+// default: every node must point to its own beacon and execution clients.
+func DefaultConfig() Config {
+	return Config{
+		BeaconAPIURLs: []string{},
+		EthAPIURLs:    []string{},
+		// proofs are opt-in as they require eth_getProof, eth_createAccessList
+		// and debug_getRawHeader on the execution endpoints
+		VerifyStorageProofs:   false,
+		LightClientCheckpoint: "",
+		LightClientNetwork:    types.EthereumNetworkMainnet,
+		CheckpointKeyFile:     "",
+		QuorumSize:            0,
+		QuorumThreshold:       0,
+	}
+}
+
+// ApiUrls returns the configured endpoints as types.ApiUrls.
+func (c Config) ApiUrls() types.ApiUrls {
+	return types.NewApiUrls(c.BeaconAPIURLs, c.EthAPIURLs)
+}
+
+// NewSource creates the SymbioticSource configured by c over the endpoints of
+// source: a QuorumSource if a quorum threshold is set, source itself otherwise.
+func (c Config) NewSource(logger log.Logger, source *RPCSource) types.SymbioticSource {
+	if c.QuorumThreshold <= 0 {
+		return source
+	}
+
+	return NewQuorumSource(logger, c.BeaconAPIURLs, c.EthAPIURLs, c.QuorumSize, c.QuorumThreshold)
+}
+
+// NewLightClient creates the light client configured by c, reading the beacon
+// API light client endpoints of source.
+func (c Config) NewLightClient(logger log.Logger, source *RPCSource) (*lightclient.LightClient, error) {
+	checkpoint, err := hexutil.Decode(c.LightClientCheckpoint)
+	if err != nil || len(checkpoint) != common.HashLength {
+		return nil, fmt.Errorf("invalid light client checkpoint: %q", c.LightClientCheckpoint)
+	}
+
+	networkConfig, err := lightclient.GetNetworkConfig(c.LightClientNetwork)
+	if err != nil {
+		return nil, err
+	}
+
+	return lightclient.NewLightClient(logger, source, networkConfig, common.BytesToHash(checkpoint)), nil
+}
+
+// LoadCheckpointKey loads the checkpoint key configured by c, or returns nil if
+// there is none.
+func (c Config) LoadCheckpointKey() (*ecdsa.PrivateKey, error) {
+	if c.CheckpointKeyFile == "" {
+		return nil, nil
+	}
+
+	key, err := crypto.LoadECDSA(c.CheckpointKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the checkpoint key: %w", err)
+	}
+
+	return key, nil
+}
+
+// DefaultConfigTemplate is the app.toml template of the [symbiotic] section.
+// It expects the Config to be exposed as .Symbiotic.
+const DefaultConfigTemplate = `
+###############################################################################
+###                           Symbiotic Configuration                       ###
+###############################################################################
+
+[symbiotic]
+
+# Beacon API endpoints used to fetch finalized blocks, tried in order on failure.
+# Use your own beacon clients: the fetched block hashes drive the validator set.
+beacon-api-urls = [{{ range $i, $url := .Symbiotic.BeaconAPIURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Execution layer JSON-RPC endpoints used to read the Symbiotic middleware,
+# tried in order on failure.
+eth-api-urls = [{{ range $i, $url := .Symbiotic.EthAPIURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Verify the middleware getValidatorSet results with Merkle-Patricia proofs of the
+# storage slots it reads against the state root of the execution block, instead of
+# trusting the execution endpoints. The endpoints must serve eth_getProof,
+# eth_createAccessList and debug_getRawHeader for the synced blocks.
+verify-storage-proofs = {{ .Symbiotic.VerifyStorageProofs }}
+
+# Trusted beacon block root the embedded sync committee light client is bootstrapped
+# from, e.g. a recent finalized checkpoint root. When set, the finalized blocks are
+# taken from the light client instead of the finalized flag of the beacon API. The
+# beacon endpoints must serve the light client API.
+light-client-checkpoint = "{{ .Symbiotic.LightClientCheckpoint }}"
+
+# Ethereum network followed by the light client: mainnet, holesky or sepolia.
+light-client-network = "{{ .Symbiotic.LightClientNetwork }}"
+
+# File holding the hex encoded secp256k1 key of the Symbiotic operator of the validator.
+# When set, the validator signs the checkpoints of the validator set in its vote extensions,
+# to be submitted to the middleware by a relayer. Leave empty on non-validator nodes.
+checkpoint-key-file = "{{ .Symbiotic.CheckpointKeyFile }}"
+
+# Number of endpoints of each kind queried concurrently by every read when the quorum
+# is enabled, the healthiest ones by error rate and latency. 0 queries all of them.
+quorum-size = {{ .Symbiotic.QuorumSize }}
+
+# Number of queried endpoints that must agree on the finalized blocks, the headers and
+# the middleware results. 0 disables the quorum: the endpoints are tried in order.
+quorum-threshold = {{ .Symbiotic.QuorumThreshold }}
+`
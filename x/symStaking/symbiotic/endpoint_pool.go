@@ -0,0 +1,147 @@
+package symbiotic
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	// EndpointKindBeacon labels the beacon API endpoints.
+	EndpointKindBeacon = "beacon"
+	// EndpointKindEth labels the execution layer JSON-RPC endpoints.
+	EndpointKindEth = "eth"
+
+	// healthWeight is the weight of the last request in the moving averages of
+	// the health of an endpoint.
+	healthWeight = 0.2
+)
+
+// EndpointHealth is the health score of an endpoint.
+type EndpointHealth struct {
+	URL string
+	// Requests is the number of requests made to the endpoint.
+	Requests uint64
+	// Errors is the number of failed requests, including the answers that
+	// disagreed with the quorum.
+	Errors uint64
+	// FailureScore is the moving average of the failures of the endpoint,
+	// between 0 (healthy) and 1.
+	FailureScore float64
+	// Latency is the moving average of the latency of the successful requests.
+	Latency time.Duration
+}
+
+// endpointPool holds the endpoints of a kind along with their health.
+type endpointPool struct {
+	kind string
+
+	mtx    sync.Mutex
+	health []EndpointHealth
+}
+
+func newEndpointPool(kind string, urls []string) *endpointPool {
+	pool := &endpointPool{kind: kind, health: make([]EndpointHealth, len(urls))}
+	for i, u := range urls {
+		pool.health[i].URL = u
+	}
+	return pool
+}
+
+// Select returns the n healthiest endpoints, by failure score then latency,
+// in the configured order on ties. The failure score of the endpoints left out
+// decays, so that an endpoint that failed is selected again after a while.
+func (p *endpointPool) Select(n int) []string {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	order := make([]int, len(p.health))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := p.health[order[i]], p.health[order[j]]
+		if a.FailureScore != b.FailureScore {
+			return a.FailureScore < b.FailureScore
+		}
+		return a.Latency < b.Latency
+	})
+
+	n = min(n, len(order))
+	urls := make([]string, n)
+	for i, idx := range order {
+		if i < n {
+			urls[i] = p.health[idx].URL
+			continue
+		}
+		p.health[idx].FailureScore *= 1 - healthWeight
+	}
+
+	return urls
+}
+
+// Record records the outcome of a request to an endpoint and exports its
+// health as telemetry metrics.
+func (p *endpointPool) Record(endpoint string, latency time.Duration, failed bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for i := range p.health {
+		h := &p.health[i]
+		if h.URL != endpoint {
+			continue
+		}
+
+		h.Requests++
+		failure := 0.0
+		if failed {
+			h.Errors++
+			failure = 1
+		} else if h.Latency == 0 {
+			h.Latency = latency
+		} else {
+			h.Latency = time.Duration((1-healthWeight)*float64(h.Latency) + healthWeight*float64(latency))
+		}
+		h.FailureScore = (1-healthWeight)*h.FailureScore + healthWeight*failure
+
+		labels := p.labels(i)
+		telemetry.IncrCounterWithLabels([]string{"symbiotic", "endpoint", "requests"}, 1, labels)
+		if failed {
+			telemetry.IncrCounterWithLabels([]string{"symbiotic", "endpoint", "errors"}, 1, labels)
+		}
+		telemetry.SetGaugeWithLabels([]string{"symbiotic", "endpoint", "failure_score"}, float32(h.FailureScore), labels)
+		telemetry.SetGaugeWithLabels([]string{"symbiotic", "endpoint", "latency_ms"}, float32(h.Latency.Milliseconds()), labels)
+		return
+	}
+}
+
+// Health returns the health of the endpoints, in the configured order.
+func (p *endpointPool) Health() []EndpointHealth {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	health := make([]EndpointHealth, len(p.health))
+	copy(health, p.health)
+	return health
+}
+
+// labels returns the telemetry labels of the endpoint at index i. Only the host
+// of the URL is exported, as its path or query often holds an API key.
+func (p *endpointPool) labels(i int) []metrics.Label {
+	host := ""
+	if u, err := url.Parse(p.health[i].URL); err == nil {
+		host = u.Host
+	}
+
+	return []metrics.Label{
+		telemetry.NewLabel("kind", p.kind),
+		telemetry.NewLabel("index", strconv.Itoa(i)),
+		telemetry.NewLabel("host", host),
+	}
+}
@@ -0,0 +1,293 @@
+package symbiotic
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// AccountProof is the eth_getProof result of an account. Only the proof nodes
+// are used: the values are read from the verified proofs.
+type AccountProof struct {
+	Address      common.Address  `json:"address"`
+	AccountProof []hexutil.Bytes `json:"accountProof"`
+	StorageProof []StorageProof  `json:"storageProof"`
+}
+
+// StorageProof is the eth_getProof result of a storage slot.
+type StorageProof struct {
+	Key   string          `json:"key"`
+	Value *hexutil.Big    `json:"value"`
+	Proof []hexutil.Bytes `json:"proof"`
+}
+
+// executionHeader holds the fields of a raw execution block header needed to
+// execute a call against its state.
+type executionHeader struct {
+	Root        common.Hash
+	Coinbase    common.Address
+	Difficulty  *big.Int
+	Number      *big.Int
+	GasLimit    uint64
+	Time        uint64
+	MixDigest   common.Hash
+	BaseFee     *big.Int
+	BlobBaseFee *big.Int
+}
+
+// headerField is the index of a field in the RLP encoded header and where it
+// is decoded to.
+type headerField struct {
+	index int
+	value any
+}
+
+// decodeExecutionHeader decodes the RLP encoded header of the execution block
+// blockHash. The header is decoded field by field so that fields added by later
+// forks don't break the hash check.
+func decodeExecutionHeader(raw []byte, blockHash common.Hash) (executionHeader, error) {
+	var header executionHeader
+
+	if hash := crypto.Keccak256Hash(raw); hash != blockHash {
+		return header, fmt.Errorf("header hash %s doesn't match block hash %s", hash, blockHash)
+	}
+
+	var fields []rlp.RawValue
+	if err := rlp.DecodeBytes(raw, &fields); err != nil {
+		return header, fmt.Errorf("invalid header: %w", err)
+	}
+
+	if len(fields) < 15 {
+		return header, fmt.Errorf("invalid header: %d fields", len(fields))
+	}
+
+	decoders := []headerField{
+		{2, &header.Coinbase},
+		{3, &header.Root},
+		{7, &header.Difficulty},
+		{8, &header.Number},
+		{9, &header.GasLimit},
+		{11, &header.Time},
+		{13, &header.MixDigest},
+	}
+
+	// london
+	if len(fields) > 15 {
+		decoders = append(decoders, headerField{15, &header.BaseFee})
+	}
+
+	for _, d := range decoders {
+		if err := rlp.DecodeBytes(fields[d.index], d.value); err != nil {
+			return header, fmt.Errorf("invalid header field %d: %w", d.index, err)
+		}
+	}
+
+	// cancun
+	if len(fields) > 18 {
+		var excessBlobGas uint64
+		if err := rlp.DecodeBytes(fields[18], &excessBlobGas); err != nil {
+			return header, fmt.Errorf("invalid header field 18: %w", err)
+		}
+		header.BlobBaseFee = eip4844.CalcBlobFee(excessBlobGas)
+	}
+
+	return header, nil
+}
+
+// verifyAccountProof verifies the proof of account against the state root and
+// returns the proven account, or nil if the proof shows it doesn't exist.
+func verifyAccountProof(root common.Hash, account common.Address, proof []hexutil.Bytes) (*ethtypes.StateAccount, error) {
+	value, err := verifyProof(root, crypto.Keccak256(account.Bytes()), proofNodes(proof))
+	if err != nil {
+		return nil, fmt.Errorf("invalid account proof of %s: %w", account, err)
+	}
+
+	if value == nil {
+		return nil, nil
+	}
+
+	var acc ethtypes.StateAccount
+	if err := rlp.DecodeBytes(value, &acc); err != nil {
+		return nil, fmt.Errorf("invalid account %s: %w", account, err)
+	}
+
+	return &acc, nil
+}
+
+// verifyStorageProof verifies the value of slot against the storage root of an
+// account. The nodes of every storage proof of the account can be given at once.
+func verifyStorageProof(root common.Hash, slot common.Hash, nodes map[common.Hash][]byte) (common.Hash, error) {
+	value, err := verifyProof(root, crypto.Keccak256(slot.Bytes()), nodes)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("invalid storage proof of slot %s: %w", slot, err)
+	}
+
+	if value == nil {
+		return common.Hash{}, nil
+	}
+
+	var content []byte
+	if err := rlp.DecodeBytes(value, &content); err != nil {
+		return common.Hash{}, fmt.Errorf("invalid storage value of slot %s: %w", slot, err)
+	}
+
+	if len(content) > common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid storage value length of slot %s: %d", slot, len(content))
+	}
+
+	return common.BytesToHash(content), nil
+}
+
+// proofNodes indexes the nodes of a proof by their hash.
+func proofNodes(proof []hexutil.Bytes) map[common.Hash][]byte {
+	nodes := make(map[common.Hash][]byte, len(proof))
+	for _, node := range proof {
+		nodes[crypto.Keccak256Hash(node)] = node
+	}
+	return nodes
+}
+
+// verifyProof walks the Merkle-Patricia trie from root along key using the
+// given nodes. It returns the value stored at key, nil if the proof shows that
+// key is absent, or an error if the nodes don't prove either.
+func verifyProof(root common.Hash, key []byte, nodes map[common.Hash][]byte) ([]byte, error) {
+	if root == ethtypes.EmptyRootHash {
+		return nil, nil
+	}
+
+	node, ok := nodes[root]
+	if !ok {
+		return nil, fmt.Errorf("missing root node %s", root)
+	}
+
+	path := keyToNibbles(key)
+	for {
+		elems, err := splitNode(node)
+		if err != nil {
+			return nil, err
+		}
+
+		var child []byte
+		switch len(elems) {
+		case 17:
+			// branch node, secure trie keys all have the same length so the
+			// value slot is never used
+			if len(path) == 0 {
+				return nil, errors.New("unexpected branch node at the end of the key")
+			}
+			child, path = elems[path[0]], path[1:]
+
+		case 2:
+			// leaf or extension node
+			kind, content, _, err := rlp.Split(elems[0])
+			if err != nil || kind != rlp.String {
+				return nil, errors.New("invalid node path")
+			}
+
+			nodePath, isLeaf := compactToNibbles(content)
+			if !bytes.HasPrefix(path, nodePath) {
+				return nil, nil
+			}
+			path = path[len(nodePath):]
+
+			if isLeaf {
+				if len(path) != 0 {
+					return nil, nil
+				}
+				_, value, _, err := rlp.Split(elems[1])
+				if err != nil {
+					return nil, fmt.Errorf("invalid leaf value: %w", err)
+				}
+				return value, nil
+			}
+			child = elems[1]
+
+		default:
+			return nil, fmt.Errorf("invalid node with %d elements", len(elems))
+		}
+
+		node, err = resolveChild(child, nodes)
+		if err != nil || node == nil {
+			return nil, err
+		}
+	}
+}
+
+// splitNode returns the raw RLP elements of a trie node.
+func splitNode(node []byte) ([][]byte, error) {
+	kind, content, _, err := rlp.Split(node)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node: %w", err)
+	}
+
+	if kind != rlp.List {
+		return nil, errors.New("invalid node: not a list")
+	}
+
+	var elems [][]byte
+	for len(content) > 0 {
+		_, _, rest, err := rlp.Split(content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node: %w", err)
+		}
+		elems = append(elems, content[:len(content)-len(rest)])
+		content = rest
+	}
+
+	return elems, nil
+}
+
+// resolveChild returns the node referenced by a raw child reference, which is
+// either empty, the hash of the node or the node itself if shorter than a hash.
+func resolveChild(child []byte, nodes map[common.Hash][]byte) ([]byte, error) {
+	kind, content, _, err := rlp.Split(child)
+	if err != nil {
+		return nil, fmt.Errorf("invalid child reference: %w", err)
+	}
+
+	switch {
+	case kind == rlp.List:
+		return child, nil
+	case len(content) == 0:
+		return nil, nil
+	case len(content) == common.HashLength:
+		node, ok := nodes[common.BytesToHash(content)]
+		if !ok {
+			return nil, fmt.Errorf("missing node %x", content)
+		}
+		return node, nil
+	default:
+		return nil, fmt.Errorf("invalid child reference length: %d", len(content))
+	}
+}
+
+func keyToNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+	return nibbles
+}
+
+// compactToNibbles decodes the hex prefix encoded path of a leaf or extension node.
+func compactToNibbles(compact []byte) ([]byte, bool) {
+	if len(compact) == 0 {
+		return nil, false
+	}
+
+	nibbles := keyToNibbles(compact)
+	isLeaf := nibbles[0] >= 2
+	// odd length paths store their first nibble in the flag byte
+	if nibbles[0]&1 == 1 {
+		return nibbles[1:], isLeaf
+	}
+	return nibbles[2:], isLeaf
+}
@@ -0,0 +1,238 @@
+package symbiotic
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+var _ vm.StateDB = (*provenState)(nil)
+
+// provenAccount is an account whose fields and storage slots were verified
+// against the state root.
+type provenAccount struct {
+	exists   bool
+	nonce    uint64
+	balance  *uint256.Int
+	codeHash common.Hash
+	code     []byte
+	storage  map[common.Hash]common.Hash
+}
+
+// provenState is a read-only vm.StateDB holding the accounts and storage slots
+// proven against the state root of an execution block. Reading anything that
+// wasn't proven or writing to the state fails the call.
+type provenState struct {
+	accounts  map[common.Address]*provenAccount
+	transient map[common.Address]map[common.Hash]common.Hash
+
+	accessedAddresses map[common.Address]struct{}
+	accessedSlots     map[common.Address]map[common.Hash]struct{}
+
+	// err is the first unproven read or write
+	err error
+}
+
+func newProvenState() *provenState {
+	return &provenState{
+		accounts:          make(map[common.Address]*provenAccount),
+		transient:         make(map[common.Address]map[common.Hash]common.Hash),
+		accessedAddresses: make(map[common.Address]struct{}),
+		accessedSlots:     make(map[common.Address]map[common.Hash]struct{}),
+	}
+}
+
+// call executes a read-only call to contract in the context of header, with
+// the same settings as eth_call.
+func (s *provenState) call(header executionHeader, contract common.Address, data []byte) ([]byte, error) {
+	blockCtx := vm.BlockContext{
+		CanTransfer: func(vm.StateDB, common.Address, *uint256.Int) bool { return true },
+		Transfer:    func(vm.StateDB, common.Address, common.Address, *uint256.Int) {},
+		GetHash: func(n uint64) common.Hash {
+			s.fail(fmt.Errorf("block hash %d read without proof", n))
+			return common.Hash{}
+		},
+		Coinbase:    header.Coinbase,
+		GasLimit:    header.GasLimit,
+		BlockNumber: header.Number,
+		Time:        header.Time,
+		Difficulty:  header.Difficulty,
+		BaseFee:     header.BaseFee,
+		BlobBaseFee: header.BlobBaseFee,
+		Random:      &header.MixDigest,
+	}
+
+	if blockCtx.BaseFee == nil {
+		blockCtx.BaseFee = new(big.Int)
+	}
+
+	if blockCtx.BlobBaseFee == nil {
+		blockCtx.BlobBaseFee = new(big.Int)
+	}
+
+	evm := vm.NewEVM(blockCtx, vm.TxContext{GasPrice: new(big.Int), BlobFeeCap: new(big.Int)}, s, params.MergedTestChainConfig, vm.Config{NoBaseFee: true})
+
+	rules := evm.ChainConfig().Rules(header.Number, true, header.Time)
+	s.Prepare(rules, common.Address{}, header.Coinbase, &contract, vm.ActivePrecompiles(rules), nil)
+
+	result, _, err := evm.StaticCall(vm.AccountRef(common.Address{}), contract, data, header.GasLimit)
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("call execution failed: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *provenState) fail(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// account returns the proven account, or an empty account if addr wasn't proven.
+func (s *provenState) account(addr common.Address) *provenAccount {
+	acc, ok := s.accounts[addr]
+	if !ok {
+		s.fail(fmt.Errorf("account %s read without proof", addr))
+		return &provenAccount{balance: new(uint256.Int)}
+	}
+	return acc
+}
+
+func (s *provenState) write(addr common.Address) {
+	s.fail(fmt.Errorf("unexpected state write to %s", addr))
+}
+
+func (s *provenState) CreateAccount(addr common.Address) { s.write(addr) }
+
+func (s *provenState) SubBalance(addr common.Address, amount *uint256.Int) {
+	if !amount.IsZero() {
+		s.write(addr)
+	}
+}
+
+// AddBalance accepts the zero transfer made by every static call.
+func (s *provenState) AddBalance(addr common.Address, amount *uint256.Int) {
+	if !amount.IsZero() {
+		s.write(addr)
+	}
+}
+
+func (s *provenState) GetBalance(addr common.Address) *uint256.Int {
+	return new(uint256.Int).Set(s.account(addr).balance)
+}
+
+func (s *provenState) GetNonce(addr common.Address) uint64 { return s.account(addr).nonce }
+
+func (s *provenState) SetNonce(addr common.Address, _ uint64) { s.write(addr) }
+
+func (s *provenState) GetCodeHash(addr common.Address) common.Hash {
+	acc := s.account(addr)
+	if !acc.exists {
+		return common.Hash{}
+	}
+	return acc.codeHash
+}
+
+func (s *provenState) GetCode(addr common.Address) []byte { return s.account(addr).code }
+
+func (s *provenState) SetCode(addr common.Address, _ []byte) { s.write(addr) }
+
+func (s *provenState) GetCodeSize(addr common.Address) int { return len(s.account(addr).code) }
+
+func (s *provenState) AddRefund(uint64) {}
+
+func (s *provenState) SubRefund(uint64) {}
+
+func (s *provenState) GetRefund() uint64 { return 0 }
+
+func (s *provenState) GetCommittedState(addr common.Address, slot common.Hash) common.Hash {
+	return s.GetState(addr, slot)
+}
+
+func (s *provenState) GetState(addr common.Address, slot common.Hash) common.Hash {
+	value, ok := s.account(addr).storage[slot]
+	if !ok {
+		s.fail(fmt.Errorf("storage slot %s of %s read without proof", slot, addr))
+	}
+	return value
+}
+
+func (s *provenState) SetState(addr common.Address, _, _ common.Hash) { s.write(addr) }
+
+func (s *provenState) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transient[addr][key]
+}
+
+func (s *provenState) SetTransientState(addr common.Address, key, value common.Hash) {
+	if s.transient[addr] == nil {
+		s.transient[addr] = make(map[common.Hash]common.Hash)
+	}
+	s.transient[addr][key] = value
+}
+
+func (s *provenState) SelfDestruct(addr common.Address) { s.write(addr) }
+
+func (s *provenState) HasSelfDestructed(common.Address) bool { return false }
+
+func (s *provenState) Selfdestruct6780(addr common.Address) { s.write(addr) }
+
+func (s *provenState) Exist(addr common.Address) bool { return s.account(addr).exists }
+
+func (s *provenState) Empty(addr common.Address) bool {
+	acc := s.account(addr)
+	return acc.nonce == 0 && acc.balance.IsZero() && len(acc.code) == 0
+}
+
+func (s *provenState) AddressInAccessList(addr common.Address) bool {
+	_, ok := s.accessedAddresses[addr]
+	return ok
+}
+
+func (s *provenState) SlotInAccessList(addr common.Address, slot common.Hash) (bool, bool) {
+	_, addressOk := s.accessedAddresses[addr]
+	_, slotOk := s.accessedSlots[addr][slot]
+	return addressOk, slotOk
+}
+
+func (s *provenState) AddAddressToAccessList(addr common.Address) {
+	s.accessedAddresses[addr] = struct{}{}
+}
+
+func (s *provenState) AddSlotToAccessList(addr common.Address, slot common.Hash) {
+	s.AddAddressToAccessList(addr)
+	if s.accessedSlots[addr] == nil {
+		s.accessedSlots[addr] = make(map[common.Hash]struct{})
+	}
+	s.accessedSlots[addr][slot] = struct{}{}
+}
+
+func (s *provenState) Prepare(_ params.Rules, sender, coinbase common.Address, dest *common.Address, precompiles []common.Address, _ ethtypes.AccessList) {
+	s.AddAddressToAccessList(sender)
+	s.AddAddressToAccessList(coinbase)
+	if dest != nil {
+		s.AddAddressToAccessList(*dest)
+	}
+	for _, addr := range precompiles {
+		s.AddAddressToAccessList(addr)
+	}
+}
+
+// RevertToSnapshot is a no-op: the state is never written and the access list
+// only affects gas.
+func (s *provenState) RevertToSnapshot(int) {}
+
+func (s *provenState) Snapshot() int { return 0 }
+
+func (s *provenState) AddLog(*ethtypes.Log) {}
+
+func (s *provenState) AddPreimage(common.Hash, []byte) {}
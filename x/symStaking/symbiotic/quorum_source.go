@@ -0,0 +1,339 @@
+package symbiotic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/symStaking/types"
+)
+
+// quorumGrace is the time given to the endpoints still pending once a quorum
+// is reached to answer, before being scored as failed.
+const quorumGrace = 5 * time.Second
+
+var _ types.SymbioticSource = (*QuorumSource)(nil)
+
+// QuorumSource is a SymbioticSource reading every value from several endpoints
+// concurrently, and only returning it once a threshold of them agree. The
+// endpoints queried are the healthiest ones, scored by their failures and
+// latency.
+type QuorumSource struct {
+	logger    log.Logger
+	beacon    *endpointPool
+	eth       *endpointPool
+	size      int
+	threshold int
+	timeout   time.Duration
+}
+
+// NewQuorumSource creates a new QuorumSource over the given endpoints. Every
+// read queries size endpoints, or all of them if size is 0, and requires
+// threshold of them to agree.
+func NewQuorumSource(logger log.Logger, beaconURLs, ethURLs []string, size, threshold int) *QuorumSource {
+	return &QuorumSource{
+		logger:    logger,
+		beacon:    newEndpointPool(EndpointKindBeacon, beaconURLs),
+		eth:       newEndpointPool(EndpointKindEth, ethURLs),
+		size:      size,
+		threshold: threshold,
+		timeout:   RequestTimeout,
+	}
+}
+
+// SetRequestTimeout sets the time given to the endpoints to answer a quorum
+// round, RequestTimeout by default. The endpoints still pending then are
+// scored as failed.
+func (s *QuorumSource) SetRequestTimeout(timeout time.Duration) {
+	s.timeout = timeout
+}
+
+// BeaconBlock implements types.SymbioticSource. The endpoints must agree on the
+// finality of the block and on the hash of its execution block.
+func (s *QuorumSource) BeaconBlock(ctx context.Context, slot int64) (types.BeaconBlock, error) {
+	path := BlockPath + strconv.FormatInt(slot, 10)
+	return quorumRead(ctx, s, s.beacon, func(ctx context.Context, url string) (types.BeaconBlock, string, error) {
+		var block types.BeaconBlock
+		if err := fetchBeacon(ctx, url+path, &block); err != nil {
+			return block, "", err
+		}
+		return block, fmt.Sprintf("%t/%s", block.Finalized, block.Data.Message.Body.ExecutionPayload.BlockHash), nil
+	})
+}
+
+// HeaderByHash implements types.SymbioticSource.
+func (s *QuorumSource) HeaderByHash(ctx context.Context, hash common.Hash) (*ethtypes.Header, error) {
+	return quorumRead(ctx, s, s.eth, func(ctx context.Context, url string) (*ethtypes.Header, string, error) {
+		return withEthClientAt(ctx, url, func(client *ethclient.Client) (*ethtypes.Header, string, error) {
+			header, err := client.HeaderByHash(ctx, hash)
+			if err != nil {
+				return nil, "", err
+			}
+			return header, header.Hash().Hex(), nil
+		})
+	})
+}
+
+// HeaderByNumber implements types.SymbioticSource.
+func (s *QuorumSource) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	return quorumRead(ctx, s, s.eth, func(ctx context.Context, url string) (*ethtypes.Header, string, error) {
+		return withEthClientAt(ctx, url, func(client *ethclient.Client) (*ethtypes.Header, string, error) {
+			header, err := client.HeaderByNumber(ctx, number)
+			if err != nil {
+				return nil, "", err
+			}
+			return header, header.Hash().Hex(), nil
+		})
+	})
+}
+
+// CallContract implements types.SymbioticSource. The endpoints must return the
+// same result, i.e. the same validator set for the middleware calls.
+func (s *QuorumSource) CallContract(ctx context.Context, contract common.Address, data []byte, blockHash common.Hash) ([]byte, error) {
+	return quorumRead(ctx, s, s.eth, func(ctx context.Context, url string) ([]byte, string, error) {
+		return withEthClientAt(ctx, url, func(client *ethclient.Client) ([]byte, string, error) {
+			result, err := client.CallContractAtHash(ctx, ethereum.CallMsg{To: &contract, Data: data}, blockHash)
+			if err != nil {
+				return nil, "", err
+			}
+			return result, hexutil.Encode(result), nil
+		})
+	})
+}
+
+// BeaconHealth returns the health of the beacon API endpoints.
+func (s *QuorumSource) BeaconHealth() []EndpointHealth {
+	return s.beacon.Health()
+}
+
+// EthHealth returns the health of the execution layer JSON-RPC endpoints.
+func (s *QuorumSource) EthHealth() []EndpointHealth {
+	return s.eth.Health()
+}
+
+// validate returns an error if the quorum can't be reached with the endpoints
+// of pool.
+func (s *QuorumSource) validate(pool *endpointPool) error {
+	endpoints := len(pool.health)
+	if endpoints == 0 {
+		return fmt.Errorf("%w: no %s api urls", types.ErrSymbioticNotConfigured, pool.kind)
+	}
+
+	if s.threshold <= 0 || s.threshold > s.querySize(pool) {
+		return fmt.Errorf("%w: quorum of %d out of %d %s endpoints", types.ErrSymbioticNotConfigured, s.threshold, s.querySize(pool), pool.kind)
+	}
+
+	return nil
+}
+
+// querySize returns the number of endpoints of pool queried by every read.
+func (s *QuorumSource) querySize(pool *endpointPool) int {
+	if s.size <= 0 {
+		return len(pool.health)
+	}
+	return min(s.size, len(pool.health))
+}
+
+// quorumAnswer is the answer of an endpoint to a quorum read.
+type quorumAnswer[T any] struct {
+	url     string
+	value   T
+	key     string
+	err     error
+	latency time.Duration
+}
+
+// quorumRead runs fetch concurrently against the healthiest endpoints of pool
+// until the threshold of s agree on a result, retrying with the endpoints
+// rescored otherwise. fetch returns the value read along with the key it is
+// compared by.
+func quorumRead[T any](
+	ctx context.Context,
+	s *QuorumSource,
+	pool *endpointPool,
+	fetch func(ctx context.Context, url string) (T, string, error),
+) (T, error) {
+	var zero T
+	if err := s.validate(pool); err != nil {
+		return zero, err
+	}
+
+	var err error
+	for i := 0; i < Retries; i++ {
+		var (
+			value T
+			ok    bool
+		)
+		value, ok, err = quorumRound(ctx, s, pool, fetch)
+		if ok {
+			return value, err
+		}
+
+		s.logger.Error("rpc error: quorum not reached", "kind", pool.kind, "err", err)
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-time.After(SleepOnRetry):
+		}
+	}
+
+	return zero, err
+}
+
+// quorumRound queries the healthiest endpoints of pool once. It returns true
+// along with the result agreed on, or false along with an error if the quorum
+// wasn't reached within the request timeout of s. The endpoints still pending
+// once the quorum is reached are scored in the background.
+func quorumRound[T any](
+	ctx context.Context,
+	s *QuorumSource,
+	pool *endpointPool,
+	fetch func(ctx context.Context, url string) (T, string, error),
+) (T, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+
+	urls := pool.Select(s.querySize(pool))
+	start := time.Now()
+	answers := make(chan quorumAnswer[T], len(urls))
+	for _, url := range urls {
+		go func(url string) {
+			value, key, err := fetch(ctx, url)
+			answers <- quorumAnswer[T]{url: url, value: value, key: key, err: err, latency: time.Since(start)}
+		}(url)
+	}
+
+	var (
+		zero     T
+		votes    = make(map[string][]quorumAnswer[T])
+		answered = make(map[string]bool, len(urls))
+		pending  = len(urls)
+		best     int
+		lastErr  error
+	)
+	for pending > 0 && best+pending >= s.threshold {
+		var answer quorumAnswer[T]
+		select {
+		case answer = <-answers:
+		case <-ctx.Done():
+			// the endpoints that didn't answer in time are scored as failures
+			for _, url := range urls {
+				if !answered[url] {
+					pool.Record(url, time.Since(start), true)
+				}
+			}
+			lastErr = fmt.Errorf("%d endpoints pending: %w", pending, ctx.Err())
+			pending = 0
+			continue
+		}
+		pending--
+		answered[answer.url] = true
+
+		key, ok := answerKey(answer)
+		if !ok {
+			s.logger.Debug("rpc error: endpoint failed", "kind", pool.kind, "err", answer.err)
+			pool.Record(answer.url, answer.latency, true)
+			lastErr = answer.err
+			continue
+		}
+
+		votes[key] = append(votes[key], answer)
+		best = max(best, len(votes[key]))
+		if len(votes[key]) < s.threshold {
+			continue
+		}
+
+		// the endpoints that disagree with the quorum are scored as failures
+		for k, voters := range votes {
+			for _, a := range voters {
+				pool.Record(a.url, a.latency, k != key)
+			}
+		}
+		go scorePending(cancel, pool, answers, answered, urls, key, start)
+
+		return answer.value, true, answer.err
+	}
+	cancel()
+
+	// no result can be told wrong without a quorum
+	for _, voters := range votes {
+		for _, a := range voters {
+			pool.Record(a.url, a.latency, false)
+		}
+	}
+
+	err := fmt.Errorf("%w: %d out of %d %s endpoints agreed, %d required", types.ErrSymbioticNoQuorum, best, len(urls), pool.kind, s.threshold)
+	if lastErr != nil {
+		err = fmt.Errorf("%w: %w", err, lastErr)
+	}
+	return zero, false, err
+}
+
+// scorePending scores the answers of the endpoints still pending once the
+// quorum on key is reached. The endpoints not answering within quorumGrace are
+// cancelled and scored as failures.
+func scorePending[T any](
+	cancel context.CancelFunc,
+	pool *endpointPool,
+	answers <-chan quorumAnswer[T],
+	answered map[string]bool,
+	urls []string,
+	key string,
+	start time.Time,
+) {
+	defer cancel()
+
+	timeout := time.After(quorumGrace)
+	for len(answered) < len(urls) {
+		select {
+		case answer := <-answers:
+			answered[answer.url] = true
+			k, ok := answerKey(answer)
+			pool.Record(answer.url, answer.latency, !ok || k != key)
+		case <-timeout:
+			for _, url := range urls {
+				if !answered[url] {
+					pool.Record(url, time.Since(start), true)
+				}
+			}
+			return
+		}
+	}
+}
+
+// answerKey returns the key an answer is compared by, or false if it is a
+// failure of the endpoint. Errors that are answers of the endpoint (not found,
+// non canonical block) are compared as results.
+func answerKey[T any](answer quorumAnswer[T]) (string, bool) {
+	switch {
+	case answer.err == nil:
+		return answer.key, true
+	case errors.Is(answer.err, types.ErrSymbioticNotFound), errors.Is(answer.err, ethereum.NotFound):
+		return "not found", true
+	case types.IsNotCanonicalError(answer.err):
+		return "not canonical", true
+	default:
+		return "", false
+	}
+}
+
+// withEthClientAt runs fn against the execution endpoint at url.
+func withEthClientAt[T any](ctx context.Context, url string, fn func(client *ethclient.Client) (T, string, error)) (T, string, error) {
+	client, err := dialEth(ctx, url)
+	if err != nil {
+		var zero T
+		return zero, "", err
+	}
+	defer client.Close()
+
+	return fn(client)
+}
@@ -0,0 +1,201 @@
+package symbiotic_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/symStaking/symbiotic"
+	"cosmossdk.io/x/symStaking/types"
+)
+
+// newBeaconServer serves the finalized block of the slot 64 with the given
+// execution block hash, or the given error status if not 200.
+func newBeaconServer(t *testing.T, blockHash string, status int) string {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		if r.URL.Path != symbiotic.BlockPath+"64" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"finalized":true,"data":{"message":{"body":{"execution_payload":{"block_hash":"%s"}}}}}`, blockHash)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL
+}
+
+// newEthServer serves eth_call with the given result.
+func newEthServer(t *testing.T, result string) string {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "eth_call" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"%s"}`, req.ID, result)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL
+}
+
+// newHangingServer never answers the requests it accepts.
+func newHangingServer(t *testing.T) string {
+	t.Helper()
+
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	t.Cleanup(func() {
+		close(done)
+		srv.Close()
+	})
+
+	return srv.URL
+}
+
+func TestQuorumSourceBeaconBlock(t *testing.T) {
+	urls := []string{
+		newBeaconServer(t, "0xdef", http.StatusOK),
+		newBeaconServer(t, "0xabc", http.StatusOK),
+		newBeaconServer(t, "0xabc", http.StatusOK),
+	}
+	source := symbiotic.NewQuorumSource(log.NewNopLogger(), urls, nil, 0, 2)
+
+	block, err := source.BeaconBlock(context.Background(), 64)
+	require.NoError(t, err)
+	require.True(t, block.Finalized)
+	require.Equal(t, "0xabc", block.Data.Message.Body.ExecutionPayload.BlockHash)
+
+	// the endpoints agree on the missing slots
+	_, err = source.BeaconBlock(context.Background(), 65)
+	require.ErrorIs(t, err, types.ErrSymbioticNotFound)
+
+	// the endpoint disagreeing with the quorum is scored as failed, even if it
+	// answered after the quorum was reached
+	require.Eventually(t, func() bool {
+		health := source.BeaconHealth()
+		return health[0].Requests == 2 && health[2].Requests == 2
+	}, time.Second, 10*time.Millisecond)
+
+	health := source.BeaconHealth()
+	require.Equal(t, uint64(1), health[0].Errors)
+	require.Positive(t, health[0].FailureScore)
+	require.Zero(t, health[1].Errors)
+	require.Zero(t, health[1].FailureScore)
+}
+
+func TestQuorumSourceNoQuorum(t *testing.T) {
+	urls := []string{
+		newBeaconServer(t, "0xabc", http.StatusOK),
+		newBeaconServer(t, "0xdef", http.StatusOK),
+		newBeaconServer(t, "", http.StatusInternalServerError),
+	}
+	source := symbiotic.NewQuorumSource(log.NewNopLogger(), urls, nil, 0, 2)
+
+	_, err := source.BeaconBlock(context.Background(), 64)
+	require.ErrorIs(t, err, types.ErrSymbioticNoQuorum)
+
+	health := source.BeaconHealth()
+	require.Zero(t, health[0].Errors)
+	require.Zero(t, health[1].Errors)
+	require.Equal(t, uint64(symbiotic.Retries), health[2].Errors)
+}
+
+func TestQuorumSourceEndpointNeverAnswers(t *testing.T) {
+	beaconURLs := []string{newBeaconServer(t, "0xabc", http.StatusOK), newHangingServer(t)}
+	ethURLs := []string{newEthServer(t, "0x01"), newHangingServer(t)}
+	source := symbiotic.NewQuorumSource(log.NewNopLogger(), beaconURLs, ethURLs, 0, 2)
+	source.SetRequestTimeout(50 * time.Millisecond)
+
+	// every round gives up on the endpoint instead of waiting for it
+	_, err := source.BeaconBlock(context.Background(), 64)
+	require.ErrorIs(t, err, types.ErrSymbioticNoQuorum)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	health := source.BeaconHealth()
+	require.Zero(t, health[0].Errors)
+	require.Equal(t, uint64(symbiotic.Retries), health[1].Errors)
+
+	_, err = source.CallContract(context.Background(), common.HexToAddress("0x01"), nil, common.HexToHash("0x02"))
+	require.ErrorIs(t, err, types.ErrSymbioticNoQuorum)
+	require.Equal(t, uint64(symbiotic.Retries), source.EthHealth()[1].Errors)
+}
+
+func TestQuorumSourceHealthiestEndpoints(t *testing.T) {
+	urls := []string{
+		newBeaconServer(t, "", http.StatusInternalServerError),
+		newBeaconServer(t, "0xabc", http.StatusOK),
+		newBeaconServer(t, "0xabc", http.StatusOK),
+	}
+	source := symbiotic.NewQuorumSource(log.NewNopLogger(), urls, nil, 2, 2)
+
+	// the failing endpoint is first queried, then left out for the healthy ones
+	block, err := source.BeaconBlock(context.Background(), 64)
+	require.NoError(t, err)
+	require.Equal(t, "0xabc", block.Data.Message.Body.ExecutionPayload.BlockHash)
+
+	health := source.BeaconHealth()
+	require.Equal(t, uint64(1), health[0].Requests)
+	require.Equal(t, uint64(1), health[0].Errors)
+	require.Equal(t, uint64(1), health[2].Requests)
+
+	_, err = source.BeaconBlock(context.Background(), 64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), source.BeaconHealth()[0].Requests)
+}
+
+func TestQuorumSourceCallContract(t *testing.T) {
+	urls := []string{
+		newEthServer(t, "0x01"),
+		newEthServer(t, "0x02"),
+		newEthServer(t, "0x01"),
+	}
+	source := symbiotic.NewQuorumSource(log.NewNopLogger(), nil, urls, 0, 2)
+
+	result, err := source.CallContract(context.Background(), common.HexToAddress("0x01"), nil, common.HexToHash("0x02"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, result)
+
+	require.Eventually(t, func() bool {
+		return source.EthHealth()[1].Errors == 1
+	}, time.Second, 10*time.Millisecond)
+
+	health := source.EthHealth()
+	require.Zero(t, health[0].Errors)
+	require.Zero(t, health[2].Errors)
+}
+
+func TestQuorumSourceNotConfigured(t *testing.T) {
+	source := symbiotic.NewQuorumSource(log.NewNopLogger(), []string{newBeaconServer(t, "0xabc", http.StatusOK)}, nil, 0, 2)
+
+	_, err := source.BeaconBlock(context.Background(), 64)
+	require.ErrorIs(t, err, types.ErrSymbioticNotConfigured)
+
+	_, err = source.HeaderByNumber(context.Background(), nil)
+	require.ErrorIs(t, err, types.ErrSymbioticNotConfigured)
+}
@@ -0,0 +1,342 @@
+package symbiotic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/symStaking/lightclient"
+	"cosmossdk.io/x/symStaking/types"
+)
+
+const (
+	// SleepOnRetry is the delay between two attempts against the RPC endpoints.
+	SleepOnRetry = 200 * time.Millisecond
+	// Retries is the number of attempts made before giving up on a request.
+	Retries = 5
+	// RequestTimeout is the time given to an endpoint to answer a request.
+	RequestTimeout = 10 * time.Second
+	// BlockPath is the beacon API path used to fetch a block by slot.
+	BlockPath = "/eth/v2/beacon/blocks/"
+	// LightClientBootstrapPath is the beacon API path of the light client bootstrap of a block root.
+	LightClientBootstrapPath = "/eth/v1/beacon/light_client/bootstrap/"
+	// LightClientUpdatesPath is the beacon API path of the light client updates.
+	LightClientUpdatesPath = "/eth/v1/beacon/light_client/updates"
+	// LightClientFinalityUpdatePath is the beacon API path of the latest light client finality update.
+	LightClientFinalityUpdatePath = "/eth/v1/beacon/light_client/finality_update"
+)
+
+// httpClient is the client of the beacon API and JSON-RPC requests, so that an
+// endpoint that never answers doesn't block the reads.
+var httpClient = &http.Client{Timeout: RequestTimeout}
+
+var (
+	_ types.SymbioticSource = (*RPCSource)(nil)
+	_ ProofSource           = (*RPCSource)(nil)
+	_ lightclient.API       = (*RPCSource)(nil)
+)
+
+// RPCSource is the default SymbioticSource. It reads the beacon chain through
+// the beacon REST API and the execution layer through the JSON-RPC API,
+// rotating over the configured endpoints on failure.
+type RPCSource struct {
+	logger  log.Logger
+	apiUrls *types.ApiUrls
+}
+
+// NewRPCSource creates a new RPCSource over the given endpoints.
+func NewRPCSource(logger log.Logger, apiUrls types.ApiUrls) *RPCSource {
+	return &RPCSource{
+		logger:  logger,
+		apiUrls: &apiUrls,
+	}
+}
+
+// BeaconBlock implements types.SymbioticSource.
+func (s *RPCSource) BeaconBlock(_ context.Context, slot int64) (types.BeaconBlock, error) {
+	var (
+		block types.BeaconBlock
+		err   error
+	)
+
+	if err = s.apiUrls.Validate(); err != nil {
+		return block, err
+	}
+
+	for i := 0; i < Retries; i++ {
+		block, err = s.parseBlock(slot)
+		if err == nil || errors.Is(err, types.ErrSymbioticNotFound) {
+			return block, err
+		}
+
+		s.apiUrls.RotateBeaconUrl()
+		time.Sleep(SleepOnRetry)
+	}
+
+	return block, err
+}
+
+// LightClientBootstrap implements lightclient.API.
+func (s *RPCSource) LightClientBootstrap(ctx context.Context, blockRoot common.Hash) (lightclient.Bootstrap, error) {
+	var resp struct {
+		Data lightclient.Bootstrap `json:"data"`
+	}
+	err := s.getBeacon(ctx, LightClientBootstrapPath+blockRoot.String(), &resp)
+	return resp.Data, err
+}
+
+// LightClientUpdates implements lightclient.API.
+func (s *RPCSource) LightClientUpdates(ctx context.Context, startPeriod, count uint64) ([]lightclient.Update, error) {
+	var resp []struct {
+		Data lightclient.Update `json:"data"`
+	}
+	path := fmt.Sprintf("%s?start_period=%d&count=%d", LightClientUpdatesPath, startPeriod, count)
+	if err := s.getBeacon(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+
+	updates := make([]lightclient.Update, len(resp))
+	for i, update := range resp {
+		updates[i] = update.Data
+	}
+	return updates, nil
+}
+
+// LightClientFinalityUpdate implements lightclient.API.
+func (s *RPCSource) LightClientFinalityUpdate(ctx context.Context) (lightclient.Update, error) {
+	var resp struct {
+		Data lightclient.Update `json:"data"`
+	}
+	err := s.getBeacon(ctx, LightClientFinalityUpdatePath, &resp)
+	return resp.Data, err
+}
+
+// HeaderByHash implements types.SymbioticSource.
+func (s *RPCSource) HeaderByHash(ctx context.Context, hash common.Hash) (*ethtypes.Header, error) {
+	var header *ethtypes.Header
+	err := s.withEthClient(func(client *ethclient.Client) error {
+		var err error
+		header, err = client.HeaderByHash(ctx, hash)
+		return err
+	})
+	return header, err
+}
+
+// HeaderByNumber implements types.SymbioticSource.
+func (s *RPCSource) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	var header *ethtypes.Header
+	err := s.withEthClient(func(client *ethclient.Client) error {
+		var err error
+		header, err = client.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+// CallContract implements types.SymbioticSource.
+func (s *RPCSource) CallContract(ctx context.Context, contract common.Address, data []byte, blockHash common.Hash) ([]byte, error) {
+	var result []byte
+	err := s.withEthClient(func(client *ethclient.Client) error {
+		var err error
+		result, err = client.CallContractAtHash(ctx, ethereum.CallMsg{To: &contract, Data: data}, blockHash)
+		if err != nil {
+			s.logger.Error("rpc error: eth_call error", "url", s.apiUrls.GetEthApiUrl(), "err", err)
+		}
+		return err
+	})
+	return result, err
+}
+
+// RawHeader implements ProofSource. It requires the debug namespace of the
+// execution client.
+func (s *RPCSource) RawHeader(ctx context.Context, blockHash common.Hash) ([]byte, error) {
+	var raw hexutil.Bytes
+	err := s.withEthClient(func(client *ethclient.Client) error {
+		return client.Client().CallContext(ctx, &raw, "debug_getRawHeader", rpc.BlockNumberOrHashWithHash(blockHash, false))
+	})
+	return raw, err
+}
+
+// AccessList implements ProofSource.
+func (s *RPCSource) AccessList(ctx context.Context, contract common.Address, data []byte, blockHash common.Hash) (ethtypes.AccessList, error) {
+	var result struct {
+		AccessList ethtypes.AccessList `json:"accessList"`
+		Error      string              `json:"error,omitempty"`
+	}
+	err := s.withEthClient(func(client *ethclient.Client) error {
+		call := map[string]interface{}{"to": contract, "input": hexutil.Bytes(data)}
+		return client.Client().CallContext(ctx, &result, "eth_createAccessList", call, rpc.BlockNumberOrHashWithHash(blockHash, false))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Error != "" {
+		return nil, fmt.Errorf("eth_createAccessList error: %s", result.Error)
+	}
+
+	return result.AccessList, nil
+}
+
+// Proof implements ProofSource.
+func (s *RPCSource) Proof(ctx context.Context, account common.Address, slots []common.Hash, blockHash common.Hash) (AccountProof, error) {
+	var proof AccountProof
+	err := s.withEthClient(func(client *ethclient.Client) error {
+		return client.Client().CallContext(ctx, &proof, "eth_getProof", account, slots, rpc.BlockNumberOrHashWithHash(blockHash, false))
+	})
+	return proof, err
+}
+
+// CodeAt implements ProofSource.
+func (s *RPCSource) CodeAt(ctx context.Context, account common.Address, blockHash common.Hash) ([]byte, error) {
+	var code []byte
+	err := s.withEthClient(func(client *ethclient.Client) error {
+		var err error
+		code, err = client.CodeAtHash(ctx, account, blockHash)
+		return err
+	})
+	return code, err
+}
+
+// withEthClient runs fn against the current execution endpoint, rotating to
+// the next endpoint on failure. Errors that won't be fixed by another endpoint
+// (not found, non canonical block) are returned immediately.
+func (s *RPCSource) withEthClient(fn func(client *ethclient.Client) error) error {
+	err := s.apiUrls.Validate()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < Retries; i++ {
+		var client *ethclient.Client
+		client, err = dialEth(context.Background(), s.apiUrls.GetEthApiUrl())
+		if err != nil {
+			s.logger.Error("rpc error: ethclient dial error", "url", s.apiUrls.GetEthApiUrl(), "err", err)
+		} else {
+			err = fn(client)
+			client.Close()
+		}
+
+		if err == nil || isPermanentError(err) {
+			return err
+		}
+
+		s.apiUrls.RotateEthUrl()
+		time.Sleep(SleepOnRetry)
+	}
+
+	return err
+}
+
+// dialEth connects to the execution endpoint at url, with the requests over
+// HTTP bounded by RequestTimeout.
+func dialEth(ctx context.Context, url string) (*ethclient.Client, error) {
+	client, err := rpc.DialOptions(ctx, url, rpc.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, err
+	}
+
+	return ethclient.NewClient(client), nil
+}
+
+// getBeacon decodes the JSON response of the beacon API at path into out,
+// rotating to the next endpoint on failure.
+func (s *RPCSource) getBeacon(ctx context.Context, path string, out any) error {
+	err := s.apiUrls.Validate()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < Retries; i++ {
+		err = fetchBeacon(ctx, s.apiUrls.GetBeaconApiUrl()+path, out)
+		if err == nil || errors.Is(err, types.ErrSymbioticNotFound) {
+			return err
+		}
+
+		s.logger.Error("rpc error: beacon rpc call error", "url", s.apiUrls.GetBeaconApiUrl(), "path", path, "err", err)
+		s.apiUrls.RotateBeaconUrl()
+		time.Sleep(SleepOnRetry)
+	}
+
+	return err
+}
+
+// fetchBeacon decodes the JSON response of the beacon API at url into out.
+func fetchBeacon(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return types.ErrSymbioticNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("error unmarshaling JSON: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RPCSource) parseBlock(slot int64) (types.BeaconBlock, error) {
+	url := s.apiUrls.GetBeaconApiUrl() + BlockPath + strconv.FormatInt(slot, 10)
+
+	var block types.BeaconBlock
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		s.logger.Error("rpc error: beacon rpc call error", "url", url, "err", err)
+		return block, fmt.Errorf("error making HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return block, types.ErrSymbioticNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Error("rpc error: beacon rpc call error", "url", url, "status", resp.StatusCode)
+		return block, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return block, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if err := json.Unmarshal(body, &block); err != nil {
+		return block, fmt.Errorf("error unmarshaling JSON: %w", err)
+	}
+
+	return block, nil
+}
+
+// isPermanentError reports whether err is an answer from the endpoint rather
+// than a transport failure.
+func isPermanentError(err error) bool {
+	return errors.Is(err, ethereum.NotFound) || types.IsNotCanonicalError(err)
+}
@@ -0,0 +1,46 @@
+package symbiotic_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/symStaking/symbiotic"
+	"cosmossdk.io/x/symStaking/types"
+)
+
+func TestRPCSourceBeaconBlock(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case symbiotic.BlockPath + "64":
+			_, _ = w.Write([]byte(`{"finalized":true,"data":{"message":{"body":{"execution_payload":{"block_hash":"0xabc"}}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	source := symbiotic.NewRPCSource(log.NewNopLogger(), types.NewApiUrls([]string{srv.URL}, []string{srv.URL}))
+
+	block, err := source.BeaconBlock(context.Background(), 64)
+	require.NoError(t, err)
+	require.True(t, block.Finalized)
+	require.Equal(t, "0xabc", block.Data.Message.Body.ExecutionPayload.BlockHash)
+
+	_, err = source.BeaconBlock(context.Background(), 65)
+	require.ErrorIs(t, err, types.ErrSymbioticNotFound)
+}
+
+func TestRPCSourceNotConfigured(t *testing.T) {
+	source := symbiotic.NewRPCSource(log.NewNopLogger(), symbiotic.DefaultConfig().ApiUrls())
+
+	_, err := source.BeaconBlock(context.Background(), 64)
+	require.ErrorIs(t, err, types.ErrSymbioticNotConfigured)
+
+	_, err = source.HeaderByNumber(context.Background(), nil)
+	require.ErrorIs(t, err, types.ErrSymbioticNotConfigured)
+}
@@ -0,0 +1,166 @@
+package symbiotic
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	"cosmossdk.io/x/symStaking/types"
+)
+
+// ProofSource defines the Ethereum data needed to verify a contract call
+// against the state root of an execution block.
+type ProofSource interface {
+	// RawHeader returns the RLP encoded header of the execution block with the given hash.
+	RawHeader(ctx context.Context, blockHash common.Hash) ([]byte, error)
+	// AccessList returns the accounts and storage slots read by a contract call.
+	AccessList(ctx context.Context, contract common.Address, data []byte, blockHash common.Hash) (ethtypes.AccessList, error)
+	// Proof returns the Merkle-Patricia proofs of an account and of the given storage slots.
+	Proof(ctx context.Context, account common.Address, slots []common.Hash, blockHash common.Hash) (AccountProof, error)
+	// CodeAt returns the code of an account.
+	CodeAt(ctx context.Context, account common.Address, blockHash common.Hash) ([]byte, error)
+}
+
+var _ types.SymbioticSource = (*VerifyingSource)(nil)
+
+// VerifyingSource is a SymbioticSource that doesn't trust the contract call
+// results of its endpoints. It fetches the Merkle-Patricia proofs of the
+// accounts and storage slots read by the call, verifies them against the state
+// root of the execution block and executes the call locally over the proven
+// state, so that a compromised endpoint can't forge the validator set.
+//
+// The rest of SymbioticSource is served by the wrapped source.
+type VerifyingSource struct {
+	types.SymbioticSource
+	proofs ProofSource
+}
+
+// NewVerifyingSource wraps source to verify its contract calls with proofs.
+func NewVerifyingSource(source types.SymbioticSource, proofs ProofSource) *VerifyingSource {
+	return &VerifyingSource{
+		SymbioticSource: source,
+		proofs:          proofs,
+	}
+}
+
+// CallContract implements types.SymbioticSource.
+func (s *VerifyingSource) CallContract(ctx context.Context, contract common.Address, data []byte, blockHash common.Hash) ([]byte, error) {
+	raw, err := s.proofs.RawHeader(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := decodeExecutionHeader(raw, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	accessList, err := s.proofs.AccessList(ctx, contract, data, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	state := newProvenState()
+	for _, tuple := range accessListWith(accessList, contract) {
+		account, err := s.proveAccount(ctx, header.Root, tuple.Address, tuple.StorageKeys, blockHash)
+		if err != nil {
+			return nil, err
+		}
+		state.accounts[tuple.Address] = account
+	}
+
+	return state.call(header, contract, data)
+}
+
+// proveAccount fetches and verifies the account, its code and the given storage slots.
+func (s *VerifyingSource) proveAccount(ctx context.Context, root common.Hash, addr common.Address, slots []common.Hash, blockHash common.Hash) (*provenAccount, error) {
+	proof, err := s.proofs.Proof(ctx, addr, slots, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := verifyAccountProof(root, addr, proof.AccountProof)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &provenAccount{
+		balance:  new(uint256.Int),
+		codeHash: ethtypes.EmptyCodeHash,
+		storage:  make(map[common.Hash]common.Hash, len(slots)),
+	}
+
+	storageRoot := ethtypes.EmptyRootHash
+	if acc != nil {
+		account.exists = true
+		account.nonce = acc.Nonce
+		account.balance = acc.Balance
+		account.codeHash = common.BytesToHash(acc.CodeHash)
+		storageRoot = acc.Root
+	}
+
+	nodes := make(map[common.Hash][]byte)
+	for _, sp := range proof.StorageProof {
+		for hash, node := range proofNodes(sp.Proof) {
+			nodes[hash] = node
+		}
+	}
+
+	for _, slot := range slots {
+		account.storage[slot], err = verifyStorageProof(storageRoot, slot, nodes)
+		if err != nil {
+			return nil, fmt.Errorf("account %s: %w", addr, err)
+		}
+	}
+
+	if account.codeHash != ethtypes.EmptyCodeHash {
+		account.code, err = s.proofs.CodeAt(ctx, addr, blockHash)
+		if err != nil {
+			return nil, err
+		}
+
+		if crypto.Keccak256Hash(account.code) != account.codeHash {
+			return nil, fmt.Errorf("code of %s doesn't match its code hash", addr)
+		}
+	}
+
+	return account, nil
+}
+
+// accessListWith merges the tuples of accessList by address, adds contract to
+// it and sorts it so that the proofs are fetched in a deterministic order.
+func accessListWith(accessList ethtypes.AccessList, contract common.Address) ethtypes.AccessList {
+	slots := map[common.Address]map[common.Hash]struct{}{contract: {}}
+	for _, tuple := range accessList {
+		if slots[tuple.Address] == nil {
+			slots[tuple.Address] = make(map[common.Hash]struct{})
+		}
+		for _, slot := range tuple.StorageKeys {
+			slots[tuple.Address][slot] = struct{}{}
+		}
+	}
+
+	merged := make(ethtypes.AccessList, 0, len(slots))
+	for addr, keys := range slots {
+		tuple := ethtypes.AccessTuple{Address: addr, StorageKeys: make([]common.Hash, 0, len(keys))}
+		for slot := range keys {
+			tuple.StorageKeys = append(tuple.StorageKeys, slot)
+		}
+		sort.Slice(tuple.StorageKeys, func(i, j int) bool {
+			return bytes.Compare(tuple.StorageKeys[i][:], tuple.StorageKeys[j][:]) < 0
+		})
+		merged = append(merged, tuple)
+	}
+
+	sort.Slice(merged, func(i, j int) bool {
+		return bytes.Compare(merged[i].Address[:], merged[j].Address[:]) < 0
+	})
+
+	return merged
+}
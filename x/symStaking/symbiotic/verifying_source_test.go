@@ -0,0 +1,285 @@
+package symbiotic_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/symStaking/symbiotic"
+	"cosmossdk.io/x/symStaking/testutil"
+)
+
+// returns the sum of the storage slots 0 and 1
+var sumCode = common.FromHex("0x6001546000540160005260206000f3")
+
+type fakeProofSource struct {
+	rawHeader    []byte
+	accessList   ethtypes.AccessList
+	stateNodes   []hexutil.Bytes
+	storageNodes map[common.Address][]hexutil.Bytes
+	code         map[common.Address][]byte
+}
+
+func (s *fakeProofSource) RawHeader(context.Context, common.Hash) ([]byte, error) {
+	return s.rawHeader, nil
+}
+
+func (s *fakeProofSource) AccessList(context.Context, common.Address, []byte, common.Hash) (ethtypes.AccessList, error) {
+	return s.accessList, nil
+}
+
+func (s *fakeProofSource) Proof(_ context.Context, account common.Address, _ []common.Hash, _ common.Hash) (symbiotic.AccountProof, error) {
+	return symbiotic.AccountProof{
+		Address:      account,
+		AccountProof: s.stateNodes,
+		StorageProof: []symbiotic.StorageProof{{Proof: s.storageNodes[account]}},
+	}, nil
+}
+
+func (s *fakeProofSource) CodeAt(_ context.Context, account common.Address, _ common.Hash) ([]byte, error) {
+	return s.code[account], nil
+}
+
+// newFakeProofSource builds the state of a contract running code with the given
+// storage, along with another account so that the state trie has a branch.
+func newFakeProofSource(t *testing.T, contract common.Address, code []byte, storage map[common.Hash]uint64) (*fakeProofSource, common.Hash) {
+	t.Helper()
+
+	storageEntries := make(map[common.Hash][]byte)
+	for slot, value := range storage {
+		enc, err := rlp.EncodeToBytes(new(big.Int).SetUint64(value))
+		require.NoError(t, err)
+		storageEntries[crypto.Keccak256Hash(slot.Bytes())] = enc
+	}
+	storageRoot, storageNodes := buildTrie(t, storageEntries)
+
+	other := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	stateEntries := make(map[common.Hash][]byte)
+	for addr, acc := range map[common.Address]ethtypes.StateAccount{
+		contract: {Nonce: 1, Balance: new(uint256.Int), Root: storageRoot, CodeHash: crypto.Keccak256(code)},
+		other:    {Balance: uint256.NewInt(1), Root: ethtypes.EmptyRootHash, CodeHash: ethtypes.EmptyCodeHash.Bytes()},
+	} {
+		enc, err := rlp.EncodeToBytes(&acc)
+		require.NoError(t, err)
+		stateEntries[crypto.Keccak256Hash(addr.Bytes())] = enc
+	}
+	stateRoot, stateNodes := buildTrie(t, stateEntries)
+
+	header := &ethtypes.Header{
+		Root:       stateRoot,
+		Difficulty: new(big.Int),
+		Number:     big.NewInt(100),
+		GasLimit:   30_000_000,
+		Time:       1000,
+		BaseFee:    big.NewInt(7),
+	}
+	rawHeader, err := rlp.EncodeToBytes(header)
+	require.NoError(t, err)
+
+	slots := make([]common.Hash, 0, len(storage))
+	for slot := range storage {
+		slots = append(slots, slot)
+	}
+
+	return &fakeProofSource{
+		rawHeader:    rawHeader,
+		accessList:   ethtypes.AccessList{{Address: contract, StorageKeys: slots}},
+		stateNodes:   stateNodes,
+		storageNodes: map[common.Address][]hexutil.Bytes{contract: storageNodes},
+		code:         map[common.Address][]byte{contract: code},
+	}, header.Hash()
+}
+
+func TestVerifyingSourceCallContract(t *testing.T) {
+	contract := common.HexToAddress("0x5081a39b8A5f0E35a8D959395a630b68B74Dd30f")
+	slot0, slot1, slot5 := common.Hash{}, common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(5))
+
+	testCases := []struct {
+		name     string
+		malleate func(proofs *fakeProofSource)
+		expErr   string
+	}{
+		{
+			name:     "valid",
+			malleate: func(*fakeProofSource) {},
+		},
+		{
+			name: "unproven slot",
+			malleate: func(proofs *fakeProofSource) {
+				proofs.accessList = ethtypes.AccessList{{Address: contract, StorageKeys: []common.Hash{slot0}}}
+			},
+			expErr: "read without proof",
+		},
+		{
+			name: "forged storage",
+			malleate: func(proofs *fakeProofSource) {
+				forged, _ := newFakeProofSource(t, contract, sumCode, map[common.Hash]uint64{slot0: 1000, slot5: 7})
+				proofs.storageNodes = forged.storageNodes
+			},
+			expErr: "invalid storage proof",
+		},
+		{
+			name: "forged code",
+			malleate: func(proofs *fakeProofSource) {
+				proofs.code[contract] = common.FromHex("0x6000")
+			},
+			expErr: "doesn't match its code hash",
+		},
+		{
+			name: "forged header",
+			malleate: func(proofs *fakeProofSource) {
+				proofs.rawHeader = append([]byte{}, proofs.rawHeader...)
+				proofs.rawHeader[len(proofs.rawHeader)-1]++
+			},
+			expErr: "doesn't match block hash",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// slot 1 is absent from the storage trie and proven to be zero
+			proofs, blockHash := newFakeProofSource(t, contract, sumCode, map[common.Hash]uint64{slot0: 42, slot5: 7})
+			proofs.accessList[0].StorageKeys = []common.Hash{slot0, slot1}
+			tc.malleate(proofs)
+
+			source := symbiotic.NewVerifyingSource(testutil.NewFakeSymbioticSource(), proofs)
+
+			result, err := source.CallContract(context.Background(), contract, nil, blockHash)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, common.BigToHash(big.NewInt(42)).Bytes(), result)
+		})
+	}
+}
+
+type trieEntry struct {
+	path  []byte
+	value []byte
+}
+
+// buildTrie builds the Merkle-Patricia trie holding entries and returns its root
+// and the nodes referenced by hash.
+func buildTrie(t *testing.T, entries map[common.Hash][]byte) (common.Hash, []hexutil.Bytes) {
+	t.Helper()
+
+	var trieEntries []trieEntry
+	for key, value := range entries {
+		trieEntries = append(trieEntries, trieEntry{path: toNibbles(key.Bytes()), value: value})
+	}
+
+	var nodes []hexutil.Bytes
+	root := encodeTrieNode(t, trieEntries, &nodes)
+	nodes = append(nodes, root)
+
+	return crypto.Keccak256Hash(root), nodes
+}
+
+func encodeTrieNode(t *testing.T, entries []trieEntry, nodes *[]hexutil.Bytes) []byte {
+	t.Helper()
+
+	sort.Slice(entries, func(i, j int) bool { return bytes.Compare(entries[i].path, entries[j].path) < 0 })
+
+	var elems []interface{}
+	switch prefix := commonPrefix(entries); {
+	case len(entries) == 1:
+		elems = []interface{}{hexPrefix(entries[0].path, true), entries[0].value}
+
+	case len(prefix) > 0:
+		children := make([]trieEntry, len(entries))
+		for i, e := range entries {
+			children[i] = trieEntry{path: e.path[len(prefix):], value: e.value}
+		}
+		elems = []interface{}{hexPrefix(prefix, false), trieRef(t, encodeTrieNode(t, children, nodes), nodes)}
+
+	default:
+		elems = make([]interface{}, 17)
+		for i := range elems {
+			elems[i] = rlp.RawValue{0x80}
+		}
+		for nibble := byte(0); nibble < 16; nibble++ {
+			var children []trieEntry
+			for _, e := range entries {
+				if e.path[0] == nibble {
+					children = append(children, trieEntry{path: e.path[1:], value: e.value})
+				}
+			}
+			if len(children) > 0 {
+				elems[nibble] = trieRef(t, encodeTrieNode(t, children, nodes), nodes)
+			}
+		}
+	}
+
+	enc, err := rlp.EncodeToBytes(elems)
+	require.NoError(t, err)
+	return enc
+}
+
+// trieRef returns the reference to node stored in its parent.
+func trieRef(t *testing.T, node []byte, nodes *[]hexutil.Bytes) rlp.RawValue {
+	t.Helper()
+
+	if len(node) < 32 {
+		return node
+	}
+
+	*nodes = append(*nodes, node)
+	enc, err := rlp.EncodeToBytes(crypto.Keccak256(node))
+	require.NoError(t, err)
+	return enc
+}
+
+func commonPrefix(entries []trieEntry) []byte {
+	prefix := entries[0].path
+	for _, e := range entries[1:] {
+		i := 0
+		for i < len(prefix) && i < len(e.path) && prefix[i] == e.path[i] {
+			i++
+		}
+		prefix = prefix[:i]
+	}
+	return prefix
+}
+
+func hexPrefix(nibbles []byte, leaf bool) []byte {
+	flag := byte(0)
+	if leaf {
+		flag = 2
+	}
+
+	if len(nibbles)%2 == 1 {
+		flag++
+		out := []byte{flag<<4 | nibbles[0]}
+		for i := 1; i < len(nibbles); i += 2 {
+			out = append(out, nibbles[i]<<4|nibbles[i+1])
+		}
+		return out
+	}
+
+	out := []byte{flag << 4}
+	for i := 0; i < len(nibbles); i += 2 {
+		out = append(out, nibbles[i]<<4|nibbles[i+1])
+	}
+	return out
+}
+
+func toNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+	return nibbles
+}
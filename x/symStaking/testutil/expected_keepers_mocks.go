@@ -6,15 +6,17 @@ package testutil
 
 import (
 	context "context"
+	big "math/big"
 	reflect "reflect"
 
-	stakingv1beta1 "cosmossdk.io/api/cosmos/symStaking/v1beta1"
 	address "cosmossdk.io/core/address"
 	math "cosmossdk.io/math"
 	types "cosmossdk.io/x/consensus/types"
 	types0 "cosmossdk.io/x/symStaking/types"
 	types1 "github.com/cosmos/cosmos-sdk/crypto/types"
 	types2 "github.com/cosmos/cosmos-sdk/types"
+	common "github.com/ethereum/go-ethereum/common"
+	types3 "github.com/ethereum/go-ethereum/core/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -309,7 +311,6 @@ func (m *MockValidatorSet) EXPECT() *MockValidatorSetMockRecorder {
 	return m.recorder
 }
 
-
 // GetPubKeyByConsAddr mocks base method.
 func (m *MockValidatorSet) GetPubKeyByConsAddr(arg0 context.Context, arg1 types2.ConsAddress) (types1.PubKey, error) {
 	m.ctrl.T.Helper()
@@ -382,51 +383,6 @@ func (mr *MockValidatorSetMockRecorder) MaxValidators(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxValidators", reflect.TypeOf((*MockValidatorSet)(nil).MaxValidators), arg0)
 }
 
-// Slash mocks base method.
-func (m *MockValidatorSet) Slash(arg0 context.Context, arg1 types2.ConsAddress, arg2, arg3 int64, arg4 math.LegacyDec) (math.Int, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Slash", arg0, arg1, arg2, arg3, arg4)
-	ret0, _ := ret[0].(math.Int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Slash indicates an expected call of Slash.
-func (mr *MockValidatorSetMockRecorder) Slash(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockValidatorSet)(nil).Slash), arg0, arg1, arg2, arg3, arg4)
-}
-
-// SlashWithInfractionReason mocks base method.
-func (m *MockValidatorSet) SlashWithInfractionReason(arg0 context.Context, arg1 types2.ConsAddress, arg2, arg3 int64, arg4 math.LegacyDec, arg5 stakingv1beta1.Infraction) (math.Int, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SlashWithInfractionReason", arg0, arg1, arg2, arg3, arg4, arg5)
-	ret0, _ := ret[0].(math.Int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// SlashWithInfractionReason indicates an expected call of SlashWithInfractionReason.
-func (mr *MockValidatorSetMockRecorder) SlashWithInfractionReason(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashWithInfractionReason", reflect.TypeOf((*MockValidatorSet)(nil).SlashWithInfractionReason), arg0, arg1, arg2, arg3, arg4, arg5)
-}
-
-// StakingTokenSupply mocks base method.
-func (m *MockValidatorSet) StakingTokenSupply(arg0 context.Context) (math.Int, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StakingTokenSupply", arg0)
-	ret0, _ := ret[0].(math.Int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// StakingTokenSupply indicates an expected call of StakingTokenSupply.
-func (mr *MockValidatorSetMockRecorder) StakingTokenSupply(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StakingTokenSupply", reflect.TypeOf((*MockValidatorSet)(nil).StakingTokenSupply), arg0)
-}
-
 // TotalBondedTokens mocks base method.
 func (m *MockValidatorSet) TotalBondedTokens(arg0 context.Context) (math.Int, error) {
 	m.ctrl.T.Helper()
@@ -486,6 +442,48 @@ func (mr *MockValidatorSetMockRecorder) ValidatorByConsAddr(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockValidatorSet)(nil).ValidatorByConsAddr), arg0, arg1)
 }
 
+// MockCircuitKeeper is a mock of CircuitKeeper interface.
+type MockCircuitKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockCircuitKeeperMockRecorder
+}
+
+// MockCircuitKeeperMockRecorder is the mock recorder for MockCircuitKeeper.
+type MockCircuitKeeperMockRecorder struct {
+	mock *MockCircuitKeeper
+}
+
+// NewMockCircuitKeeper creates a new mock instance.
+func NewMockCircuitKeeper(ctrl *gomock.Controller) *MockCircuitKeeper {
+	mock := &MockCircuitKeeper{ctrl: ctrl}
+	mock.recorder = &MockCircuitKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCircuitKeeper) EXPECT() *MockCircuitKeeperMockRecorder {
+	return m.recorder
+}
+
+// DisableMsgs mocks base method.
+func (m *MockCircuitKeeper) DisableMsgs(ctx context.Context, msgTypeURLs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range msgTypeURLs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableMsgs", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableMsgs indicates an expected call of DisableMsgs.
+func (mr *MockCircuitKeeperMockRecorder) DisableMsgs(ctx interface{}, msgTypeURLs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, msgTypeURLs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMsgs", reflect.TypeOf((*MockCircuitKeeper)(nil).DisableMsgs), varargs...)
+}
+
 // MockStakingHooks is a mock of StakingHooks interface.
 type MockStakingHooks struct {
 	ctrl     *gomock.Controller
@@ -509,6 +507,20 @@ func (m *MockStakingHooks) EXPECT() *MockStakingHooksMockRecorder {
 	return m.recorder
 }
 
+// AfterConsensusPubKeyUpdate mocks base method.
+func (m *MockStakingHooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey types1.PubKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterConsensusPubKeyUpdate", ctx, oldPubKey, newPubKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterConsensusPubKeyUpdate indicates an expected call of AfterConsensusPubKeyUpdate.
+func (mr *MockStakingHooksMockRecorder) AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterConsensusPubKeyUpdate", reflect.TypeOf((*MockStakingHooks)(nil).AfterConsensusPubKeyUpdate), ctx, oldPubKey, newPubKey)
+}
+
 // AfterUnbondingInitiated mocks base method.
 func (m *MockStakingHooks) AfterUnbondingInitiated(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
@@ -659,3 +671,86 @@ func (mr *MockConsensusKeeperMockRecorder) Params(arg0, arg1 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockConsensusKeeper)(nil).Params), arg0, arg1)
 }
+
+// MockSymbioticSource is a mock of SymbioticSource interface.
+type MockSymbioticSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockSymbioticSourceMockRecorder
+}
+
+// MockSymbioticSourceMockRecorder is the mock recorder for MockSymbioticSource.
+type MockSymbioticSourceMockRecorder struct {
+	mock *MockSymbioticSource
+}
+
+// NewMockSymbioticSource creates a new mock instance.
+func NewMockSymbioticSource(ctrl *gomock.Controller) *MockSymbioticSource {
+	mock := &MockSymbioticSource{ctrl: ctrl}
+	mock.recorder = &MockSymbioticSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSymbioticSource) EXPECT() *MockSymbioticSourceMockRecorder {
+	return m.recorder
+}
+
+// BeaconBlock mocks base method.
+func (m *MockSymbioticSource) BeaconBlock(ctx context.Context, slot int64) (types0.BeaconBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeaconBlock", ctx, slot)
+	ret0, _ := ret[0].(types0.BeaconBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeaconBlock indicates an expected call of BeaconBlock.
+func (mr *MockSymbioticSourceMockRecorder) BeaconBlock(ctx, slot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeaconBlock", reflect.TypeOf((*MockSymbioticSource)(nil).BeaconBlock), ctx, slot)
+}
+
+// CallContract mocks base method.
+func (m *MockSymbioticSource) CallContract(ctx context.Context, contract common.Address, data []byte, blockHash common.Hash) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallContract", ctx, contract, data, blockHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallContract indicates an expected call of CallContract.
+func (mr *MockSymbioticSourceMockRecorder) CallContract(ctx, contract, data, blockHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockSymbioticSource)(nil).CallContract), ctx, contract, data, blockHash)
+}
+
+// HeaderByHash mocks base method.
+func (m *MockSymbioticSource) HeaderByHash(ctx context.Context, hash common.Hash) (*types3.Header, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeaderByHash", ctx, hash)
+	ret0, _ := ret[0].(*types3.Header)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeaderByHash indicates an expected call of HeaderByHash.
+func (mr *MockSymbioticSourceMockRecorder) HeaderByHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeaderByHash", reflect.TypeOf((*MockSymbioticSource)(nil).HeaderByHash), ctx, hash)
+}
+
+// HeaderByNumber mocks base method.
+func (m *MockSymbioticSource) HeaderByNumber(ctx context.Context, number *big.Int) (*types3.Header, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeaderByNumber", ctx, number)
+	ret0, _ := ret[0].(*types3.Header)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeaderByNumber indicates an expected call of HeaderByNumber.
+func (mr *MockSymbioticSourceMockRecorder) HeaderByNumber(ctx, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeaderByNumber", reflect.TypeOf((*MockSymbioticSource)(nil).HeaderByNumber), ctx, number)
+}
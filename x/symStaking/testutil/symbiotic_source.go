@@ -0,0 +1,130 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"cosmossdk.io/x/symStaking/types"
+)
+
+var _ types.SymbioticSource = (*FakeSymbioticSource)(nil)
+
+// FakeSymbioticSource is an in-memory types.SymbioticSource for tests.
+type FakeSymbioticSource struct {
+	BeaconBlocks map[int64]types.BeaconBlock
+	Headers      map[common.Hash]*ethtypes.Header
+	// Calls maps a contract, a block hash and the hex encoded call data to the
+	// returned bytes.
+	Calls map[common.Address]map[common.Hash]map[string][]byte
+	// Err, if set, is returned by every method.
+	Err error
+}
+
+// NewFakeSymbioticSource creates an empty FakeSymbioticSource.
+func NewFakeSymbioticSource() *FakeSymbioticSource {
+	return &FakeSymbioticSource{
+		BeaconBlocks: make(map[int64]types.BeaconBlock),
+		Headers:      make(map[common.Hash]*ethtypes.Header),
+		Calls:        make(map[common.Address]map[common.Hash]map[string][]byte),
+	}
+}
+
+// AddBeaconBlock registers a beacon block at slot pointing to the given execution block hash.
+func (s *FakeSymbioticSource) AddBeaconBlock(slot int64, blockHash string, finalized bool) {
+	var block types.BeaconBlock
+	block.Finalized = finalized
+	block.Data.Message.Body.ExecutionPayload.BlockHash = blockHash
+	s.BeaconBlocks[slot] = block
+}
+
+// AddHeader registers an execution block header and returns its hash.
+func (s *FakeSymbioticSource) AddHeader(header *ethtypes.Header) common.Hash {
+	hash := header.Hash()
+	s.Headers[hash] = header
+	return hash
+}
+
+// AddCall registers the result of a call to contract with the given data at blockHash.
+func (s *FakeSymbioticSource) AddCall(contract common.Address, blockHash common.Hash, data, result []byte) {
+	if s.Calls[contract] == nil {
+		s.Calls[contract] = make(map[common.Hash]map[string][]byte)
+	}
+	if s.Calls[contract][blockHash] == nil {
+		s.Calls[contract][blockHash] = make(map[string][]byte)
+	}
+	s.Calls[contract][blockHash][common.Bytes2Hex(data)] = result
+}
+
+// BeaconBlock implements types.SymbioticSource.
+func (s *FakeSymbioticSource) BeaconBlock(_ context.Context, slot int64) (types.BeaconBlock, error) {
+	if s.Err != nil {
+		return types.BeaconBlock{}, s.Err
+	}
+
+	block, ok := s.BeaconBlocks[slot]
+	if !ok {
+		return types.BeaconBlock{}, types.ErrSymbioticNotFound
+	}
+
+	return block, nil
+}
+
+// HeaderByHash implements types.SymbioticSource.
+func (s *FakeSymbioticSource) HeaderByHash(_ context.Context, hash common.Hash) (*ethtypes.Header, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	header, ok := s.Headers[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+
+	return header, nil
+}
+
+// HeaderByNumber implements types.SymbioticSource.
+func (s *FakeSymbioticSource) HeaderByNumber(_ context.Context, number *big.Int) (*ethtypes.Header, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	var latest *ethtypes.Header
+	for _, header := range s.Headers {
+		if number == nil {
+			if latest == nil || header.Number.Cmp(latest.Number) > 0 {
+				latest = header
+			}
+			continue
+		}
+
+		if header.Number.Cmp(number) == 0 {
+			return header, nil
+		}
+	}
+
+	if latest == nil {
+		return nil, ethereum.NotFound
+	}
+
+	return latest, nil
+}
+
+// CallContract implements types.SymbioticSource.
+func (s *FakeSymbioticSource) CallContract(_ context.Context, contract common.Address, data []byte, blockHash common.Hash) ([]byte, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	result, ok := s.Calls[contract][blockHash][common.Bytes2Hex(data)]
+	if !ok {
+		return nil, fmt.Errorf("no call registered for %x to %s at %s", data, contract, blockHash)
+	}
+
+	return result, nil
+}
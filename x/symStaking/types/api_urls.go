@@ -1,10 +1,9 @@
 package types
 
-import (
-	"os"
-	"strings"
-)
+import "cosmossdk.io/errors"
 
+// ApiUrls holds the beacon and execution layer endpoints used to sync with
+// the Symbiotic middleware and the index of the endpoint currently in use.
 type ApiUrls struct {
 	beaconApiUrls   []string
 	ethApiUrls      []string
@@ -12,26 +11,23 @@ type ApiUrls struct {
 	currentEthId    int
 }
 
-func NewApiUrls() ApiUrls {
-	// USE ONLY YOUR LOCAL BEACON CLIENT FOR SAFETY!!!
-	beaconApiUrls := strings.Split(os.Getenv("BEACON_API_URLS"), ",")
-	if len(beaconApiUrls) == 1 && beaconApiUrls[0] == "" {
-		beaconApiUrls[0] = "https://eth-holesky-beacon.public.blastapi.io"
-		beaconApiUrls = append(beaconApiUrls, "http://unstable.holesky.beacon-api.nimbus.team")
-		beaconApiUrls = append(beaconApiUrls, "https://ethereum-holesky-beacon-api.publicnode.com")
-	}
+// NewApiUrls creates a new ApiUrls from the given endpoint lists. There are no
+// public fallbacks: operators must configure their own endpoints.
+func NewApiUrls(beaconApiUrls, ethApiUrls []string) ApiUrls {
+	return ApiUrls{beaconApiUrls: beaconApiUrls, ethApiUrls: ethApiUrls}
+}
 
-	ethApiUrls := strings.Split(os.Getenv("ETH_API_URLS"), ",")
+// Validate returns an error if no beacon or execution endpoint is configured.
+func (au ApiUrls) Validate() error {
+	if len(au.beaconApiUrls) == 0 {
+		return errors.Wrap(ErrSymbioticNotConfigured, "no beacon api urls")
+	}
 
-	if len(ethApiUrls) == 1 && ethApiUrls[0] == "" {
-		ethApiUrls[0] = "https://rpc.ankr.com/eth_holesky"
-		ethApiUrls = append(ethApiUrls, "https://ethereum-holesky.blockpi.network/v1/rpc/public")
-		ethApiUrls = append(ethApiUrls, "https://eth-holesky.public.blastapi.io")
-		ethApiUrls = append(ethApiUrls, "https://ethereum-holesky.gateway.tatum.io")
-		ethApiUrls = append(ethApiUrls, "https://holesky.gateway.tenderly.co")
+	if len(au.ethApiUrls) == 0 {
+		return errors.Wrap(ErrSymbioticNotConfigured, "no eth api urls")
 	}
 
-	return ApiUrls{beaconApiUrls: beaconApiUrls, ethApiUrls: ethApiUrls}
+	return nil
 }
 
 func (au ApiUrls) GetEthApiUrl() string {
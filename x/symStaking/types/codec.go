@@ -15,6 +15,9 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCreateValidator{}, "cosmos-sdk/MsgCreateValidator")
 	legacy.RegisterAminoMsg(cdc, &MsgEditValidator{}, "cosmos-sdk/MsgEditValidator")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/symStaking/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgInjectSymbioticData{}, "cosmos-sdk/MsgInjectSymbioticData")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateSymbioticValidatorSet{}, "cosmos-sdk/MsgUpdateSymbioticValSet")
+	legacy.RegisterAminoMsg(cdc, &MsgLinkOperator{}, "cosmos-sdk/MsgLinkOperator")
 
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/symStaking/Params")
 }
@@ -25,6 +28,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgCreateValidator{},
 		&MsgEditValidator{},
 		&MsgUpdateParams{},
+		&MsgInjectSymbioticData{},
+		&MsgUpdateSymbioticValidatorSet{},
+		&MsgLinkOperator{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -51,6 +51,14 @@ var (
 	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 46, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid       = errors.Register(ModuleName, 47, "consensus pubkey len is invalid")
 
-	ErrSymbioticValUpdate = errors.Register(ModuleName, 48, "symbiotic validator update error")
-	ErrSymbioticNotFound  = errors.Register(ModuleName, 49, "symbiotic not found")
+	ErrSymbioticValUpdate     = errors.Register(ModuleName, 48, "symbiotic validator update error")
+	ErrSymbioticNotFound      = errors.Register(ModuleName, 49, "symbiotic not found")
+	ErrSymbioticNotConfigured = errors.Register(ModuleName, 50, "symbiotic rpc endpoints not configured")
+	ErrInvalidInjectedTx      = errors.Register(ModuleName, 51, "invalid injected symbiotic tx")
+	ErrAmbiguousSymbioticKey  = errors.Register(ModuleName, 52, "ambiguous symbiotic key")
+	ErrSymbioticNotStale      = errors.Register(ModuleName, 53, "symbiotic validator set not stale")
+	ErrInvalidCheckpointSig   = errors.Register(ModuleName, 54, "invalid symbiotic checkpoint signature")
+	ErrSymbioticNoQuorum      = errors.Register(ModuleName, 55, "symbiotic rpc endpoints quorum not reached")
+	ErrInvalidOperatorLink    = errors.Register(ModuleName, 56, "invalid symbiotic operator link")
+	ErrSymbioticNoCircuit     = errors.Register(ModuleName, 57, "symbiotic halt stale policy without circuit keeper")
 )
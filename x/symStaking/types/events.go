@@ -7,8 +7,35 @@ const (
 	EventTypeEditValidator     = "edit_validator"
 	EventTypeUnbond            = "unbond"
 
+	EventTypeSymbioticValidatorRemoved = "symbiotic_validator_removed"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
+	EventTypeSymbioticSyncStale        = "symbiotic_sync_stale"
+	EventTypeSymbioticSyncRecovered    = "symbiotic_sync_recovered"
+	EventTypeUpdateSymbioticValSet     = "update_symbiotic_validator_set"
+	EventTypeSymbioticCheckpoint       = "symbiotic_checkpoint"
+	EventTypeSymbioticCheckpointSigned = "symbiotic_checkpoint_signed"
+	EventTypeSymbioticSyncScheduled    = "symbiotic_sync_scheduled"
+	EventTypeLinkSymbioticOperator     = "link_symbiotic_operator"
+
 	AttributeKeyValidator      = "validator"
 	AttributeKeyCommissionRate = "commission_rate"
 	AttributeKeyCreationHeight = "creation_height"
 	AttributeKeyCompletionTime = "completion_time"
+	AttributeKeyTokens         = "tokens"
+
+	AttributeKeySymbioticOperator = "symbiotic_operator"
+	AttributeKeyOldConsAddress    = "old_consensus_address"
+	AttributeKeyNewConsAddress    = "new_consensus_address"
+	AttributeKeyStaleSince        = "stale_since"
+	AttributeKeySkippedSyncs      = "skipped_syncs"
+	AttributeKeyStalePolicy       = "stale_policy"
+	AttributeKeyValidatorsUpdated = "validators_updated"
+	AttributeKeyValidatorsRemoved = "validators_removed"
+	AttributeKeyEpoch             = "epoch"
+	AttributeKeyValidatorSetHash  = "validator_set_hash"
+	AttributeKeySignedPower       = "signed_power"
+	AttributeKeyTotalPower        = "total_power"
+	AttributeKeyEpochIdentifier   = "epoch_identifier"
+	AttributeKeyEpochNumber       = "epoch_number"
+	AttributeKeySyncHeight        = "sync_height"
 )
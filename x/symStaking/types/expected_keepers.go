@@ -2,6 +2,10 @@ package types
 
 import (
 	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
@@ -68,6 +72,12 @@ type ValidatorSet interface {
 	TotalBondedTokens(context.Context) (math.Int, error) // total bonded tokens within the validator set
 }
 
+// CircuitKeeper defines the expected circuit breaker keeper, used to disable
+// messages under the halt stale policy.
+type CircuitKeeper interface {
+	DisableMsgs(ctx context.Context, msgTypeURLs ...string) error
+}
+
 // Event Hooks
 // These can be utilized to communicate between a staking keeper and another
 // keeper which must take particular actions when validators/delegators change
@@ -85,6 +95,7 @@ type StakingHooks interface {
 
 	BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error
 	AfterUnbondingInitiated(ctx context.Context, id uint64) error
+	AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey) error // Must be called when the consensus pubkey of a validator is rotated
 }
 
 // StakingHooksWrapper is a wrapper for modules to inject StakingHooks using depinject.
@@ -97,3 +108,25 @@ type ConsensusKeeper interface {
 	Params(context.Context, *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error)
 	GetCometInfo(context.Context, *consensustypes.QueryGetCometInfoRequest) (*consensustypes.QueryGetCometInfoResponse, error)
 }
+
+// SymbioticSource defines the expected Ethereum data source used to sync the
+// validator set from the Symbiotic middleware. Implementations are responsible
+// for their own retries and endpoint selection.
+type SymbioticSource interface {
+	// BeaconBlock returns the beacon block at the given slot, or ErrSymbioticNotFound
+	// if the slot was skipped.
+	BeaconBlock(ctx context.Context, slot int64) (BeaconBlock, error)
+	// HeaderByHash returns the execution block header with the given hash.
+	HeaderByHash(ctx context.Context, hash common.Hash) (*ethtypes.Header, error)
+	// HeaderByNumber returns the canonical execution block header at the given number.
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+	// CallContract executes a read-only contract call at the given execution block.
+	CallContract(ctx context.Context, contract common.Address, data []byte, blockHash common.Hash) ([]byte, error)
+}
+
+// SymbioticLightClient defines the expected beacon light client the Symbiotic
+// source reads the finalized blocks from, run along with the node.
+type SymbioticLightClient interface {
+	// Run follows the finalized blocks until ctx is done.
+	Run(ctx context.Context)
+}
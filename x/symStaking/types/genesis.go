@@ -42,5 +42,10 @@ func (g GenesisState) UnpackInterfaces(c gogoprotoany.AnyUnpacker) error {
 			return err
 		}
 	}
+	for i := range g.ConsPubKeyRotations {
+		if err := g.ConsPubKeyRotations[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
 	return nil
 }
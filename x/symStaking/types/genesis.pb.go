@@ -40,6 +40,28 @@ type GenesisState struct {
 	Validators []Validator `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,5,opt,name=exported,proto3" json:"exported,omitempty"`
+	// symbiotic_anchor is the Ethereum block the validators were imported from,
+	// or last synced with if exported. It is stored as the sync point of its
+	// height.
+	SymbioticAnchor *SymbioticSyncPoint `protobuf:"bytes,6,opt,name=symbiotic_anchor,json=symbioticAnchor,proto3" json:"symbiotic_anchor,omitempty"`
+	// symbiotic_staleness tracks the sync heights skipped since the last
+	// validator set update, if any.
+	SymbioticStaleness *SymbioticStaleness `protobuf:"bytes,7,opt,name=symbiotic_staleness,json=symbioticStaleness,proto3" json:"symbiotic_staleness,omitempty"`
+	// symbiotic_next_sync_height is the height of the next sync scheduled by the
+	// epoch hooks, 0 if none is scheduled.
+	SymbioticNextSyncHeight int64 `protobuf:"varint,8,opt,name=symbiotic_next_sync_height,json=symbioticNextSyncHeight,proto3" json:"symbiotic_next_sync_height,omitempty"`
+	// symbiotic_stakes are the middleware stakes of the validators at the last
+	// validator set update.
+	SymbioticStakes []SymbioticValidatorStakes `protobuf:"bytes,9,rep,name=symbiotic_stakes,json=symbioticStakes,proto3" json:"symbiotic_stakes"`
+	// symbiotic_checkpoints are the checkpoints of the validator set, along with
+	// the ones still collecting signatures.
+	SymbioticCheckpoints []SymbioticCheckpoint `protobuf:"bytes,10,rep,name=symbiotic_checkpoints,json=symbioticCheckpoints,proto3" json:"symbiotic_checkpoints"`
+	// cons_pub_key_rotations are the consensus pubkey rotations of the
+	// validators not applied yet.
+	ConsPubKeyRotations []ConsPubKeyRotation `protobuf:"bytes,11,rep,name=cons_pub_key_rotations,json=consPubKeyRotations,proto3" json:"cons_pub_key_rotations"`
+	// old_cons_addresses are the consensus addresses the validators rotated
+	// from, which keep resolving to them.
+	OldConsAddresses []ValidatorOldConsAddress `protobuf:"bytes,12,rep,name=old_cons_addresses,json=oldConsAddresses,proto3" json:"old_cons_addresses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -103,6 +125,165 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetSymbioticAnchor() *SymbioticSyncPoint {
+	if m != nil {
+		return m.SymbioticAnchor
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSymbioticStaleness() *SymbioticStaleness {
+	if m != nil {
+		return m.SymbioticStaleness
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSymbioticNextSyncHeight() int64 {
+	if m != nil {
+		return m.SymbioticNextSyncHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSymbioticStakes() []SymbioticValidatorStakes {
+	if m != nil {
+		return m.SymbioticStakes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSymbioticCheckpoints() []SymbioticCheckpoint {
+	if m != nil {
+		return m.SymbioticCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsPubKeyRotations() []ConsPubKeyRotation {
+	if m != nil {
+		return m.ConsPubKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOldConsAddresses() []ValidatorOldConsAddress {
+	if m != nil {
+		return m.OldConsAddresses
+	}
+	return nil
+}
+
+// ValidatorOldConsAddress is a consensus address a validator rotated from.
+type ValidatorOldConsAddress struct {
+	// cons_address is the old consensus address.
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *ValidatorOldConsAddress) Reset()         { *m = ValidatorOldConsAddress{} }
+func (m *ValidatorOldConsAddress) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOldConsAddress) ProtoMessage()    {}
+func (*ValidatorOldConsAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4a78334c4fc7e58, []int{1}
+}
+func (m *ValidatorOldConsAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOldConsAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOldConsAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOldConsAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOldConsAddress.Merge(m, src)
+}
+func (m *ValidatorOldConsAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOldConsAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOldConsAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOldConsAddress proto.InternalMessageInfo
+
+func (m *ValidatorOldConsAddress) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *ValidatorOldConsAddress) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// SymbioticValidatorStakes are the middleware stakes of a validator.
+type SymbioticValidatorStakes struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// stakes are the stakes of the validator in each middleware.
+	Stakes []SymbioticMiddlewareStake `protobuf:"bytes,2,rep,name=stakes,proto3" json:"stakes"`
+}
+
+func (m *SymbioticValidatorStakes) Reset()         { *m = SymbioticValidatorStakes{} }
+func (m *SymbioticValidatorStakes) String() string { return proto.CompactTextString(m) }
+func (*SymbioticValidatorStakes) ProtoMessage()    {}
+func (*SymbioticValidatorStakes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4a78334c4fc7e58, []int{2}
+}
+func (m *SymbioticValidatorStakes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticValidatorStakes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticValidatorStakes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbioticValidatorStakes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticValidatorStakes.Merge(m, src)
+}
+func (m *SymbioticValidatorStakes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticValidatorStakes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticValidatorStakes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticValidatorStakes proto.InternalMessageInfo
+
+func (m *SymbioticValidatorStakes) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SymbioticValidatorStakes) GetStakes() []SymbioticMiddlewareStake {
+	if m != nil {
+		return m.Stakes
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -115,7 +296,7 @@ func (m *LastValidatorPower) Reset()         { *m = LastValidatorPower{} }
 func (m *LastValidatorPower) String() string { return proto.CompactTextString(m) }
 func (*LastValidatorPower) ProtoMessage()    {}
 func (*LastValidatorPower) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4a78334c4fc7e58, []int{1}
+	return fileDescriptor_c4a78334c4fc7e58, []int{3}
 }
 func (m *LastValidatorPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,6 +327,8 @@ var xxx_messageInfo_LastValidatorPower proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.symStaking.v1beta1.GenesisState")
+	proto.RegisterType((*ValidatorOldConsAddress)(nil), "cosmos.symStaking.v1beta1.ValidatorOldConsAddress")
+	proto.RegisterType((*SymbioticValidatorStakes)(nil), "cosmos.symStaking.v1beta1.SymbioticValidatorStakes")
 	proto.RegisterType((*LastValidatorPower)(nil), "cosmos.symStaking.v1beta1.LastValidatorPower")
 }
 
@@ -154,34 +337,54 @@ func init() {
 }
 
 var fileDescriptor_c4a78334c4fc7e58 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
-	0x1c, 0xc6, 0x33, 0xc6, 0x5d, 0xbb, 0xb3, 0x8b, 0xe8, 0xd8, 0x85, 0xd8, 0x43, 0x12, 0x17, 0xc1,
-	0x20, 0x34, 0x71, 0xeb, 0x49, 0x6f, 0x06, 0x41, 0x16, 0x04, 0x4b, 0x2a, 0x1e, 0x7a, 0x29, 0x53,
-	0x33, 0xa4, 0xa1, 0x49, 0x26, 0x64, 0xfe, 0xd4, 0xf6, 0x0d, 0x04, 0x2f, 0x3e, 0x42, 0x8f, 0x1e,
-	0x3d, 0xf4, 0x21, 0x7a, 0x2c, 0x3d, 0x89, 0x87, 0x22, 0xed, 0x41, 0x1f, 0x43, 0x92, 0x49, 0xdb,
-	0x48, 0xb1, 0x97, 0x90, 0xc9, 0xff, 0xfb, 0x7e, 0xdf, 0x47, 0xe6, 0x8f, 0x9f, 0x7c, 0xe4, 0x22,
-	0xe6, 0xc2, 0x11, 0x93, 0xb8, 0x03, 0x74, 0x18, 0x26, 0x81, 0x33, 0xba, 0xee, 0x33, 0xa0, 0xd7,
-	0x4e, 0xc0, 0x12, 0x26, 0x42, 0x61, 0xa7, 0x19, 0x07, 0x4e, 0x1e, 0x4a, 0xa1, 0xbd, 0x17, 0xda,
-	0xa5, 0xb0, 0x51, 0x0f, 0x78, 0xc0, 0x0b, 0x95, 0x93, 0xbf, 0x49, 0x43, 0xe3, 0x08, 0x59, 0x94,
-	0x00, 0x29, 0x2c, 0xc9, 0x3d, 0x49, 0x28, 0x63, 0xe4, 0xe8, 0x3e, 0x8d, 0xc3, 0x84, 0x3b, 0xc5,
-	0x53, 0x7e, 0xba, 0xfa, 0xa2, 0xe2, 0x8b, 0x37, 0xb2, 0x59, 0x07, 0x28, 0x30, 0xf2, 0x1a, 0x9f,
-	0xa6, 0x34, 0xa3, 0xb1, 0xd0, 0x90, 0x89, 0xac, 0xf3, 0xd6, 0x23, 0xfb, 0xbf, 0x4d, 0xed, 0x76,
-	0x21, 0x74, 0xcf, 0xe6, 0x2b, 0x43, 0xf9, 0xf6, 0xfb, 0xfb, 0x53, 0xe4, 0x95, 0x5e, 0xd2, 0xc5,
-	0xf7, 0x22, 0x2a, 0xa0, 0x07, 0x1c, 0x68, 0xd4, 0x4b, 0xf9, 0x27, 0x96, 0x69, 0xb7, 0x4c, 0x64,
-	0x5d, 0xb8, 0xcf, 0x72, 0xf1, 0xcf, 0x95, 0x71, 0x29, 0xb1, 0xc2, 0x1f, 0xda, 0x21, 0x77, 0x62,
-	0x0a, 0x03, 0xfb, 0x26, 0x81, 0xe5, 0xac, 0x89, 0xcb, 0xbc, 0x9b, 0x04, 0x24, 0xf3, 0x6e, 0x4e,
-	0x7a, 0x9f, 0x83, 0xda, 0x39, 0x87, 0x44, 0xf8, 0xb2, 0x60, 0x8f, 0x68, 0x14, 0xfa, 0x14, 0x78,
-	0x26, 0xf9, 0x42, 0x53, 0x4d, 0xd5, 0x3a, 0x6f, 0x35, 0x8f, 0x14, 0x7e, 0x4b, 0x05, 0x7c, 0xd8,
-	0xda, 0x0a, 0x5a, 0xb5, 0xfc, 0x83, 0xe8, 0x60, 0x2c, 0xc8, 0x3b, 0x8c, 0x77, 0x41, 0x42, 0xbb,
-	0x5d, 0x44, 0x3c, 0x3e, 0x12, 0xb1, 0xf3, 0x57, 0xc9, 0x15, 0x04, 0x69, 0xe0, 0x1a, 0x1b, 0xa7,
-	0x3c, 0x03, 0xe6, 0x6b, 0x27, 0x26, 0xb2, 0x6a, 0xde, 0xee, 0x7c, 0x35, 0xc0, 0xe4, 0xb0, 0x22,
-	0x69, 0xe1, 0x3b, 0xd4, 0xf7, 0x33, 0x26, 0xe4, 0x9d, 0x9c, 0xb9, 0xda, 0x72, 0xd6, 0xac, 0x97,
-	0x15, 0x5e, 0xc9, 0x49, 0x07, 0xb2, 0x30, 0x09, 0xbc, 0xad, 0x90, 0xd4, 0xf1, 0xc9, 0xfe, 0xaf,
-	0xab, 0x9e, 0x3c, 0xbc, 0xac, 0x7d, 0x9e, 0x1a, 0xca, 0x9f, 0xa9, 0xa1, 0xb8, 0x2f, 0xe6, 0x6b,
-	0x1d, 0x2d, 0xd6, 0x3a, 0xfa, 0xb5, 0xd6, 0xd1, 0xd7, 0x8d, 0xae, 0x2c, 0x36, 0xba, 0xf2, 0x63,
-	0xa3, 0x2b, 0x5d, 0xe3, 0x9f, 0x8b, 0x19, 0x57, 0x17, 0x0e, 0x26, 0x29, 0x13, 0xfd, 0xd3, 0x62,
-	0x73, 0x9e, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x85, 0xf6, 0x83, 0x12, 0xec, 0x02, 0x00, 0x00,
+	// 743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x6f, 0xda, 0x48,
+	0x14, 0xc7, 0x71, 0xd8, 0x10, 0x32, 0xa0, 0x0d, 0x99, 0x90, 0x8d, 0x17, 0x69, 0x81, 0xa0, 0x95,
+	0x16, 0xad, 0x84, 0xd9, 0x90, 0xd3, 0xb6, 0xa7, 0x90, 0x48, 0x6d, 0xd4, 0x36, 0x41, 0xa6, 0x8a,
+	0xaa, 0x1c, 0x6a, 0x0d, 0xf6, 0x08, 0x2c, 0xcc, 0x0c, 0xf2, 0x9b, 0x24, 0xf0, 0x0d, 0x7a, 0xec,
+	0x47, 0xc8, 0xb1, 0xb7, 0xf6, 0x90, 0x4b, 0xaf, 0x3d, 0xe5, 0x18, 0xe5, 0x54, 0xf5, 0x10, 0x55,
+	0xc9, 0xa1, 0xfd, 0x18, 0x95, 0xc7, 0xc6, 0x98, 0xd2, 0x24, 0xb4, 0x17, 0x84, 0xfd, 0xde, 0xff,
+	0xf7, 0xff, 0x7b, 0x78, 0x3c, 0xa3, 0x7f, 0x4c, 0x0e, 0x3d, 0x0e, 0x55, 0x18, 0xf6, 0x9a, 0x82,
+	0x74, 0x6d, 0xd6, 0xae, 0x1e, 0x6f, 0xb4, 0xa8, 0x20, 0x1b, 0xd5, 0x36, 0x65, 0x14, 0x6c, 0xd0,
+	0xfa, 0x2e, 0x17, 0x1c, 0xff, 0xe9, 0x37, 0x6a, 0xe3, 0x46, 0x2d, 0x68, 0xcc, 0x65, 0xdb, 0xbc,
+	0xcd, 0x65, 0x57, 0xd5, 0xfb, 0xe6, 0x0b, 0x72, 0x77, 0x90, 0x21, 0x00, 0xf8, 0x8d, 0x01, 0xd9,
+	0xf0, 0x09, 0x81, 0x8d, 0x5f, 0x5a, 0x26, 0x3d, 0x9b, 0xf1, 0xaa, 0xfc, 0xf4, 0x6f, 0x95, 0xde,
+	0x27, 0x51, 0xfa, 0x91, 0x9f, 0xac, 0x29, 0x88, 0xa0, 0x78, 0x07, 0x25, 0xfa, 0xc4, 0x25, 0x3d,
+	0x50, 0x95, 0xa2, 0x52, 0x4e, 0xd5, 0xd6, 0xb5, 0x5b, 0x93, 0x6a, 0x0d, 0xd9, 0x58, 0x5f, 0x3c,
+	0xbf, 0x2a, 0xc4, 0xde, 0x7c, 0x79, 0xf7, 0xaf, 0xa2, 0x07, 0x5a, 0x7c, 0x88, 0x32, 0x0e, 0x01,
+	0x61, 0x08, 0x2e, 0x88, 0x63, 0xf4, 0xf9, 0x09, 0x75, 0xd5, 0xb9, 0xa2, 0x52, 0x4e, 0xd7, 0xff,
+	0xf3, 0x9a, 0x3f, 0x5d, 0x15, 0x56, 0x7d, 0x2c, 0x58, 0x5d, 0xcd, 0xe6, 0xd5, 0x1e, 0x11, 0x1d,
+	0x6d, 0x97, 0x89, 0xcb, 0xb3, 0x0a, 0x0a, 0xfc, 0x76, 0x99, 0xf0, 0x99, 0xbf, 0x7b, 0xa4, 0xe7,
+	0x1e, 0xa8, 0xe1, 0x71, 0xb0, 0x83, 0x56, 0x25, 0xfb, 0x98, 0x38, 0xb6, 0x45, 0x04, 0x77, 0x7d,
+	0x3e, 0xa8, 0xf1, 0x62, 0xbc, 0x9c, 0xaa, 0x55, 0xee, 0x08, 0xfc, 0x94, 0x80, 0x38, 0x18, 0xc9,
+	0x24, 0x2d, 0x1a, 0x7e, 0xc5, 0x99, 0x2a, 0x03, 0xde, 0x47, 0x28, 0x34, 0x02, 0xf5, 0x37, 0x69,
+	0xf1, 0xf7, 0x1d, 0x16, 0xa1, 0x3e, 0x4a, 0x8e, 0x20, 0x70, 0x0e, 0x25, 0xe9, 0xa0, 0xcf, 0x5d,
+	0x41, 0x2d, 0x75, 0xbe, 0xa8, 0x94, 0x93, 0x7a, 0x78, 0x8d, 0x5f, 0xa0, 0x0c, 0x0c, 0x7b, 0x2d,
+	0x9b, 0x0b, 0xdb, 0x34, 0x08, 0x33, 0x3b, 0xdc, 0x55, 0x13, 0x45, 0xe5, 0x9e, 0xa7, 0x6a, 0x8e,
+	0x24, 0xcd, 0x21, 0x33, 0x1b, 0xdc, 0x66, 0x42, 0x5f, 0x0a, 0x31, 0x5b, 0x92, 0x82, 0x5f, 0xa2,
+	0x95, 0x31, 0x19, 0x04, 0x71, 0xbc, 0xdf, 0x1c, 0xd4, 0x85, 0x9f, 0x80, 0x8f, 0x44, 0x3a, 0x86,
+	0xa9, 0x7b, 0xf8, 0x21, 0xca, 0x8d, 0xf9, 0x8c, 0x0e, 0x84, 0x01, 0x43, 0x66, 0x1a, 0x1d, 0x6a,
+	0xb7, 0x3b, 0x42, 0x4d, 0x16, 0x95, 0x72, 0x5c, 0x5f, 0x0b, 0x3b, 0xf6, 0xe8, 0x40, 0x78, 0x61,
+	0x1f, 0xcb, 0x32, 0xb6, 0xa3, 0x8f, 0xed, 0x4d, 0x33, 0x05, 0x75, 0x51, 0x9e, 0xf4, 0xe6, 0x2c,
+	0xc9, 0xc2, 0x23, 0x6f, 0x4a, 0x69, 0xf4, 0xe0, 0x97, 0xa2, 0x51, 0xbb, 0x14, 0x30, 0x43, 0xab,
+	0x63, 0x2b, 0xb3, 0x43, 0xcd, 0x6e, 0xdf, 0x3b, 0x30, 0x50, 0x91, 0xf4, 0xd3, 0x66, 0xf1, 0xdb,
+	0x0e, 0x65, 0x51, 0xab, 0x2c, 0x4c, 0xd7, 0x01, 0xf7, 0xd0, 0x1f, 0x26, 0x67, 0x60, 0xf4, 0x8f,
+	0x5a, 0x46, 0x97, 0x0e, 0x0d, 0x97, 0x0b, 0x22, 0x6c, 0xce, 0x40, 0x4d, 0xdd, 0x3b, 0xad, 0xdb,
+	0x9c, 0x41, 0xe3, 0xa8, 0xf5, 0x84, 0x0e, 0xf5, 0x40, 0x35, 0x31, 0xad, 0xe6, 0x54, 0x19, 0x70,
+	0x17, 0x61, 0xee, 0x58, 0x86, 0xb4, 0x24, 0x96, 0xe5, 0x52, 0x00, 0x0a, 0x6a, 0x5a, 0x5a, 0xd5,
+	0x66, 0x99, 0xda, 0x7d, 0xc7, 0xf2, 0x6c, 0xb7, 0x7c, 0x6d, 0xd4, 0x2f, 0xc3, 0x27, 0x4a, 0x14,
+	0x4a, 0x6f, 0x15, 0xb4, 0x76, 0x8b, 0x10, 0xef, 0xa0, 0x74, 0x34, 0x84, 0x5c, 0x26, 0x8b, 0xf5,
+	0xf5, 0xcb, 0xb3, 0xca, 0x5f, 0x41, 0x0a, 0xaf, 0x9b, 0x32, 0x38, 0x1a, 0x49, 0x9a, 0xc2, 0xb5,
+	0x59, 0x5b, 0x4f, 0x99, 0x11, 0xca, 0x1e, 0x5a, 0x1e, 0xff, 0xcb, 0x47, 0xa8, 0xb9, 0x29, 0x54,
+	0x18, 0x62, 0x12, 0x95, 0x39, 0xfe, 0xee, 0x7e, 0xe9, 0x83, 0x82, 0xd4, 0xdb, 0xc6, 0xe6, 0xc7,
+	0x66, 0xca, 0x2f, 0x9b, 0xe1, 0x03, 0x94, 0x08, 0x66, 0x79, 0x6e, 0xf6, 0x59, 0x7e, 0x66, 0x5b,
+	0x96, 0x43, 0x4f, 0x88, 0x4b, 0x65, 0xaa, 0x89, 0xdd, 0xea, 0xd3, 0x4a, 0x1d, 0x84, 0xa7, 0xf7,
+	0x18, 0xae, 0xa1, 0x85, 0xc9, 0xcc, 0xea, 0xe5, 0x59, 0x25, 0x1b, 0x38, 0x4e, 0x46, 0x1d, 0x35,
+	0xe2, 0x2c, 0x9a, 0x1f, 0xaf, 0xe6, 0xb8, 0xee, 0x5f, 0x3c, 0x48, 0xbe, 0x3a, 0x2d, 0xc4, 0xbe,
+	0x9e, 0x16, 0x62, 0xf5, 0xff, 0xcf, 0xaf, 0xf3, 0xca, 0xc5, 0x75, 0x5e, 0xf9, 0x7c, 0x9d, 0x57,
+	0x5e, 0xdf, 0xe4, 0x63, 0x17, 0x37, 0xf9, 0xd8, 0xc7, 0x9b, 0x7c, 0xec, 0xb0, 0x30, 0xb1, 0xbd,
+	0x07, 0xd1, 0xb7, 0x92, 0x18, 0xf6, 0x29, 0xb4, 0x12, 0xf2, 0xf5, 0xb2, 0xf9, 0x6d, 0x00, 0x11,
+	0x01, 0xa0, 0xdc, 0x11, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -204,6 +407,91 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OldConsAddresses) > 0 {
+		for iNdEx := len(m.OldConsAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OldConsAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ConsPubKeyRotations) > 0 {
+		for iNdEx := len(m.ConsPubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.SymbioticCheckpoints) > 0 {
+		for iNdEx := len(m.SymbioticCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbioticCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SymbioticStakes) > 0 {
+		for iNdEx := len(m.SymbioticStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbioticStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.SymbioticNextSyncHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SymbioticNextSyncHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SymbioticStaleness != nil {
+		{
+			size, err := m.SymbioticStaleness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SymbioticAnchor != nil {
+		{
+			size, err := m.SymbioticAnchor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -265,6 +553,87 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorOldConsAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOldConsAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOldConsAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticValidatorStakes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticValidatorStakes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticValidatorStakes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stakes) > 0 {
+		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LastValidatorPower) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -336,6 +705,77 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if m.SymbioticAnchor != nil {
+		l = m.SymbioticAnchor.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.SymbioticStaleness != nil {
+		l = m.SymbioticStaleness.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.SymbioticNextSyncHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.SymbioticNextSyncHeight))
+	}
+	if len(m.SymbioticStakes) > 0 {
+		for _, e := range m.SymbioticStakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SymbioticCheckpoints) > 0 {
+		for _, e := range m.SymbioticCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsPubKeyRotations) > 0 {
+		for _, e := range m.ConsPubKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OldConsAddresses) > 0 {
+		for _, e := range m.OldConsAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorOldConsAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SymbioticValidatorStakes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Stakes) > 0 {
+		for _, e := range m.Stakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -544,6 +984,463 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticAnchor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SymbioticAnchor == nil {
+				m.SymbioticAnchor = &SymbioticSyncPoint{}
+			}
+			if err := m.SymbioticAnchor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticStaleness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SymbioticStaleness == nil {
+				m.SymbioticStaleness = &SymbioticStaleness{}
+			}
+			if err := m.SymbioticStaleness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticNextSyncHeight", wireType)
+			}
+			m.SymbioticNextSyncHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SymbioticNextSyncHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbioticStakes = append(m.SymbioticStakes, SymbioticValidatorStakes{})
+			if err := m.SymbioticStakes[len(m.SymbioticStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbioticCheckpoints = append(m.SymbioticCheckpoints, SymbioticCheckpoint{})
+			if err := m.SymbioticCheckpoints[len(m.SymbioticCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubKeyRotations = append(m.ConsPubKeyRotations, ConsPubKeyRotation{})
+			if err := m.ConsPubKeyRotations[len(m.ConsPubKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldConsAddresses = append(m.OldConsAddresses, ValidatorOldConsAddress{})
+			if err := m.OldConsAddresses[len(m.OldConsAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOldConsAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOldConsAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOldConsAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticValidatorStakes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticValidatorStakes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticValidatorStakes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakes = append(m.Stakes, SymbioticMiddlewareStake{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
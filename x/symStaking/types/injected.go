@@ -0,0 +1,170 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	stakingv1beta1 "cosmossdk.io/api/cosmos/symStaking/v1beta1"
+	"cosmossdk.io/core/transaction"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InjectedSymbioticDataVersion is the current version of the injected data
+// encoding. Since version 2, the validator set carries the operators, and since
+// version 3 the validator sets of every middleware.
+const InjectedSymbioticDataVersion uint32 = 3
+
+// Reasons a sync height is skipped, recorded in the SymbioticSyncPoint.
+const (
+	// SyncSkipReasonNoAgreement means that no block was reported by validators
+	// holding more than 2/3 of the voting power, e.g. because the beacon chain
+	// didn't finalize or the validators couldn't reach their Ethereum RPC.
+	SyncSkipReasonNoAgreement = "no_agreement"
+	// SyncSkipReasonOutOfWindow means that the agreed block is older than the
+	// last synced one or outside the allowed timestamp window.
+	SyncSkipReasonOutOfWindow = "out_of_window"
+	// SyncSkipReasonManualUpdateRequired means that the validator set went stale
+	// under the manual stale policy, so that the syncs are suspended until it is
+	// updated by governance.
+	SyncSkipReasonManualUpdateRequired = "manual_update_required"
+	// SyncSkipReasonEpochNotAdvanced means that the middleware epoch of the
+	// agreed block is the one of the last synced validator set, which is kept.
+	SyncSkipReasonEpochNotAdvanced = "epoch_not_advanced"
+	// SyncSkipReasonUnavailable means that the proposer skipped the sync as
+	// unavailable. It is only accepted when no block was agreed on in the vote
+	// extensions, as a proposer can't skip an agreed block.
+	SyncSkipReasonUnavailable = "unavailable"
+)
+
+// InjectedTxPrefix prefixes the tx injected by the proposer. A protobuf message
+// can't start with a zero byte, so it can't be mistaken for a user tx.
+var InjectedTxPrefix = []byte("\x00symbiotic")
+
+// IsInjectedTx returns true if tx carries the injected tx prefix.
+func IsInjectedTx(tx []byte) bool {
+	return bytes.HasPrefix(tx, InjectedTxPrefix)
+}
+
+// EncodeInjectedTx encodes data as the tx injected by the proposer.
+func EncodeInjectedTx(data InjectedSymbioticData) ([]byte, error) {
+	bz, err := data.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, InjectedTxPrefix...), bz...), nil
+}
+
+// DecodeInjectedTx decodes the tx injected by the proposer.
+func DecodeInjectedTx(tx []byte) (InjectedSymbioticData, error) {
+	var data InjectedSymbioticData
+
+	if !IsInjectedTx(tx) {
+		return data, ErrInvalidInjectedTx.Wrap("missing prefix")
+	}
+
+	if err := data.Unmarshal(tx[len(InjectedTxPrefix):]); err != nil {
+		return data, ErrInvalidInjectedTx.Wrap(err.Error())
+	}
+
+	if data.Version != InjectedSymbioticDataVersion {
+		return data, ErrInvalidInjectedTx.Wrapf("unsupported version %d", data.Version)
+	}
+
+	return data, nil
+}
+
+var _ sdk.Tx = (*InjectedTx)(nil)
+
+// InjectedTx is the sdk.Tx decoded from the tx injected by the proposer. It
+// carries a single MsgInjectSymbioticData and is skipped by the ante handler.
+type InjectedTx struct {
+	msg   *MsgInjectSymbioticData
+	bytes []byte
+}
+
+// InjectedTxDecoder wraps decoder to decode the tx injected by the proposer as
+// an InjectedTx, on behalf of authority.
+func InjectedTxDecoder(authority string, decoder sdk.TxDecoder) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, error) {
+		if !IsInjectedTx(txBytes) {
+			return decoder(txBytes)
+		}
+
+		data, err := DecodeInjectedTx(txBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &InjectedTx{
+			msg:   &MsgInjectSymbioticData{Authority: authority, Data: data},
+			bytes: txBytes,
+		}, nil
+	}
+}
+
+// Hash implements transaction.Tx.
+func (tx *InjectedTx) Hash() [32]byte {
+	return sha256.Sum256(tx.bytes)
+}
+
+// GetMessages implements transaction.Tx.
+func (tx *InjectedTx) GetMessages() ([]transaction.Msg, error) {
+	return []transaction.Msg{tx.msg}, nil
+}
+
+// GetSenders implements transaction.Tx. The injected tx has no sender.
+func (tx *InjectedTx) GetSenders() ([]transaction.Identity, error) {
+	return nil, nil
+}
+
+// GetGasLimit implements transaction.Tx. The injected tx has infinite gas.
+func (tx *InjectedTx) GetGasLimit() (uint64, error) {
+	return math.MaxUint64, nil
+}
+
+// Bytes implements transaction.Tx.
+func (tx *InjectedTx) Bytes() []byte {
+	return tx.bytes
+}
+
+// GetMsgs implements sdk.HasMsgs.
+func (tx *InjectedTx) GetMsgs() []sdk.Msg {
+	return []sdk.Msg{tx.msg}
+}
+
+// GetReflectMessages implements sdk.Tx.
+func (tx *InjectedTx) GetReflectMessages() ([]protoreflect.Message, error) {
+	msg := &stakingv1beta1.MsgInjectSymbioticData{
+		Authority: tx.msg.Authority,
+		Data: &stakingv1beta1.InjectedSymbioticData{
+			Version:            tx.msg.Data.Version,
+			BlockHash:          tx.msg.Data.BlockHash,
+			BlockNumber:        tx.msg.Data.BlockNumber,
+			BlockTimestamp:     tx.msg.Data.BlockTimestamp,
+			Slot:               tx.msg.Data.Slot,
+			ValidatorSetDigest: tx.msg.Data.ValidatorSetDigest,
+			ExtendedCommitInfo: tx.msg.Data.ExtendedCommitInfo,
+			ValidatorSet:       tx.msg.Data.ValidatorSet,
+			Epoch:              tx.msg.Data.Epoch,
+			SkipReason:         tx.msg.Data.SkipReason,
+		},
+	}
+
+	return []protoreflect.Message{msg.ProtoReflect()}, nil
+}
+
+// Data returns the injected data.
+func (tx *InjectedTx) Data() InjectedSymbioticData {
+	return tx.msg.Data
+}
+
+// String implements fmt.Stringer.
+func (tx *InjectedTx) String() string {
+	return fmt.Sprintf("InjectedTx{%s}", tx.msg.Data.String())
+}
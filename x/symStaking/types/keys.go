@@ -51,13 +51,25 @@ var (
 	HistoricalInfoKey = collections.NewPrefix(80) // prefix for the historical info
 	ParamsKey         = collections.NewPrefix(81) // prefix for parameters for module x/symStaking
 
-	CachedBlockHashKey = collections.NewPrefix(90) // prefix for finalized blockhash
-
+	InjectedSymbioticDataKey = collections.NewPrefix(91) // prefix for the data injected at each sync height
+	SymbioticSyncPointsKey   = collections.NewPrefix(92) // prefix for the outcome of each sync height
+	ValidatorsBySymbioticKey = collections.NewPrefix(93) // prefix for each key to a validator index, by full symbiotic key
+
+	ValidatorsBySymbioticOperatorKey = collections.NewPrefix(94) // prefix for each key to a validator index, by symbiotic operator
+	ValidatorsByOldConsAddrKey       = collections.NewPrefix(95) // prefix for each key to a validator index, by rotated consensus address
+	ConsPubKeyRotationsKey           = collections.NewPrefix(96) // prefix for the consensus pubkey rotations, by height
+	SymbioticStakesKey               = collections.NewPrefix(98) // prefix for the middleware stakes of each validator
+	SymbioticStalenessKey            = collections.NewPrefix(99) // key for the sync heights skipped since the last validator set update
+
+	SymbioticCheckpointsKey    = collections.NewPrefix(100) // prefix for the checkpoints of the validator set, by epoch
+	SymbioticEpochKey          = collections.NewPrefix(101) // key for the middleware epoch of the last synced validator set
+	SymbioticNextSyncHeightKey = collections.NewPrefix(102) // key for the height of the next sync scheduled by the epoch hooks
 )
 
 // Reserved kvstore keys
 var (
-	_ = collections.NewPrefix(97)
+	CachedBlockHashKey = collections.NewPrefix(90) // prefix for the finalized block hashes, cleared in the v10 migration
+	_                  = collections.NewPrefix(97)
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Ethereum networks the Symbiotic middleware can be deployed on.
+const (
+	EthereumNetworkMainnet = "mainnet"
+	EthereumNetworkHolesky = "holesky"
+	EthereumNetworkSepolia = "sepolia"
+	// EthereumNetworkCustom takes the beacon chain timings from the params,
+	// e.g. for a local devnet.
+	EthereumNetworkCustom = "custom"
+)
+
+// NetworkProfile defines the beacon chain timings used to compute the
+// finalized slot of an Ethereum network.
+type NetworkProfile struct {
+	// BeaconGenesisTimestamp is the unix timestamp of the beacon chain genesis.
+	BeaconGenesisTimestamp uint64
+	// SlotDuration is the duration of a beacon chain slot.
+	SlotDuration time.Duration
+	// SlotsPerEpoch is the number of slots in an epoch.
+	SlotsPerEpoch uint64
+	// FinalityDepth is the number of epochs behind the current one that are
+	// considered finalized.
+	FinalityDepth uint64
+}
+
+// NetworkProfiles holds the profiles of the named Ethereum networks.
+var NetworkProfiles = map[string]NetworkProfile{
+	EthereumNetworkMainnet: {
+		BeaconGenesisTimestamp: 1606824023,
+		SlotDuration:           12 * time.Second,
+		SlotsPerEpoch:          32,
+		FinalityDepth:          3,
+	},
+	EthereumNetworkHolesky: {
+		BeaconGenesisTimestamp: 1695902400,
+		SlotDuration:           12 * time.Second,
+		SlotsPerEpoch:          32,
+		FinalityDepth:          3,
+	},
+	EthereumNetworkSepolia: {
+		BeaconGenesisTimestamp: 1655733600,
+		SlotDuration:           12 * time.Second,
+		SlotsPerEpoch:          32,
+		FinalityDepth:          3,
+	},
+}
+
+// NetworkProfile returns the beacon chain timings of the configured Ethereum
+// network.
+func (p Params) NetworkProfile() (NetworkProfile, error) {
+	if p.EthereumNetwork == EthereumNetworkCustom {
+		return NetworkProfile{
+			BeaconGenesisTimestamp: p.BeaconGenesisTimestamp,
+			SlotDuration:           p.SlotDuration,
+			SlotsPerEpoch:          p.SlotsPerEpoch,
+			FinalityDepth:          p.FinalityDepth,
+		}, nil
+	}
+
+	profile, ok := NetworkProfiles[p.EthereumNetwork]
+	if !ok {
+		return NetworkProfile{}, fmt.Errorf("unknown ethereum network: %s", p.EthereumNetwork)
+	}
+
+	return profile, nil
+}
+
+// Validate performs a basic validation of the profile.
+func (np NetworkProfile) Validate() error {
+	if err := validateBeaconGenesisTimestamp(np.BeaconGenesisTimestamp); err != nil {
+		return err
+	}
+
+	if err := validateSlotDuration(np.SlotDuration); err != nil {
+		return err
+	}
+
+	if err := validateSlotsPerEpoch(np.SlotsPerEpoch); err != nil {
+		return err
+	}
+
+	return validateFinalityDepth(np.FinalityDepth)
+}
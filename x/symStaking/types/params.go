@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -29,6 +31,18 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultEthereumNetwork is the Ethereum network the Symbiotic middleware is deployed on.
+	DefaultEthereumNetwork = EthereumNetworkHolesky
+
+	// DefaultSymbioticSyncPeriod is the default number of blocks between two validator set syncs.
+	DefaultSymbioticSyncPeriod int64 = 10
+
+	// DefaultSymbioticKeyType is the default type of the middleware validator keys.
+	DefaultSymbioticKeyType = SymbioticKeyTypeAddress
+
+	// DefaultSymbioticStalePolicy is the default policy applied to a stale validator set.
+	DefaultSymbioticStalePolicy = SymbioticStalePolicyKeep
 )
 
 var (
@@ -48,6 +62,15 @@ func NewParams(unbondingTime time.Duration,
 		HistoricalEntries: historicalEntries,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
+
+		SymbioticSyncPeriod:     DefaultSymbioticSyncPeriod,
+		EthereumNetwork:         DefaultEthereumNetwork,
+		SymbioticKeyType:        DefaultSymbioticKeyType,
+		SymbioticMaxPowerShare:  math.LegacyZeroDec(),
+		SymbioticMaxPowerChange: math.LegacyZeroDec(),
+		SymbioticMinStake:       math.ZeroInt(),
+		SymbioticStalePolicy:    DefaultSymbioticStalePolicy,
+		SymbioticStaleDecay:     math.LegacyZeroDec(),
 	}
 }
 
@@ -63,6 +86,24 @@ func DefaultParams() Params {
 	)
 }
 
+// Middlewares returns the middlewares the validator set is synced with: the
+// symbiotic middlewares, or the symbiotic middleware address with a unit weight.
+func (p Params) Middlewares() []SymbioticMiddleware {
+	if len(p.SymbioticMiddlewares) > 0 {
+		return p.SymbioticMiddlewares
+	}
+
+	if p.SymbioticMiddlewareAddress == "" {
+		return nil
+	}
+
+	return []SymbioticMiddleware{{
+		Address: p.SymbioticMiddlewareAddress,
+		Weight:  math.LegacyOneDec(),
+		Cap:     math.ZeroInt(),
+	}}
+}
+
 // unmarshal the current staking params value from store key or panic
 func MustUnmarshalParams(cdc *codec.LegacyAmino, value []byte) Params {
 	params, err := UnmarshalParams(cdc, value)
@@ -109,6 +150,46 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSymbioticMiddlewareAddress(p.SymbioticMiddlewareAddress); err != nil {
+		return err
+	}
+
+	if err := validateSymbioticMiddlewares(p); err != nil {
+		return err
+	}
+
+	if err := validateEthereumNetwork(p); err != nil {
+		return err
+	}
+
+	if err := validateSymbioticSyncPeriod(p.SymbioticSyncPeriod); err != nil {
+		return err
+	}
+
+	if err := validateSymbioticEpochIdentifier(p.SymbioticEpochIdentifier); err != nil {
+		return err
+	}
+
+	if err := validateSymbioticKeyType(p); err != nil {
+		return err
+	}
+
+	if err := validateSymbioticMaxPowerShare(p.SymbioticMaxPowerShare); err != nil {
+		return err
+	}
+
+	if err := validateSymbioticMaxPowerChange(p.SymbioticMaxPowerChange); err != nil {
+		return err
+	}
+
+	if err := validateSymbioticMinStake(p.SymbioticMinStake); err != nil {
+		return err
+	}
+
+	if err := validateSymbioticStalePolicy(p); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -208,3 +289,247 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateSymbioticMiddlewareAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != "" && !common.IsHexAddress(v) {
+		return fmt.Errorf("symbiotic middleware address must be a hex address: %s", v)
+	}
+
+	return nil
+}
+
+func validateSymbioticMiddlewares(p Params) error {
+	if len(p.SymbioticMiddlewares) > 0 && p.SymbioticMiddlewareAddress != "" {
+		return errors.New("symbiotic middleware address and symbiotic middlewares can't be both set")
+	}
+
+	seen := make(map[common.Address]bool, len(p.SymbioticMiddlewares))
+	for _, m := range p.SymbioticMiddlewares {
+		if !common.IsHexAddress(m.Address) {
+			return fmt.Errorf("symbiotic middleware address must be a hex address: %s", m.Address)
+		}
+
+		address := common.HexToAddress(m.Address)
+		if seen[address] {
+			return fmt.Errorf("duplicate symbiotic middleware: %s", m.Address)
+		}
+		seen[address] = true
+
+		if m.Weight.IsNil() || !m.Weight.IsPositive() {
+			return fmt.Errorf("symbiotic middleware %s weight must be positive: %s", m.Address, m.Weight)
+		}
+
+		if m.Cap.IsNil() || m.Cap.IsNegative() {
+			return fmt.Errorf("symbiotic middleware %s cap must not be negative: %s", m.Address, m.Cap)
+		}
+	}
+
+	return nil
+}
+
+func validateBeaconGenesisTimestamp(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("beacon genesis timestamp must be positive")
+	}
+
+	return nil
+}
+
+func validateSlotDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < time.Second || v%time.Second != 0 {
+		return fmt.Errorf("slot duration must be a positive number of seconds: %s", v)
+	}
+
+	return nil
+}
+
+func validateSymbioticSyncPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("symbiotic sync period must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateSymbioticEpochIdentifier(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) != v {
+		return fmt.Errorf("invalid symbiotic epoch identifier: %q", v)
+	}
+
+	return nil
+}
+
+func validateEthereumNetwork(p Params) error {
+	if p.EthereumNetwork != EthereumNetworkCustom &&
+		(p.BeaconGenesisTimestamp != 0 || p.SlotDuration != 0 || p.SlotsPerEpoch != 0 || p.FinalityDepth != 0) {
+		return fmt.Errorf("beacon chain timings can only be set for the %s ethereum network", EthereumNetworkCustom)
+	}
+
+	profile, err := p.NetworkProfile()
+	if err != nil {
+		return err
+	}
+
+	return profile.Validate()
+}
+
+func validateSlotsPerEpoch(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("slots per epoch must be positive")
+	}
+
+	return nil
+}
+
+func validateFinalityDepth(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("finality depth must be positive")
+	}
+
+	return nil
+}
+
+func validateSymbioticKeyType(p Params) error {
+	switch p.SymbioticKeyType {
+	case SymbioticKeyTypeAddress, SymbioticKeyTypeEd25519, SymbioticKeyTypeSecp256k1:
+	default:
+		return fmt.Errorf("unknown symbiotic key type: %q", p.SymbioticKeyType)
+	}
+
+	// only the ed25519 pubkey can be recovered from its key
+	if p.SymbioticAutoCreateValidators && p.SymbioticKeyType != SymbioticKeyTypeEd25519 {
+		return fmt.Errorf("symbiotic validators can only be created from %s keys", SymbioticKeyTypeEd25519)
+	}
+
+	return nil
+}
+
+func validateSymbioticMaxPowerShare(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("symbiotic max power share cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("symbiotic max power share cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("symbiotic max power share cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateSymbioticMaxPowerChange(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("symbiotic max power change cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("symbiotic max power change cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateSymbioticMinStake(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("symbiotic min stake cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("symbiotic min stake cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateSymbioticStalePolicy(p Params) error {
+	switch p.SymbioticStalePolicy {
+	case SymbioticStalePolicyKeep, SymbioticStalePolicyDecay, SymbioticStalePolicyHalt, SymbioticStalePolicyManual:
+	default:
+		return fmt.Errorf("unknown symbiotic stale policy: %q", p.SymbioticStalePolicy)
+	}
+
+	if p.SymbioticStaleDecay.IsNil() {
+		return fmt.Errorf("symbiotic stale decay cannot be nil: %s", p.SymbioticStaleDecay)
+	}
+	if p.SymbioticStaleDecay.IsNegative() {
+		return fmt.Errorf("symbiotic stale decay cannot be negative: %s", p.SymbioticStaleDecay)
+	}
+	if p.SymbioticStaleDecay.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("symbiotic stale decay cannot be greater than 100%%: %s", p.SymbioticStaleDecay)
+	}
+
+	if p.SymbioticStalePolicy == SymbioticStalePolicyDecay && !p.SymbioticStaleDecay.IsPositive() {
+		return fmt.Errorf("symbiotic stale decay must be positive for the %s stale policy", SymbioticStalePolicyDecay)
+	}
+
+	if p.SymbioticStalePolicy == SymbioticStalePolicyHalt && len(p.SymbioticHaltMsgTypeUrls) == 0 {
+		return fmt.Errorf("symbiotic halt msg type urls can't be empty for the %s stale policy", SymbioticStalePolicyHalt)
+	}
+
+	seen := make(map[string]bool, len(p.SymbioticHaltMsgTypeUrls))
+	for _, url := range p.SymbioticHaltMsgTypeUrls {
+		if strings.TrimSpace(url) == "" {
+			return errors.New("symbiotic halt msg type url cannot be blank")
+		}
+		if seen[url] {
+			return fmt.Errorf("duplicate symbiotic halt msg type url: %s", url)
+		}
+		seen[url] = true
+
+		// governance must still be able to update the validator set
+		if url == sdk.MsgTypeURL(&MsgUpdateSymbioticValidatorSet{}) {
+			return fmt.Errorf("symbiotic halt msg type urls can't include %s", url)
+		}
+	}
+
+	return nil
+}
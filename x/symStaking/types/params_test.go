@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -46,4 +47,48 @@ func TestValidateParams(t *testing.T) {
 	// check keyRotationFee
 	params.KeyRotationFee = coinZero
 	require.Error(t, params.Validate())
+
+	// check symbiotic params
+	params = types.DefaultParams()
+	params.SymbioticMiddlewareAddress = "0x5081a39b8A5f0E35a8D959395a630b68B74Dd30f"
+	require.NoError(t, params.Validate())
+
+	params.SymbioticMiddlewareAddress = "not an address"
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.EthereumNetwork = types.EthereumNetworkCustom
+	params.BeaconGenesisTimestamp = 1700000000
+	params.SlotDuration = 1500 * time.Millisecond
+	params.SlotsPerEpoch = 32
+	params.FinalityDepth = 3
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.SymbioticSyncPeriod = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.SymbioticEpochIdentifier = "hour"
+	require.NoError(t, params.Validate())
+
+	params.SymbioticEpochIdentifier = " hour"
+	require.Error(t, params.Validate())
+
+	// check ethereum network
+	params = types.DefaultParams()
+	params.EthereumNetwork = "unknown"
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.SlotsPerEpoch = 32
+	require.Error(t, params.Validate())
+
+	params.EthereumNetwork = types.EthereumNetworkCustom
+	require.Error(t, params.Validate())
+
+	params.BeaconGenesisTimestamp = 1700000000
+	params.SlotDuration = 6 * time.Second
+	params.FinalityDepth = 2
+	require.NoError(t, params.Validate())
 }
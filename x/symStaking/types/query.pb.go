@@ -475,751 +475,3306 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.symStaking.v1beta1.QueryValidatorsRequest")
-	proto.RegisterType((*ValidatorInfo)(nil), "cosmos.symStaking.v1beta1.ValidatorInfo")
-	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.symStaking.v1beta1.QueryValidatorsResponse")
-	proto.RegisterType((*QueryValidatorRequest)(nil), "cosmos.symStaking.v1beta1.QueryValidatorRequest")
-	proto.RegisterType((*QueryValidatorResponse)(nil), "cosmos.symStaking.v1beta1.QueryValidatorResponse")
-	proto.RegisterType((*QueryHistoricalInfoRequest)(nil), "cosmos.symStaking.v1beta1.QueryHistoricalInfoRequest")
-	proto.RegisterType((*QueryHistoricalInfoResponse)(nil), "cosmos.symStaking.v1beta1.QueryHistoricalInfoResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.symStaking.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.symStaking.v1beta1.QueryParamsResponse")
+// QueryInjectedSymbioticDataRequest is request type for the Query/InjectedSymbioticData
+// RPC method.
+type QueryInjectedSymbioticDataRequest struct {
+	// height defines at which sync height to query the injected data.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("cosmos/symStaking/v1beta1/query.proto", fileDescriptor_c2072ddbc3d435e5)
+func (m *QueryInjectedSymbioticDataRequest) Reset()         { *m = QueryInjectedSymbioticDataRequest{} }
+func (m *QueryInjectedSymbioticDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInjectedSymbioticDataRequest) ProtoMessage()    {}
+func (*QueryInjectedSymbioticDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{9}
 }
-
-var fileDescriptor_c2072ddbc3d435e5 = []byte{
-	// 766 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xee, 0x94, 0x8f, 0x26, 0x1d, 0x02, 0x81, 0x81, 0x0f, 0xfa, 0x2d, 0x9f, 0xa5, 0xac, 0x22,
-	0x15, 0xc3, 0x2e, 0x2d, 0x42, 0xd4, 0x93, 0x54, 0xa2, 0x78, 0x30, 0xe2, 0x92, 0x18, 0x83, 0x87,
-	0x66, 0x68, 0x97, 0xed, 0x06, 0xba, 0x53, 0x76, 0xa6, 0x0d, 0x84, 0x70, 0xf1, 0xe4, 0x4d, 0x13,
-	0x0f, 0xfe, 0x09, 0x7a, 0xf4, 0x80, 0x17, 0x6f, 0x9e, 0xe4, 0x48, 0xf0, 0x62, 0x3c, 0x18, 0x03,
-	0x26, 0xfe, 0x1b, 0x66, 0x67, 0xa6, 0xfb, 0x03, 0x4b, 0x29, 0x5e, 0x9a, 0xce, 0x3b, 0xef, 0xf3,
-	0xbc, 0xcf, 0xfb, 0x6b, 0x16, 0x4e, 0x94, 0x08, 0xad, 0x12, 0xaa, 0xd3, 0x9d, 0xea, 0x0a, 0xc3,
-	0x1b, 0xb6, 0x63, 0xe9, 0x8d, 0xdc, 0x9a, 0xc9, 0x70, 0x4e, 0xdf, 0xaa, 0x9b, 0xee, 0x8e, 0x56,
-	0x73, 0x09, 0x23, 0xe8, 0x3f, 0xe1, 0xa6, 0x05, 0x6e, 0x9a, 0x74, 0x53, 0xa6, 0x24, 0xc3, 0x1a,
-	0xa6, 0xa6, 0xc0, 0xf8, 0x0c, 0x35, 0x6c, 0xd9, 0x0e, 0x66, 0x36, 0x71, 0x04, 0x8d, 0x32, 0x64,
-	0x11, 0x8b, 0xf0, 0xbf, 0xba, 0xf7, 0x4f, 0x5a, 0xff, 0xb7, 0x08, 0xb1, 0x36, 0x4d, 0x1d, 0xd7,
-	0x6c, 0x1d, 0x3b, 0x0e, 0x61, 0x1c, 0x42, 0xe5, 0xed, 0xe4, 0xd9, 0x0a, 0xa9, 0x94, 0x22, 0x1c,
-	0xa5, 0xc6, 0xa2, 0xe0, 0x97, 0x82, 0xc5, 0xd5, 0xa8, 0xe4, 0x68, 0xca, 0x0b, 0xe7, 0xa6, 0x0c,
-	0xe0, 0xaa, 0xed, 0x10, 0x9d, 0xff, 0x0a, 0x93, 0xba, 0x0d, 0x87, 0x1f, 0x7b, 0x1e, 0x4f, 0xf0,
-	0xa6, 0x5d, 0xc6, 0x8c, 0xb8, 0xd4, 0x30, 0xb7, 0xea, 0x26, 0x65, 0x68, 0x18, 0x26, 0x28, 0xc3,
-	0xac, 0x4e, 0x53, 0x20, 0x03, 0xb2, 0x49, 0x43, 0x9e, 0xd0, 0x3d, 0x08, 0x83, 0x6c, 0x53, 0xf1,
-	0x0c, 0xc8, 0xf6, 0xe4, 0xaf, 0x6a, 0x52, 0x84, 0x57, 0x1a, 0x4d, 0x84, 0x94, 0xd2, 0xb5, 0x65,
-	0x6c, 0x99, 0x92, 0xd3, 0x08, 0x21, 0x55, 0x07, 0xf6, 0xfa, 0x41, 0x1f, 0x38, 0xeb, 0x04, 0x2d,
-	0xc2, 0x81, 0x12, 0x71, 0xa8, 0xe9, 0xd0, 0x3a, 0x2d, 0xe2, 0x72, 0xd9, 0x35, 0xa9, 0x8c, 0x5d,
-	0x18, 0xf9, 0xb6, 0x3f, 0x3d, 0xb8, 0x1d, 0x2a, 0x4c, 0xa6, 0x31, 0xa3, 0xe5, 0xb5, 0x19, 0xa3,
-	0xdf, 0x47, 0x2c, 0x08, 0xc0, 0xed, 0x91, 0xa3, 0xd6, 0xae, 0xea, 0xcb, 0x38, 0x1c, 0xf9, 0x23,
-	0x55, 0x5a, 0xf3, 0xf0, 0xe8, 0x11, 0x84, 0x0d, 0xdf, 0x9a, 0x02, 0x99, 0xae, 0x6c, 0x4f, 0xfe,
-	0x8a, 0x76, 0xe6, 0x24, 0x68, 0x3e, 0x45, 0x21, 0x79, 0xf0, 0x7d, 0x2c, 0xf6, 0xee, 0xd7, 0xfb,
-	0x29, 0x60, 0x84, 0x28, 0xd0, 0x2a, 0xec, 0xf3, 0x4f, 0x45, 0xdb, 0x59, 0x27, 0xa9, 0x38, 0x27,
-	0xcd, 0x76, 0x42, 0xea, 0x55, 0x23, 0x4c, 0xdc, 0xdb, 0x88, 0xd4, 0xe9, 0x7e, 0xa4, 0x01, 0x5d,
-	0xbc, 0x01, 0x93, 0xe7, 0x36, 0x40, 0x64, 0x1a, 0xe9, 0x00, 0x86, 0xff, 0x46, 0x0b, 0xd2, 0x6c,
-	0xfd, 0x52, 0x58, 0xbd, 0xd7, 0x09, 0xd9, 0x86, 0xf1, 0xa3, 0xfd, 0xe9, 0x4b, 0x32, 0x90, 0x0f,
-	0x92, 0x85, 0x5f, 0x61, 0xae, 0xed, 0x58, 0x21, 0xad, 0x9e, 0x5d, 0xb5, 0x4e, 0x8f, 0x97, 0x5f,
-	0xf2, 0x87, 0x30, 0xe9, 0xbb, 0x72, 0xfa, 0xbf, 0xa8, 0x78, 0xc0, 0xa0, 0xde, 0x80, 0x0a, 0x0f,
-	0xb4, 0x64, 0x53, 0x46, 0x5c, 0xbb, 0x84, 0x37, 0xbd, 0x5a, 0x85, 0x66, 0xb9, 0x62, 0xda, 0x56,
-	0x85, 0xf1, 0x48, 0x5d, 0x86, 0x3c, 0xa9, 0x1f, 0x01, 0x1c, 0x6d, 0x09, 0x93, 0x22, 0x17, 0xe0,
-	0x3f, 0x15, 0x9b, 0x32, 0xa9, 0xef, 0x5a, 0x1b, 0x7d, 0x51, 0x82, 0x42, 0x3c, 0x05, 0x0c, 0x0e,
-	0x45, 0x4f, 0xe1, 0x40, 0xc5, 0xbf, 0x2b, 0xba, 0x66, 0x89, 0xb8, 0x65, 0xb9, 0x35, 0xd7, 0x3b,
-	0xe2, 0x33, 0x38, 0xc4, 0xe8, 0xaf, 0x9c, 0xb2, 0xa8, 0x43, 0x10, 0x71, 0xed, 0xcb, 0xd8, 0xc5,
-	0xd5, 0xe6, 0xda, 0xaa, 0xcf, 0xe0, 0x60, 0xc4, 0x2a, 0x33, 0x59, 0x84, 0x89, 0x1a, 0xb7, 0xc8,
-	0x5c, 0xc6, 0xdb, 0xc4, 0x16, 0xd0, 0x70, 0xa1, 0x25, 0x36, 0xff, 0xb9, 0x1b, 0x76, 0x73, 0x76,
-	0xf4, 0x16, 0x40, 0x18, 0x2c, 0x12, 0xca, 0xb5, 0xa1, 0x6b, 0xfd, 0xbe, 0x28, 0xf9, 0x8b, 0x40,
-	0x44, 0x16, 0x6a, 0xfe, 0x85, 0x27, 0xe7, 0xf9, 0x97, 0x9f, 0xaf, 0xe3, 0x93, 0x68, 0x42, 0x3f,
-	0xfb, 0xbd, 0x0c, 0xad, 0xe2, 0x07, 0x00, 0x93, 0x3e, 0x15, 0x9a, 0xe9, 0x38, 0x6a, 0x53, 0x67,
-	0xee, 0x02, 0x08, 0x29, 0xf3, 0x6e, 0x20, 0xf3, 0x26, 0x9a, 0xef, 0x48, 0xa6, 0xbe, 0x1b, 0xdd,
-	0xb8, 0x3d, 0xf4, 0x09, 0xc0, 0xbe, 0xe8, 0x54, 0xa1, 0xb9, 0xf3, 0xa4, 0xb4, 0x9c, 0x7e, 0x65,
-	0xfe, 0xa2, 0x30, 0x99, 0xc6, 0x9d, 0x20, 0x8d, 0x39, 0x34, 0xdb, 0x26, 0x8d, 0xd0, 0x80, 0x7b,
-	0x6f, 0x9d, 0xbe, 0x2b, 0xd6, 0x6b, 0x0f, 0xbd, 0x01, 0x30, 0x21, 0xa6, 0x09, 0x4d, 0x9f, 0x27,
-	0x22, 0x32, 0xc6, 0x8a, 0xd6, 0xa9, 0xbb, 0xd4, 0xaa, 0x05, 0x5a, 0x2f, 0xa3, 0xf1, 0x36, 0x5a,
-	0xc5, 0x24, 0x17, 0x6e, 0x1d, 0x1c, 0xa7, 0xc1, 0xe1, 0x71, 0x1a, 0xfc, 0x38, 0x4e, 0x83, 0x57,
-	0x27, 0xe9, 0xd8, 0xe1, 0x49, 0x3a, 0xf6, 0xf5, 0x24, 0x1d, 0x5b, 0x1d, 0x13, 0x58, 0x5a, 0xde,
-	0xd0, 0x6c, 0xa2, 0x87, 0xbf, 0x24, 0x3a, 0xdb, 0xa9, 0x99, 0x74, 0x2d, 0xc1, 0xbf, 0x9c, 0xb3,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x3a, 0xee, 0xa6, 0x51, 0x08, 0x00, 0x00,
+func (m *QueryInjectedSymbioticDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInjectedSymbioticDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInjectedSymbioticDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInjectedSymbioticDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInjectedSymbioticDataRequest.Merge(m, src)
+}
+func (m *QueryInjectedSymbioticDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInjectedSymbioticDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInjectedSymbioticDataRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryInjectedSymbioticDataRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Validators queries all validators that match the given status.
-	//
-	// When called from another module, this query might consume a high amount of
-	// gas if the pagination field is incorrectly set.
-	Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error)
-	// Validator queries validator info for given validator address.
-	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
-	// HistoricalInfo queries the historical info for given height.
-	HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error)
-	// Parameters queries the staking parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryInjectedSymbioticDataRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryInjectedSymbioticDataResponse is response type for the Query/InjectedSymbioticData
+// RPC method.
+type QueryInjectedSymbioticDataResponse struct {
+	// data holds the data injected at the requested height.
+	Data InjectedSymbioticData `protobuf:"bytes,1,opt,name=data,proto3" json:"data"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryInjectedSymbioticDataResponse) Reset()         { *m = QueryInjectedSymbioticDataResponse{} }
+func (m *QueryInjectedSymbioticDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInjectedSymbioticDataResponse) ProtoMessage()    {}
+func (*QueryInjectedSymbioticDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{10}
 }
-
-func (c *queryClient) Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error) {
-	out := new(QueryValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/Validators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInjectedSymbioticDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInjectedSymbioticDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInjectedSymbioticDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryInjectedSymbioticDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInjectedSymbioticDataResponse.Merge(m, src)
+}
+func (m *QueryInjectedSymbioticDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInjectedSymbioticDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInjectedSymbioticDataResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
-	out := new(QueryValidatorResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/Validator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInjectedSymbioticDataResponse proto.InternalMessageInfo
+
+func (m *QueryInjectedSymbioticDataResponse) GetData() InjectedSymbioticData {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return InjectedSymbioticData{}
 }
 
-func (c *queryClient) HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error) {
-	out := new(QueryHistoricalInfoResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/HistoricalInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QuerySymbioticSyncStatusRequest is request type for the Query/SymbioticSyncStatus
+// RPC method.
+type QuerySymbioticSyncStatusRequest struct {
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySymbioticSyncStatusRequest) Reset()         { *m = QuerySymbioticSyncStatusRequest{} }
+func (m *QuerySymbioticSyncStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySymbioticSyncStatusRequest) ProtoMessage()    {}
+func (*QuerySymbioticSyncStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{11}
+}
+func (m *QuerySymbioticSyncStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySymbioticSyncStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySymbioticSyncStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Validators queries all validators that match the given status.
-	//
-	// When called from another module, this query might consume a high amount of
-	// gas if the pagination field is incorrectly set.
-	Validators(context.Context, *QueryValidatorsRequest) (*QueryValidatorsResponse, error)
-	// Validator queries validator info for given validator address.
-	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
-	// HistoricalInfo queries the historical info for given height.
-	HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error)
-	// Parameters queries the staking parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QuerySymbioticSyncStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySymbioticSyncStatusRequest.Merge(m, src)
+}
+func (m *QuerySymbioticSyncStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySymbioticSyncStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySymbioticSyncStatusRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QuerySymbioticSyncStatusRequest proto.InternalMessageInfo
+
+// QuerySymbioticSyncStatusResponse is response type for the Query/SymbioticSyncStatus
+// RPC method.
+type QuerySymbioticSyncStatusResponse struct {
+	// last_sync_point is the outcome of the last sync height, if any.
+	LastSyncPoint *SymbioticSyncPoint `protobuf:"bytes,1,opt,name=last_sync_point,json=lastSyncPoint,proto3" json:"last_sync_point,omitempty"`
+	// last_applied_sync_point is the last sync point the validator set was updated
+	// at, if any.
+	LastAppliedSyncPoint *SymbioticSyncPoint `protobuf:"bytes,2,opt,name=last_applied_sync_point,json=lastAppliedSyncPoint,proto3" json:"last_applied_sync_point,omitempty"`
+	// next_sync_height is the next sync height, or 0 if the Symbiotic middleware is
+	// not configured or, under the symbiotic epoch identifier, no sync is scheduled.
+	NextSyncHeight int64 `protobuf:"varint,3,opt,name=next_sync_height,json=nextSyncHeight,proto3" json:"next_sync_height,omitempty"`
+	// staleness tracks the sync heights skipped since the last update of the
+	// validator set, if any.
+	Staleness *SymbioticStaleness `protobuf:"bytes,4,opt,name=staleness,proto3" json:"staleness,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryValidatorsRequest) (*QueryValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+func (m *QuerySymbioticSyncStatusResponse) Reset()         { *m = QuerySymbioticSyncStatusResponse{} }
+func (m *QuerySymbioticSyncStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySymbioticSyncStatusResponse) ProtoMessage()    {}
+func (*QuerySymbioticSyncStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{12}
 }
-func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+func (m *QuerySymbioticSyncStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) HistoricalInfo(ctx context.Context, req *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
+func (m *QuerySymbioticSyncStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySymbioticSyncStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySymbioticSyncStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySymbioticSyncStatusResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QuerySymbioticSyncStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySymbioticSyncStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySymbioticSyncStatusResponse.DiscardUnknown(m)
 }
 
-func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.symStaking.v1beta1.Query/Validators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validators(ctx, req.(*QueryValidatorsRequest))
+var xxx_messageInfo_QuerySymbioticSyncStatusResponse proto.InternalMessageInfo
+
+func (m *QuerySymbioticSyncStatusResponse) GetLastSyncPoint() *SymbioticSyncPoint {
+	if m != nil {
+		return m.LastSyncPoint
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.symStaking.v1beta1.Query/Validator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validator(ctx, req.(*QueryValidatorRequest))
+func (m *QuerySymbioticSyncStatusResponse) GetLastAppliedSyncPoint() *SymbioticSyncPoint {
+	if m != nil {
+		return m.LastAppliedSyncPoint
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHistoricalInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HistoricalInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.symStaking.v1beta1.Query/HistoricalInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HistoricalInfo(ctx, req.(*QueryHistoricalInfoRequest))
+func (m *QuerySymbioticSyncStatusResponse) GetNextSyncHeight() int64 {
+	if m != nil {
+		return m.NextSyncHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.symStaking.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QuerySymbioticSyncStatusResponse) GetStaleness() *SymbioticStaleness {
+	if m != nil {
+		return m.Staleness
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.symStaking.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Validators",
-			Handler:    _Query_Validators_Handler,
-		},
-		{
-			MethodName: "Validator",
-			Handler:    _Query_Validator_Handler,
-		},
-		{
-			MethodName: "HistoricalInfo",
-			Handler:    _Query_HistoricalInfo_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/symStaking/v1beta1/query.proto",
+// QuerySymbioticSyncHistoryRequest is request type for the Query/SymbioticSyncHistory
+// RPC method.
+type QuerySymbioticSyncHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySymbioticSyncHistoryRequest) Reset()         { *m = QuerySymbioticSyncHistoryRequest{} }
+func (m *QuerySymbioticSyncHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySymbioticSyncHistoryRequest) ProtoMessage()    {}
+func (*QuerySymbioticSyncHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{13}
 }
-
-func (m *QueryValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySymbioticSyncHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySymbioticSyncHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySymbioticSyncHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ValidatorInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySymbioticSyncHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySymbioticSyncHistoryRequest.Merge(m, src)
 }
-
-func (m *ValidatorInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySymbioticSyncHistoryRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *ValidatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsensusAddress) > 0 {
-		i -= len(m.ConsensusAddress)
-		copy(dAtA[i:], m.ConsensusAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySymbioticSyncHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySymbioticSyncHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySymbioticSyncHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySymbioticSyncHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySymbioticSyncHistoryResponse is response type for the Query/SymbioticSyncHistory
+// RPC method.
+type QuerySymbioticSyncHistoryResponse struct {
+	// sync_points holds the sync points ordered by height.
+	SyncPoints []SymbioticSyncPoint `protobuf:"bytes,1,rep,name=sync_points,json=syncPoints,proto3" json:"sync_points"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorInfo) > 0 {
-		for iNdEx := len(m.ValidatorInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySymbioticSyncHistoryResponse) Reset()         { *m = QuerySymbioticSyncHistoryResponse{} }
+func (m *QuerySymbioticSyncHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySymbioticSyncHistoryResponse) ProtoMessage()    {}
+func (*QuerySymbioticSyncHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{14}
+}
+func (m *QuerySymbioticSyncHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySymbioticSyncHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySymbioticSyncHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySymbioticSyncHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySymbioticSyncHistoryResponse.Merge(m, src)
 }
-
-func (m *QueryValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySymbioticSyncHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySymbioticSyncHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySymbioticSyncHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuerySymbioticSyncHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySymbioticSyncHistoryResponse) GetSyncPoints() []SymbioticSyncPoint {
+	if m != nil {
+		return m.SyncPoints
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySymbioticSyncHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorSymbioticStakesRequest is request type for the
+// Query/ValidatorSymbioticStakes RPC method.
+type QueryValidatorSymbioticStakesRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryValidatorSymbioticStakesRequest) Reset()         { *m = QueryValidatorSymbioticStakesRequest{} }
+func (m *QueryValidatorSymbioticStakesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSymbioticStakesRequest) ProtoMessage()    {}
+func (*QueryValidatorSymbioticStakesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{15}
+}
+func (m *QueryValidatorSymbioticStakesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSymbioticStakesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSymbioticStakesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorSymbioticStakesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSymbioticStakesRequest.Merge(m, src)
+}
+func (m *QueryValidatorSymbioticStakesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSymbioticStakesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSymbioticStakesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryHistoricalInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorSymbioticStakesRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorSymbioticStakesRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryHistoricalInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorSymbioticStakesResponse is response type for the
+// Query/ValidatorSymbioticStakes RPC method.
+type QueryValidatorSymbioticStakesResponse struct {
+	// stakes holds the stake of the validator in every middleware listing it,
+	// ordered as the middlewares in the params.
+	Stakes []SymbioticMiddlewareStake `protobuf:"bytes,1,rep,name=stakes,proto3" json:"stakes"`
 }
 
-func (m *QueryHistoricalInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryValidatorSymbioticStakesResponse) Reset()         { *m = QueryValidatorSymbioticStakesResponse{} }
+func (m *QueryValidatorSymbioticStakesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSymbioticStakesResponse) ProtoMessage()    {}
+func (*QueryValidatorSymbioticStakesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{16}
+}
+func (m *QueryValidatorSymbioticStakesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSymbioticStakesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSymbioticStakesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorSymbioticStakesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSymbioticStakesResponse.Merge(m, src)
+}
+func (m *QueryValidatorSymbioticStakesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSymbioticStakesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSymbioticStakesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryHistoricalInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorSymbioticStakesResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorSymbioticStakesResponse) GetStakes() []SymbioticMiddlewareStake {
+	if m != nil {
+		return m.Stakes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryHistoricalInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySymbioticCheckpointRequest is request type for the
+// Query/SymbioticCheckpoint RPC method.
+type QuerySymbioticCheckpointRequest struct {
+	// epoch defines the epoch of the checkpoint to query for.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (m *QueryHistoricalInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HistoricalRecord != nil {
-		{
-			size, err := m.HistoricalRecord.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Hist != nil {
-		{
-			size, err := m.Hist.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySymbioticCheckpointRequest) Reset()         { *m = QuerySymbioticCheckpointRequest{} }
+func (m *QuerySymbioticCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySymbioticCheckpointRequest) ProtoMessage()    {}
+func (*QuerySymbioticCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{17}
+}
+func (m *QuerySymbioticCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySymbioticCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySymbioticCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySymbioticCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySymbioticCheckpointRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySymbioticCheckpointRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySymbioticCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySymbioticCheckpointRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySymbioticCheckpointRequest proto.InternalMessageInfo
+
+func (m *QuerySymbioticCheckpointRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySymbioticCheckpointResponse is response type for the
+// Query/SymbioticCheckpoint RPC method.
+type QuerySymbioticCheckpointResponse struct {
+	// checkpoint is the checkpoint of the epoch.
+	Checkpoint SymbioticCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint"`
+	// payload is the ABI encoded checkpoint whose keccak256 digest is signed.
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// quorum is true if the validators that signed hold more than 2/3 of the
+	// power of the checkpoint.
+	Quorum bool `protobuf:"varint,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySymbioticCheckpointResponse) Reset()         { *m = QuerySymbioticCheckpointResponse{} }
+func (m *QuerySymbioticCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySymbioticCheckpointResponse) ProtoMessage()    {}
+func (*QuerySymbioticCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{18}
+}
+func (m *QuerySymbioticCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySymbioticCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySymbioticCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySymbioticCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySymbioticCheckpointResponse.Merge(m, src)
+}
+func (m *QuerySymbioticCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySymbioticCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySymbioticCheckpointResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QuerySymbioticCheckpointResponse proto.InternalMessageInfo
+
+func (m *QuerySymbioticCheckpointResponse) GetCheckpoint() SymbioticCheckpoint {
+	if m != nil {
+		return m.Checkpoint
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return SymbioticCheckpoint{}
 }
-func (m *QueryValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QuerySymbioticCheckpointResponse) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	return n
+	return nil
 }
 
-func (m *ValidatorInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsensusAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySymbioticCheckpointResponse) GetQuorum() bool {
+	if m != nil {
+		return m.Quorum
 	}
-	return n
+	return false
 }
 
-func (m *QueryValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.ValidatorInfo) > 0 {
-		for _, e := range m.ValidatorInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// QuerySymbioticCheckpointsRequest is request type for the
+// Query/SymbioticCheckpoints RPC method.
+type QuerySymbioticCheckpointsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySymbioticCheckpointsRequest) Reset()         { *m = QuerySymbioticCheckpointsRequest{} }
+func (m *QuerySymbioticCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySymbioticCheckpointsRequest) ProtoMessage()    {}
+func (*QuerySymbioticCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{19}
+}
+func (m *QuerySymbioticCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySymbioticCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySymbioticCheckpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QuerySymbioticCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySymbioticCheckpointsRequest.Merge(m, src)
+}
+func (m *QuerySymbioticCheckpointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySymbioticCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySymbioticCheckpointsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySymbioticCheckpointsRequest proto.InternalMessageInfo
+
+func (m *QuerySymbioticCheckpointsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Validator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QuerySymbioticCheckpointsResponse is response type for the
+// Query/SymbioticCheckpoints RPC method.
+type QuerySymbioticCheckpointsResponse struct {
+	// checkpoints holds the checkpoints ordered by epoch.
+	Checkpoints []SymbioticCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryHistoricalInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+func (m *QuerySymbioticCheckpointsResponse) Reset()         { *m = QuerySymbioticCheckpointsResponse{} }
+func (m *QuerySymbioticCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySymbioticCheckpointsResponse) ProtoMessage()    {}
+func (*QuerySymbioticCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2072ddbc3d435e5, []int{20}
+}
+func (m *QuerySymbioticCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySymbioticCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySymbioticCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySymbioticCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySymbioticCheckpointsResponse.Merge(m, src)
+}
+func (m *QuerySymbioticCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySymbioticCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySymbioticCheckpointsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryHistoricalInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Hist != nil {
-		l = m.Hist.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.HistoricalRecord != nil {
-		l = m.HistoricalRecord.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySymbioticCheckpointsResponse proto.InternalMessageInfo
+
+func (m *QuerySymbioticCheckpointsResponse) GetCheckpoints() []SymbioticCheckpoint {
+	if m != nil {
+		return m.Checkpoints
 	}
-	return n
+	return nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySymbioticCheckpointsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
+func init() {
+	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.symStaking.v1beta1.QueryValidatorsRequest")
+	proto.RegisterType((*ValidatorInfo)(nil), "cosmos.symStaking.v1beta1.ValidatorInfo")
+	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.symStaking.v1beta1.QueryValidatorsResponse")
+	proto.RegisterType((*QueryValidatorRequest)(nil), "cosmos.symStaking.v1beta1.QueryValidatorRequest")
+	proto.RegisterType((*QueryValidatorResponse)(nil), "cosmos.symStaking.v1beta1.QueryValidatorResponse")
+	proto.RegisterType((*QueryHistoricalInfoRequest)(nil), "cosmos.symStaking.v1beta1.QueryHistoricalInfoRequest")
+	proto.RegisterType((*QueryHistoricalInfoResponse)(nil), "cosmos.symStaking.v1beta1.QueryHistoricalInfoResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.symStaking.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.symStaking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryInjectedSymbioticDataRequest)(nil), "cosmos.symStaking.v1beta1.QueryInjectedSymbioticDataRequest")
+	proto.RegisterType((*QueryInjectedSymbioticDataResponse)(nil), "cosmos.symStaking.v1beta1.QueryInjectedSymbioticDataResponse")
+	proto.RegisterType((*QuerySymbioticSyncStatusRequest)(nil), "cosmos.symStaking.v1beta1.QuerySymbioticSyncStatusRequest")
+	proto.RegisterType((*QuerySymbioticSyncStatusResponse)(nil), "cosmos.symStaking.v1beta1.QuerySymbioticSyncStatusResponse")
+	proto.RegisterType((*QuerySymbioticSyncHistoryRequest)(nil), "cosmos.symStaking.v1beta1.QuerySymbioticSyncHistoryRequest")
+	proto.RegisterType((*QuerySymbioticSyncHistoryResponse)(nil), "cosmos.symStaking.v1beta1.QuerySymbioticSyncHistoryResponse")
+	proto.RegisterType((*QueryValidatorSymbioticStakesRequest)(nil), "cosmos.symStaking.v1beta1.QueryValidatorSymbioticStakesRequest")
+	proto.RegisterType((*QueryValidatorSymbioticStakesResponse)(nil), "cosmos.symStaking.v1beta1.QueryValidatorSymbioticStakesResponse")
+	proto.RegisterType((*QuerySymbioticCheckpointRequest)(nil), "cosmos.symStaking.v1beta1.QuerySymbioticCheckpointRequest")
+	proto.RegisterType((*QuerySymbioticCheckpointResponse)(nil), "cosmos.symStaking.v1beta1.QuerySymbioticCheckpointResponse")
+	proto.RegisterType((*QuerySymbioticCheckpointsRequest)(nil), "cosmos.symStaking.v1beta1.QuerySymbioticCheckpointsRequest")
+	proto.RegisterType((*QuerySymbioticCheckpointsResponse)(nil), "cosmos.symStaking.v1beta1.QuerySymbioticCheckpointsResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/symStaking/v1beta1/query.proto", fileDescriptor_c2072ddbc3d435e5)
+}
+
+var fileDescriptor_c2072ddbc3d435e5 = []byte{
+	// 1331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xc1, 0x53, 0x1b, 0x55,
+	0x18, 0x67, 0x03, 0x45, 0xf9, 0x10, 0x0a, 0x0f, 0x5a, 0xe2, 0x56, 0x43, 0xb2, 0x16, 0xc1, 0x3a,
+	0xec, 0x42, 0xb0, 0xb5, 0x42, 0xd1, 0x42, 0xd1, 0xc2, 0x38, 0x1d, 0x71, 0xd1, 0x8e, 0xb6, 0x87,
+	0xcc, 0x23, 0xd9, 0x26, 0x5b, 0x92, 0xdd, 0x25, 0x6f, 0x83, 0x64, 0x18, 0xc6, 0x19, 0x4f, 0xde,
+	0x74, 0xc6, 0x83, 0x7f, 0x82, 0xde, 0xf4, 0x50, 0x2f, 0xde, 0x3c, 0x59, 0x0f, 0xce, 0x60, 0x1d,
+	0x1d, 0xc7, 0x43, 0xc7, 0x01, 0x67, 0x3c, 0x79, 0xf2, 0x1f, 0x70, 0xf6, 0xed, 0xdb, 0x7d, 0xbb,
+	0x90, 0x6c, 0x92, 0x2d, 0x17, 0x26, 0xfb, 0xf2, 0xbe, 0xdf, 0xf7, 0xfb, 0x7d, 0xdf, 0x7b, 0xdf,
+	0xfe, 0x08, 0x4c, 0xe4, 0x4d, 0x52, 0x31, 0x89, 0x42, 0xea, 0x95, 0x0d, 0x1b, 0x6f, 0xe9, 0x46,
+	0x51, 0xd9, 0x99, 0xdd, 0xd4, 0x6c, 0x3c, 0xab, 0x6c, 0xd7, 0xb4, 0x6a, 0x5d, 0xb6, 0xaa, 0xa6,
+	0x6d, 0xa2, 0x67, 0xdd, 0x6d, 0x32, 0xdf, 0x26, 0xb3, 0x6d, 0xe2, 0x25, 0x86, 0xb0, 0x89, 0x89,
+	0xe6, 0xc6, 0xf8, 0x08, 0x16, 0x2e, 0xea, 0x06, 0xb6, 0x75, 0xd3, 0x70, 0x61, 0xc4, 0xd1, 0xa2,
+	0x59, 0x34, 0xe9, 0x47, 0xc5, 0xf9, 0xc4, 0x56, 0x9f, 0x2b, 0x9a, 0x66, 0xb1, 0xac, 0x29, 0xd8,
+	0xd2, 0x15, 0x6c, 0x18, 0xa6, 0x4d, 0x43, 0x08, 0xfb, 0x76, 0xb2, 0x39, 0x43, 0xc2, 0xa8, 0xb8,
+	0x1b, 0x19, 0xc7, 0x9c, 0x8b, 0xcf, 0x08, 0xbb, 0x5f, 0x5d, 0x60, 0x18, 0x1e, 0xbd, 0xa0, 0x36,
+	0x71, 0x18, 0x57, 0x74, 0xc3, 0x54, 0xe8, 0x5f, 0x77, 0x49, 0xda, 0x85, 0xf3, 0xef, 0x3a, 0x3b,
+	0x6e, 0xe3, 0xb2, 0x5e, 0xc0, 0xb6, 0x59, 0x25, 0xaa, 0xb6, 0x5d, 0xd3, 0x88, 0x8d, 0xce, 0x43,
+	0x2f, 0xb1, 0xb1, 0x5d, 0x23, 0x49, 0x21, 0x2d, 0x4c, 0xf5, 0xa9, 0xec, 0x09, 0xbd, 0x05, 0xc0,
+	0xd5, 0x26, 0x13, 0x69, 0x61, 0xaa, 0x3f, 0xfb, 0xa2, 0xcc, 0x48, 0x38, 0xa5, 0x91, 0xdd, 0x94,
+	0x8c, 0xba, 0xbc, 0x8e, 0x8b, 0x1a, 0xc3, 0x54, 0x03, 0x91, 0x92, 0x01, 0x03, 0x7e, 0xd2, 0x35,
+	0xe3, 0x9e, 0x89, 0x56, 0x60, 0x38, 0x6f, 0x1a, 0x44, 0x33, 0x48, 0x8d, 0xe4, 0x70, 0xa1, 0x50,
+	0xd5, 0x08, 0xcb, 0xbd, 0x3c, 0xf6, 0xe7, 0x83, 0xe9, 0x91, 0xdd, 0x40, 0x61, 0xd2, 0x3b, 0x33,
+	0x72, 0x56, 0x9e, 0x51, 0x87, 0xfc, 0x88, 0x25, 0x37, 0x60, 0x7e, 0xec, 0x51, 0xe3, 0xad, 0xd2,
+	0x67, 0x09, 0x18, 0x3b, 0x21, 0x95, 0x58, 0x4e, 0x3c, 0x7a, 0x07, 0x60, 0xc7, 0x5f, 0x4d, 0x0a,
+	0xe9, 0xee, 0xa9, 0xfe, 0xec, 0x45, 0xb9, 0xe9, 0x49, 0x90, 0x7d, 0x88, 0xe5, 0xbe, 0x87, 0x8f,
+	0xc7, 0xbb, 0xbe, 0xfe, 0xe7, 0xdb, 0x4b, 0x82, 0x1a, 0x80, 0x40, 0x77, 0x60, 0xd0, 0x7f, 0xca,
+	0xe9, 0xc6, 0x3d, 0x33, 0x99, 0xa0, 0xa0, 0x53, 0xed, 0x80, 0x3a, 0xd5, 0x08, 0x02, 0x0f, 0xec,
+	0x84, 0xea, 0x74, 0x33, 0xd4, 0x80, 0x6e, 0xda, 0x80, 0xc9, 0x96, 0x0d, 0x70, 0x95, 0x86, 0x3a,
+	0x80, 0xe1, 0x5c, 0xb8, 0x20, 0x5e, 0xeb, 0x57, 0x83, 0xec, 0x9d, 0x4e, 0xb0, 0x36, 0x64, 0x1e,
+	0x3d, 0x98, 0x7e, 0x9e, 0x25, 0xf2, 0x83, 0x58, 0xe1, 0x37, 0xec, 0xaa, 0x6e, 0x14, 0x03, 0x5c,
+	0x9d, 0x75, 0xa9, 0x78, 0xfc, 0x78, 0xf9, 0x25, 0xbf, 0x05, 0x7d, 0xfe, 0x56, 0x0a, 0x1f, 0xa3,
+	0xe2, 0x1c, 0x41, 0x7a, 0x05, 0x44, 0x9a, 0x68, 0x55, 0x27, 0xb6, 0x59, 0xd5, 0xf3, 0xb8, 0xec,
+	0xd4, 0x2a, 0x70, 0x96, 0x4b, 0x9a, 0x5e, 0x2c, 0xd9, 0x34, 0x53, 0xb7, 0xca, 0x9e, 0xa4, 0xef,
+	0x05, 0xb8, 0xd0, 0x30, 0x8c, 0x91, 0x5c, 0x82, 0x9e, 0x92, 0x4e, 0x6c, 0xc6, 0xef, 0xa5, 0x08,
+	0x7e, 0x61, 0x80, 0xe5, 0x44, 0x52, 0x50, 0x69, 0x28, 0xfa, 0x00, 0x86, 0x4b, 0xfe, 0x77, 0xb9,
+	0xaa, 0x96, 0x37, 0xab, 0x05, 0x76, 0x6b, 0x5e, 0x6e, 0x0b, 0x4f, 0xa5, 0x21, 0xea, 0x50, 0xe9,
+	0xd8, 0x8a, 0x34, 0x0a, 0x88, 0x72, 0x5f, 0xc7, 0x55, 0x5c, 0xf1, 0xae, 0xad, 0x74, 0x17, 0x46,
+	0x42, 0xab, 0x4c, 0xc9, 0x0a, 0xf4, 0x5a, 0x74, 0x85, 0x69, 0xc9, 0x44, 0xe4, 0x76, 0x43, 0x83,
+	0x85, 0x66, 0xb1, 0xd2, 0x02, 0x64, 0x28, 0xf8, 0x9a, 0x71, 0x5f, 0xcb, 0xdb, 0x5a, 0x61, 0xa3,
+	0x5e, 0xd9, 0xd4, 0x4d, 0x5b, 0xcf, 0xaf, 0x60, 0x1b, 0xb7, 0x2a, 0x76, 0x0d, 0xa4, 0xa8, 0x60,
+	0xff, 0x2a, 0xf6, 0x14, 0xb0, 0x8d, 0x19, 0xcd, 0x99, 0x08, 0x9a, 0x0d, 0x71, 0x82, 0xac, 0x29,
+	0x90, 0x94, 0x81, 0x71, 0x9a, 0xd6, 0xdf, 0xb6, 0x51, 0x37, 0xf2, 0x1b, 0x74, 0x96, 0x79, 0x35,
+	0xfb, 0x3d, 0x01, 0xe9, 0xe6, 0x7b, 0x18, 0xb1, 0xf7, 0xe1, 0x6c, 0x19, 0x13, 0x3b, 0x47, 0xea,
+	0x46, 0x3e, 0x67, 0x99, 0xba, 0xe1, 0x1d, 0x8b, 0xe9, 0x08, 0x8e, 0x21, 0xc0, 0x75, 0x27, 0x48,
+	0x1d, 0x70, 0x50, 0xfc, 0x47, 0x54, 0x80, 0x31, 0x0a, 0x8b, 0x2d, 0xab, 0xac, 0x6b, 0x85, 0x20,
+	0x7c, 0x22, 0x0e, 0xfc, 0xa8, 0x83, 0xb6, 0xe4, 0x82, 0xf1, 0x2c, 0x53, 0x30, 0x64, 0x68, 0xbb,
+	0x8c, 0x3c, 0xeb, 0x4e, 0x37, 0xed, 0xce, 0xa0, 0xb3, 0xee, 0x6c, 0x5c, 0xa5, 0xab, 0xe8, 0x6d,
+	0xe8, 0x23, 0x36, 0x2e, 0x6b, 0x86, 0x33, 0x7d, 0x7b, 0x3a, 0x60, 0xe0, 0x05, 0xa9, 0x3c, 0x5e,
+	0xba, 0xdf, 0xa8, 0xae, 0xee, 0xd1, 0xae, 0x7b, 0xc7, 0x25, 0xfc, 0x3e, 0x11, 0x62, 0xbf, 0x4f,
+	0x7e, 0x14, 0x20, 0x13, 0x91, 0x8c, 0x75, 0xf1, 0x43, 0xe8, 0xe7, 0x15, 0xf6, 0x46, 0x7d, 0x67,
+	0x25, 0x0e, 0xcd, 0x7c, 0xe2, 0xad, 0x92, 0x63, 0x73, 0x39, 0x11, 0x7f, 0x2e, 0x5b, 0x70, 0x31,
+	0x3c, 0x34, 0x83, 0x45, 0xde, 0xd2, 0xc8, 0xe9, 0x8f, 0xe9, 0x8f, 0x61, 0xa2, 0x45, 0x46, 0x56,
+	0xbe, 0xdb, 0xd4, 0x14, 0x6c, 0x69, 0x5e, 0xe5, 0xe6, 0xda, 0xa9, 0xdc, 0x2d, 0xbd, 0x50, 0x28,
+	0x6b, 0x1f, 0xe1, 0xaa, 0x46, 0xd1, 0x42, 0x83, 0xc5, 0x45, 0x93, 0x5e, 0x3d, 0x7e, 0x49, 0x6f,
+	0x94, 0xb4, 0xfc, 0x16, 0x6d, 0x92, 0xa7, 0x76, 0x14, 0xce, 0x68, 0x96, 0x99, 0x2f, 0x51, 0x91,
+	0x3d, 0xaa, 0xfb, 0x20, 0x7d, 0x23, 0x40, 0xba, 0x79, 0xa4, 0xdf, 0x74, 0xc8, 0xfb, 0xab, 0xec,
+	0x88, 0xc9, 0xed, 0x30, 0xe7, 0x58, 0xa1, 0xa6, 0x73, 0x30, 0x94, 0x84, 0xa7, 0x2c, 0x5c, 0x2f,
+	0x9b, 0xd8, 0x1d, 0xea, 0xcf, 0xa8, 0xde, 0xa3, 0x33, 0x06, 0xb7, 0x6b, 0x66, 0xb5, 0x56, 0xa1,
+	0x17, 0xed, 0x69, 0x95, 0x3d, 0x9d, 0xbc, 0x13, 0x3c, 0x09, 0x39, 0xed, 0x3b, 0xf1, 0xd3, 0x89,
+	0x3b, 0x11, 0x4a, 0xc6, 0xca, 0x73, 0x17, 0xfa, 0xb9, 0x22, 0xaf, 0xb3, 0x4f, 0x50, 0x9f, 0x20,
+	0xda, 0xa9, 0xdd, 0x8a, 0xec, 0xbf, 0x67, 0xe1, 0x0c, 0xd5, 0x82, 0xbe, 0x12, 0x00, 0xb8, 0x89,
+	0x43, 0xb3, 0x11, 0x4c, 0x1b, 0x7b, 0x5b, 0x31, 0xdb, 0x49, 0x88, 0xcb, 0x45, 0xca, 0x7e, 0xea,
+	0x88, 0xfb, 0xe4, 0xd7, 0xbf, 0xbf, 0x48, 0x4c, 0xa2, 0x09, 0xa5, 0xb9, 0x57, 0x0f, 0xd8, 0xc0,
+	0xef, 0x04, 0xe8, 0xf3, 0xa1, 0xd0, 0x4c, 0xdb, 0x59, 0x3d, 0x9e, 0xb3, 0x1d, 0x44, 0x30, 0x9a,
+	0x37, 0x38, 0xcd, 0xab, 0xe8, 0x4a, 0x5b, 0x34, 0x95, 0xbd, 0xf0, 0x18, 0xd9, 0x47, 0x3f, 0x08,
+	0x30, 0x18, 0x76, 0x34, 0xe8, 0x72, 0x2b, 0x2a, 0x0d, 0x9d, 0x97, 0x78, 0xa5, 0xd3, 0x30, 0x26,
+	0xe3, 0x3a, 0x97, 0x71, 0x19, 0xcd, 0x45, 0xc8, 0x08, 0x98, 0x2b, 0xc7, 0x67, 0x2b, 0x7b, 0xee,
+	0xdb, 0x6d, 0x1f, 0x7d, 0x29, 0x40, 0xaf, 0xeb, 0x64, 0xd0, 0x74, 0x2b, 0x12, 0x21, 0x0b, 0x25,
+	0xca, 0xed, 0x6e, 0x67, 0x5c, 0x65, 0xce, 0xf5, 0x05, 0x94, 0x89, 0xe0, 0xea, 0xba, 0x28, 0xf4,
+	0x58, 0x80, 0x73, 0x0d, 0xcd, 0x0b, 0xba, 0xd6, 0x2a, 0x73, 0x94, 0xf1, 0x12, 0x17, 0x63, 0x46,
+	0x33, 0x19, 0xab, 0x5c, 0xc6, 0x22, 0x5a, 0x88, 0x90, 0xa1, 0x33, 0x98, 0x1c, 0xf1, 0x70, 0x72,
+	0x8e, 0xd3, 0xe2, 0xa5, 0xff, 0x59, 0x80, 0x91, 0x06, 0x56, 0x0a, 0xcd, 0xb7, 0x22, 0xd8, 0xdc,
+	0xa3, 0x89, 0x0b, 0xb1, 0x62, 0x99, 0xb4, 0x45, 0x2e, 0x2d, 0x8b, 0x66, 0x22, 0xa4, 0x71, 0x45,
+	0xd4, 0x25, 0xb0, 0x7f, 0x79, 0x7f, 0x11, 0x60, 0xb4, 0x91, 0xab, 0x40, 0x9d, 0x91, 0x0a, 0x1b,
+	0x1f, 0xf1, 0x5a, 0xbc, 0x60, 0x26, 0xe9, 0x75, 0x2e, 0x69, 0x0e, 0xcd, 0xb6, 0x2f, 0xa9, 0xc4,
+	0xa8, 0xff, 0x27, 0x40, 0xb2, 0xd9, 0xeb, 0x1e, 0xbd, 0xd1, 0xf6, 0xdc, 0x69, 0x6c, 0x4d, 0xc4,
+	0xeb, 0xf1, 0x01, 0x98, 0xbe, 0xf7, 0xb8, 0xbe, 0x35, 0x74, 0x33, 0xde, 0x1c, 0x0b, 0x6a, 0x77,
+	0x85, 0xfd, 0x16, 0x3c, 0x99, 0xfc, 0xed, 0xd5, 0xc1, 0xc9, 0x3c, 0x61, 0x4c, 0xc4, 0x85, 0x58,
+	0xb1, 0x4c, 0xe6, 0x9b, 0x5c, 0xe6, 0x3c, 0xba, 0xda, 0x56, 0x1b, 0x03, 0x6f, 0x57, 0x65, 0x8f,
+	0xba, 0xa0, 0x7d, 0x74, 0x10, 0x3c, 0xa1, 0x3c, 0x0d, 0x41, 0x71, 0xc8, 0x91, 0xce, 0x4f, 0x68,
+	0x03, 0x5b, 0x11, 0xe7, 0xd2, 0x05, 0xa4, 0x2d, 0xbf, 0xf6, 0xf0, 0x30, 0x25, 0x1c, 0x1c, 0xa6,
+	0x84, 0xbf, 0x0e, 0x53, 0xc2, 0xe7, 0x47, 0xa9, 0xae, 0x83, 0xa3, 0x54, 0xd7, 0x1f, 0x47, 0xa9,
+	0xae, 0x3b, 0xe3, 0x2e, 0x14, 0x29, 0x6c, 0xc9, 0xba, 0xa9, 0x04, 0x7f, 0xeb, 0x51, 0xec, 0xba,
+	0xa5, 0x91, 0xcd, 0x5e, 0xfa, 0xdb, 0xd6, 0xdc, 0xff, 0x03, 0x00, 0x4c, 0xb5, 0x60, 0x68, 0xf3,
+	0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Validators queries all validators that match the given status.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error)
+	// Validator queries validator info for given validator address.
+	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
+	// HistoricalInfo queries the historical info for given height.
+	HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error)
+	// Parameters queries the staking parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// InjectedSymbioticData queries the data injected by the proposer at the given
+	// sync height, i.e. the Ethereum block the validator set update is derived from.
+	InjectedSymbioticData(ctx context.Context, in *QueryInjectedSymbioticDataRequest, opts ...grpc.CallOption) (*QueryInjectedSymbioticDataResponse, error)
+	// SymbioticSyncStatus queries the last sync points of the validator set with
+	// the Symbiotic middleware and the next sync height.
+	SymbioticSyncStatus(ctx context.Context, in *QuerySymbioticSyncStatusRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncStatusResponse, error)
+	// SymbioticSyncHistory queries the sync points of the validator set with the
+	// Symbiotic middleware.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	SymbioticSyncHistory(ctx context.Context, in *QuerySymbioticSyncHistoryRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncHistoryResponse, error)
+	// ValidatorSymbioticStakes queries the stake of a validator in every Symbiotic
+	// middleware listing it at the last sync.
+	ValidatorSymbioticStakes(ctx context.Context, in *QueryValidatorSymbioticStakesRequest, opts ...grpc.CallOption) (*QueryValidatorSymbioticStakesResponse, error)
+	// SymbioticCheckpoint queries the Symbiotic checkpoint of an epoch along with
+	// its payload, for submission to the middleware.
+	SymbioticCheckpoint(ctx context.Context, in *QuerySymbioticCheckpointRequest, opts ...grpc.CallOption) (*QuerySymbioticCheckpointResponse, error)
+	// SymbioticCheckpoints queries the Symbiotic checkpoints.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	SymbioticCheckpoints(ctx context.Context, in *QuerySymbioticCheckpointsRequest, opts ...grpc.CallOption) (*QuerySymbioticCheckpointsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error) {
+	out := new(QueryValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/Validators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
+	out := new(QueryValidatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/Validator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error) {
+	out := new(QueryHistoricalInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/HistoricalInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InjectedSymbioticData(ctx context.Context, in *QueryInjectedSymbioticDataRequest, opts ...grpc.CallOption) (*QueryInjectedSymbioticDataResponse, error) {
+	out := new(QueryInjectedSymbioticDataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/InjectedSymbioticData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SymbioticSyncStatus(ctx context.Context, in *QuerySymbioticSyncStatusRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncStatusResponse, error) {
+	out := new(QuerySymbioticSyncStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/SymbioticSyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SymbioticSyncHistory(ctx context.Context, in *QuerySymbioticSyncHistoryRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncHistoryResponse, error) {
+	out := new(QuerySymbioticSyncHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/SymbioticSyncHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSymbioticStakes(ctx context.Context, in *QueryValidatorSymbioticStakesRequest, opts ...grpc.CallOption) (*QueryValidatorSymbioticStakesResponse, error) {
+	out := new(QueryValidatorSymbioticStakesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/ValidatorSymbioticStakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SymbioticCheckpoint(ctx context.Context, in *QuerySymbioticCheckpointRequest, opts ...grpc.CallOption) (*QuerySymbioticCheckpointResponse, error) {
+	out := new(QuerySymbioticCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/SymbioticCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SymbioticCheckpoints(ctx context.Context, in *QuerySymbioticCheckpointsRequest, opts ...grpc.CallOption) (*QuerySymbioticCheckpointsResponse, error) {
+	out := new(QuerySymbioticCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Query/SymbioticCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Validators queries all validators that match the given status.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	Validators(context.Context, *QueryValidatorsRequest) (*QueryValidatorsResponse, error)
+	// Validator queries validator info for given validator address.
+	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
+	// HistoricalInfo queries the historical info for given height.
+	HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error)
+	// Parameters queries the staking parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// InjectedSymbioticData queries the data injected by the proposer at the given
+	// sync height, i.e. the Ethereum block the validator set update is derived from.
+	InjectedSymbioticData(context.Context, *QueryInjectedSymbioticDataRequest) (*QueryInjectedSymbioticDataResponse, error)
+	// SymbioticSyncStatus queries the last sync points of the validator set with
+	// the Symbiotic middleware and the next sync height.
+	SymbioticSyncStatus(context.Context, *QuerySymbioticSyncStatusRequest) (*QuerySymbioticSyncStatusResponse, error)
+	// SymbioticSyncHistory queries the sync points of the validator set with the
+	// Symbiotic middleware.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	SymbioticSyncHistory(context.Context, *QuerySymbioticSyncHistoryRequest) (*QuerySymbioticSyncHistoryResponse, error)
+	// ValidatorSymbioticStakes queries the stake of a validator in every Symbiotic
+	// middleware listing it at the last sync.
+	ValidatorSymbioticStakes(context.Context, *QueryValidatorSymbioticStakesRequest) (*QueryValidatorSymbioticStakesResponse, error)
+	// SymbioticCheckpoint queries the Symbiotic checkpoint of an epoch along with
+	// its payload, for submission to the middleware.
+	SymbioticCheckpoint(context.Context, *QuerySymbioticCheckpointRequest) (*QuerySymbioticCheckpointResponse, error)
+	// SymbioticCheckpoints queries the Symbiotic checkpoints.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	SymbioticCheckpoints(context.Context, *QuerySymbioticCheckpointsRequest) (*QuerySymbioticCheckpointsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryValidatorsRequest) (*QueryValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+}
+func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+}
+func (*UnimplementedQueryServer) HistoricalInfo(ctx context.Context, req *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) InjectedSymbioticData(ctx context.Context, req *QueryInjectedSymbioticDataRequest) (*QueryInjectedSymbioticDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InjectedSymbioticData not implemented")
+}
+func (*UnimplementedQueryServer) SymbioticSyncStatus(ctx context.Context, req *QuerySymbioticSyncStatusRequest) (*QuerySymbioticSyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbioticSyncStatus not implemented")
+}
+func (*UnimplementedQueryServer) SymbioticSyncHistory(ctx context.Context, req *QuerySymbioticSyncHistoryRequest) (*QuerySymbioticSyncHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbioticSyncHistory not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSymbioticStakes(ctx context.Context, req *QueryValidatorSymbioticStakesRequest) (*QueryValidatorSymbioticStakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSymbioticStakes not implemented")
+}
+func (*UnimplementedQueryServer) SymbioticCheckpoint(ctx context.Context, req *QuerySymbioticCheckpointRequest) (*QuerySymbioticCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbioticCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) SymbioticCheckpoints(ctx context.Context, req *QuerySymbioticCheckpointsRequest) (*QuerySymbioticCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbioticCheckpoints not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Query/Validators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validators(ctx, req.(*QueryValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Query/Validator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validator(ctx, req.(*QueryValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Query/HistoricalInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalInfo(ctx, req.(*QueryHistoricalInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InjectedSymbioticData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInjectedSymbioticDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InjectedSymbioticData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Query/InjectedSymbioticData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InjectedSymbioticData(ctx, req.(*QueryInjectedSymbioticDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SymbioticSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbioticSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbioticSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Query/SymbioticSyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbioticSyncStatus(ctx, req.(*QuerySymbioticSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SymbioticSyncHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbioticSyncHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbioticSyncHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Query/SymbioticSyncHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbioticSyncHistory(ctx, req.(*QuerySymbioticSyncHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSymbioticStakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSymbioticStakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSymbioticStakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Query/ValidatorSymbioticStakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSymbioticStakes(ctx, req.(*QueryValidatorSymbioticStakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SymbioticCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbioticCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbioticCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Query/SymbioticCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbioticCheckpoint(ctx, req.(*QuerySymbioticCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SymbioticCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbioticCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbioticCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Query/SymbioticCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbioticCheckpoints(ctx, req.(*QuerySymbioticCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.symStaking.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validators",
+			Handler:    _Query_Validators_Handler,
+		},
+		{
+			MethodName: "Validator",
+			Handler:    _Query_Validator_Handler,
+		},
+		{
+			MethodName: "HistoricalInfo",
+			Handler:    _Query_HistoricalInfo_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "InjectedSymbioticData",
+			Handler:    _Query_InjectedSymbioticData_Handler,
+		},
+		{
+			MethodName: "SymbioticSyncStatus",
+			Handler:    _Query_SymbioticSyncStatus_Handler,
+		},
+		{
+			MethodName: "SymbioticSyncHistory",
+			Handler:    _Query_SymbioticSyncHistory_Handler,
+		},
+		{
+			MethodName: "ValidatorSymbioticStakes",
+			Handler:    _Query_ValidatorSymbioticStakes_Handler,
+		},
+		{
+			MethodName: "SymbioticCheckpoint",
+			Handler:    _Query_SymbioticCheckpoint_Handler,
+		},
+		{
+			MethodName: "SymbioticCheckpoints",
+			Handler:    _Query_SymbioticCheckpoints_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/symStaking/v1beta1/query.proto",
+}
+
+func (m *QueryValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorInfo) > 0 {
+		for iNdEx := len(m.ValidatorInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HistoricalRecord != nil {
+		{
+			size, err := m.HistoricalRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Hist != nil {
+		{
+			size, err := m.Hist.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInjectedSymbioticDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInjectedSymbioticDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInjectedSymbioticDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInjectedSymbioticDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInjectedSymbioticDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInjectedSymbioticDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySymbioticSyncStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySymbioticSyncStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySymbioticSyncStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySymbioticSyncStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySymbioticSyncStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySymbioticSyncStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Staleness != nil {
+		{
+			size, err := m.Staleness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NextSyncHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSyncHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastAppliedSyncPoint != nil {
+		{
+			size, err := m.LastAppliedSyncPoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LastSyncPoint != nil {
+		{
+			size, err := m.LastSyncPoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySymbioticSyncHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySymbioticSyncHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySymbioticSyncHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySymbioticSyncHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySymbioticSyncHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySymbioticSyncHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SyncPoints) > 0 {
+		for iNdEx := len(m.SyncPoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyncPoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSymbioticStakesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSymbioticStakesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSymbioticStakesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSymbioticStakesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSymbioticStakesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSymbioticStakesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stakes) > 0 {
+		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySymbioticCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySymbioticCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySymbioticCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySymbioticCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySymbioticCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySymbioticCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quorum {
+		i--
+		if m.Quorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySymbioticCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySymbioticCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySymbioticCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySymbioticCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySymbioticCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySymbioticCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ValidatorInfo) > 0 {
+		for _, e := range m.ValidatorInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHistoricalInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryHistoricalInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hist != nil {
+		l = m.Hist.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HistoricalRecord != nil {
+		l = m.HistoricalRecord.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInjectedSymbioticDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryInjectedSymbioticDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Data.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySymbioticSyncStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySymbioticSyncStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastSyncPoint != nil {
+		l = m.LastSyncPoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastAppliedSyncPoint != nil {
+		l = m.LastAppliedSyncPoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextSyncHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextSyncHeight))
+	}
+	if m.Staleness != nil {
+		l = m.Staleness.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySymbioticSyncHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySymbioticSyncHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SyncPoints) > 0 {
+		for _, e := range m.SyncPoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorSymbioticStakesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorSymbioticStakesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stakes) > 0 {
+		for _, e := range m.Stakes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySymbioticCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QuerySymbioticCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Quorum {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySymbioticCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySymbioticCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorInfo = append(m.ValidatorInfo, ValidatorInfo{})
+			if err := m.ValidatorInfo[len(m.ValidatorInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hist == nil {
+				m.Hist = &HistoricalInfo{}
+			}
+			if err := m.Hist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HistoricalRecord == nil {
+				m.HistoricalRecord = &HistoricalRecord{}
+			}
+			if err := m.HistoricalRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInjectedSymbioticDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInjectedSymbioticDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInjectedSymbioticDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryInjectedSymbioticDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInjectedSymbioticDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInjectedSymbioticDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySymbioticSyncStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1242,17 +3797,67 @@ func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySymbioticSyncStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySymbioticSyncStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySymbioticSyncStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySymbioticSyncStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySymbioticSyncStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncPoint", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1262,27 +3867,31 @@ func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			if m.LastSyncPoint == nil {
+				m.LastSyncPoint = &SymbioticSyncPoint{}
+			}
+			if err := m.LastSyncPoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAppliedSyncPoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1309,10 +3918,65 @@ func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.LastAppliedSyncPoint == nil {
+				m.LastAppliedSyncPoint = &SymbioticSyncPoint{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastAppliedSyncPoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSyncHeight", wireType)
+			}
+			m.NextSyncHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSyncHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staleness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Staleness == nil {
+				m.Staleness = &SymbioticStaleness{}
+			}
+			if err := m.Staleness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1337,7 +4001,7 @@ func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorInfo) Unmarshal(dAtA []byte) error {
+func (m *QuerySymbioticSyncHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1360,17 +4024,17 @@ func (m *ValidatorInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySymbioticSyncHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySymbioticSyncHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1380,23 +4044,27 @@ func (m *ValidatorInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1419,7 +4087,7 @@ func (m *ValidatorInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySymbioticSyncHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1442,15 +4110,15 @@ func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySymbioticSyncHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySymbioticSyncHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1477,46 +4145,12 @@ func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SyncPoints = append(m.SyncPoints, SymbioticSyncPoint{})
+			if err := m.SyncPoints[len(m.SyncPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorInfo = append(m.ValidatorInfo, ValidatorInfo{})
-			if err := m.ValidatorInfo[len(m.ValidatorInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1573,7 +4207,7 @@ func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorSymbioticStakesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1596,10 +4230,10 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorSymbioticStakesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorSymbioticStakesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1655,7 +4289,7 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorSymbioticStakesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1678,15 +4312,15 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorSymbioticStakesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorSymbioticStakesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1713,7 +4347,8 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stakes = append(m.Stakes, SymbioticMiddlewareStake{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1738,7 +4373,7 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHistoricalInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySymbioticCheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1761,17 +4396,17 @@ func (m *QueryHistoricalInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHistoricalInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySymbioticCheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHistoricalInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySymbioticCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.Height = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1781,7 +4416,7 @@ func (m *QueryHistoricalInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1807,7 +4442,7 @@ func (m *QueryHistoricalInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySymbioticCheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1830,15 +4465,15 @@ func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHistoricalInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySymbioticCheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHistoricalInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySymbioticCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1865,18 +4500,15 @@ func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hist == nil {
-				m.Hist = &HistoricalInfo{}
-			}
-			if err := m.Hist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1886,28 +4518,46 @@ func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HistoricalRecord == nil {
-				m.HistoricalRecord = &HistoricalRecord{}
-			}
-			if err := m.HistoricalRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quorum = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1929,7 +4579,7 @@ func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySymbioticCheckpointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1952,12 +4602,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySymbioticCheckpointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySymbioticCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1979,7 +4665,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySymbioticCheckpointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2002,15 +4688,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySymbioticCheckpointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySymbioticCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2037,7 +4723,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Checkpoints = append(m.Checkpoints, SymbioticCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
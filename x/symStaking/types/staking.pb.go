@@ -169,6 +169,8 @@ type HistoricalRecord struct {
 	Apphash        []byte     `protobuf:"bytes,1,opt,name=apphash,proto3" json:"apphash,omitempty"`
 	Time           *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
 	ValidatorsHash []byte     `protobuf:"bytes,3,opt,name=validators_hash,json=validatorsHash,proto3" json:"validators_hash,omitempty"`
+	// symbiotic_epoch is the middleware epoch of the validator set at the height.
+	SymbioticEpoch uint64 `protobuf:"varint,4,opt,name=symbiotic_epoch,json=symbioticEpoch,proto3" json:"symbiotic_epoch,omitempty"`
 }
 
 func (m *HistoricalRecord) Reset()         { *m = HistoricalRecord{} }
@@ -225,6 +227,13 @@ func (m *HistoricalRecord) GetValidatorsHash() []byte {
 	return nil
 }
 
+func (m *HistoricalRecord) GetSymbioticEpoch() uint64 {
+	if m != nil {
+		return m.SymbioticEpoch
+	}
+	return 0
+}
+
 // CommissionRates defines the initial commission rates to be used for creating
 // a validator.
 type CommissionRates struct {
@@ -428,6 +437,9 @@ type Validator struct {
 	Commission Commission `protobuf:"bytes,9,opt,name=commission,proto3" json:"commission"`
 	// list of unbonding ids, each uniquely identifying an unbonding of this validator
 	UnbondingIds []uint64 `protobuf:"varint,10,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
+	// symbiotic_operator is the Ethereum address of the operator that registered the
+	// validator key in the Symbiotic middleware, empty if unknown.
+	SymbioticOperator string `protobuf:"bytes,11,opt,name=symbiotic_operator,json=symbioticOperator,proto3" json:"symbiotic_operator,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -522,6 +534,63 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// symbiotic_middleware_address is the hex address of the Symbiotic network middleware contract on Ethereum.
+	// The validator set sync is disabled while it and symbiotic_middlewares are empty.
+	SymbioticMiddlewareAddress string `protobuf:"bytes,7,opt,name=symbiotic_middleware_address,json=symbioticMiddlewareAddress,proto3" json:"symbiotic_middleware_address,omitempty"`
+	// beacon_genesis_timestamp is the unix timestamp of the beacon chain genesis.
+	// Only used by the custom ethereum network.
+	BeaconGenesisTimestamp uint64 `protobuf:"varint,8,opt,name=beacon_genesis_timestamp,json=beaconGenesisTimestamp,proto3" json:"beacon_genesis_timestamp,omitempty"`
+	// slot_duration is the duration of a beacon chain slot.
+	// Only used by the custom ethereum network.
+	SlotDuration time.Duration `protobuf:"bytes,9,opt,name=slot_duration,json=slotDuration,proto3,stdduration" json:"slot_duration"`
+	// symbiotic_sync_period is the number of blocks between two validator set syncs, or between two
+	// attempts of a sync under symbiotic_epoch_identifier.
+	SymbioticSyncPeriod int64 `protobuf:"varint,10,opt,name=symbiotic_sync_period,json=symbioticSyncPeriod,proto3" json:"symbiotic_sync_period,omitempty"`
+	// ethereum_network is the name of the Ethereum network the Symbiotic middleware is deployed on:
+	// mainnet, holesky, sepolia or custom.
+	EthereumNetwork string `protobuf:"bytes,11,opt,name=ethereum_network,json=ethereumNetwork,proto3" json:"ethereum_network,omitempty"`
+	// slots_per_epoch is the number of slots in a beacon chain epoch.
+	// Only used by the custom ethereum network.
+	SlotsPerEpoch uint64 `protobuf:"varint,12,opt,name=slots_per_epoch,json=slotsPerEpoch,proto3" json:"slots_per_epoch,omitempty"`
+	// finality_depth is the number of epochs behind the current one that are considered finalized.
+	// Only used by the custom ethereum network.
+	FinalityDepth uint64 `protobuf:"varint,13,opt,name=finality_depth,json=finalityDepth,proto3" json:"finality_depth,omitempty"`
+	// symbiotic_auto_create_validators makes the validator set sync create the validators missing
+	// from the store. It requires the ed25519 symbiotic_key_type.
+	SymbioticAutoCreateValidators bool `protobuf:"varint,14,opt,name=symbiotic_auto_create_validators,json=symbioticAutoCreateValidators,proto3" json:"symbiotic_auto_create_validators,omitempty"`
+	// symbiotic_key_type is the type of the 32-byte keys of the middleware validator set: address
+	// (zero padded consensus address), ed25519 (consensus pubkey) or secp256k1 (sha256 hash of the
+	// compressed consensus pubkey).
+	SymbioticKeyType string `protobuf:"bytes,15,opt,name=symbiotic_key_type,json=symbioticKeyType,proto3" json:"symbiotic_key_type,omitempty"`
+	// symbiotic_middlewares are the Symbiotic middleware contracts the stake of the validators is
+	// combined from, in place of symbiotic_middleware_address.
+	SymbioticMiddlewares []SymbioticMiddleware `protobuf:"bytes,16,rep,name=symbiotic_middlewares,json=symbioticMiddlewares,proto3" json:"symbiotic_middlewares"`
+	// symbiotic_max_power_share is the maximum share of the tokens of the synced validators a single
+	// validator can get, or zero if unlimited.
+	SymbioticMaxPowerShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=symbiotic_max_power_share,json=symbioticMaxPowerShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"symbiotic_max_power_share"`
+	// symbiotic_max_power_change is the maximum change of the tokens of a validator at a sync, relative
+	// to its tokens, or zero if unlimited. The rest of the change is applied over the next syncs.
+	SymbioticMaxPowerChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,18,opt,name=symbiotic_max_power_change,json=symbioticMaxPowerChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"symbiotic_max_power_change"`
+	// symbiotic_min_stake is the minimum combined stake of a validator to get tokens.
+	SymbioticMinStake cosmossdk_io_math.Int `protobuf:"bytes,19,opt,name=symbiotic_min_stake,json=symbioticMinStake,proto3,customtype=cosmossdk.io/math.Int" json:"symbiotic_min_stake"`
+	// symbiotic_max_stale_syncs is the number of consecutive skipped syncs the last synced validator
+	// set is kept for before the symbiotic_stale_policy applies, or zero to keep it indefinitely.
+	SymbioticMaxStaleSyncs uint32 `protobuf:"varint,20,opt,name=symbiotic_max_stale_syncs,json=symbioticMaxStaleSyncs,proto3" json:"symbiotic_max_stale_syncs,omitempty"`
+	// symbiotic_stale_policy is the policy applied at each skipped sync past symbiotic_max_stale_syncs:
+	// keep (the validator set is kept), decay (the tokens of the validators decay), halt (the
+	// symbiotic_halt_msg_type_urls are disabled through x/circuit) or manual (the syncs are suspended
+	// until a MsgUpdateSymbioticValidatorSet).
+	SymbioticStalePolicy string `protobuf:"bytes,21,opt,name=symbiotic_stale_policy,json=symbioticStalePolicy,proto3" json:"symbiotic_stale_policy,omitempty"`
+	// symbiotic_stale_decay is the share of their tokens the validators lose at each skipped sync
+	// under the decay stale policy.
+	SymbioticStaleDecay cosmossdk_io_math.LegacyDec `protobuf:"bytes,22,opt,name=symbiotic_stale_decay,json=symbioticStaleDecay,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"symbiotic_stale_decay"`
+	// symbiotic_halt_msg_type_urls are the messages disabled under the halt stale policy.
+	SymbioticHaltMsgTypeUrls []string `protobuf:"bytes,23,rep,name=symbiotic_halt_msg_type_urls,json=symbioticHaltMsgTypeUrls,proto3" json:"symbiotic_halt_msg_type_urls,omitempty"`
+	// symbiotic_epoch_identifier is the x/epochs identifier whose epochs match the middleware epochs: the
+	// validator set is synced at the start of each of its epochs, and retried every symbiotic_sync_period
+	// blocks until synced, backing off while the middleware epoch doesn't advance. If empty, the validator
+	// set is synced every symbiotic_sync_period blocks.
+	SymbioticEpochIdentifier string `protobuf:"bytes,24,opt,name=symbiotic_epoch_identifier,json=symbioticEpochIdentifier,proto3" json:"symbiotic_epoch_identifier,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -592,26 +661,128 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
-// ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
-// TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
-//
-// Deprecated: Do not use.
-type ValidatorUpdates struct {
-	Updates []v11.ValidatorUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+func (m *Params) GetSymbioticMiddlewareAddress() string {
+	if m != nil {
+		return m.SymbioticMiddlewareAddress
+	}
+	return ""
 }
 
-func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
-func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
-func (*ValidatorUpdates) ProtoMessage()    {}
-func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
+func (m *Params) GetBeaconGenesisTimestamp() uint64 {
+	if m != nil {
+		return m.BeaconGenesisTimestamp
+	}
+	return 0
+}
+
+func (m *Params) GetSlotDuration() time.Duration {
+	if m != nil {
+		return m.SlotDuration
+	}
+	return 0
+}
+
+func (m *Params) GetSymbioticSyncPeriod() int64 {
+	if m != nil {
+		return m.SymbioticSyncPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetEthereumNetwork() string {
+	if m != nil {
+		return m.EthereumNetwork
+	}
+	return ""
+}
+
+func (m *Params) GetSlotsPerEpoch() uint64 {
+	if m != nil {
+		return m.SlotsPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetFinalityDepth() uint64 {
+	if m != nil {
+		return m.FinalityDepth
+	}
+	return 0
+}
+
+func (m *Params) GetSymbioticAutoCreateValidators() bool {
+	if m != nil {
+		return m.SymbioticAutoCreateValidators
+	}
+	return false
+}
+
+func (m *Params) GetSymbioticKeyType() string {
+	if m != nil {
+		return m.SymbioticKeyType
+	}
+	return ""
+}
+
+func (m *Params) GetSymbioticMiddlewares() []SymbioticMiddleware {
+	if m != nil {
+		return m.SymbioticMiddlewares
+	}
+	return nil
+}
+
+func (m *Params) GetSymbioticMaxStaleSyncs() uint32 {
+	if m != nil {
+		return m.SymbioticMaxStaleSyncs
+	}
+	return 0
+}
+
+func (m *Params) GetSymbioticStalePolicy() string {
+	if m != nil {
+		return m.SymbioticStalePolicy
+	}
+	return ""
+}
+
+func (m *Params) GetSymbioticHaltMsgTypeUrls() []string {
+	if m != nil {
+		return m.SymbioticHaltMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetSymbioticEpochIdentifier() string {
+	if m != nil {
+		return m.SymbioticEpochIdentifier
+	}
+	return ""
+}
+
+// SymbioticMiddleware is a Symbiotic middleware contract the stake of the
+// validators is read from.
+type SymbioticMiddleware struct {
+	// address is the hex address of the middleware contract on Ethereum.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the factor applied to the stake of the validators in the middleware.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+	// cap is the maximum weighted stake of a validator in the middleware, or zero
+	// if the stake isn't capped.
+	Cap cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=cap,proto3,customtype=cosmossdk.io/math.Int" json:"cap"`
+}
+
+func (m *SymbioticMiddleware) Reset()         { *m = SymbioticMiddleware{} }
+func (m *SymbioticMiddleware) String() string { return proto.CompactTextString(m) }
+func (*SymbioticMiddleware) ProtoMessage()    {}
+func (*SymbioticMiddleware) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9ea901dc076fbe21, []int{8}
 }
-func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
+func (m *SymbioticMiddleware) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SymbioticMiddleware) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorUpdates.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SymbioticMiddleware.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -621,1002 +792,6082 @@ func (m *ValidatorUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ValidatorUpdates) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorUpdates.Merge(m, src)
+func (m *SymbioticMiddleware) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticMiddleware.Merge(m, src)
 }
-func (m *ValidatorUpdates) XXX_Size() int {
+func (m *SymbioticMiddleware) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorUpdates) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorUpdates.DiscardUnknown(m)
+func (m *SymbioticMiddleware) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticMiddleware.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorUpdates proto.InternalMessageInfo
+var xxx_messageInfo_SymbioticMiddleware proto.InternalMessageInfo
 
-func (m *ValidatorUpdates) GetUpdates() []v11.ValidatorUpdate {
+func (m *SymbioticMiddleware) GetAddress() string {
 	if m != nil {
-		return m.Updates
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("cosmos.symStaking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
-	proto.RegisterEnum("cosmos.symStaking.v1beta1.Infraction", Infraction_name, Infraction_value)
-	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.symStaking.v1beta1.HistoricalInfo")
-	proto.RegisterType((*HistoricalRecord)(nil), "cosmos.symStaking.v1beta1.HistoricalRecord")
-	proto.RegisterType((*CommissionRates)(nil), "cosmos.symStaking.v1beta1.CommissionRates")
-	proto.RegisterType((*Commission)(nil), "cosmos.symStaking.v1beta1.Commission")
-	proto.RegisterType((*Description)(nil), "cosmos.symStaking.v1beta1.Description")
-	proto.RegisterType((*Validator)(nil), "cosmos.symStaking.v1beta1.Validator")
-	proto.RegisterType((*ValAddresses)(nil), "cosmos.symStaking.v1beta1.ValAddresses")
-	proto.RegisterType((*Params)(nil), "cosmos.symStaking.v1beta1.Params")
-	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.symStaking.v1beta1.ValidatorUpdates")
+// SymbioticMiddlewareStake is the stake of a validator in a Symbiotic middleware.
+type SymbioticMiddlewareStake struct {
+	// middleware_address is the hex address of the middleware contract.
+	MiddlewareAddress string `protobuf:"bytes,1,opt,name=middleware_address,json=middlewareAddress,proto3" json:"middleware_address,omitempty"`
+	// stake is the stake of the validator in the middleware.
+	Stake cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=stake,proto3,customtype=cosmossdk.io/math.Int" json:"stake"`
+	// weighted_stake is the stake after the weight and cap of the middleware,
+	// added to the tokens of the validator.
+	WeightedStake cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=weighted_stake,json=weightedStake,proto3,customtype=cosmossdk.io/math.Int" json:"weighted_stake"`
+	// epoch is the epoch of the middleware validator set the stake was synced
+	// from.
+	Epoch uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("cosmos/symStaking/v1beta1/staking.proto", fileDescriptor_9ea901dc076fbe21)
+func (m *SymbioticMiddlewareStake) Reset()         { *m = SymbioticMiddlewareStake{} }
+func (m *SymbioticMiddlewareStake) String() string { return proto.CompactTextString(m) }
+func (*SymbioticMiddlewareStake) ProtoMessage()    {}
+func (*SymbioticMiddlewareStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{9}
+}
+func (m *SymbioticMiddlewareStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticMiddlewareStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticMiddlewareStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbioticMiddlewareStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticMiddlewareStake.Merge(m, src)
+}
+func (m *SymbioticMiddlewareStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticMiddlewareStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticMiddlewareStake.DiscardUnknown(m)
 }
 
-var fileDescriptor_9ea901dc076fbe21 = []byte{
-	// 1329 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x1b, 0xf7, 0xda, 0xae, 0x13, 0x8f, 0x93, 0xd8, 0x9d, 0xa6, 0xed, 0xd6, 0xef, 0x5b, 0xdb, 0xaf,
-	0xdf, 0xb7, 0x6d, 0xde, 0x40, 0xd6, 0x4a, 0x40, 0x95, 0x88, 0xe0, 0x10, 0xdb, 0x49, 0x63, 0x28,
-	0x4e, 0x58, 0xc7, 0x45, 0x20, 0xd1, 0xd5, 0x78, 0x77, 0x62, 0x0f, 0xf1, 0xee, 0x5a, 0x3b, 0xe3,
-	0x10, 0xdf, 0x39, 0x54, 0xe1, 0xd2, 0x13, 0x42, 0xa0, 0x88, 0x4a, 0x5c, 0x7a, 0xec, 0xa1, 0xe2,
-	0x13, 0x70, 0xa8, 0x38, 0x55, 0x3d, 0x21, 0x0e, 0x01, 0xb5, 0x87, 0xf6, 0xcc, 0x27, 0x40, 0x33,
-	0x3b, 0xeb, 0xb5, 0x9d, 0x36, 0x2a, 0xea, 0xc5, 0xf2, 0xf3, 0xef, 0xb7, 0xcf, 0x3c, 0xcf, 0xef,
-	0x79, 0x66, 0xc0, 0x35, 0xd3, 0xa5, 0xb6, 0x4b, 0x4b, 0x74, 0x60, 0x37, 0x18, 0xda, 0x23, 0x4e,
-	0xbb, 0xb4, 0xbf, 0xdc, 0xc2, 0x0c, 0x2d, 0x97, 0xa8, 0x2f, 0x6b, 0x3d, 0xcf, 0x65, 0x2e, 0xbc,
-	0xe4, 0x3b, 0x6a, 0xa1, 0xa3, 0x26, 0x1d, 0xb3, 0xf3, 0x6d, 0xb7, 0xed, 0x0a, 0xaf, 0x12, 0xff,
-	0xe7, 0x07, 0x64, 0x2f, 0xb5, 0x5d, 0xb7, 0xdd, 0xc5, 0x25, 0x21, 0xb5, 0xfa, 0xbb, 0x25, 0xe4,
-	0x0c, 0xa4, 0x29, 0x37, 0x69, 0xb2, 0xfa, 0x1e, 0x62, 0xc4, 0x75, 0xa4, 0x3d, 0x3f, 0x69, 0x67,
-	0xc4, 0xc6, 0x94, 0x21, 0xbb, 0x17, 0x60, 0xfb, 0xc9, 0x18, 0xfe, 0x47, 0x65, 0x66, 0x12, 0x5b,
-	0x1e, 0xa8, 0x85, 0x28, 0x1e, 0x1e, 0xc5, 0x74, 0x49, 0x80, 0x7d, 0x16, 0xd9, 0xc4, 0x71, 0x4b,
-	0xe2, 0x57, 0xaa, 0x2e, 0x9b, 0xae, 0x8d, 0x59, 0x6b, 0x97, 0x95, 0xd8, 0xa0, 0x87, 0x69, 0x69,
-	0x7f, 0xd9, 0xff, 0x23, 0xcd, 0xff, 0x1e, 0x9a, 0x51, 0xcb, 0x24, 0x13, 0xd6, 0xe2, 0x0f, 0x0a,
-	0x98, 0xdb, 0x24, 0x94, 0xb9, 0x1e, 0x31, 0x51, 0xb7, 0xe6, 0xec, 0xba, 0xf0, 0x7d, 0x90, 0xe8,
-	0x60, 0x64, 0x61, 0x4f, 0x55, 0x0a, 0xca, 0x42, 0x6a, 0xe5, 0x92, 0x16, 0x20, 0x68, 0x7e, 0xe4,
-	0xfe, 0xb2, 0xb6, 0x29, 0x1c, 0xca, 0xc9, 0x47, 0xc7, 0xf9, 0xc8, 0xfd, 0xe7, 0x0f, 0x16, 0x15,
-	0x5d, 0xc6, 0xc0, 0x1b, 0x20, 0xb1, 0x8f, 0xba, 0x14, 0x33, 0x35, 0x5a, 0x88, 0x2d, 0xa4, 0x56,
-	0xfe, 0xa7, 0xbd, 0xb2, 0xf2, 0xda, 0x2d, 0xd4, 0x25, 0x16, 0x62, 0xee, 0x38, 0x90, 0x1f, 0xbe,
-	0x1a, 0x55, 0x95, 0xe2, 0x37, 0x0a, 0xc8, 0x84, 0xd9, 0xe9, 0xd8, 0x74, 0x3d, 0x0b, 0xaa, 0x60,
-	0x0a, 0xf5, 0x7a, 0x1d, 0x44, 0x3b, 0x22, 0xc1, 0x19, 0x3d, 0x10, 0xe1, 0xbb, 0x20, 0xce, 0x4b,
-	0xad, 0x46, 0x45, 0xde, 0x59, 0xcd, 0xef, 0x83, 0x16, 0xf4, 0x41, 0xdb, 0x09, 0xfa, 0x50, 0x8e,
-	0xdf, 0xfd, 0x23, 0xaf, 0xe8, 0xc2, 0x1b, 0x5e, 0x03, 0xe9, 0xfd, 0x20, 0x11, 0x6a, 0x08, 0xdc,
-	0x98, 0xc0, 0x9d, 0x0b, 0xd5, 0x9b, 0x88, 0x76, 0x8a, 0xdf, 0x46, 0x41, 0xba, 0xe2, 0xda, 0x36,
-	0xa1, 0x94, 0xb8, 0x8e, 0x8e, 0x18, 0xa6, 0xf0, 0x43, 0x10, 0xf7, 0x10, 0xc3, 0x22, 0x93, 0x64,
-	0xf9, 0x3a, 0x3f, 0xc6, 0xef, 0xc7, 0xf9, 0x7f, 0xf9, 0x67, 0xa6, 0xd6, 0x9e, 0x46, 0xdc, 0x92,
-	0x8d, 0x58, 0x47, 0xbb, 0x89, 0xdb, 0xc8, 0x1c, 0x54, 0xb1, 0xf9, 0xe4, 0xe1, 0x12, 0x90, 0x25,
-	0xa9, 0x62, 0xd3, 0x3f, 0xb3, 0xc0, 0x80, 0x9f, 0x80, 0x69, 0x1b, 0x1d, 0x18, 0x02, 0x2f, 0xfa,
-	0x46, 0x78, 0x53, 0x36, 0x3a, 0xe0, 0xf9, 0xc1, 0xdb, 0x20, 0xcd, 0x21, 0xcd, 0x0e, 0x72, 0xda,
-	0xd8, 0x47, 0x8e, 0xbd, 0x11, 0xf2, 0xac, 0x8d, 0x0e, 0x2a, 0x02, 0x8d, 0xe3, 0xaf, 0xc6, 0x5f,
-	0xdc, 0xcb, 0x2b, 0xc5, 0x5f, 0x14, 0x00, 0xc2, 0xc2, 0x40, 0x0b, 0x64, 0xcc, 0xa1, 0x24, 0x3e,
-	0x4a, 0x25, 0x95, 0x16, 0x4f, 0x21, 0xc3, 0x44, 0x65, 0xcb, 0xb3, 0x3c, 0xc3, 0xc7, 0xc7, 0x79,
-	0xc5, 0xff, 0x70, 0xda, 0x3c, 0x51, 0xf9, 0x54, 0xbf, 0x67, 0x21, 0x86, 0x8d, 0xd7, 0xec, 0xb9,
-	0x00, 0xe4, 0x7d, 0xf7, 0x01, 0x81, 0x1f, 0xcd, 0xed, 0xf2, 0x18, 0xf7, 0x15, 0x90, 0xaa, 0x62,
-	0x6a, 0x7a, 0xa4, 0xc7, 0xa7, 0x99, 0x13, 0xcd, 0x76, 0x1d, 0xb2, 0x27, 0x27, 0x21, 0xa9, 0x07,
-	0x22, 0xcc, 0x82, 0x69, 0x62, 0x61, 0x87, 0x11, 0x36, 0xf0, 0x3b, 0xa5, 0x0f, 0x65, 0x1e, 0xf5,
-	0x15, 0x6e, 0x51, 0x12, 0x94, 0x5a, 0x0f, 0x44, 0xf8, 0x7f, 0x90, 0xa1, 0xd8, 0xec, 0x7b, 0x84,
-	0x0d, 0x0c, 0xd3, 0x75, 0x18, 0x32, 0x99, 0x1a, 0x17, 0x2e, 0xe9, 0x40, 0x5f, 0xf1, 0xd5, 0x1c,
-	0xc4, 0xc2, 0x0c, 0x91, 0x2e, 0x55, 0xcf, 0xf8, 0x20, 0x52, 0x94, 0xa9, 0x7e, 0x7f, 0x06, 0x24,
-	0x87, 0xd3, 0x03, 0x2b, 0x20, 0xe3, 0xf6, 0xb0, 0xc7, 0xff, 0x1b, 0xc8, 0xb2, 0x3c, 0x4c, 0xa9,
-	0x24, 0xa4, 0xfa, 0xe4, 0xe1, 0xd2, 0xbc, 0xac, 0xf9, 0x9a, 0x6f, 0x69, 0x30, 0x8f, 0x38, 0x6d,
-	0x3d, 0x1d, 0x44, 0x48, 0x35, 0xfc, 0x8c, 0x77, 0xcd, 0xa1, 0xd8, 0xa1, 0x7d, 0x6a, 0xf4, 0xfa,
-	0xad, 0x3d, 0x3c, 0x90, 0x45, 0x9d, 0x3f, 0x51, 0xd4, 0x35, 0x67, 0x50, 0x56, 0x7f, 0x0d, 0xa1,
-	0x4d, 0x6f, 0xd0, 0x63, 0xae, 0xb6, 0xdd, 0x6f, 0x7d, 0x84, 0x07, 0xbc, 0x55, 0x12, 0x67, 0x5b,
-	0xc0, 0xc0, 0x0b, 0x20, 0xf1, 0x25, 0x22, 0x5d, 0x6c, 0x89, 0x8a, 0x4c, 0xeb, 0x52, 0x82, 0x1f,
-	0x80, 0x04, 0x65, 0x88, 0xf5, 0xa9, 0x28, 0xc3, 0xdc, 0xca, 0x95, 0x53, 0xe8, 0x51, 0x76, 0x1d,
-	0xab, 0x21, 0x9c, 0x75, 0x19, 0x04, 0x2b, 0x20, 0xc1, 0xdc, 0x3d, 0xec, 0xc8, 0x1a, 0x95, 0xdf,
-	0x92, 0x9c, 0x3e, 0x7f, 0x92, 0xd3, 0x35, 0x87, 0x8d, 0xb0, 0xb9, 0xe6, 0x30, 0x5d, 0x86, 0xc2,
-	0x06, 0x48, 0x59, 0x61, 0xcf, 0xd5, 0x84, 0x38, 0xf1, 0xd5, 0x53, 0x12, 0x19, 0x61, 0xc8, 0xe8,
-	0xda, 0x1a, 0x45, 0xe1, 0x9d, 0xee, 0x3b, 0x2d, 0xd7, 0xb1, 0x88, 0xd3, 0x36, 0x3a, 0x98, 0xb4,
-	0x3b, 0x4c, 0x9d, 0x2a, 0x28, 0x0b, 0x31, 0x3d, 0x3d, 0xd4, 0x6f, 0x0a, 0x35, 0xdc, 0x06, 0x73,
-	0xa1, 0xab, 0x60, 0xf2, 0xf4, 0x3f, 0x65, 0xf2, 0xec, 0x10, 0x80, 0xbb, 0xc0, 0x6d, 0x00, 0xc2,
-	0x59, 0x51, 0x93, 0x02, 0xed, 0xca, 0x6b, 0x0d, 0xde, 0xe8, 0x79, 0x46, 0x30, 0xe0, 0x7f, 0x41,
-	0xf8, 0x09, 0x83, 0x58, 0x54, 0x05, 0x85, 0xd8, 0x42, 0x5c, 0x9f, 0x19, 0x2a, 0x6b, 0x16, 0x5d,
-	0x9d, 0xbe, 0x73, 0x2f, 0x1f, 0x79, 0x71, 0x2f, 0x1f, 0x29, 0x6e, 0x80, 0x99, 0x5b, 0xa8, 0x2b,
-	0x79, 0x85, 0x29, 0xbc, 0x0e, 0x92, 0x28, 0x10, 0x54, 0xa5, 0x10, 0x3b, 0x95, 0x97, 0xa1, 0x6b,
-	0xf1, 0xc7, 0x18, 0x48, 0x6c, 0x23, 0x0f, 0xd9, 0x14, 0x6e, 0x9d, 0xa8, 0x52, 0x70, 0x37, 0x4d,
-	0x56, 0xa9, 0x2a, 0xef, 0x62, 0xbf, 0x48, 0xdf, 0xbd, 0xaa, 0x48, 0x57, 0xc0, 0x1c, 0x5f, 0x8c,
-	0xe1, 0x86, 0x17, 0x5c, 0x9f, 0x15, 0xfb, 0x6d, 0x38, 0x58, 0x14, 0xe6, 0x41, 0x8a, 0xbb, 0x61,
-	0x87, 0x79, 0x04, 0x53, 0x41, 0xdf, 0x59, 0x1d, 0xd8, 0xe8, 0x60, 0xdd, 0xd7, 0xc0, 0x25, 0x00,
-	0x3b, 0xc3, 0x0b, 0x6a, 0xe8, 0x17, 0x17, 0x7e, 0x67, 0x43, 0x4b, 0xe0, 0x7e, 0x19, 0x00, 0x9e,
-	0x85, 0x61, 0x61, 0xc7, 0xb5, 0xe5, 0x68, 0x27, 0xb9, 0xa6, 0xca, 0x15, 0xf0, 0x6b, 0x05, 0x9c,
-	0xb3, 0x89, 0x63, 0x4c, 0xac, 0x4f, 0xc1, 0xca, 0x64, 0x79, 0xe7, 0x35, 0x76, 0xf6, 0x5f, 0xc7,
-	0xf9, 0xec, 0x00, 0xd9, 0xdd, 0xd5, 0xe2, 0x4b, 0x70, 0x8a, 0x2f, 0xdb, 0xe8, 0x67, 0x6d, 0xe2,
-	0x8c, 0xef, 0xde, 0xd5, 0x6b, 0x7c, 0xc7, 0x1c, 0x3e, 0x7f, 0xb0, 0x28, 0x5f, 0x23, 0x4b, 0xd4,
-	0xda, 0x2b, 0x1d, 0x8c, 0x3e, 0xb2, 0xfc, 0xb6, 0x14, 0x6f, 0x83, 0xcc, 0xb0, 0x58, 0x4d, 0xb1,
-	0x4e, 0x29, 0xdc, 0x00, 0x53, 0xfe, 0x66, 0xf5, 0x7b, 0x9d, 0x5a, 0xf9, 0x4f, 0xf8, 0x7e, 0xe0,
-	0x2f, 0x10, 0xfe, 0x7c, 0x98, 0x08, 0x1a, 0xe5, 0x5d, 0x10, 0xcc, 0xef, 0xff, 0xc5, 0x9f, 0x15,
-	0x00, 0xc2, 0xc1, 0x87, 0x6f, 0x83, 0x8b, 0xe5, 0xad, 0x7a, 0xd5, 0x68, 0xec, 0xac, 0xed, 0x34,
-	0x1b, 0x46, 0xb3, 0xde, 0xd8, 0x5e, 0xaf, 0xd4, 0x36, 0x6a, 0xeb, 0xd5, 0x4c, 0x24, 0x9b, 0x3e,
-	0x3c, 0x2a, 0xa4, 0x9a, 0x0e, 0xed, 0x61, 0x93, 0xec, 0x12, 0x6c, 0xc1, 0xab, 0x60, 0x7e, 0xdc,
-	0x9b, 0x4b, 0xeb, 0xd5, 0x8c, 0x92, 0x9d, 0x39, 0x3c, 0x2a, 0x4c, 0x37, 0x05, 0x1f, 0xb0, 0x05,
-	0x17, 0xc0, 0xf9, 0x93, 0x7e, 0xb5, 0xfa, 0x8d, 0x4c, 0x34, 0x3b, 0x7b, 0x78, 0x54, 0x48, 0x36,
-	0x03, 0xe2, 0xc0, 0x22, 0x80, 0xa3, 0x9e, 0x12, 0x2f, 0x96, 0x05, 0x87, 0x47, 0x85, 0x44, 0x59,
-	0xa0, 0x65, 0xe3, 0x77, 0x7e, 0xca, 0x45, 0x16, 0xbf, 0x00, 0xa0, 0xe6, 0xec, 0x7a, 0xc8, 0x14,
-	0xeb, 0x20, 0x0b, 0x2e, 0xd4, 0xea, 0x1b, 0xfa, 0x5a, 0x65, 0xa7, 0xb6, 0x55, 0x1f, 0x4f, 0x7b,
-	0xc2, 0x56, 0xdd, 0x6a, 0x96, 0x6f, 0xae, 0x1b, 0x8d, 0xda, 0x8d, 0x7a, 0x46, 0x81, 0x17, 0xc1,
-	0xb9, 0x31, 0xdb, 0xa7, 0xf5, 0x9d, 0xda, 0xc7, 0xeb, 0x99, 0x68, 0xf9, 0xbd, 0x47, 0x4f, 0x73,
-	0xca, 0xe3, 0xa7, 0x39, 0xe5, 0xcf, 0xa7, 0x39, 0xe5, 0xee, 0xb3, 0x5c, 0xe4, 0xf1, 0xb3, 0x5c,
-	0xe4, 0xb7, 0x67, 0xb9, 0xc8, 0xe7, 0xf9, 0x31, 0x6e, 0x8c, 0xf5, 0x4c, 0x3c, 0xde, 0x5a, 0x09,
-	0x31, 0x29, 0xef, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x75, 0x99, 0x08, 0x0f, 0x3a, 0x0b, 0x00,
-	0x00,
+var xxx_messageInfo_SymbioticMiddlewareStake proto.InternalMessageInfo
+
+func (m *SymbioticMiddlewareStake) GetMiddlewareAddress() string {
+	if m != nil {
+		return m.MiddlewareAddress
+	}
+	return ""
 }
 
-func (this *CommissionRates) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *SymbioticMiddlewareStake) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
+	return 0
+}
 
-	that1, ok := that.(*CommissionRates)
-	if !ok {
-		that2, ok := that.(CommissionRates)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// SymbioticStakes holds the stake of a validator in every middleware listing it
+// at the last sync.
+type SymbioticStakes struct {
+	Stakes []SymbioticMiddlewareStake `protobuf:"bytes,1,rep,name=stakes,proto3" json:"stakes"`
+}
+
+func (m *SymbioticStakes) Reset()         { *m = SymbioticStakes{} }
+func (m *SymbioticStakes) String() string { return proto.CompactTextString(m) }
+func (*SymbioticStakes) ProtoMessage()    {}
+func (*SymbioticStakes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{10}
+}
+func (m *SymbioticStakes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticStakes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticStakes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Rate.Equal(that1.Rate) {
-		return false
+}
+func (m *SymbioticStakes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticStakes.Merge(m, src)
+}
+func (m *SymbioticStakes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticStakes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticStakes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticStakes proto.InternalMessageInfo
+
+func (m *SymbioticStakes) GetStakes() []SymbioticMiddlewareStake {
+	if m != nil {
+		return m.Stakes
 	}
-	if !this.MaxRate.Equal(that1.MaxRate) {
-		return false
+	return nil
+}
+
+// InjectedSymbioticData is the data injected by the proposer as the first tx of a
+// block at a Symbiotic sync height. It identifies the Ethereum block the validator
+// set update is derived from.
+type InjectedSymbioticData struct {
+	// version is the version of the injected data encoding.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// block_hash is the hash of the finalized execution block, or "invalid" if the
+	// sync is skipped.
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_number is the number of the execution block.
+	BlockNumber uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the unix timestamp of the execution block.
+	BlockTimestamp uint64 `protobuf:"varint,4,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// slot is the beacon chain slot the execution block was included in.
+	Slot int64 `protobuf:"varint,5,opt,name=slot,proto3" json:"slot,omitempty"`
+	// validator_set_digest is the sha256 digest of the validator set at the
+	// execution block.
+	ValidatorSetDigest []byte `protobuf:"bytes,6,opt,name=validator_set_digest,json=validatorSetDigest,proto3" json:"validator_set_digest,omitempty"`
+	// extended_commit_info is the encoded ExtendedCommitInfo holding the vote
+	// extensions the proposer derived the data from.
+	ExtendedCommitInfo []byte `protobuf:"bytes,7,opt,name=extended_commit_info,json=extendedCommitInfo,proto3" json:"extended_commit_info,omitempty"`
+	// validator_set is the validator set at the execution block, i.e. the
+	// getValidatorSet entries of every middleware along with the operator of their
+	// key, fetched by the proposer and checked against validator_set_digest.
+	ValidatorSet []byte `protobuf:"bytes,8,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+	// epoch is the epoch of the validator set of the first middleware.
+	Epoch uint64 `protobuf:"varint,9,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// skip_reason is the reason the block hash is "invalid", if so, or
+	// "epoch_not_advanced" if the block carries no validator set.
+	SkipReason string `protobuf:"bytes,10,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+}
+
+func (m *InjectedSymbioticData) Reset()         { *m = InjectedSymbioticData{} }
+func (m *InjectedSymbioticData) String() string { return proto.CompactTextString(m) }
+func (*InjectedSymbioticData) ProtoMessage()    {}
+func (*InjectedSymbioticData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{11}
+}
+func (m *InjectedSymbioticData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InjectedSymbioticData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InjectedSymbioticData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
-		return false
+}
+func (m *InjectedSymbioticData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InjectedSymbioticData.Merge(m, src)
+}
+func (m *InjectedSymbioticData) XXX_Size() int {
+	return m.Size()
+}
+func (m *InjectedSymbioticData) XXX_DiscardUnknown() {
+	xxx_messageInfo_InjectedSymbioticData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InjectedSymbioticData proto.InternalMessageInfo
+
+func (m *InjectedSymbioticData) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-	return true
+	return 0
 }
-func (this *Commission) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *InjectedSymbioticData) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
 	}
+	return ""
+}
 
-	that1, ok := that.(*Commission)
-	if !ok {
-		that2, ok := that.(Commission)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *InjectedSymbioticData) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *InjectedSymbioticData) GetBlockTimestamp() uint64 {
+	if m != nil {
+		return m.BlockTimestamp
 	}
-	if !this.CommissionRates.Equal(&that1.CommissionRates) {
-		return false
+	return 0
+}
+
+func (m *InjectedSymbioticData) GetSlot() int64 {
+	if m != nil {
+		return m.Slot
 	}
-	if !this.UpdateTime.Equal(that1.UpdateTime) {
-		return false
+	return 0
+}
+
+func (m *InjectedSymbioticData) GetValidatorSetDigest() []byte {
+	if m != nil {
+		return m.ValidatorSetDigest
 	}
-	return true
+	return nil
 }
-func (this *Description) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *InjectedSymbioticData) GetExtendedCommitInfo() []byte {
+	if m != nil {
+		return m.ExtendedCommitInfo
 	}
+	return nil
+}
 
-	that1, ok := that.(*Description)
-	if !ok {
-		that2, ok := that.(Description)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *InjectedSymbioticData) GetValidatorSet() []byte {
+	if m != nil {
+		return m.ValidatorSet
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Moniker != that1.Moniker {
-		return false
-	}
-	if this.Identity != that1.Identity {
-		return false
-	}
-	if this.Website != that1.Website {
-		return false
-	}
-	if this.SecurityContact != that1.SecurityContact {
-		return false
-	}
-	if this.Details != that1.Details {
-		return false
+	return nil
+}
+
+func (m *InjectedSymbioticData) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return true
+	return 0
 }
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *InjectedSymbioticData) GetSkipReason() string {
+	if m != nil {
+		return m.SkipReason
 	}
+	return ""
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// SymbioticVoteExtension is the vote extension of a validator at the height
+// before a Symbiotic sync height. It holds the finalized execution block the
+// validator observed locally, if any, and its signature of the last Symbiotic
+// checkpoint.
+type SymbioticVoteExtension struct {
+	// block_hash is the hash of the finalized execution block.
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_number is the number of the execution block.
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the unix timestamp of the execution block.
+	BlockTimestamp uint64 `protobuf:"varint,3,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// slot is the beacon chain slot the execution block was included in.
+	Slot int64 `protobuf:"varint,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	// validator_set_digest is the sha256 digest of the validator set at the
+	// execution block, empty if its epoch didn't advance past the last synced one.
+	ValidatorSetDigest []byte `protobuf:"bytes,5,opt,name=validator_set_digest,json=validatorSetDigest,proto3" json:"validator_set_digest,omitempty"`
+	// epoch is the epoch of the validator set of the first middleware.
+	Epoch uint64 `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// checkpoint_epoch is the epoch of the checkpoint signed, if any.
+	CheckpointEpoch uint64 `protobuf:"varint,7,opt,name=checkpoint_epoch,json=checkpointEpoch,proto3" json:"checkpoint_epoch,omitempty"`
+	// checkpoint_signature is the signature of the checkpoint digest by the
+	// Symbiotic operator key of the validator, if any.
+	CheckpointSignature []byte `protobuf:"bytes,8,opt,name=checkpoint_signature,json=checkpointSignature,proto3" json:"checkpoint_signature,omitempty"`
+}
+
+func (m *SymbioticVoteExtension) Reset()         { *m = SymbioticVoteExtension{} }
+func (m *SymbioticVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*SymbioticVoteExtension) ProtoMessage()    {}
+func (*SymbioticVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{12}
+}
+func (m *SymbioticVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *SymbioticVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticVoteExtension.Merge(m, src)
+}
+func (m *SymbioticVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticVoteExtension proto.InternalMessageInfo
+
+func (m *SymbioticVoteExtension) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
 	}
-	if this.UnbondingTime != that1.UnbondingTime {
-		return false
+	return ""
+}
+
+func (m *SymbioticVoteExtension) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
 	}
-	if this.MaxValidators != that1.MaxValidators {
-		return false
+	return 0
+}
+
+func (m *SymbioticVoteExtension) GetBlockTimestamp() uint64 {
+	if m != nil {
+		return m.BlockTimestamp
 	}
-	if this.MaxEntries != that1.MaxEntries {
-		return false
+	return 0
+}
+
+func (m *SymbioticVoteExtension) GetSlot() int64 {
+	if m != nil {
+		return m.Slot
 	}
-	if this.HistoricalEntries != that1.HistoricalEntries {
-		return false
+	return 0
+}
+
+func (m *SymbioticVoteExtension) GetValidatorSetDigest() []byte {
+	if m != nil {
+		return m.ValidatorSetDigest
 	}
-	if this.BondDenom != that1.BondDenom {
-		return false
+	return nil
+}
+
+func (m *SymbioticVoteExtension) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
-		return false
+	return 0
+}
+
+func (m *SymbioticVoteExtension) GetCheckpointEpoch() uint64 {
+	if m != nil {
+		return m.CheckpointEpoch
 	}
-	return true
+	return 0
 }
-func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *SymbioticVoteExtension) GetCheckpointSignature() []byte {
+	if m != nil {
+		return m.CheckpointSignature
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SymbioticSyncPoint records the outcome of a Symbiotic sync height.
+type SymbioticSyncPoint struct {
+	// height is the sync height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// block_hash is the hash of the execution block the validator set was synced
+	// with, empty if the sync was skipped.
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_number is the number of the execution block.
+	BlockNumber uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the unix timestamp of the execution block.
+	BlockTimestamp uint64 `protobuf:"varint,4,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// epoch is the epoch of the validator set of the first middleware.
+	Epoch uint64 `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// validators_updated is the number of validators whose tokens were updated.
+	ValidatorsUpdated uint32 `protobuf:"varint,6,opt,name=validators_updated,json=validatorsUpdated,proto3" json:"validators_updated,omitempty"`
+	// skip_reason is the reason the sync was skipped, empty if it was applied.
+	SkipReason string `protobuf:"bytes,7,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+	// validators_removed is the number of validators missing from the validator
+	// set whose tokens were zeroed.
+	ValidatorsRemoved uint32 `protobuf:"varint,8,opt,name=validators_removed,json=validatorsRemoved,proto3" json:"validators_removed,omitempty"`
+	// validators_rotated is the number of validators whose consensus pubkey was
+	// rotated following an operator key update.
+	ValidatorsRotated uint32 `protobuf:"varint,9,opt,name=validators_rotated,json=validatorsRotated,proto3" json:"validators_rotated,omitempty"`
 }
 
-func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Valset) > 0 {
-		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+func (m *SymbioticSyncPoint) Reset()         { *m = SymbioticSyncPoint{} }
+func (m *SymbioticSyncPoint) String() string { return proto.CompactTextString(m) }
+func (*SymbioticSyncPoint) ProtoMessage()    {}
+func (*SymbioticSyncPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{13}
+}
+func (m *SymbioticSyncPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticSyncPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticSyncPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *SymbioticSyncPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticSyncPoint.Merge(m, src)
+}
+func (m *SymbioticSyncPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticSyncPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticSyncPoint.DiscardUnknown(m)
 }
 
-func (m *HistoricalRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SymbioticSyncPoint proto.InternalMessageInfo
+
+func (m *SymbioticSyncPoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *HistoricalRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SymbioticSyncPoint) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
 }
 
-func (m *HistoricalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorsHash) > 0 {
-		i -= len(m.ValidatorsHash)
-		copy(dAtA[i:], m.ValidatorsHash)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorsHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Time != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintStaking(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Apphash) > 0 {
-		i -= len(m.Apphash)
-		copy(dAtA[i:], m.Apphash)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Apphash)))
-		i--
-		dAtA[i] = 0xa
+func (m *SymbioticSyncPoint) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SymbioticSyncPoint) GetBlockTimestamp() uint64 {
+	if m != nil {
+		return m.BlockTimestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SymbioticSyncPoint) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxChangeRate.Size()
-		i -= size
-		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MaxRate.Size()
-		i -= size
-		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+func (m *SymbioticSyncPoint) GetValidatorsUpdated() uint32 {
+	if m != nil {
+		return m.ValidatorsUpdated
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *SymbioticSyncPoint) GetSkipReason() string {
+	if m != nil {
+		return m.SkipReason
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Commission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SymbioticSyncPoint) GetValidatorsRemoved() uint32 {
+	if m != nil {
+		return m.ValidatorsRemoved
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SymbioticSyncPoint) GetValidatorsRotated() uint32 {
+	if m != nil {
+		return m.ValidatorsRotated
+	}
+	return 0
 }
 
-func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintStaking(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.CommissionRates.MarshalToSizedBuffer(dAtA[:i])
+// SymbioticStaleness tracks the sync heights skipped since the last update of
+// the validator set.
+type SymbioticStaleness struct {
+	// since_height is the first sync height skipped since the last update.
+	SinceHeight int64 `protobuf:"varint,1,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
+	// skipped_syncs is the number of sync heights skipped since the last update.
+	SkippedSyncs uint32 `protobuf:"varint,2,opt,name=skipped_syncs,json=skippedSyncs,proto3" json:"skipped_syncs,omitempty"`
+}
+
+func (m *SymbioticStaleness) Reset()         { *m = SymbioticStaleness{} }
+func (m *SymbioticStaleness) String() string { return proto.CompactTextString(m) }
+func (*SymbioticStaleness) ProtoMessage()    {}
+func (*SymbioticStaleness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{14}
+}
+func (m *SymbioticStaleness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticStaleness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticStaleness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *SymbioticStaleness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticStaleness.Merge(m, src)
+}
+func (m *SymbioticStaleness) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticStaleness) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticStaleness.DiscardUnknown(m)
 }
 
-func (m *Description) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SymbioticStaleness proto.InternalMessageInfo
+
+func (m *SymbioticStaleness) GetSinceHeight() int64 {
+	if m != nil {
+		return m.SinceHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Description) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SymbioticStaleness) GetSkippedSyncs() uint32 {
+	if m != nil {
+		return m.SkippedSyncs
+	}
+	return 0
 }
 
-func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SecurityContact) > 0 {
-		i -= len(m.SecurityContact)
-		copy(dAtA[i:], m.SecurityContact)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.SecurityContact)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
+// SymbioticValidatorTokens are the tokens of a validator in a validator set
+// update submitted by governance.
+type SymbioticValidatorTokens struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// tokens are the tokens of the validator.
+	Tokens cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=tokens,proto3,customtype=cosmossdk.io/math.Int" json:"tokens"`
+}
+
+func (m *SymbioticValidatorTokens) Reset()         { *m = SymbioticValidatorTokens{} }
+func (m *SymbioticValidatorTokens) String() string { return proto.CompactTextString(m) }
+func (*SymbioticValidatorTokens) ProtoMessage()    {}
+func (*SymbioticValidatorTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{15}
+}
+func (m *SymbioticValidatorTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticValidatorTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticValidatorTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SymbioticValidatorTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticValidatorTokens.Merge(m, src)
+}
+func (m *SymbioticValidatorTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticValidatorTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticValidatorTokens.DiscardUnknown(m)
 }
 
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SymbioticValidatorTokens proto.InternalMessageInfo
+
+func (m *SymbioticValidatorTokens) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsPubKeyRotation records the rotation of the consensus pubkey of a validator
+// following an operator key update in the Symbiotic middleware.
+type ConsPubKeyRotation struct {
+	// operator_address is the address of the validator's operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus pubkey replaced.
+	OldConsPubkey *any.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the new consensus pubkey.
+	NewConsPubkey *any.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the height the rotation occurred at.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnbondingIds) > 0 {
-		dAtA6 := make([]byte, len(m.UnbondingIds)*10)
-		var j5 int
-		for _, num := range m.UnbondingIds {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
+func (m *ConsPubKeyRotation) Reset()         { *m = ConsPubKeyRotation{} }
+func (m *ConsPubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotation) ProtoMessage()    {}
+func (*ConsPubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{16}
+}
+func (m *ConsPubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintStaking(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	{
-		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *ConsPubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotation.Merge(m, src)
+}
+func (m *ConsPubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotation proto.InternalMessageInfo
+
+// SymbioticCheckpoint is a checkpoint of the validator set at a Symbiotic
+// middleware epoch, co-signed by the Symbiotic operators of the validators
+// through vote extensions for submission to the middleware.
+type SymbioticCheckpoint struct {
+	// epoch is the epoch of the validator set of the first middleware.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// height is the height the checkpoint was created at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// validator_set_hash is the keccak256 hash of the ABI encoded operators and
+	// powers of the validators.
+	ValidatorSetHash []byte `protobuf:"bytes,3,opt,name=validator_set_hash,json=validatorSetHash,proto3" json:"validator_set_hash,omitempty"`
+	// app_hash is the app hash of the block the checkpoint was created at.
+	AppHash []byte `protobuf:"bytes,4,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	// validators are the bonded validators with a Symbiotic operator, ordered by
+	// power.
+	Validators []SymbioticCheckpointValidator `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators"`
+	// total_power is the sum of the powers of the validators.
+	TotalPower int64 `protobuf:"varint,6,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// signatures are the signatures of the checkpoint digest collected so far.
+	Signatures []SymbioticCheckpointSignature `protobuf:"bytes,7,rep,name=signatures,proto3" json:"signatures"`
+	// signed_power is the sum of the powers of the validators that signed.
+	SignedPower int64 `protobuf:"varint,8,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+}
+
+func (m *SymbioticCheckpoint) Reset()         { *m = SymbioticCheckpoint{} }
+func (m *SymbioticCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*SymbioticCheckpoint) ProtoMessage()    {}
+func (*SymbioticCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{17}
+}
+func (m *SymbioticCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x4a
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
-	if err8 != nil {
-		return 0, err8
+}
+func (m *SymbioticCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticCheckpoint.Merge(m, src)
+}
+func (m *SymbioticCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticCheckpoint proto.InternalMessageInfo
+
+func (m *SymbioticCheckpoint) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	i -= n8
-	i = encodeVarintStaking(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x42
-	if m.UnbondingHeight != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingHeight))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *SymbioticCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *SymbioticCheckpoint) GetValidatorSetHash() []byte {
+	if m != nil {
+		return m.ValidatorSetHash
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Tokens.Size()
-		i -= size
-		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *SymbioticCheckpoint) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Status != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
+	return nil
+}
+
+func (m *SymbioticCheckpoint) GetValidators() []SymbioticCheckpointValidator {
+	if m != nil {
+		return m.Validators
 	}
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ConsensusPubkey != nil {
-		{
-			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ValAddresses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SymbioticCheckpoint) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValAddresses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *ValAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *SymbioticCheckpoint) GetSignatures() []SymbioticCheckpointSignature {
+	if m != nil {
+		return m.Signatures
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SymbioticCheckpoint) GetSignedPower() int64 {
+	if m != nil {
+		return m.SignedPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SymbioticCheckpointValidator is a validator of a Symbiotic checkpoint.
+type SymbioticCheckpointValidator struct {
+	// operator is the Symbiotic operator of the validator.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// power is the consensus power of the validator.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinCommissionRate.Size()
-		i -= size
-		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *SymbioticCheckpointValidator) Reset()         { *m = SymbioticCheckpointValidator{} }
+func (m *SymbioticCheckpointValidator) String() string { return proto.CompactTextString(m) }
+func (*SymbioticCheckpointValidator) ProtoMessage()    {}
+func (*SymbioticCheckpointValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{18}
+}
+func (m *SymbioticCheckpointValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticCheckpointValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticCheckpointValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.BondDenom) > 0 {
-		i -= len(m.BondDenom)
-		copy(dAtA[i:], m.BondDenom)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MaxEntries != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEntries))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MaxValidators != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
-		i--
-		dAtA[i] = 0x10
-	}
-	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err11 != nil {
-		return 0, err11
+		return b[:n], nil
 	}
-	i -= n11
-	i = encodeVarintStaking(dAtA, i, uint64(n11))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *SymbioticCheckpointValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticCheckpointValidator.Merge(m, src)
+}
+func (m *SymbioticCheckpointValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticCheckpointValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticCheckpointValidator.DiscardUnknown(m)
 }
 
-func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SymbioticCheckpointValidator proto.InternalMessageInfo
+
+func (m *SymbioticCheckpointValidator) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SymbioticCheckpointValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
 }
 
-func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// SymbioticCheckpointSignature is the signature of a Symbiotic checkpoint by
+// the operator of a validator.
+type SymbioticCheckpointSignature struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// operator is the Symbiotic operator of the validator.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// signature is the 65 bytes [R || S || V] secp256k1 signature of the
+	// checkpoint digest, with V 0 or 1.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *SymbioticCheckpointSignature) Reset()         { *m = SymbioticCheckpointSignature{} }
+func (m *SymbioticCheckpointSignature) String() string { return proto.CompactTextString(m) }
+func (*SymbioticCheckpointSignature) ProtoMessage()    {}
+func (*SymbioticCheckpointSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{19}
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+func (m *SymbioticCheckpointSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticCheckpointSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticCheckpointSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SymbioticCheckpointSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticCheckpointSignature.Merge(m, src)
+}
+func (m *SymbioticCheckpointSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticCheckpointSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticCheckpointSignature.DiscardUnknown(m)
 }
 
-func (m *HistoricalRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Apphash)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if m.Time != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorsHash)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+var xxx_messageInfo_SymbioticCheckpointSignature proto.InternalMessageInfo
+
+func (m *SymbioticCheckpointSignature) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return n
+	return ""
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SymbioticCheckpointSignature) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return ""
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SymbioticCheckpointSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	var l int
-	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return nil
 }
 
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+// ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
+// TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
+//
+// Deprecated: Do not use.
+type ValidatorUpdates struct {
+	Updates []v11.ValidatorUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+}
+
+func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
+func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUpdates) ProtoMessage()    {}
+func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{20}
+}
+func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ValidatorUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUpdates.Merge(m, src)
+}
+func (m *ValidatorUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUpdates.DiscardUnknown(m)
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ValidatorUpdates proto.InternalMessageInfo
+
+func (m *ValidatorUpdates) GetUpdates() []v11.ValidatorUpdate {
+	if m != nil {
+		return m.Updates
 	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.symStaking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterEnum("cosmos.symStaking.v1beta1.Infraction", Infraction_name, Infraction_value)
+	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.symStaking.v1beta1.HistoricalInfo")
+	proto.RegisterType((*HistoricalRecord)(nil), "cosmos.symStaking.v1beta1.HistoricalRecord")
+	proto.RegisterType((*CommissionRates)(nil), "cosmos.symStaking.v1beta1.CommissionRates")
+	proto.RegisterType((*Commission)(nil), "cosmos.symStaking.v1beta1.Commission")
+	proto.RegisterType((*Description)(nil), "cosmos.symStaking.v1beta1.Description")
+	proto.RegisterType((*Validator)(nil), "cosmos.symStaking.v1beta1.Validator")
+	proto.RegisterType((*ValAddresses)(nil), "cosmos.symStaking.v1beta1.ValAddresses")
+	proto.RegisterType((*Params)(nil), "cosmos.symStaking.v1beta1.Params")
+	proto.RegisterType((*SymbioticMiddleware)(nil), "cosmos.symStaking.v1beta1.SymbioticMiddleware")
+	proto.RegisterType((*SymbioticMiddlewareStake)(nil), "cosmos.symStaking.v1beta1.SymbioticMiddlewareStake")
+	proto.RegisterType((*SymbioticStakes)(nil), "cosmos.symStaking.v1beta1.SymbioticStakes")
+	proto.RegisterType((*InjectedSymbioticData)(nil), "cosmos.symStaking.v1beta1.InjectedSymbioticData")
+	proto.RegisterType((*SymbioticVoteExtension)(nil), "cosmos.symStaking.v1beta1.SymbioticVoteExtension")
+	proto.RegisterType((*SymbioticSyncPoint)(nil), "cosmos.symStaking.v1beta1.SymbioticSyncPoint")
+	proto.RegisterType((*SymbioticStaleness)(nil), "cosmos.symStaking.v1beta1.SymbioticStaleness")
+	proto.RegisterType((*SymbioticValidatorTokens)(nil), "cosmos.symStaking.v1beta1.SymbioticValidatorTokens")
+	proto.RegisterType((*ConsPubKeyRotation)(nil), "cosmos.symStaking.v1beta1.ConsPubKeyRotation")
+	proto.RegisterType((*SymbioticCheckpoint)(nil), "cosmos.symStaking.v1beta1.SymbioticCheckpoint")
+	proto.RegisterType((*SymbioticCheckpointValidator)(nil), "cosmos.symStaking.v1beta1.SymbioticCheckpointValidator")
+	proto.RegisterType((*SymbioticCheckpointSignature)(nil), "cosmos.symStaking.v1beta1.SymbioticCheckpointSignature")
+	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.symStaking.v1beta1.ValidatorUpdates")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/symStaking/v1beta1/staking.proto", fileDescriptor_9ea901dc076fbe21)
+}
+
+var fileDescriptor_9ea901dc076fbe21 = []byte{
+	// 2558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0xd4, 0x07, 0x9f, 0x44, 0x91, 0x1a, 0xc9, 0xf6, 0x9a, 0x7f, 0x5b, 0x92, 0x99,
+	0x7f, 0x62, 0xc7, 0x8d, 0xa8, 0xd8, 0x09, 0xd2, 0xc6, 0x48, 0x8b, 0x9a, 0xa2, 0x6c, 0xb3, 0x89,
+	0x65, 0x75, 0x29, 0xb9, 0x68, 0xd1, 0x66, 0x3b, 0xdc, 0x1d, 0x91, 0x1b, 0x72, 0x67, 0xd9, 0x9d,
+	0xa1, 0x24, 0xde, 0x7b, 0x08, 0x7c, 0xca, 0xa9, 0x08, 0x50, 0x18, 0x08, 0xd0, 0x1e, 0x02, 0xf4,
+	0x92, 0x83, 0xd1, 0x5b, 0x6f, 0x3d, 0x24, 0x3d, 0x05, 0x39, 0x15, 0x2d, 0xe0, 0x14, 0xc9, 0x21,
+	0x39, 0x16, 0x3d, 0xf4, 0x5c, 0xcc, 0xc7, 0x7e, 0x90, 0x52, 0x54, 0xdb, 0x0a, 0x7a, 0x21, 0x38,
+	0xef, 0xbd, 0xf9, 0xcd, 0x9b, 0x37, 0xef, 0x6b, 0x66, 0xe1, 0xb2, 0x13, 0x30, 0x3f, 0x60, 0xeb,
+	0x6c, 0xe8, 0x37, 0x39, 0xee, 0x7a, 0xb4, 0xbd, 0xbe, 0x7f, 0xad, 0x45, 0x38, 0xbe, 0xb6, 0xce,
+	0xd4, 0xb8, 0xda, 0x0f, 0x03, 0x1e, 0xa0, 0xf3, 0x4a, 0xb0, 0x9a, 0x08, 0x56, 0xb5, 0x60, 0x79,
+	0xa9, 0x1d, 0xb4, 0x03, 0x29, 0xb5, 0x2e, 0xfe, 0xa9, 0x09, 0xe5, 0xf3, 0xed, 0x20, 0x68, 0xf7,
+	0xc8, 0xba, 0x1c, 0xb5, 0x06, 0x7b, 0xeb, 0x98, 0x0e, 0x35, 0x6b, 0x79, 0x9c, 0xe5, 0x0e, 0x42,
+	0xcc, 0xbd, 0x80, 0x6a, 0xfe, 0xca, 0x38, 0x9f, 0x7b, 0x3e, 0x61, 0x1c, 0xfb, 0xfd, 0x08, 0x5b,
+	0x29, 0x63, 0xab, 0x45, 0xb5, 0x66, 0x1a, 0x5b, 0x6f, 0xa8, 0x85, 0x19, 0x89, 0xb7, 0xe2, 0x04,
+	0x5e, 0x84, 0xbd, 0x80, 0x7d, 0x8f, 0x06, 0xeb, 0xf2, 0x57, 0x93, 0x2e, 0x3a, 0x81, 0x4f, 0x78,
+	0x6b, 0x8f, 0xaf, 0xf3, 0x61, 0x9f, 0xb0, 0xf5, 0xfd, 0x6b, 0xea, 0x8f, 0x66, 0x5f, 0x88, 0xd9,
+	0xb8, 0xe5, 0x78, 0x63, 0xdc, 0xca, 0x6f, 0x0d, 0x98, 0xbf, 0xe3, 0x31, 0x1e, 0x84, 0x9e, 0x83,
+	0x7b, 0x0d, 0xba, 0x17, 0xa0, 0x37, 0x60, 0xaa, 0x43, 0xb0, 0x4b, 0x42, 0xd3, 0x58, 0x35, 0xae,
+	0xcc, 0x5e, 0x3f, 0x5f, 0x8d, 0x10, 0xaa, 0x6a, 0xe6, 0xfe, 0xb5, 0xea, 0x1d, 0x29, 0x50, 0xcb,
+	0x7f, 0xfc, 0x78, 0x65, 0xe2, 0xc3, 0xaf, 0x3e, 0xba, 0x6a, 0x58, 0x7a, 0x0e, 0xba, 0x0d, 0x53,
+	0xfb, 0xb8, 0xc7, 0x08, 0x37, 0x33, 0xab, 0xd9, 0x2b, 0xb3, 0xd7, 0xff, 0xbf, 0xfa, 0x8d, 0x96,
+	0xaf, 0xde, 0xc7, 0x3d, 0xcf, 0xc5, 0x3c, 0x18, 0x05, 0x52, 0xd3, 0x6f, 0x64, 0x4c, 0xa3, 0xf2,
+	0xc8, 0x80, 0x52, 0xa2, 0x9d, 0x45, 0x9c, 0x20, 0x74, 0x91, 0x09, 0xd3, 0xb8, 0xdf, 0xef, 0x60,
+	0xd6, 0x91, 0x0a, 0xce, 0x59, 0xd1, 0x10, 0xbd, 0x0a, 0x39, 0x61, 0x6a, 0x33, 0x23, 0xf5, 0x2e,
+	0x57, 0xd5, 0x39, 0x54, 0xa3, 0x73, 0xa8, 0xee, 0x44, 0xe7, 0x50, 0xcb, 0xbd, 0xf7, 0xf9, 0x8a,
+	0x61, 0x49, 0x69, 0x74, 0x19, 0x8a, 0xfb, 0x91, 0x22, 0xcc, 0x96, 0xb8, 0x59, 0x89, 0x3b, 0x9f,
+	0x90, 0xef, 0x08, 0xf8, 0xcb, 0x50, 0x64, 0x43, 0xbf, 0xe5, 0x05, 0xdc, 0x73, 0x6c, 0xd2, 0x0f,
+	0x9c, 0x8e, 0x99, 0x5b, 0x35, 0xae, 0xe4, 0xac, 0xf9, 0x98, 0xbc, 0x29, 0xa8, 0x95, 0xdf, 0x64,
+	0xa0, 0xb8, 0x11, 0xf8, 0xbe, 0xc7, 0x98, 0x17, 0x50, 0x0b, 0x73, 0xc2, 0xd0, 0x8f, 0x20, 0x17,
+	0x62, 0x4e, 0xa4, 0xca, 0xf9, 0xda, 0x6b, 0x62, 0xbf, 0x7f, 0x7b, 0xbc, 0xf2, 0x7f, 0xca, 0x38,
+	0xcc, 0xed, 0x56, 0xbd, 0x60, 0xdd, 0xc7, 0xbc, 0x53, 0x7d, 0x8b, 0xb4, 0xb1, 0x33, 0xac, 0x13,
+	0xe7, 0xb3, 0x47, 0x6b, 0xa0, 0x6d, 0x57, 0x27, 0x8e, 0x32, 0x8e, 0xc4, 0x40, 0x3f, 0x86, 0x19,
+	0x1f, 0x1f, 0xda, 0x12, 0x2f, 0x73, 0x2a, 0xbc, 0x69, 0x1f, 0x1f, 0x0a, 0xfd, 0xd0, 0xdb, 0x50,
+	0x14, 0x90, 0x4e, 0x07, 0xd3, 0x36, 0x51, 0xc8, 0xd9, 0x53, 0x21, 0x17, 0x7c, 0x7c, 0xb8, 0x21,
+	0xd1, 0x04, 0xfe, 0x8d, 0xdc, 0xd7, 0x1f, 0xac, 0x18, 0x95, 0x3f, 0x1b, 0x00, 0x89, 0x61, 0x90,
+	0x0b, 0x25, 0x27, 0x1e, 0xc9, 0x45, 0x99, 0xf6, 0xb9, 0xab, 0x27, 0x78, 0xcd, 0x98, 0x65, 0x6b,
+	0x05, 0xa1, 0xe1, 0xa7, 0x8f, 0x57, 0x0c, 0xb5, 0x70, 0xd1, 0x39, 0x62, 0xf9, 0xd9, 0x41, 0xdf,
+	0xc5, 0x9c, 0xd8, 0x4f, 0xe8, 0x1c, 0x12, 0xf0, 0xbd, 0xcf, 0x23, 0x40, 0x50, 0xb3, 0x05, 0x5f,
+	0x6f, 0xe3, 0x43, 0x03, 0x66, 0xeb, 0x84, 0x39, 0xa1, 0xd7, 0x17, 0x61, 0x2f, 0x3c, 0xd2, 0x0f,
+	0xa8, 0xd7, 0xd5, 0x21, 0x93, 0xb7, 0xa2, 0x21, 0x2a, 0xc3, 0x8c, 0xe7, 0x12, 0xca, 0x3d, 0x3e,
+	0x54, 0x27, 0x65, 0xc5, 0x63, 0x31, 0xeb, 0x80, 0xb4, 0x98, 0x17, 0x99, 0xda, 0x8a, 0x86, 0xe8,
+	0x45, 0x28, 0x31, 0xe2, 0x0c, 0x42, 0x8f, 0x0f, 0x6d, 0x27, 0xa0, 0x1c, 0x3b, 0x5c, 0x7a, 0x5a,
+	0xde, 0x2a, 0x46, 0xf4, 0x0d, 0x45, 0x16, 0x20, 0x2e, 0xe1, 0xd8, 0xeb, 0x31, 0x73, 0x52, 0x81,
+	0xe8, 0xa1, 0x56, 0xf5, 0x93, 0x49, 0xc8, 0xc7, 0x61, 0x86, 0x36, 0xa0, 0x14, 0xf4, 0x49, 0x28,
+	0xfe, 0xdb, 0xd8, 0x75, 0x43, 0xc2, 0x98, 0x76, 0x48, 0xf3, 0xb3, 0x47, 0x6b, 0x4b, 0xda, 0xe6,
+	0x37, 0x15, 0xa7, 0xc9, 0x43, 0x8f, 0xb6, 0xad, 0x62, 0x34, 0x43, 0x93, 0xd1, 0x4f, 0xc5, 0xa9,
+	0x51, 0x46, 0x28, 0x1b, 0x30, 0xbb, 0x3f, 0x68, 0x75, 0xc9, 0x50, 0x1b, 0x75, 0xe9, 0x88, 0x51,
+	0x6f, 0xd2, 0x61, 0xcd, 0xfc, 0x4b, 0x02, 0xed, 0x84, 0xc3, 0x3e, 0x0f, 0xaa, 0xdb, 0x83, 0xd6,
+	0x9b, 0x64, 0x68, 0x15, 0x63, 0x9c, 0x6d, 0x09, 0x83, 0xce, 0xc2, 0xd4, 0x3b, 0xd8, 0xeb, 0x11,
+	0x57, 0x5a, 0x64, 0xc6, 0xd2, 0x23, 0xf4, 0x7d, 0x98, 0x62, 0x1c, 0xf3, 0x01, 0x93, 0x66, 0x98,
+	0xbf, 0xfe, 0xfc, 0x09, 0xee, 0x51, 0x0b, 0xa8, 0xdb, 0x94, 0xc2, 0x96, 0x9e, 0x84, 0x36, 0x60,
+	0x8a, 0x07, 0x5d, 0x42, 0xb5, 0x8d, 0x6a, 0xdf, 0xd1, 0x3e, 0x7d, 0xe6, 0xa8, 0x4f, 0x37, 0x28,
+	0x4f, 0x79, 0x73, 0x83, 0x72, 0x4b, 0x4f, 0x45, 0x4d, 0x98, 0x75, 0x93, 0x33, 0x37, 0xa7, 0xe4,
+	0x8e, 0x5f, 0x38, 0x41, 0x91, 0x94, 0x87, 0xa4, 0xf3, 0x5b, 0x1a, 0x45, 0x9c, 0xf4, 0x80, 0xb6,
+	0x02, 0xea, 0x7a, 0xb4, 0x6d, 0x77, 0x88, 0xd7, 0xee, 0x70, 0x73, 0x7a, 0xd5, 0xb8, 0x92, 0xb5,
+	0x8a, 0x31, 0xfd, 0x8e, 0x24, 0xa3, 0x6d, 0x98, 0x4f, 0x44, 0xa5, 0x27, 0xcf, 0x3c, 0xad, 0x27,
+	0x17, 0x62, 0x00, 0x21, 0x82, 0xb6, 0x01, 0x92, 0x58, 0x31, 0xf3, 0x12, 0xed, 0xf9, 0x27, 0x0a,
+	0xbc, 0xf4, 0x7e, 0x52, 0x18, 0xe8, 0x39, 0x48, 0x96, 0xb0, 0x3d, 0x97, 0x99, 0xb0, 0x9a, 0xbd,
+	0x92, 0xb3, 0xe6, 0x62, 0x62, 0xc3, 0x65, 0x68, 0x0d, 0x50, 0x92, 0x46, 0x23, 0xe7, 0x32, 0x67,
+	0xa5, 0xf7, 0x2e, 0xc4, 0x9c, 0x7b, 0x9a, 0x71, 0x63, 0xe6, 0xdd, 0x0f, 0x56, 0x26, 0xbe, 0xfe,
+	0x60, 0x65, 0xa2, 0x72, 0x0b, 0xe6, 0xee, 0xe3, 0x9e, 0x76, 0x43, 0xc2, 0xd0, 0x6b, 0x90, 0xc7,
+	0xd1, 0xc0, 0x34, 0x56, 0xb3, 0x27, 0xba, 0x71, 0x22, 0x5a, 0xf9, 0x7d, 0x01, 0xa6, 0xb6, 0x71,
+	0x88, 0x7d, 0x86, 0xee, 0x1d, 0x31, 0x6a, 0x54, 0xf3, 0xc6, 0x8d, 0x5a, 0xd7, 0x35, 0x5e, 0xd9,
+	0xf4, 0xfd, 0x6f, 0xb2, 0xe9, 0xf3, 0x30, 0x2f, 0xf2, 0x68, 0x52, 0x39, 0x64, 0x68, 0x14, 0x64,
+	0x3a, 0x8c, 0xe3, 0x90, 0xa1, 0x15, 0x98, 0x15, 0x62, 0x84, 0xf2, 0xd0, 0x23, 0x4c, 0x7a, 0x7b,
+	0xc1, 0x02, 0x1f, 0x1f, 0x6e, 0x2a, 0x8a, 0x30, 0x52, 0x27, 0x2e, 0x7c, 0xb1, 0x5c, 0x4e, 0xca,
+	0x2d, 0x24, 0x9c, 0x48, 0xfc, 0x22, 0x80, 0xd0, 0xc2, 0x76, 0x09, 0x0d, 0x7c, 0x9d, 0x09, 0xf2,
+	0x82, 0x52, 0x17, 0x04, 0xf4, 0x6b, 0x03, 0x16, 0x7d, 0x8f, 0xda, 0x63, 0xd9, 0x56, 0x3a, 0x71,
+	0xbe, 0xb6, 0xf3, 0x04, 0x29, 0xfe, 0x5f, 0x8f, 0x57, 0xca, 0x43, 0xec, 0xf7, 0x6e, 0x54, 0x8e,
+	0xc1, 0xa9, 0x1c, 0x57, 0x00, 0x16, 0x7c, 0x8f, 0x8e, 0xa6, 0x6a, 0xf4, 0x43, 0xb8, 0x90, 0x9c,
+	0xbc, 0xef, 0xb9, 0x6e, 0x8f, 0x1c, 0xe0, 0x90, 0xc4, 0xa9, 0x68, 0x5a, 0xea, 0x5d, 0x8e, 0x65,
+	0xee, 0xc6, 0x22, 0x51, 0xee, 0xf9, 0x1e, 0x98, 0x2d, 0x82, 0x9d, 0x80, 0xda, 0x6d, 0x42, 0x09,
+	0xf3, 0x98, 0x1d, 0xf7, 0x56, 0x32, 0x1c, 0x72, 0xd6, 0x59, 0xc5, 0xbf, 0xad, 0xd8, 0x71, 0x28,
+	0xa0, 0xbb, 0x50, 0x60, 0xbd, 0x80, 0xdb, 0x51, 0xaf, 0x66, 0xe6, 0x9f, 0xf2, 0xa0, 0xe7, 0xc4,
+	0xf4, 0x88, 0x89, 0xae, 0xc3, 0x99, 0x64, 0x2b, 0x6c, 0x48, 0x1d, 0xbb, 0x4f, 0x42, 0x2f, 0x70,
+	0x4d, 0x90, 0xd1, 0xbb, 0x18, 0x33, 0x9b, 0x43, 0xea, 0x6c, 0x4b, 0x96, 0x08, 0x76, 0xc2, 0x3b,
+	0x24, 0x24, 0x03, 0xdf, 0xa6, 0x84, 0x1f, 0x04, 0x61, 0x57, 0xbb, 0x7d, 0x31, 0xa2, 0x6f, 0x29,
+	0x32, 0x7a, 0x01, 0x8a, 0x62, 0x39, 0x26, 0x50, 0x75, 0xab, 0x31, 0x27, 0xb7, 0x27, 0x37, 0xc1,
+	0xb6, 0x49, 0x28, 0x3b, 0x0d, 0xe1, 0x6e, 0x7b, 0x1e, 0xc5, 0x3d, 0x51, 0x29, 0x5c, 0xd2, 0xe7,
+	0x1d, 0xb3, 0xa0, 0xc4, 0x22, 0x6a, 0x5d, 0x10, 0xd1, 0x6d, 0x58, 0x4d, 0xb4, 0xc5, 0x03, 0x1e,
+	0xd8, 0x4e, 0x48, 0x44, 0x45, 0x4c, 0xf9, 0xe9, 0xbc, 0xcc, 0xb8, 0x17, 0x63, 0xb9, 0x9b, 0x03,
+	0x1e, 0x6c, 0x48, 0xa9, 0x94, 0xdf, 0xbe, 0x94, 0x8e, 0xdd, 0x2e, 0x19, 0xda, 0xa2, 0x23, 0x34,
+	0x8b, 0x72, 0x13, 0xa5, 0x98, 0xf3, 0x26, 0x19, 0xee, 0x0c, 0xfb, 0x04, 0x51, 0x38, 0x73, 0xdc,
+	0x79, 0x33, 0xb3, 0x24, 0x5b, 0xc3, 0xea, 0x09, 0xb9, 0xa6, 0x79, 0xd4, 0x07, 0xd2, 0x49, 0x67,
+	0xe9, 0x18, 0x1f, 0x61, 0xe8, 0x57, 0x70, 0x3e, 0xb5, 0x1e, 0x3e, 0xb4, 0xfb, 0xc1, 0x01, 0x09,
+	0x6d, 0xd6, 0xc1, 0x21, 0x31, 0x17, 0x4e, 0xd5, 0xce, 0x9c, 0x4d, 0x16, 0xc4, 0x87, 0xdb, 0x02,
+	0xb6, 0x29, 0x50, 0x11, 0x83, 0xf2, 0x71, 0x4b, 0xaa, 0x3e, 0xca, 0x44, 0xa7, 0x5a, 0xf3, 0xdc,
+	0x91, 0x35, 0x55, 0x43, 0x85, 0x7e, 0x09, 0x8b, 0x69, 0xbb, 0x52, 0x5b, 0xdc, 0x75, 0x88, 0xb9,
+	0x28, 0x57, 0x7b, 0xf9, 0x29, 0x8a, 0x9b, 0x8e, 0xd4, 0x94, 0x31, 0xa9, 0x38, 0x08, 0x82, 0x5e,
+	0x1f, 0xb7, 0x24, 0xe3, 0xb8, 0x47, 0xa4, 0xa3, 0x33, 0x73, 0x49, 0x66, 0xa1, 0x11, 0x8b, 0x34,
+	0x05, 0x5b, 0xb8, 0x3a, 0x43, 0xaf, 0x42, 0xc2, 0xd1, 0xd3, 0xfa, 0x41, 0xcf, 0x73, 0x86, 0xe6,
+	0x19, 0xe9, 0x26, 0xc9, 0xd1, 0xc9, 0x49, 0xdb, 0x92, 0x87, 0xde, 0x19, 0x89, 0x27, 0x39, 0xcb,
+	0x25, 0x0e, 0x1e, 0x9a, 0x67, 0x4f, 0x65, 0xc2, 0xc5, 0xd1, 0xc5, 0xea, 0x02, 0x12, 0xfd, 0x20,
+	0x9d, 0x86, 0x3a, 0xb8, 0xc7, 0x6d, 0x9f, 0xb5, 0xa5, 0x27, 0xdb, 0x83, 0xb0, 0xc7, 0xcc, 0x73,
+	0xa2, 0x94, 0x58, 0x66, 0x2c, 0x73, 0x07, 0xf7, 0xf8, 0x5d, 0xd6, 0x16, 0x2e, 0xbd, 0x1b, 0xf6,
+	0x18, 0x7a, 0x23, 0x7d, 0xe6, 0x32, 0x38, 0x6d, 0xd5, 0xd4, 0xed, 0x79, 0x24, 0x34, 0xcd, 0x55,
+	0x63, 0x64, 0xb6, 0x0c, 0xd4, 0x46, 0xcc, 0xbf, 0x71, 0x59, 0xf4, 0x65, 0x0f, 0xbe, 0xfa, 0xe8,
+	0xaa, 0xbe, 0xea, 0xad, 0x31, 0xb7, 0xbb, 0x7e, 0x98, 0xbe, 0xc1, 0xaa, 0xda, 0x54, 0xf9, 0xc4,
+	0x80, 0xc5, 0x63, 0xc2, 0x40, 0xde, 0x7f, 0xd2, 0xbd, 0x9b, 0x15, 0x0d, 0xd1, 0x16, 0x4c, 0x1d,
+	0xa8, 0x1e, 0xe2, 0x74, 0xb7, 0x02, 0x8d, 0x82, 0x6a, 0x90, 0x75, 0x70, 0xdf, 0xcc, 0x3e, 0xa3,
+	0x5f, 0x89, 0xc9, 0xba, 0x0d, 0xfd, 0xb7, 0x01, 0xe6, 0x31, 0x7b, 0x51, 0xce, 0xb6, 0x06, 0xe8,
+	0x98, 0x62, 0xa0, 0xf6, 0xb6, 0xe0, 0x1f, 0xa9, 0x01, 0x37, 0x61, 0x52, 0xf9, 0x7b, 0xe6, 0xe9,
+	0x9b, 0x39, 0x35, 0x13, 0x59, 0x30, 0xaf, 0xb6, 0x48, 0x5c, 0x1d, 0x3b, 0xd9, 0xa7, 0xc7, 0x2a,
+	0x44, 0x10, 0x6a, 0x17, 0x4b, 0x30, 0x99, 0xbe, 0x13, 0xaa, 0x41, 0xc5, 0x83, 0x62, 0x33, 0xe5,
+	0x82, 0x5d, 0xc2, 0xd0, 0x7d, 0xd9, 0xcc, 0x76, 0x75, 0xcf, 0x32, 0x7b, 0xfd, 0x95, 0xa7, 0x4b,
+	0x83, 0x12, 0x65, 0xe4, 0xc2, 0xac, 0xd0, 0x2a, 0xff, 0xcc, 0xc0, 0x99, 0x06, 0x7d, 0x87, 0x38,
+	0x42, 0xa5, 0x68, 0x5e, 0x1d, 0x73, 0x2c, 0x3c, 0x66, 0x9f, 0x84, 0xb2, 0xcb, 0x33, 0x64, 0xec,
+	0x46, 0x43, 0xd9, 0x37, 0xf4, 0x02, 0xa7, 0xab, 0xae, 0xbd, 0x19, 0xdd, 0x37, 0x08, 0x8a, 0xbc,
+	0xf1, 0x5e, 0x82, 0x39, 0xc5, 0xa6, 0x03, 0xbf, 0x45, 0x42, 0x69, 0xa5, 0x9c, 0x35, 0x2b, 0x69,
+	0x5b, 0x92, 0x24, 0x2e, 0xc5, 0x4a, 0x24, 0x29, 0xc4, 0xfa, 0x52, 0x2c, 0xc9, 0x49, 0x01, 0x46,
+	0x90, 0x13, 0xb5, 0x4b, 0x36, 0x27, 0x59, 0x4b, 0xfe, 0x47, 0x2f, 0xc3, 0x52, 0x5c, 0x81, 0x6c,
+	0x46, 0xb8, 0xed, 0x7a, 0x6d, 0xc2, 0xb8, 0xec, 0x4b, 0xe6, 0x2c, 0x14, 0xf3, 0x9a, 0x84, 0xd7,
+	0x25, 0x47, 0xcc, 0x20, 0x87, 0x9c, 0x50, 0x97, 0xb8, 0xaa, 0x0b, 0xe1, 0xb6, 0x47, 0xf7, 0x02,
+	0xd9, 0x3a, 0xcc, 0x59, 0x28, 0xe2, 0xc9, 0xc6, 0x83, 0xcb, 0xe7, 0x8c, 0xe7, 0xa0, 0x30, 0xb2,
+	0x86, 0xec, 0x13, 0xe6, 0xac, 0xb9, 0x34, 0x78, 0x72, 0x78, 0xf9, 0xd4, 0xe1, 0x89, 0x2e, 0x8d,
+	0x75, 0xbd, 0xbe, 0x1d, 0x12, 0xcc, 0x02, 0x2a, 0x4b, 0x7b, 0xde, 0x02, 0x41, 0xb2, 0x24, 0xa5,
+	0xf2, 0xa7, 0x0c, 0x9c, 0x8d, 0x4d, 0x7d, 0x3f, 0xe0, 0x64, 0x53, 0xac, 0x7f, 0x8c, 0x65, 0x8d,
+	0xff, 0x66, 0xd9, 0xcc, 0x13, 0x59, 0x36, 0x7b, 0xa2, 0x65, 0x73, 0x4f, 0x60, 0xd9, 0xc9, 0x6f,
+	0xb4, 0x6c, 0x6c, 0x82, 0xa9, 0xb4, 0x09, 0x5e, 0x84, 0x92, 0xd3, 0x21, 0x4e, 0xb7, 0x1f, 0x78,
+	0x94, 0xeb, 0x4e, 0x64, 0x5a, 0x0a, 0x14, 0x13, 0xba, 0xea, 0x45, 0xae, 0xc1, 0x52, 0x4a, 0x94,
+	0x79, 0x6d, 0x8a, 0xf9, 0x20, 0x24, 0xda, 0xde, 0x8b, 0x09, 0xaf, 0x19, 0xb1, 0x2a, 0x7f, 0xcf,
+	0x00, 0x6a, 0x8e, 0x74, 0x4a, 0x82, 0x2f, 0xae, 0x81, 0xfa, 0x2e, 0x64, 0xc8, 0x2d, 0xe9, 0xd1,
+	0xff, 0xd2, 0x5b, 0x63, 0x6b, 0x4c, 0xa6, 0xad, 0xb1, 0x06, 0x28, 0xf5, 0x54, 0xa4, 0xde, 0x05,
+	0x5c, 0x69, 0xb0, 0x82, 0xb5, 0x90, 0x70, 0x76, 0x15, 0x63, 0xdc, 0x7f, 0xa6, 0xc7, 0xfd, 0x67,
+	0x0c, 0x2f, 0x24, 0x7e, 0xb0, 0x4f, 0x5c, 0x73, 0x66, 0x1c, 0xcf, 0x52, 0x8c, 0x71, 0xf1, 0x80,
+	0xcb, 0xe5, 0xf3, 0x47, 0xc4, 0x15, 0xa3, 0xf2, 0xf3, 0xb4, 0x71, 0x45, 0xf9, 0xa3, 0x22, 0x7d,
+	0x5e, 0x82, 0x39, 0xe6, 0x51, 0x87, 0xd8, 0x23, 0x26, 0x9e, 0x95, 0x34, 0x7d, 0xd5, 0x7c, 0x0e,
+	0x0a, 0x42, 0xc9, 0xbe, 0xc8, 0x8e, 0xb2, 0xe2, 0xab, 0x3b, 0xcc, 0x9c, 0x26, 0xca, 0x3a, 0x5f,
+	0x79, 0x94, 0x4e, 0xe9, 0x71, 0x8b, 0xb8, 0xa3, 0x2e, 0xcb, 0x5b, 0x90, 0xe8, 0x33, 0xf6, 0xd2,
+	0x70, 0xe9, 0xb3, 0x47, 0x6b, 0x17, 0x75, 0xc6, 0x8b, 0xa7, 0x8d, 0xde, 0xd5, 0x4a, 0xfb, 0x63,
+	0x74, 0x74, 0x27, 0xbe, 0xc1, 0x67, 0x9e, 0xb1, 0x18, 0xe9, 0xf9, 0x95, 0x3f, 0x64, 0x00, 0x6d,
+	0x04, 0x94, 0xe9, 0x27, 0x08, 0x61, 0x2a, 0x11, 0xae, 0xdf, 0xca, 0xcb, 0xc8, 0x7d, 0x28, 0x06,
+	0x3d, 0x91, 0x97, 0xe8, 0x29, 0x1f, 0x46, 0x0a, 0x41, 0xcf, 0xd5, 0x4a, 0x8a, 0x67, 0x91, 0xfb,
+	0x50, 0xa4, 0xe4, 0x60, 0x04, 0x37, 0xfb, 0x6c, 0xb8, 0x94, 0x1c, 0xa4, 0x70, 0x93, 0x38, 0xcb,
+	0xa5, 0xe3, 0x2c, 0x75, 0xe5, 0x7e, 0x3f, 0x9b, 0xea, 0x41, 0x36, 0xe2, 0x08, 0x4e, 0xc2, 0xc3,
+	0x48, 0x87, 0x47, 0x82, 0x97, 0x19, 0x89, 0xdb, 0x97, 0x00, 0x8d, 0x26, 0xa3, 0xd4, 0x23, 0x6b,
+	0x29, 0x9d, 0x8a, 0x64, 0x18, 0x9f, 0x87, 0x19, 0xdc, 0xef, 0x2b, 0x99, 0x5c, 0xfc, 0xc0, 0x2b,
+	0x59, 0x2d, 0x80, 0xd4, 0x8d, 0x65, 0x52, 0x96, 0xcf, 0xef, 0x3e, 0x49, 0xf9, 0x4c, 0x54, 0x3f,
+	0xf6, 0xcd, 0x39, 0x85, 0x2a, 0x82, 0x96, 0x07, 0x1c, 0xf7, 0x54, 0x27, 0x2f, 0x83, 0x3b, 0x6b,
+	0x81, 0x24, 0xc9, 0x1e, 0x5c, 0x28, 0x11, 0x27, 0x37, 0x71, 0x67, 0x7d, 0x06, 0x25, 0xe2, 0x0c,
+	0x38, 0xa2, 0x44, 0x82, 0xaa, 0x82, 0xb4, 0x4d, 0x89, 0xab, 0xb5, 0x98, 0x89, 0x82, 0x54, 0xd0,
+	0xa4, 0x1a, 0x95, 0x6d, 0xb8, 0x70, 0xd2, 0xf6, 0xc4, 0xd3, 0x63, 0xfc, 0xb8, 0xa2, 0xca, 0x4f,
+	0x3c, 0x16, 0xc7, 0xa7, 0x70, 0xd5, 0x39, 0xa9, 0x81, 0x78, 0xd6, 0xbc, 0x70, 0x92, 0xb2, 0xdf,
+	0x7a, 0x54, 0xa7, 0x55, 0xcc, 0x8c, 0xa9, 0x78, 0x01, 0xf2, 0x49, 0x09, 0x51, 0xae, 0x92, 0x10,
+	0x2a, 0x6f, 0x43, 0x29, 0x5e, 0x45, 0x65, 0x5b, 0x86, 0x6e, 0xc1, 0xb4, 0xca, 0xc8, 0x51, 0x63,
+	0x75, 0x29, 0xf9, 0x70, 0x21, 0x3e, 0x7d, 0x88, 0xef, 0x16, 0x63, 0x93, 0xd2, 0xe6, 0x8f, 0x26,
+	0x8b, 0x0f, 0x0f, 0x57, 0xff, 0x68, 0x00, 0x24, 0x0f, 0x89, 0xe8, 0x25, 0x38, 0x57, 0xbb, 0xb7,
+	0x55, 0xb7, 0x9b, 0x3b, 0x37, 0x77, 0x76, 0x9b, 0xf6, 0xee, 0x56, 0x73, 0x7b, 0x73, 0xa3, 0x71,
+	0xab, 0xb1, 0x59, 0x2f, 0x4d, 0x94, 0x8b, 0x0f, 0x1e, 0xae, 0xce, 0xee, 0x52, 0xd6, 0x27, 0x8e,
+	0xe8, 0xf0, 0x5d, 0xf4, 0x02, 0x2c, 0x8d, 0x4a, 0x8b, 0xd1, 0x66, 0xbd, 0x64, 0x94, 0xe7, 0x1e,
+	0x3c, 0x5c, 0x9d, 0xd9, 0x95, 0x0f, 0x46, 0xc4, 0x45, 0x57, 0xe0, 0xcc, 0x51, 0xb9, 0xc6, 0xd6,
+	0xed, 0x52, 0xa6, 0x5c, 0x78, 0xf0, 0x70, 0x35, 0xbf, 0x1b, 0xbd, 0x2c, 0xa1, 0x0a, 0xa0, 0xb4,
+	0xa4, 0xc6, 0xcb, 0x96, 0xe1, 0xc1, 0xc3, 0xd5, 0xa9, 0x9a, 0x44, 0x2b, 0xe7, 0xde, 0xfd, 0xdd,
+	0xf2, 0xc4, 0xd5, 0x5f, 0x00, 0x34, 0xe8, 0x5e, 0x88, 0x1d, 0x99, 0xd5, 0xca, 0x70, 0xb6, 0xb1,
+	0x75, 0xcb, 0xba, 0xb9, 0xb1, 0xd3, 0xb8, 0xb7, 0x35, 0xaa, 0xf6, 0x18, 0xaf, 0x7e, 0x6f, 0xb7,
+	0xf6, 0xd6, 0xa6, 0xdd, 0x6c, 0xdc, 0xde, 0x2a, 0x19, 0xe8, 0x1c, 0x2c, 0x8e, 0xf0, 0x7e, 0xb2,
+	0xb5, 0xd3, 0xb8, 0xbb, 0x59, 0xca, 0xd4, 0x5e, 0xff, 0xf8, 0x8b, 0x65, 0xe3, 0xd3, 0x2f, 0x96,
+	0x8d, 0x7f, 0x7c, 0xb1, 0x6c, 0xbc, 0xf7, 0xe5, 0xf2, 0xc4, 0xa7, 0x5f, 0x2e, 0x4f, 0xfc, 0xf5,
+	0xcb, 0xe5, 0x89, 0x9f, 0xad, 0x8c, 0x64, 0xe2, 0x91, 0xfb, 0x8c, 0xfc, 0x6a, 0xd4, 0x9a, 0x92,
+	0x39, 0xea, 0x95, 0xff, 0x0c, 0x00, 0x73, 0x22, 0xcc, 0x2d, 0xb3, 0x1b, 0x00, 0x00,
+}
+
+func (this *CommissionRates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.ConsensusPubkey != nil {
-		l = m.ConsensusPubkey.Size()
-		n += 1 + l + sovStaking(uint64(l))
+
+	that1, ok := that.(*CommissionRates)
+	if !ok {
+		that2, ok := that.(CommissionRates)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Jailed {
-		n += 2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Status != 0 {
-		n += 1 + sovStaking(uint64(m.Status))
+	if !this.Rate.Equal(that1.Rate) {
+		return false
 	}
-	l = m.Tokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Description.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingHeight != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	if !this.MaxRate.Equal(that1.MaxRate) {
+		return false
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.UnbondingIds) > 0 {
-		l = 0
-		for _, e := range m.UnbondingIds {
-			l += sovStaking(uint64(e))
-		}
-		n += 1 + sovStaking(uint64(l)) + l
+	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *ValAddresses) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Commission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovStaking(uint64(l))
+
+	that1, ok := that.(*Commission)
+	if !ok {
+		that2, ok := that.(Commission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CommissionRates.Equal(&that1.CommissionRates) {
+		return false
+	}
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
+		return false
+	}
+	return true
 }
+func (this *Description) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Description)
+	if !ok {
+		that2, ok := that.(Description)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	if m.MaxValidators != 0 {
-		n += 1 + sovStaking(uint64(m.MaxValidators))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.MaxEntries != 0 {
-		n += 1 + sovStaking(uint64(m.MaxEntries))
+	if this.Moniker != that1.Moniker {
+		return false
 	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	if this.Identity != that1.Identity {
+		return false
 	}
-	l = len(m.BondDenom)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if this.Website != that1.Website {
+		return false
 	}
-	l = m.MinCommissionRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
-}
-
-func (m *ValidatorUpdates) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.SecurityContact != that1.SecurityContact {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if this.Details != that1.Details {
+		return false
 	}
-	return n
+	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UnbondingTime != that1.UnbondingTime {
+		return false
+	}
+	if this.MaxValidators != that1.MaxValidators {
+		return false
+	}
+	if this.MaxEntries != that1.MaxEntries {
+		return false
+	}
+	if this.HistoricalEntries != that1.HistoricalEntries {
+		return false
+	}
+	if this.BondDenom != that1.BondDenom {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if this.SymbioticMiddlewareAddress != that1.SymbioticMiddlewareAddress {
+		return false
+	}
+	if this.BeaconGenesisTimestamp != that1.BeaconGenesisTimestamp {
+		return false
+	}
+	if this.SlotDuration != that1.SlotDuration {
+		return false
+	}
+	if this.SymbioticSyncPeriod != that1.SymbioticSyncPeriod {
+		return false
+	}
+	if this.EthereumNetwork != that1.EthereumNetwork {
+		return false
+	}
+	if this.SlotsPerEpoch != that1.SlotsPerEpoch {
+		return false
+	}
+	if this.FinalityDepth != that1.FinalityDepth {
+		return false
+	}
+	if this.SymbioticAutoCreateValidators != that1.SymbioticAutoCreateValidators {
+		return false
+	}
+	if this.SymbioticKeyType != that1.SymbioticKeyType {
+		return false
+	}
+	if len(this.SymbioticMiddlewares) != len(that1.SymbioticMiddlewares) {
+		return false
+	}
+	for i := range this.SymbioticMiddlewares {
+		if !this.SymbioticMiddlewares[i].Equal(&that1.SymbioticMiddlewares[i]) {
+			return false
+		}
+	}
+	if !this.SymbioticMaxPowerShare.Equal(that1.SymbioticMaxPowerShare) {
+		return false
+	}
+	if !this.SymbioticMaxPowerChange.Equal(that1.SymbioticMaxPowerChange) {
+		return false
+	}
+	if !this.SymbioticMinStake.Equal(that1.SymbioticMinStake) {
+		return false
+	}
+	if this.SymbioticMaxStaleSyncs != that1.SymbioticMaxStaleSyncs {
+		return false
+	}
+	if this.SymbioticStalePolicy != that1.SymbioticStalePolicy {
+		return false
+	}
+	if !this.SymbioticStaleDecay.Equal(that1.SymbioticStaleDecay) {
+		return false
+	}
+	if len(this.SymbioticHaltMsgTypeUrls) != len(that1.SymbioticHaltMsgTypeUrls) {
+		return false
+	}
+	for i := range this.SymbioticHaltMsgTypeUrls {
+		if this.SymbioticHaltMsgTypeUrls[i] != that1.SymbioticHaltMsgTypeUrls[i] {
+			return false
+		}
+	}
+	if this.SymbioticEpochIdentifier != that1.SymbioticEpochIdentifier {
+		return false
+	}
+	return true
+}
+func (this *SymbioticMiddleware) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SymbioticMiddleware)
+	if !ok {
+		that2, ok := that.(SymbioticMiddleware)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	if !this.Cap.Equal(that1.Cap) {
+		return false
+	}
+	return true
+}
+func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Valset) > 0 {
+		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SymbioticEpoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SymbioticEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorsHash) > 0 {
+		i -= len(m.ValidatorsHash)
+		copy(dAtA[i:], m.ValidatorsHash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorsHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Time != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintStaking(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Apphash) > 0 {
+		i -= len(m.Apphash)
+		copy(dAtA[i:], m.Apphash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Apphash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Commission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStaking(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CommissionRates.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Description) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Description) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SymbioticOperator) > 0 {
+		i -= len(m.SymbioticOperator)
+		copy(dAtA[i:], m.SymbioticOperator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SymbioticOperator)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.UnbondingIds) > 0 {
+		dAtA6 := make([]byte, len(m.UnbondingIds)*10)
+		var j5 int
+		for _, num := range m.UnbondingIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintStaking(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintStaking(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x42
+	if m.UnbondingHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Status != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsensusPubkey != nil {
+		{
+			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SymbioticEpochIdentifier) > 0 {
+		i -= len(m.SymbioticEpochIdentifier)
+		copy(dAtA[i:], m.SymbioticEpochIdentifier)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SymbioticEpochIdentifier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.SymbioticHaltMsgTypeUrls) > 0 {
+		for iNdEx := len(m.SymbioticHaltMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SymbioticHaltMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.SymbioticHaltMsgTypeUrls[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.SymbioticHaltMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	{
+		size := m.SymbioticStaleDecay.Size()
+		i -= size
+		if _, err := m.SymbioticStaleDecay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if len(m.SymbioticStalePolicy) > 0 {
+		i -= len(m.SymbioticStalePolicy)
+		copy(dAtA[i:], m.SymbioticStalePolicy)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SymbioticStalePolicy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.SymbioticMaxStaleSyncs != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SymbioticMaxStaleSyncs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.SymbioticMinStake.Size()
+		i -= size
+		if _, err := m.SymbioticMinStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.SymbioticMaxPowerChange.Size()
+		i -= size
+		if _, err := m.SymbioticMaxPowerChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.SymbioticMaxPowerShare.Size()
+		i -= size
+		if _, err := m.SymbioticMaxPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.SymbioticMiddlewares) > 0 {
+		for iNdEx := len(m.SymbioticMiddlewares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbioticMiddlewares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.SymbioticKeyType) > 0 {
+		i -= len(m.SymbioticKeyType)
+		copy(dAtA[i:], m.SymbioticKeyType)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SymbioticKeyType)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SymbioticAutoCreateValidators {
+		i--
+		if m.SymbioticAutoCreateValidators {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.FinalityDepth != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.FinalityDepth))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SlotsPerEpoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SlotsPerEpoch))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.EthereumNetwork) > 0 {
+		i -= len(m.EthereumNetwork)
+		copy(dAtA[i:], m.EthereumNetwork)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.EthereumNetwork)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SymbioticSyncPeriod != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SymbioticSyncPeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlotDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlotDuration):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x4a
+	if m.BeaconGenesisTimestamp != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.BeaconGenesisTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SymbioticMiddlewareAddress) > 0 {
+		i -= len(m.SymbioticMiddlewareAddress)
+		copy(dAtA[i:], m.SymbioticMiddlewareAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SymbioticMiddlewareAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticMiddleware) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticMiddleware) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticMiddleware) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticMiddlewareStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticMiddlewareStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticMiddlewareStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.WeightedStake.Size()
+		i -= size
+		if _, err := m.WeightedStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MiddlewareAddress) > 0 {
+		i -= len(m.MiddlewareAddress)
+		copy(dAtA[i:], m.MiddlewareAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.MiddlewareAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticStakes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticStakes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticStakes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stakes) > 0 {
+		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InjectedSymbioticData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InjectedSymbioticData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InjectedSymbioticData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SkipReason) > 0 {
+		i -= len(m.SkipReason)
+		copy(dAtA[i:], m.SkipReason)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SkipReason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ValidatorSet) > 0 {
+		i -= len(m.ValidatorSet)
+		copy(dAtA[i:], m.ValidatorSet)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSet)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExtendedCommitInfo) > 0 {
+		i -= len(m.ExtendedCommitInfo)
+		copy(dAtA[i:], m.ExtendedCommitInfo)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ExtendedCommitInfo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ValidatorSetDigest) > 0 {
+		i -= len(m.ValidatorSetDigest)
+		copy(dAtA[i:], m.ValidatorSetDigest)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSetDigest)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Slot != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockTimestamp != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.BlockTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CheckpointSignature) > 0 {
+		i -= len(m.CheckpointSignature)
+		copy(dAtA[i:], m.CheckpointSignature)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.CheckpointSignature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CheckpointEpoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CheckpointEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ValidatorSetDigest) > 0 {
+		i -= len(m.ValidatorSetDigest)
+		copy(dAtA[i:], m.ValidatorSetDigest)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSetDigest)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Slot != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockTimestamp != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.BlockTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticSyncPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticSyncPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticSyncPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorsRotated != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ValidatorsRotated))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ValidatorsRemoved != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ValidatorsRemoved))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SkipReason) > 0 {
+		i -= len(m.SkipReason)
+		copy(dAtA[i:], m.SkipReason)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SkipReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ValidatorsUpdated != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ValidatorsUpdated))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockTimestamp != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.BlockTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticStaleness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticStaleness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticStaleness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SkippedSyncs != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SkippedSyncs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SinceHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticValidatorTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticValidatorTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticValidatorTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsPubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignedPower != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SignedPower))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorSetHash) > 0 {
+		i -= len(m.ValidatorSetHash)
+		copy(dAtA[i:], m.ValidatorSetHash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSetHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticCheckpointValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticCheckpointValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticCheckpointValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticCheckpointSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticCheckpointSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticCheckpointSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HistoricalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Apphash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorsHash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.SymbioticEpoch != 0 {
+		n += 1 + sovStaking(uint64(m.SymbioticEpoch))
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRates.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovStaking(uint64(m.Status))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Description.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.UnbondingIds) > 0 {
+		l = 0
+		for _, e := range m.UnbondingIds {
+			l += sovStaking(uint64(e))
+		}
+		n += 1 + sovStaking(uint64(l)) + l
+	}
+	l = len(m.SymbioticOperator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ValAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxValidators != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidators))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovStaking(uint64(m.MaxEntries))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.SymbioticMiddlewareAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.BeaconGenesisTimestamp != 0 {
+		n += 1 + sovStaking(uint64(m.BeaconGenesisTimestamp))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlotDuration)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.SymbioticSyncPeriod != 0 {
+		n += 1 + sovStaking(uint64(m.SymbioticSyncPeriod))
+	}
+	l = len(m.EthereumNetwork)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.SlotsPerEpoch != 0 {
+		n += 1 + sovStaking(uint64(m.SlotsPerEpoch))
+	}
+	if m.FinalityDepth != 0 {
+		n += 1 + sovStaking(uint64(m.FinalityDepth))
+	}
+	if m.SymbioticAutoCreateValidators {
+		n += 2
+	}
+	l = len(m.SymbioticKeyType)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.SymbioticMiddlewares) > 0 {
+		for _, e := range m.SymbioticMiddlewares {
+			l = e.Size()
+			n += 2 + l + sovStaking(uint64(l))
+		}
+	}
+	l = m.SymbioticMaxPowerShare.Size()
+	n += 2 + l + sovStaking(uint64(l))
+	l = m.SymbioticMaxPowerChange.Size()
+	n += 2 + l + sovStaking(uint64(l))
+	l = m.SymbioticMinStake.Size()
+	n += 2 + l + sovStaking(uint64(l))
+	if m.SymbioticMaxStaleSyncs != 0 {
+		n += 2 + sovStaking(uint64(m.SymbioticMaxStaleSyncs))
+	}
+	l = len(m.SymbioticStalePolicy)
+	if l > 0 {
+		n += 2 + l + sovStaking(uint64(l))
+	}
+	l = m.SymbioticStaleDecay.Size()
+	n += 2 + l + sovStaking(uint64(l))
+	if len(m.SymbioticHaltMsgTypeUrls) > 0 {
+		for _, s := range m.SymbioticHaltMsgTypeUrls {
+			l = len(s)
+			n += 2 + l + sovStaking(uint64(l))
+		}
+	}
+	l = len(m.SymbioticEpochIdentifier)
+	if l > 0 {
+		n += 2 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *SymbioticMiddleware) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *SymbioticMiddlewareStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MiddlewareAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.WeightedStake.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovStaking(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *SymbioticStakes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stakes) > 0 {
+		for _, e := range m.Stakes {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InjectedSymbioticData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovStaking(uint64(m.Version))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovStaking(uint64(m.BlockNumber))
+	}
+	if m.BlockTimestamp != 0 {
+		n += 1 + sovStaking(uint64(m.BlockTimestamp))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovStaking(uint64(m.Slot))
+	}
+	l = len(m.ValidatorSetDigest)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ExtendedCommitInfo)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSet)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovStaking(uint64(m.Epoch))
+	}
+	l = len(m.SkipReason)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *SymbioticVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovStaking(uint64(m.BlockNumber))
+	}
+	if m.BlockTimestamp != 0 {
+		n += 1 + sovStaking(uint64(m.BlockTimestamp))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovStaking(uint64(m.Slot))
+	}
+	l = len(m.ValidatorSetDigest)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovStaking(uint64(m.Epoch))
+	}
+	if m.CheckpointEpoch != 0 {
+		n += 1 + sovStaking(uint64(m.CheckpointEpoch))
+	}
+	l = len(m.CheckpointSignature)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *SymbioticSyncPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovStaking(uint64(m.BlockNumber))
+	}
+	if m.BlockTimestamp != 0 {
+		n += 1 + sovStaking(uint64(m.BlockTimestamp))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovStaking(uint64(m.Epoch))
+	}
+	if m.ValidatorsUpdated != 0 {
+		n += 1 + sovStaking(uint64(m.ValidatorsUpdated))
+	}
+	l = len(m.SkipReason)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ValidatorsRemoved != 0 {
+		n += 1 + sovStaking(uint64(m.ValidatorsRemoved))
+	}
+	if m.ValidatorsRotated != 0 {
+		n += 1 + sovStaking(uint64(m.ValidatorsRotated))
+	}
+	return n
+}
+
+func (m *SymbioticStaleness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SinceHeight != 0 {
+		n += 1 + sovStaking(uint64(m.SinceHeight))
+	}
+	if m.SkippedSyncs != 0 {
+		n += 1 + sovStaking(uint64(m.SkippedSyncs))
+	}
+	return n
+}
+
+func (m *SymbioticValidatorTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ConsPubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SymbioticCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovStaking(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = len(m.ValidatorSetHash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovStaking(uint64(m.TotalPower))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.SignedPower != 0 {
+		n += 1 + sovStaking(uint64(m.SignedPower))
+	}
+	return n
+}
+
+func (m *SymbioticCheckpointValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *SymbioticCheckpointSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valset = append(m.Valset, Validator{})
+			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apphash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Apphash = append(m.Apphash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Apphash == nil {
+				m.Apphash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsHash = append(m.ValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorsHash == nil {
+				m.ValidatorsHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticEpoch", wireType)
+			}
+			m.SymbioticEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SymbioticEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &any.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbondingIds = append(m.UnbondingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStaking
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStaking
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbondingIds) == 0 {
+					m.UnbondingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStaking
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbondingIds = append(m.UnbondingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbioticOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticMiddlewareAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbioticMiddlewareAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconGenesisTimestamp", wireType)
+			}
+			m.BeaconGenesisTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeaconGenesisTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlotDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticSyncPeriod", wireType)
+			}
+			m.SymbioticSyncPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SymbioticSyncPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumNetwork", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumNetwork = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotsPerEpoch", wireType)
+			}
+			m.SlotsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityDepth", wireType)
+			}
+			m.FinalityDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalityDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticAutoCreateValidators", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SymbioticAutoCreateValidators = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticKeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbioticKeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticMiddlewares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbioticMiddlewares = append(m.SymbioticMiddlewares, SymbioticMiddleware{})
+			if err := m.SymbioticMiddlewares[len(m.SymbioticMiddlewares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticMaxPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SymbioticMaxPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticMaxPowerChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SymbioticMaxPowerChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticMinStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SymbioticMinStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticMaxStaleSyncs", wireType)
+			}
+			m.SymbioticMaxStaleSyncs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SymbioticMaxStaleSyncs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticStalePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbioticStalePolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticStaleDecay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SymbioticStaleDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticHaltMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbioticHaltMsgTypeUrls = append(m.SymbioticHaltMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbioticEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticMiddleware) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticMiddleware: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticMiddleware: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticMiddlewareStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticMiddlewareStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticMiddlewareStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiddlewareAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiddlewareAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticStakes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticStakes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticStakes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakes = append(m.Stakes, SymbioticMiddlewareStake{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InjectedSymbioticData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InjectedSymbioticData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InjectedSymbioticData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			m.BlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetDigest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSetDigest = append(m.ValidatorSetDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSetDigest == nil {
+				m.ValidatorSetDigest = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommitInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedCommitInfo = append(m.ExtendedCommitInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtendedCommitInfo == nil {
+				m.ExtendedCommitInfo = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSet = append(m.ValidatorSet[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkipReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetDigest", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -1626,30 +6877,69 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorSetDigest = append(m.ValidatorSetDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSetDigest == nil {
+				m.ValidatorSetDigest = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointEpoch", wireType)
+			}
+			m.CheckpointEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointSignature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -1659,24 +6949,24 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valset = append(m.Valset, Validator{})
-			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CheckpointSignature = append(m.CheckpointSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.CheckpointSignature == nil {
+				m.CheckpointSignature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1700,7 +6990,7 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
+func (m *SymbioticSyncPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1723,17 +7013,36 @@ func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SymbioticSyncPoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SymbioticSyncPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apphash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -1743,31 +7052,29 @@ func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Apphash = append(m.Apphash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Apphash == nil {
-				m.Apphash = []byte{}
-			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			var msglen int
+			m.BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -1777,33 +7084,73 @@ func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+			m.BlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			if m.Time == nil {
-				m.Time = new(time.Time)
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsUpdated", wireType)
 			}
-			iNdEx = postIndex
-		case 3:
+			m.ValidatorsUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsUpdated |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipReason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -1813,26 +7160,62 @@ func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorsHash = append(m.ValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorsHash == nil {
-				m.ValidatorsHash = []byte{}
+			m.SkipReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsRemoved", wireType)
+			}
+			m.ValidatorsRemoved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsRemoved |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsRotated", wireType)
+			}
+			m.ValidatorsRotated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsRotated |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -1854,7 +7237,7 @@ func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+func (m *SymbioticStaleness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1877,17 +7260,17 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: SymbioticStaleness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SymbioticStaleness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
 			}
-			var stringLen uint64
+			m.SinceHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -1897,65 +7280,16 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SinceHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedSyncs", wireType)
 			}
-			var stringLen uint64
+			m.SkippedSyncs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -1965,26 +7299,11 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SkippedSyncs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -2006,7 +7325,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *SymbioticValidatorTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2029,17 +7348,17 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: SymbioticValidatorTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SymbioticValidatorTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2049,30 +7368,29 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2082,22 +7400,23 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2122,7 +7441,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *ConsPubKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2145,15 +7464,15 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsPubKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsPubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2181,13 +7500,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2197,29 +7516,33 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &any.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2229,61 +7552,33 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &any.Any{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2293,24 +7588,11 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Details = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -2332,7 +7614,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *SymbioticCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2355,17 +7637,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: SymbioticCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SymbioticCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2375,65 +7657,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &any.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2443,17 +7676,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetHash", wireType)
 			}
-			m.Status = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2463,16 +7695,31 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BondStatus(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSetHash = append(m.ValidatorSetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSetHash == nil {
+				m.ValidatorSetHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2482,29 +7729,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2531,15 +7778,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, SymbioticCheckpointValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
 			}
-			m.UnbondingHeight = 0
+			m.TotalPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2549,14 +7797,14 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
+				m.TotalPower |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2583,15 +7831,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Signatures = append(m.Signatures, SymbioticCheckpointSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
 			}
-			var msglen int
+			m.SignedPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2601,101 +7850,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SignedPower |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.UnbondingIds = append(m.UnbondingIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStaking
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthStaking
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.UnbondingIds) == 0 {
-					m.UnbondingIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStaking
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.UnbondingIds = append(m.UnbondingIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -2717,7 +7876,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+func (m *SymbioticCheckpointValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2740,15 +7899,15 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: SymbioticCheckpointValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SymbioticCheckpointValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2776,8 +7935,27 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -2799,7 +7977,7 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SymbioticCheckpointSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2822,17 +8000,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SymbioticCheckpointSignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SymbioticCheckpointSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2842,85 +8020,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
-			}
-			m.MaxValidators = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
-			}
-			m.MaxEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
-			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2948,13 +8068,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2964,24 +8084,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
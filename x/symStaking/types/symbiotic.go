@@ -1,6 +1,146 @@
 package types
 
-type CachedBlockHash struct {
-	BlockHash string
-	Height    int64
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Types of the 32-byte validator keys of the Symbiotic middleware.
+const (
+	// SymbioticKeyTypeAddress is a consensus address, left-aligned and zero padded.
+	SymbioticKeyTypeAddress = "address"
+	// SymbioticKeyTypeEd25519 is an ed25519 consensus pubkey.
+	SymbioticKeyTypeEd25519 = "ed25519"
+	// SymbioticKeyTypeSecp256k1 is the sha256 hash of a compressed secp256k1
+	// consensus pubkey.
+	SymbioticKeyTypeSecp256k1 = "secp256k1"
+)
+
+// Policies applied at each skipped sync once the validator set is stale for
+// more than the max stale syncs.
+const (
+	// SymbioticStalePolicyKeep keeps the last synced validator set.
+	SymbioticStalePolicyKeep = "keep"
+	// SymbioticStalePolicyDecay decays the tokens of the validators by the stale
+	// decay.
+	SymbioticStalePolicyDecay = "decay"
+	// SymbioticStalePolicyHalt disables the halt messages through the circuit
+	// breaker, which must be reset by its authority.
+	SymbioticStalePolicyHalt = "halt"
+	// SymbioticStalePolicyManual suspends the syncs until the validator set is
+	// updated by governance with MsgUpdateSymbioticValidatorSet.
+	SymbioticStalePolicyManual = "manual"
+)
+
+// BeaconBlock is the subset of a beacon chain block (as returned by the
+// `/eth/v2/beacon/blocks/{slot}` endpoint) needed to find the finalized
+// execution block.
+type BeaconBlock struct {
+	Finalized bool `json:"finalized"`
+	Data      struct {
+		Message struct {
+			Body struct {
+				ExecutionPayload struct {
+					BlockHash string `json:"block_hash"`
+				} `json:"execution_payload"`
+			} `json:"body"`
+		} `json:"message"`
+	} `json:"data"`
+}
+
+// SymbioticValidator is a validator entry as returned by the middleware
+// `getValidatorSet` call, along with the operator that registered its key as
+// returned by `getOperatorByKey`.
+type SymbioticValidator struct {
+	Stake    *big.Int
+	ConsAddr [32]byte
+	Operator common.Address
+}
+
+// SymbioticValidatorSet is the validator set of a middleware at its epoch.
+type SymbioticValidatorSet struct {
+	Middleware common.Address
+	Epoch      uint64
+	Validators []SymbioticValidator
+}
+
+// WeightedStake returns the stake of a validator in the middleware after its
+// weight and cap.
+func (m SymbioticMiddleware) WeightedStake(stake *big.Int) *big.Int {
+	weighted := new(big.Int).Mul(stake, m.Weight.BigInt())
+	weighted.Quo(weighted, math.LegacyOneDec().BigInt())
+
+	if m.Cap.IsPositive() && weighted.Cmp(m.Cap.BigInt()) > 0 {
+		return m.Cap.BigInt()
+	}
+
+	return weighted
+}
+
+// Validate returns an error if the key of the validator can't be mapped
+// unambiguously to a consensus key of the given type.
+func (v SymbioticValidator) Validate(keyType string) error {
+	if v.ConsAddr == [32]byte{} {
+		return ErrAmbiguousSymbioticKey.Wrap("empty key")
+	}
+
+	// a padded address must not be mistaken for a pubkey or a pubkey hash
+	if keyType == SymbioticKeyTypeAddress && [12]byte(v.ConsAddr[20:]) != [12]byte{} {
+		return ErrAmbiguousSymbioticKey.Wrapf("key %x is not a padded consensus address", v.ConsAddr)
+	}
+
+	return nil
+}
+
+// HasQuorum returns true if the validators that signed the checkpoint hold
+// more than 2/3 of its power.
+func (c SymbioticCheckpoint) HasQuorum() bool {
+	return c.TotalPower > 0 && 3*c.SignedPower > 2*c.TotalPower
+}
+
+// HasSigned returns true if operator signed the checkpoint.
+func (c SymbioticCheckpoint) HasSigned(operator string) bool {
+	for _, sig := range c.Signatures {
+		if strings.EqualFold(sig.Operator, operator) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SymbioticKey returns the 32-byte key identifying the consensus pubkey in the
+// middleware, if its type isn't identified by address.
+func SymbioticKey(pk cryptotypes.PubKey) ([]byte, bool) {
+	switch pk := pk.(type) {
+	case *ed25519.PubKey:
+		return pk.Bytes(), true
+	case *secp256k1.PubKey:
+		hash := sha256.Sum256(pk.Bytes())
+		return hash[:], true
+	default:
+		return nil, false
+	}
+}
+
+// SymbioticOperatorLinkMessage returns the message signed with EIP-191
+// personal_sign by the key of a Symbiotic operator to link it to a validator.
+func SymbioticOperatorLinkMessage(validatorAddress, chainID string) string {
+	return fmt.Sprintf("Link Symbiotic operator to validator %s on chain %s", validatorAddress, chainID)
+}
+
+// IsNotCanonicalError reports whether err is the execution client error
+// returned when calling a block that is no longer on the canonical chain.
+func IsNotCanonicalError(err error) bool {
+	return err != nil && strings.HasSuffix(err.Error(), "is not currently canonical")
 }
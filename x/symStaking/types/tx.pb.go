@@ -289,6 +289,298 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgInjectSymbioticData is the Msg/InjectSymbioticData request type.
+type MsgInjectSymbioticData struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// data is the data injected by the proposer.
+	Data InjectedSymbioticData `protobuf:"bytes,2,opt,name=data,proto3" json:"data"`
+}
+
+func (m *MsgInjectSymbioticData) Reset()         { *m = MsgInjectSymbioticData{} }
+func (m *MsgInjectSymbioticData) String() string { return proto.CompactTextString(m) }
+func (*MsgInjectSymbioticData) ProtoMessage()    {}
+func (*MsgInjectSymbioticData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{6}
+}
+func (m *MsgInjectSymbioticData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInjectSymbioticData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInjectSymbioticData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInjectSymbioticData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInjectSymbioticData.Merge(m, src)
+}
+func (m *MsgInjectSymbioticData) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInjectSymbioticData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInjectSymbioticData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInjectSymbioticData proto.InternalMessageInfo
+
+func (m *MsgInjectSymbioticData) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgInjectSymbioticData) GetData() InjectedSymbioticData {
+	if m != nil {
+		return m.Data
+	}
+	return InjectedSymbioticData{}
+}
+
+// MsgInjectSymbioticDataResponse defines the response structure for executing a
+// MsgInjectSymbioticData message.
+type MsgInjectSymbioticDataResponse struct {
+}
+
+func (m *MsgInjectSymbioticDataResponse) Reset()         { *m = MsgInjectSymbioticDataResponse{} }
+func (m *MsgInjectSymbioticDataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInjectSymbioticDataResponse) ProtoMessage()    {}
+func (*MsgInjectSymbioticDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{7}
+}
+func (m *MsgInjectSymbioticDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInjectSymbioticDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInjectSymbioticDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInjectSymbioticDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInjectSymbioticDataResponse.Merge(m, src)
+}
+func (m *MsgInjectSymbioticDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInjectSymbioticDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInjectSymbioticDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInjectSymbioticDataResponse proto.InternalMessageInfo
+
+// MsgUpdateSymbioticValidatorSet is the Msg/UpdateSymbioticValidatorSet request type.
+type MsgUpdateSymbioticValidatorSet struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// validators are the tokens of the validators. The tokens of the other
+	// validators are zeroed.
+	Validators []SymbioticValidatorTokens `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *MsgUpdateSymbioticValidatorSet) Reset()         { *m = MsgUpdateSymbioticValidatorSet{} }
+func (m *MsgUpdateSymbioticValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSymbioticValidatorSet) ProtoMessage()    {}
+func (*MsgUpdateSymbioticValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{8}
+}
+func (m *MsgUpdateSymbioticValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSymbioticValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSymbioticValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSymbioticValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSymbioticValidatorSet.Merge(m, src)
+}
+func (m *MsgUpdateSymbioticValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSymbioticValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSymbioticValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSymbioticValidatorSet proto.InternalMessageInfo
+
+func (m *MsgUpdateSymbioticValidatorSet) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateSymbioticValidatorSet) GetValidators() []SymbioticValidatorTokens {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// MsgUpdateSymbioticValidatorSetResponse defines the response structure for
+// executing a MsgUpdateSymbioticValidatorSet message.
+type MsgUpdateSymbioticValidatorSetResponse struct {
+}
+
+func (m *MsgUpdateSymbioticValidatorSetResponse) Reset() {
+	*m = MsgUpdateSymbioticValidatorSetResponse{}
+}
+func (m *MsgUpdateSymbioticValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSymbioticValidatorSetResponse) ProtoMessage()    {}
+func (*MsgUpdateSymbioticValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{9}
+}
+func (m *MsgUpdateSymbioticValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSymbioticValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSymbioticValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSymbioticValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSymbioticValidatorSetResponse.Merge(m, src)
+}
+func (m *MsgUpdateSymbioticValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSymbioticValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSymbioticValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSymbioticValidatorSetResponse proto.InternalMessageInfo
+
+// MsgLinkOperator defines a SDK message for linking the Ethereum address of a
+// Symbiotic operator to a validator.
+type MsgLinkOperator struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// operator is the Ethereum address of the Symbiotic operator.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// signature is the 65 bytes [R || S || V] EIP-191 signature by the operator
+	// key of the link message of the validator address and chain ID.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgLinkOperator) Reset()         { *m = MsgLinkOperator{} }
+func (m *MsgLinkOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgLinkOperator) ProtoMessage()    {}
+func (*MsgLinkOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{10}
+}
+func (m *MsgLinkOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLinkOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLinkOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLinkOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLinkOperator.Merge(m, src)
+}
+func (m *MsgLinkOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLinkOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLinkOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLinkOperator proto.InternalMessageInfo
+
+func (m *MsgLinkOperator) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgLinkOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgLinkOperator) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgLinkOperatorResponse defines the Msg/LinkOperator response type.
+type MsgLinkOperatorResponse struct {
+}
+
+func (m *MsgLinkOperatorResponse) Reset()         { *m = MsgLinkOperatorResponse{} }
+func (m *MsgLinkOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLinkOperatorResponse) ProtoMessage()    {}
+func (*MsgLinkOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{11}
+}
+func (m *MsgLinkOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLinkOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLinkOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLinkOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLinkOperatorResponse.Merge(m, src)
+}
+func (m *MsgLinkOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLinkOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLinkOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLinkOperatorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.symStaking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.symStaking.v1beta1.MsgCreateValidatorResponse")
@@ -296,6 +588,12 @@ func init() {
 	proto.RegisterType((*MsgEditValidatorResponse)(nil), "cosmos.symStaking.v1beta1.MsgEditValidatorResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.symStaking.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.symStaking.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgInjectSymbioticData)(nil), "cosmos.symStaking.v1beta1.MsgInjectSymbioticData")
+	proto.RegisterType((*MsgInjectSymbioticDataResponse)(nil), "cosmos.symStaking.v1beta1.MsgInjectSymbioticDataResponse")
+	proto.RegisterType((*MsgUpdateSymbioticValidatorSet)(nil), "cosmos.symStaking.v1beta1.MsgUpdateSymbioticValidatorSet")
+	proto.RegisterType((*MsgUpdateSymbioticValidatorSetResponse)(nil), "cosmos.symStaking.v1beta1.MsgUpdateSymbioticValidatorSetResponse")
+	proto.RegisterType((*MsgLinkOperator)(nil), "cosmos.symStaking.v1beta1.MsgLinkOperator")
+	proto.RegisterType((*MsgLinkOperatorResponse)(nil), "cosmos.symStaking.v1beta1.MsgLinkOperatorResponse")
 }
 
 func init() {
@@ -303,52 +601,67 @@ func init() {
 }
 
 var fileDescriptor_bf1decc4a4587222 = []byte{
-	// 711 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0x96, 0xef, 0x97, 0xa4, 0x83, 0x5a, 0x58, 0x48, 0x28, 0x8b, 0x6e, 0xa1, 0x07, 0x21,
-	0x35, 0x3b, 0x2b, 0xe0, 0x8f, 0xd8, 0x83, 0x91, 0x52, 0xbd, 0x68, 0x0d, 0x29, 0x81, 0x83, 0x17,
-	0x32, 0xdd, 0x1d, 0x97, 0x4d, 0xd9, 0x9d, 0x75, 0x67, 0x8a, 0xec, 0xcd, 0xe8, 0xc5, 0x78, 0xf2,
-	0xec, 0x89, 0xa3, 0x47, 0x0e, 0xfd, 0x23, 0x08, 0x17, 0x49, 0x4f, 0xc6, 0x03, 0x31, 0x70, 0xc0,
-	0xbf, 0xc2, 0x98, 0xdd, 0x9d, 0x76, 0x77, 0x5b, 0xa8, 0x62, 0xe2, 0xa5, 0xdd, 0xbc, 0xf7, 0x99,
-	0xcf, 0x7b, 0xef, 0xf3, 0x79, 0x33, 0xa0, 0xa0, 0x11, 0x6a, 0x11, 0xaa, 0x52, 0xcf, 0x5a, 0x63,
-	0xa8, 0x61, 0xda, 0x86, 0xba, 0xb3, 0x50, 0xc7, 0x0c, 0x2d, 0xa8, 0x6c, 0x17, 0x3a, 0x2e, 0x61,
-	0x44, 0x9c, 0x0a, 0x31, 0x30, 0xc2, 0x40, 0x8e, 0x91, 0xa6, 0x0c, 0x42, 0x8c, 0x6d, 0xac, 0x06,
-	0xc0, 0x7a, 0xf3, 0xa5, 0x8a, 0x6c, 0x2f, 0x3c, 0x25, 0xe5, 0x7b, 0x53, 0xcc, 0xb4, 0x30, 0x65,
-	0xc8, 0x72, 0x38, 0x60, 0xc2, 0x20, 0x06, 0x09, 0x3e, 0x55, 0xff, 0x8b, 0x47, 0x79, 0xb1, 0xcd,
-	0x30, 0xc1, 0x2b, 0x87, 0x29, 0x99, 0xf7, 0x5a, 0x47, 0x14, 0x77, 0xbb, 0xd4, 0x88, 0x69, 0xf3,
-	0xfc, 0xdc, 0xc5, 0xb3, 0x50, 0xde, 0x77, 0x08, 0x9c, 0xe4, 0x40, 0x8b, 0xfa, 0x08, 0xff, 0x8f,
-	0x27, 0xc6, 0x90, 0x65, 0xda, 0x44, 0x0d, 0x7e, 0xc3, 0x50, 0xe1, 0xd3, 0x10, 0x10, 0xab, 0xd4,
-	0x58, 0x71, 0x31, 0x62, 0x78, 0x03, 0x6d, 0x9b, 0x3a, 0x62, 0xc4, 0x15, 0xd7, 0xc0, 0x88, 0x8e,
-	0xa9, 0xe6, 0x9a, 0x0e, 0x33, 0x89, 0x9d, 0x13, 0x66, 0x84, 0xf9, 0x91, 0xc5, 0x9b, 0xf0, 0x42,
-	0xa5, 0x60, 0x25, 0x42, 0x97, 0x33, 0x07, 0xc7, 0xf9, 0xd4, 0xe7, 0xb3, 0xfd, 0xa2, 0x50, 0x8b,
-	0xb3, 0x88, 0xeb, 0x00, 0x68, 0xc4, 0xb2, 0x4c, 0x4a, 0x7d, 0xce, 0x74, 0xc0, 0x59, 0x1c, 0xc0,
-	0xb9, 0xd2, 0x05, 0xd7, 0x10, 0xc3, 0x34, 0xce, 0x1b, 0x23, 0x12, 0x9f, 0x83, 0xb1, 0x9d, 0x4e,
-	0xe3, 0x9b, 0x48, 0xd7, 0x5d, 0x4c, 0x69, 0x6e, 0x68, 0x46, 0x98, 0xcf, 0x94, 0x67, 0xdb, 0x2d,
-	0xe5, 0x06, 0x2f, 0xd0, 0x1d, 0x6e, 0x39, 0x84, 0xac, 0x31, 0xd7, 0xb4, 0x8d, 0xda, 0xe8, 0x4e,
-	0x4f, 0x5c, 0x7c, 0x02, 0x86, 0x9d, 0x66, 0xbd, 0x81, 0xbd, 0xdc, 0x7f, 0x41, 0x8b, 0x13, 0x30,
-	0xb4, 0x1a, 0x76, 0xac, 0x86, 0xcb, 0xb6, 0x57, 0xce, 0x1d, 0xb6, 0x94, 0x09, 0x4e, 0xad, 0xb9,
-	0x9e, 0xc3, 0x08, 0x5c, 0x6d, 0xd6, 0x9f, 0x62, 0xaf, 0xc6, 0x4f, 0x97, 0x1e, 0xbd, 0xdf, 0xcb,
-	0xa7, 0x7e, 0xec, 0xe5, 0x53, 0x6f, 0xcf, 0xf6, 0x8b, 0xfd, 0x2d, 0x7e, 0x38, 0xdb, 0x2f, 0xf2,
-	0xde, 0x14, 0xaa, 0x37, 0xd4, 0x7e, 0x17, 0x0a, 0xd7, 0x81, 0xd4, 0x1f, 0xad, 0x61, 0xea, 0x10,
-	0x9b, 0xe2, 0xc2, 0x97, 0x34, 0x18, 0xad, 0x52, 0xe3, 0xb1, 0x6e, 0xb2, 0x7f, 0x6c, 0xdc, 0xb9,
-	0x0a, 0xa7, 0xff, 0x5e, 0xe1, 0x0d, 0x90, 0x8d, 0xfc, 0xdb, 0x74, 0x11, 0xc3, 0xdc, 0x2f, 0xe5,
-	0xdb, 0x71, 0x7e, 0x3a, 0x64, 0xa3, 0x7a, 0x03, 0x9a, 0x44, 0xb5, 0x10, 0xdb, 0x82, 0xcf, 0xb0,
-	0x81, 0x34, 0xaf, 0x82, 0xb5, 0x76, 0x4b, 0x01, 0xbc, 0x58, 0x05, 0x6b, 0xb5, 0x6b, 0x5a, 0x62,
-	0x43, 0x4a, 0x0f, 0x7f, 0xaf, 0xf8, 0x74, 0x52, 0xf1, 0x84, 0x78, 0x05, 0x09, 0xe4, 0x7a, 0x63,
-	0x5d, 0xb5, 0x8f, 0x05, 0x90, 0xad, 0x52, 0x63, 0xdd, 0xd1, 0x11, 0xc3, 0xab, 0xc8, 0x45, 0x16,
-	0x15, 0xef, 0x81, 0x0c, 0x6a, 0xb2, 0x2d, 0xe2, 0x9a, 0xcc, 0x0b, 0xa4, 0xce, 0x94, 0x73, 0xed,
-	0x68, 0x2d, 0x92, 0x32, 0x44, 0x50, 0xb1, 0x02, 0x86, 0x9d, 0x80, 0x81, 0x5f, 0x82, 0xd9, 0x01,
-	0xfe, 0x84, 0xa5, 0xe2, 0xd6, 0xf0, 0xb3, 0xa5, 0x6a, 0xbb, 0xa5, 0x64, 0xa3, 0x71, 0x66, 0x6e,
-	0xc3, 0x3b, 0xf7, 0xfd, 0xc1, 0xa3, 0x42, 0xfe, 0xc0, 0x73, 0xb1, 0x81, 0x77, 0xe3, 0x6f, 0x47,
-	0xcf, 0x30, 0x05, 0x08, 0x26, 0x7b, 0x42, 0x9d, 0xd9, 0x4b, 0xe3, 0xe7, 0x54, 0x5a, 0xfc, 0x99,
-	0x06, 0x43, 0x55, 0x6a, 0x88, 0xaf, 0x41, 0xb6, 0xf7, 0xf5, 0x50, 0x06, 0xcc, 0xd3, 0xbf, 0xd0,
-	0xd2, 0xdd, 0x4b, 0xc1, 0x3b, 0x5d, 0x89, 0xaf, 0xc0, 0xd5, 0xe4, 0xee, 0xdf, 0x1a, 0xcc, 0x93,
-	0x00, 0x4b, 0x4b, 0x97, 0x00, 0x77, 0x4b, 0xbe, 0x13, 0xc0, 0x95, 0xc4, 0x06, 0x14, 0x07, 0xb3,
-	0xc4, 0xb1, 0xd2, 0xe2, 0x9f, 0x63, 0xbb, 0x5b, 0x37, 0x7e, 0xd8, 0xaf, 0xbc, 0xf4, 0xff, 0x1b,
-	0x7f, 0x0f, 0xca, 0x0f, 0x0e, 0x4e, 0x64, 0xe1, 0xe8, 0x44, 0x16, 0xbe, 0x9f, 0xc8, 0xc2, 0xc7,
-	0x53, 0x39, 0x75, 0x74, 0x2a, 0xa7, 0xbe, 0x9e, 0xca, 0xa9, 0x17, 0xf9, 0xc4, 0x15, 0x4a, 0xb8,
-	0xce, 0x3c, 0x07, 0xd3, 0xfa, 0x70, 0xf0, 0x94, 0x2d, 0xfd, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xd0,
-	0xf4, 0xce, 0x44, 0x1f, 0x07, 0x00, 0x00,
+	// 952 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdb, 0x54,
+	0x1c, 0x8f, 0xdb, 0x51, 0x91, 0xd7, 0x42, 0x37, 0xb7, 0xa2, 0xa9, 0x3b, 0x9c, 0xd6, 0x48, 0x5b,
+	0x15, 0x14, 0x7b, 0x4d, 0xb7, 0xa1, 0xe6, 0x80, 0x68, 0x16, 0x90, 0x10, 0x0b, 0x9b, 0x12, 0xb6,
+	0x03, 0x07, 0xaa, 0x17, 0xfb, 0xe1, 0x3d, 0x32, 0xfb, 0x19, 0xbf, 0x97, 0x32, 0xdf, 0x10, 0x5c,
+	0x10, 0x27, 0xc4, 0x11, 0x2e, 0x3b, 0x72, 0xec, 0xa1, 0x7f, 0xc4, 0xb4, 0x0b, 0x53, 0x2e, 0x20,
+	0x0e, 0x15, 0x6a, 0x0f, 0xe5, 0xc8, 0x9f, 0x80, 0x6c, 0xbf, 0xf8, 0x47, 0xec, 0xba, 0x6d, 0x24,
+	0x2e, 0x89, 0xf3, 0x7d, 0x9f, 0xef, 0xaf, 0xcf, 0xf7, 0xeb, 0xcf, 0x0b, 0x50, 0x74, 0x42, 0x2d,
+	0x42, 0x35, 0xea, 0x59, 0x3d, 0x06, 0x07, 0xd8, 0x36, 0xb5, 0xfd, 0xad, 0x3e, 0x62, 0x70, 0x4b,
+	0x63, 0xcf, 0x54, 0xc7, 0x25, 0x8c, 0x88, 0xab, 0x21, 0x46, 0x8d, 0x31, 0x2a, 0xc7, 0x48, 0xab,
+	0x26, 0x21, 0xe6, 0x53, 0xa4, 0x05, 0xc0, 0xfe, 0xf0, 0x4b, 0x0d, 0xda, 0x5e, 0xe8, 0x25, 0x55,
+	0x27, 0x8f, 0x18, 0xb6, 0x10, 0x65, 0xd0, 0x72, 0x38, 0x60, 0xd9, 0x24, 0x26, 0x09, 0x1e, 0x35,
+	0xff, 0x89, 0x5b, 0x79, 0xb2, 0xbd, 0xf0, 0x80, 0x67, 0x0e, 0x8f, 0x64, 0x5e, 0x6b, 0x1f, 0x52,
+	0x14, 0x55, 0xa9, 0x13, 0x6c, 0xf3, 0xf3, 0x9b, 0x67, 0xf7, 0x42, 0x79, 0xdd, 0x21, 0x70, 0x85,
+	0x03, 0x2d, 0xea, 0x23, 0xfc, 0x2f, 0x7e, 0x70, 0x0d, 0x5a, 0xd8, 0x26, 0x5a, 0xf0, 0x19, 0x9a,
+	0x94, 0x5f, 0x66, 0x81, 0xd8, 0xa1, 0xe6, 0x3d, 0x17, 0x41, 0x86, 0x1e, 0xc3, 0xa7, 0xd8, 0x80,
+	0x8c, 0xb8, 0x62, 0x0f, 0xcc, 0x1b, 0x88, 0xea, 0x2e, 0x76, 0x18, 0x26, 0x76, 0x45, 0x58, 0x17,
+	0x36, 0xe7, 0x1b, 0x37, 0xd4, 0x33, 0x99, 0x52, 0xdb, 0x31, 0xba, 0x55, 0x7e, 0x71, 0x54, 0x2d,
+	0xfd, 0x76, 0x7a, 0x50, 0x13, 0xba, 0xc9, 0x28, 0xe2, 0x23, 0x00, 0x74, 0x62, 0x59, 0x98, 0x52,
+	0x3f, 0xe6, 0x4c, 0x10, 0xb3, 0x56, 0x10, 0xf3, 0x5e, 0x04, 0xee, 0x42, 0x86, 0x68, 0x32, 0x6e,
+	0x22, 0x90, 0xf8, 0x29, 0xb8, 0xb6, 0x3f, 0x2e, 0x7c, 0x0f, 0x1a, 0x86, 0x8b, 0x28, 0xad, 0xcc,
+	0xae, 0x0b, 0x9b, 0xe5, 0xd6, 0xc6, 0xe8, 0xb0, 0xfe, 0x36, 0x4f, 0x10, 0x35, 0xb7, 0x1b, 0x42,
+	0x7a, 0xcc, 0xc5, 0xb6, 0xd9, 0xbd, 0xba, 0x3f, 0x61, 0x17, 0x3f, 0x02, 0x73, 0xce, 0xb0, 0x3f,
+	0x40, 0x5e, 0xe5, 0x4a, 0x50, 0xe2, 0xb2, 0x1a, 0x8e, 0x5a, 0x1d, 0x8f, 0x5a, 0xdd, 0xb5, 0xbd,
+	0x56, 0xe5, 0xe5, 0x61, 0x7d, 0x99, 0x87, 0xd6, 0x5d, 0xcf, 0x61, 0x44, 0x7d, 0x38, 0xec, 0x7f,
+	0x82, 0xbc, 0x2e, 0xf7, 0x6e, 0x7e, 0xf0, 0xc3, 0xf3, 0x6a, 0xe9, 0x9f, 0xe7, 0xd5, 0xd2, 0x77,
+	0xa7, 0x07, 0xb5, 0x6c, 0x89, 0x3f, 0x9e, 0x1e, 0xd4, 0x78, 0x6d, 0x75, 0x6a, 0x0c, 0xb4, 0xec,
+	0x14, 0x94, 0xeb, 0x40, 0xca, 0x5a, 0xbb, 0x88, 0x3a, 0xc4, 0xa6, 0x48, 0xf9, 0x7d, 0x06, 0x5c,
+	0xed, 0x50, 0xf3, 0x43, 0x03, 0xb3, 0xff, 0x79, 0x70, 0xb9, 0x0c, 0xcf, 0x4c, 0xcf, 0xf0, 0x63,
+	0xb0, 0x18, 0xcf, 0x6f, 0xcf, 0x85, 0x0c, 0xf1, 0x79, 0xd5, 0xff, 0x3a, 0xaa, 0xae, 0x85, 0xd1,
+	0xa8, 0x31, 0x50, 0x31, 0xd1, 0x2c, 0xc8, 0x9e, 0xa8, 0xf7, 0x91, 0x09, 0x75, 0xaf, 0x8d, 0xf4,
+	0xd1, 0x61, 0x1d, 0xf0, 0x64, 0x6d, 0xa4, 0x77, 0xdf, 0xd4, 0x53, 0x1b, 0xd2, 0x7c, 0xff, 0x7c,
+	0xc6, 0xd7, 0xd2, 0x8c, 0xa7, 0xc8, 0x53, 0x24, 0x50, 0x99, 0xb4, 0x45, 0x6c, 0x1f, 0x09, 0x60,
+	0xb1, 0x43, 0xcd, 0x47, 0x8e, 0x01, 0x19, 0x7a, 0x08, 0x5d, 0x68, 0x51, 0xf1, 0x2e, 0x28, 0xc3,
+	0x21, 0x7b, 0x42, 0x5c, 0xcc, 0xbc, 0x80, 0xea, 0x72, 0xab, 0x32, 0x8a, 0xd7, 0x22, 0x4d, 0x43,
+	0x0c, 0x15, 0xdb, 0x60, 0xce, 0x09, 0x22, 0xf0, 0x97, 0x60, 0xa3, 0x60, 0x3e, 0x61, 0xaa, 0xe4,
+	0x68, 0xb8, 0x6f, 0xb3, 0x33, 0x3a, 0xac, 0x2f, 0xc6, 0xed, 0xac, 0xdf, 0x52, 0x6f, 0xbf, 0xe7,
+	0x37, 0x1e, 0x27, 0xf2, 0x1b, 0xbe, 0x99, 0x68, 0xf8, 0x59, 0x52, 0x3b, 0x26, 0x9a, 0x51, 0x54,
+	0xb0, 0x32, 0x61, 0x1a, 0xf7, 0xde, 0x5c, 0xca, 0xc9, 0xa4, 0xfc, 0x21, 0x80, 0xb7, 0x3a, 0xd4,
+	0xfc, 0xd8, 0xfe, 0x0a, 0xe9, 0xac, 0xe7, 0x59, 0x7d, 0x4c, 0x18, 0xd6, 0xdb, 0x90, 0xc1, 0xa9,
+	0x79, 0x79, 0x00, 0xae, 0x18, 0x90, 0x41, 0xce, 0xca, 0xad, 0x02, 0x56, 0xc2, 0xac, 0xc8, 0x48,
+	0xe5, 0x4d, 0x92, 0x14, 0x04, 0x6a, 0xde, 0xce, 0xf2, 0xb1, 0x91, 0x5e, 0x80, 0x9c, 0xf2, 0x95,
+	0x75, 0x20, 0xe7, 0x9f, 0x44, 0xcb, 0xf0, 0xaf, 0x00, 0xe4, 0x88, 0xac, 0x08, 0x12, 0x2d, 0x4d,
+	0x0f, 0xb1, 0xa9, 0x39, 0xf8, 0x02, 0x80, 0x68, 0x71, 0xfd, 0xfd, 0x98, 0xdd, 0x9c, 0x6f, 0x6c,
+	0x17, 0x30, 0x91, 0xcd, 0xfe, 0x19, 0x19, 0x20, 0x3b, 0xad, 0x96, 0x71, 0xc4, 0xe6, 0xdd, 0x2c,
+	0x25, 0xef, 0xa4, 0x29, 0xc9, 0x76, 0xd5, 0x43, 0x4c, 0xd9, 0x04, 0x37, 0x8a, 0x3b, 0x8e, 0xc8,
+	0x19, 0x85, 0x6f, 0xca, 0x7d, 0x6c, 0x0f, 0x1e, 0x38, 0xc8, 0x0d, 0x64, 0x29, 0x57, 0x41, 0x84,
+	0xe9, 0x15, 0x44, 0x02, 0xaf, 0x13, 0x1e, 0x3b, 0x14, 0xa2, 0x6e, 0xf4, 0x5b, 0xbc, 0x0e, 0xca,
+	0x14, 0x9b, 0x36, 0x64, 0x43, 0x37, 0xd4, 0x95, 0x85, 0x6e, 0x6c, 0x68, 0xde, 0x39, 0x5b, 0x1b,
+	0xa4, 0x34, 0x0f, 0xc9, 0x06, 0x94, 0x55, 0xb0, 0x32, 0x61, 0x1a, 0xf7, 0xdb, 0xf8, 0x79, 0x0e,
+	0xcc, 0x76, 0xa8, 0x29, 0x7e, 0x03, 0x16, 0x27, 0xaf, 0xd1, 0x7a, 0xc1, 0xe0, 0xb2, 0xca, 0x2e,
+	0xdd, 0xb9, 0x14, 0x7c, 0x5c, 0x80, 0xf8, 0x35, 0x78, 0x23, 0x7d, 0x09, 0xbc, 0x5b, 0x1c, 0x27,
+	0x05, 0x96, 0xb6, 0x2f, 0x01, 0x8e, 0x52, 0x7e, 0x2f, 0x80, 0x85, 0x94, 0x14, 0xd6, 0x8a, 0xa3,
+	0x24, 0xb1, 0x52, 0xe3, 0xe2, 0xd8, 0x68, 0xa9, 0x96, 0x5e, 0x66, 0x25, 0xc8, 0xaf, 0x62, 0x29,
+	0x4f, 0x7f, 0xb6, 0x8a, 0x13, 0xe4, 0xb8, 0x48, 0x3b, 0x97, 0x76, 0x89, 0xb8, 0xf8, 0x55, 0x00,
+	0x6b, 0x45, 0x4a, 0xb0, 0x73, 0x91, 0x76, 0x73, 0x5d, 0xa5, 0xdd, 0xa9, 0x5d, 0xa3, 0xea, 0x6c,
+	0xb0, 0x90, 0x7a, 0x13, 0xcf, 0x19, 0x54, 0x12, 0x2b, 0x35, 0x2e, 0x8e, 0x1d, 0xe7, 0x93, 0x5e,
+	0xfb, 0xd6, 0x97, 0x9c, 0xd6, 0xce, 0x8b, 0x63, 0x59, 0x78, 0x75, 0x2c, 0x0b, 0x7f, 0x1f, 0xcb,
+	0xc2, 0x4f, 0x27, 0x72, 0xe9, 0xd5, 0x89, 0x5c, 0xfa, 0xf3, 0x44, 0x2e, 0x7d, 0x5e, 0x4d, 0xdd,
+	0xef, 0xa9, 0x2b, 0x89, 0x79, 0x0e, 0xa2, 0xfd, 0xb9, 0xe0, 0x7f, 0xd6, 0xf6, 0x7f, 0x03, 0x00,
+	0xe4, 0x2e, 0xa5, 0xcb, 0xbc, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -370,6 +683,15 @@ type MsgClient interface {
 	// UpdateParams defines an operation for updating the x/symStaking module
 	// parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// InjectSymbioticData defines the message carried by the tx injected by the
+	// proposer at a Symbiotic sync height. It can't be submitted by users.
+	InjectSymbioticData(ctx context.Context, in *MsgInjectSymbioticData, opts ...grpc.CallOption) (*MsgInjectSymbioticDataResponse, error)
+	// UpdateSymbioticValidatorSet defines a governance operation for setting the
+	// tokens of the validators while the Symbiotic validator set is stale.
+	UpdateSymbioticValidatorSet(ctx context.Context, in *MsgUpdateSymbioticValidatorSet, opts ...grpc.CallOption) (*MsgUpdateSymbioticValidatorSetResponse, error)
+	// LinkOperator defines a method for linking the Ethereum address of a
+	// Symbiotic operator to a validator, with a signature of the operator key.
+	LinkOperator(ctx context.Context, in *MsgLinkOperator, opts ...grpc.CallOption) (*MsgLinkOperatorResponse, error)
 }
 
 type msgClient struct {
@@ -407,6 +729,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) InjectSymbioticData(ctx context.Context, in *MsgInjectSymbioticData, opts ...grpc.CallOption) (*MsgInjectSymbioticDataResponse, error) {
+	out := new(MsgInjectSymbioticDataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Msg/InjectSymbioticData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSymbioticValidatorSet(ctx context.Context, in *MsgUpdateSymbioticValidatorSet, opts ...grpc.CallOption) (*MsgUpdateSymbioticValidatorSetResponse, error) {
+	out := new(MsgUpdateSymbioticValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Msg/UpdateSymbioticValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LinkOperator(ctx context.Context, in *MsgLinkOperator, opts ...grpc.CallOption) (*MsgLinkOperatorResponse, error) {
+	out := new(MsgLinkOperatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Msg/LinkOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -416,6 +765,15 @@ type MsgServer interface {
 	// UpdateParams defines an operation for updating the x/symStaking module
 	// parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// InjectSymbioticData defines the message carried by the tx injected by the
+	// proposer at a Symbiotic sync height. It can't be submitted by users.
+	InjectSymbioticData(context.Context, *MsgInjectSymbioticData) (*MsgInjectSymbioticDataResponse, error)
+	// UpdateSymbioticValidatorSet defines a governance operation for setting the
+	// tokens of the validators while the Symbiotic validator set is stale.
+	UpdateSymbioticValidatorSet(context.Context, *MsgUpdateSymbioticValidatorSet) (*MsgUpdateSymbioticValidatorSetResponse, error)
+	// LinkOperator defines a method for linking the Ethereum address of a
+	// Symbiotic operator to a validator, with a signature of the operator key.
+	LinkOperator(context.Context, *MsgLinkOperator) (*MsgLinkOperatorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -431,6 +789,15 @@ func (*UnimplementedMsgServer) EditValidator(ctx context.Context, req *MsgEditVa
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) InjectSymbioticData(ctx context.Context, req *MsgInjectSymbioticData) (*MsgInjectSymbioticDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InjectSymbioticData not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSymbioticValidatorSet(ctx context.Context, req *MsgUpdateSymbioticValidatorSet) (*MsgUpdateSymbioticValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSymbioticValidatorSet not implemented")
+}
+func (*UnimplementedMsgServer) LinkOperator(ctx context.Context, req *MsgLinkOperator) (*MsgLinkOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkOperator not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -490,6 +857,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InjectSymbioticData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInjectSymbioticData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InjectSymbioticData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Msg/InjectSymbioticData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InjectSymbioticData(ctx, req.(*MsgInjectSymbioticData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSymbioticValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSymbioticValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSymbioticValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Msg/UpdateSymbioticValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSymbioticValidatorSet(ctx, req.(*MsgUpdateSymbioticValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LinkOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLinkOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LinkOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Msg/LinkOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LinkOperator(ctx, req.(*MsgLinkOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.symStaking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -506,6 +927,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "InjectSymbioticData",
+			Handler:    _Msg_InjectSymbioticData_Handler,
+		},
+		{
+			MethodName: "UpdateSymbioticValidatorSet",
+			Handler:    _Msg_UpdateSymbioticValidatorSet_Handler,
+		},
+		{
+			MethodName: "LinkOperator",
+			Handler:    _Msg_LinkOperator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symStaking/v1beta1/tx.proto",
@@ -734,26 +1167,223 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgInjectSymbioticData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Commission.Size()
+
+func (m *MsgInjectSymbioticData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInjectSymbioticData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInjectSymbioticDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInjectSymbioticDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInjectSymbioticDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSymbioticValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSymbioticValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSymbioticValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSymbioticValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSymbioticValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSymbioticValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLinkOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLinkOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLinkOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLinkOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLinkOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLinkOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Commission.Size()
 	n += 1 + l + sovTx(uint64(l))
 	l = len(m.ValidatorAddress)
 	if l > 0 {
@@ -822,18 +1452,535 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInjectSymbioticData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInjectSymbioticDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSymbioticValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateSymbioticValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLinkOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLinkOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pubkey == nil {
+				m.Pubkey = &any.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -856,17 +2003,17 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -876,28 +2023,27 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -924,13 +2070,113 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInjectSymbioticData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInjectSymbioticData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInjectSymbioticData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -958,11 +2204,11 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -989,10 +2235,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pubkey == nil {
-				m.Pubkey = &any.Any{}
-			}
-			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1017,7 +2260,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgInjectSymbioticDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1040,10 +2283,10 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInjectSymbioticDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInjectSymbioticDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1067,7 +2310,7 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSymbioticValidatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1090,48 +2333,15 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSymbioticValidatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSymbioticValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1159,13 +2369,13 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1175,25 +2385,23 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.CommissionRate = &v
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, SymbioticValidatorTokens{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1218,7 +2426,7 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSymbioticValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1241,10 +2449,10 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSymbioticValidatorSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSymbioticValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1268,7 +2476,7 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,15 +2499,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLinkOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1327,13 +2535,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1343,23 +2551,56 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1383,7 +2624,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1406,10 +2647,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLinkOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
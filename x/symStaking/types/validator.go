@@ -395,3 +395,33 @@ func (v Validator) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 	var pk cryptotypes.PubKey
 	return unpacker.UnpackAny(v.ConsensusPubkey, &pk)
 }
+
+// NewConsPubKeyRotation records the rotation of the consensus pubkey of the
+// validator of operator at height.
+func NewConsPubKeyRotation(operator string, oldPubKey, newPubKey cryptotypes.PubKey, height int64) (ConsPubKeyRotation, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotation{}, err
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotation{}, err
+	}
+
+	return ConsPubKeyRotation{
+		OperatorAddress: operator,
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          height,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r ConsPubKeyRotation) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var oldPk, newPk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.OldConsPubkey, &oldPk); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(r.NewConsPubkey, &newPk)
+}